@@ -0,0 +1,261 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	sessionCookie = "ironsmith_session"
+	sessionLength = 24 * time.Hour
+)
+
+var (
+	authUsers  map[string]string // user name -> bcrypt hash of the user's password
+	authTokens map[string]string // token name -> static api token
+	sessions   = &sessionStore{sessions: make(map[string]*session)}
+)
+
+// compared against when a user doesn't exist, so a login takes the same time whether or not the user name is valid
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("ironsmith"), bcrypt.DefaultCost)
+
+var errAuthRequired = &Fail{
+	Message:    "You must be logged in, or use a valid API token",
+	HTTPStatus: http.StatusUnauthorized,
+}
+
+// loadAuth reads the users and api tokens from the settings file.  If neither are set, authentication is disabled
+func loadAuth(settingsFile string) error {
+	err := settingValue(settingsFile, "users", &authUsers)
+	if err != nil {
+		return err
+	}
+
+	return settingValue(settingsFile, "apiTokens", &authTokens)
+}
+
+func authEnabled() bool {
+	return len(authUsers) > 0 || len(authTokens) > 0
+}
+
+type contextKey string
+
+const userKey = contextKey("user")
+
+// authHandler only passes requests through to its handler if they have a valid bearer token or session cookie
+type authHandler struct {
+	handler http.Handler
+}
+
+func authenticate(handler http.Handler) http.Handler {
+	return &authHandler{handler: handler}
+}
+
+func (a *authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !authEnabled() {
+		a.handler.ServeHTTP(w, r)
+		return
+	}
+
+	user, ok := authUser(r)
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="ironsmith"`)
+		errHandled(errAuthRequired, w, r)
+		return
+	}
+
+	a.handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userKey, user)))
+}
+
+// authUser returns the name of the api token or the logged in user that made the request
+func authUser(r *http.Request) (string, bool) {
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		token := []byte(strings.TrimPrefix(header, "Bearer "))
+		for name := range authTokens {
+			if subtle.ConstantTimeCompare(token, []byte(authTokens[name])) == 1 {
+				return name, true
+			}
+		}
+		return "", false
+	}
+
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return "", false
+	}
+
+	return sessions.get(cookie.Value)
+}
+
+// requestUser returns the user or api token name of an authenticated request, blank if authentication is disabled
+func requestUser(r *http.Request) string {
+	user, _ := r.Context().Value(userKey).(string)
+	return user
+}
+
+type session struct {
+	user    string
+	expires time.Time
+}
+
+// sessionStore holds the sessions of the users logged into the web UI.  Sessions are only kept in memory, so
+// everyone will need to log in again when ironsmith restarts
+type sessionStore struct {
+	sync.Mutex
+	sessions map[string]*session
+}
+
+func (s *sessionStore) add(user string) (string, error) {
+	id := make([]byte, 32)
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", err
+	}
+
+	key := base64.RawURLEncoding.EncodeToString(id)
+
+	s.Lock()
+	defer s.Unlock()
+
+	now := time.Now()
+	for k := range s.sessions {
+		if now.After(s.sessions[k].expires) {
+			delete(s.sessions, k)
+		}
+	}
+
+	s.sessions[key] = &session{
+		user:    user,
+		expires: now.Add(sessionLength),
+	}
+
+	return key, nil
+}
+
+func (s *sessionStore) get(key string) (string, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	ses, ok := s.sessions[key]
+	if !ok {
+		return "", false
+	}
+
+	if time.Now().After(ses.expires) {
+		delete(s.sessions, key)
+		return "", false
+	}
+
+	// users that have been removed from the settings are logged out
+	if _, ok := authUsers[ses.user]; !ok {
+		delete(s.sessions, key)
+		return "", false
+	}
+
+	return ses.user, true
+}
+
+func (s *sessionStore) remove(key string) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.sessions, key)
+}
+
+type loginInput struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+/*login routes
+/login
+	GET returns the logged in user, and whether or not authentication is enabled
+	POST logs in with a username and password, and sets the session cookie
+	DELETE logs out
+*/
+func loginGet(w http.ResponseWriter, r *http.Request) {
+	user, _ := authUser(r)
+
+	respondJsend(w, &JSend{
+		Status: statusSuccess,
+		Data: struct {
+			User        string `json:"user,omitempty"`
+			AuthEnabled bool   `json:"authEnabled"`
+		}{
+			User:        user,
+			AuthEnabled: authEnabled(),
+		},
+	})
+}
+
+func loginPost(w http.ResponseWriter, r *http.Request) {
+	input := &loginInput{}
+	if errHandled(parseInput(r, input), w, r) {
+		return
+	}
+
+	hash, ok := authUsers[input.Username]
+	if !ok {
+		hash = string(dummyHash)
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(hash), []byte(input.Password)) != nil || !ok {
+		errHandled(&Fail{
+			Message:    "Invalid username or password",
+			HTTPStatus: http.StatusUnauthorized,
+		}, w, r)
+		return
+	}
+
+	key, err := sessions.add(input.Username)
+	if errHandled(err, w, r) {
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    key,
+		Path:     "/",
+		Expires:  time.Now().Add(sessionLength),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	respondJsend(w, &JSend{
+		Status: statusSuccess,
+		Data:   input.Username,
+	})
+}
+
+func loginDelete(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie(sessionCookie); err == nil {
+		sessions.remove(cookie.Value)
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	respondJsend(w, &JSend{
+		Status: statusSuccess,
+	})
+}
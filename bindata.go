@@ -71,7 +71,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _webCssPureMinCss = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x3c\x59\x8f\xdc\x36\x93\xef\xfe\x15\x8a\x0d\x23\x33\x03\x49\x23\xa9\x6f\x09\x36\x36\x87\x93\xf5\x22\x09\x16\x48\x1e\x76\xe1\xcc\x02\x6c\x89\xdd\xad\x1d\x5d\x90\xd4\x73\xb8\xb7\xff\xfb\x16\x0f\x49\xbc\xd4\xdd\xe3\x7c\xfe\x90\x87\xcf\x0d\xc7\x22\x59\x2c\x16\xeb\x22\xab\x48\xe6\xf6\xe6\x9b\x57\xff\xb9\xaf\xb1\xf5\xe0\xb9\x73\xd7\x7b\xf5\x43\x59\x3d\xd7\xe9\x76\xd7\x5a\x81\xe7\x4f\xad\xff\x46\xbb\xb2\xfc\xc6\xfa\x58\xc4\xae\xf5\x5d\x96\x59\xb4\xa9\xb1\x6a\xdc\xe0\xfa\x01\x27\xee\xab\x5f\xd2\x18\x17\x0d\x4e\xac\x7d\x91\xe0\xda\x6a\x77\xd8\xfa\xfe\xf7\x1f\x2d\x5e\xed\xbe\xda\xb5\x6d\xd5\x84\xb7\xb7\xdb\xb4\xdd\xed\xd7\x6e\x5c\xe6\xb7\xcf\x04\xe7\x6d\x05\x83\xde\xae\xb3\x72\x7d\x9b\xa3\xa6\xc5\xf5\xed\x2f\x1f\x7f\xf8\xf0\xdb\xef\x1f\xdc\x3c\x79\x75\x73\xfb\x8a\xd0\x55\x94\x75\x8e\xb2\xf4\x33\x76\xe3\xa6\xb1\x1e\xfe\x67\xe2\x7a\xd6\xff\x59\xbf\x7e\xfc\xa3\x43\x0f\x25\xc0\xeb\xa6\xe5\x6d\x0f\x2a\x4c\xe0\x2a\xbe\xb6\x7e\x4b\xe3\x32\x43\x8d\xf5\x33\xca\x32\xb4\xdd\x01\x85\xa8\x48\xac\xff\x28\x0b\xd4\xee\x50\x61\xfd\x86\x51\xc6\x47\xb3\x94\xd1\x60\x30\x37\x38\x3b\x9c\x75\x73\xbb\x6b\xf3\xec\xb0\x29\x8b\xd6\xd9\xa0\x3c\xcd\x9e\xc3\x06\x15\x8d\x03\xfc\x49\x37\x91\x93\x37\x4e\x8b\x9f\x5a\xa7\x01\x58\x07\x25\xff\xbb\x6f\xda\xd0\xf7\xbc\xb7\x91\xf3\x88\xd7\xf7\x69\x6b\x6e\x3d\xae\xcb\xe4\xf9\x90\xa3\x7a\x9b\x16\xa1\x77\x44\x75\x9b\xc6\x19\xb6\x51\x93\x26\xd8\x4e\x70\x8b\xd2\xac\xb1\x37\xe9\x36\x46\x55\x9b\x96\x05\xf9\x04\x6e\xda\x9b\xb2\x04\x46\xda\x3b\x8c\x12\xf2\xcf\xb6\x2e\xf7\x95\x9d\xa3\xb4\xb0\x73\x5c\xec\xed\x02\x3d\xd8\x0d\x8e\x69\x8f\x66\x9f\x03\xfa\xe7\x43\x92\x36\x55\x86\x9e\x43\x10\x44\x7c\x7f\x44\xfb\x24\x2d\xed\x18\x15\x0f\xa8\xb1\xab\xba\xdc\x82\x9c\x1b\xfb\x01\x46\x2d\x7b\xc8\xb4\xc8\xd2\x02\x3b\xb4\x43\xf4\x80\x09\x69\x28\x73\x80\x19\xdb\x22\x5c\xa3\x06\x93\x56\x86\x28\x2c\xca\xf6\xea\x53\x0c\x9c\xa9\xcb\xac\xb9\xbb\xee\x51\x14\x65\x81\xa3\x1d\x26\x42\x82\xd9\x7d\xda\xa5\x49\x82\x8b\x3b\xbb\xc5\x39\x34\xb7\x58\x82\x3b\xa2\xc3\x1a\xc5\xf7\x64\x2e\x45\xe2\x80\x30\xcb\x3a\x6c\x6b\xe0\x70\x85\x6a\x5c\xb4\x47\x14\x22\x98\xd1\x03\x30\x27\xdc\x95\x40\xce\xa1\xdc\xb7\x84\x04\xc2\xb6\xf5\xba\xfe\xd4\xa6\x6d\x86\xef\x0e\xeb\xb2\x06\x9e\x38\xeb\xb2\x6d\xcb\x3c\xf4\xab\x27\x2b\x81\x4f\x9c\x1c\xd7\x76\x03\xe4\x15\x5b\x26\xc1\x47\x46\xd4\xc2\xf3\x8e\xc9\xa6\x60\x75\x4d\xfb\x9c\xe1\x30\x6d\x61\x8a\xf1\x71\xe7\xf3\x4a\x90\x58\x18\xe0\x3c\xe2\x42\x72\xe7\x0b\x9c\x5b\xde\x11\x8a\xf7\x02\xc1\xe1\x9b\xcd\xc6\x8b\x18\xd5\x6f\x3c\xc0\xda\x80\xda\x64\x02\x8a\x25\x08\xbb\xd9\x03\x11\xfb\x4a\xa8\x5d\xcc\xde\x46\x94\xcb\x1d\x93\xa2\xaa\x6c\x52\x22\xb8\xb0\xc6\xc0\x22\x98\xef\x28\xeb\x09\xa6\xb6\xac\x42\xc7\x9d\xe1\x9c\xe0\x3e\xf0\x49\x3b\x6e\x40\x6a\xd2\x7c\xcb\xb9\x01\x2c\x6a\x1e\xb6\x54\x4a\x61\x0d\xaa\x73\x7d\x20\x0c\xdc\x64\xe5\x63\xc8\x44\x72\x64\x7a\xd5\x29\xa2\x0f\x33\x9c\x7a\xd5\xd3\x71\x57\x1f\x9c\xbc\xfc\x0c\xdc\x7c\x22\xf4\xa6\xc5\x36\x24\x52\x06\x71\x90\xaa\x68\xa4\xba\x17\x78\x05\x28\xfb\x91\xd0\xbe\x2d\x8f\x71\x09\x7a\x7d\xbf\x4e\x40\xe7\xb0\xdd\xa0\xbc\x92\xec\x29\x2f\x8b\x12\xc4\x1d\x63\xbb\xff\x8a\x06\x5e\x01\x55\xc7\xf5\x1e\x66\x58\xd8\x69\x51\xed\x5b\xbb\xac\x5a\xa6\xf9\xc0\x10\xd0\x76\x9b\x58\x18\xe8\x0a\x3a\x30\x31\xa4\x05\x78\x81\xb4\xa5\x18\xfa\x42\x6f\x6a\x0c\xd3\x40\xde\x43\xda\xa4\xeb\x0c\x77\x23\x30\x94\x07\x6a\xb4\x54\x0b\x37\xe0\x08\x98\x9e\x72\x08\xe2\x0d\x2c\x4a\xc8\xa7\xf6\xb9\xc2\xef\x58\xf5\x9d\x2d\x54\x11\xe7\xd9\x4a\x35\x20\xa5\x3c\x6d\xef\x0e\x9d\x53\x40\x55\x85\x11\xa0\x8f\x71\xc8\xfa\x47\xf1\xbe\x6e\x80\xf8\xaa\x4c\x81\xa1\x35\x1f\xec\x13\x18\x0a\x02\xea\x92\x3b\x71\xd8\xbe\xf2\xc0\x3b\x25\x78\x83\xf6\x59\xcb\x3b\x85\x21\x95\xdd\xa6\x8c\xf7\x8d\x93\x16\x05\x78\x0a\xda\x4f\xaf\xef\xd5\x24\xaa\x50\x92\x10\x71\x7a\x47\x0a\x7a\x10\x75\x93\xb9\xc2\xa3\x30\x9b\x78\x87\xe3\x7b\x90\xb8\x3c\x69\x04\x1e\x81\xd8\x61\xaf\x1b\xbd\x49\x3e\xa9\xf8\x59\x8f\x62\x9f\xaf\x71\x7d\x07\x74\x71\xae\x50\xa2\x9c\xa6\x4a\x0b\x47\x14\xf8\x08\x34\xf8\x01\x19\xfa\xc0\x09\xa6\x1a\x27\x32\x1f\x58\x1d\xef\x8c\xcc\x27\x72\xde\xa4\x38\x4b\xa2\x53\xfa\xde\x75\x7c\x91\x39\x18\x28\x18\x68\x67\x15\x4e\x4c\x88\xc8\x0c\x93\x1d\xeb\x90\xe0\xb8\xac\x11\xf1\x13\xa6\xd9\x50\x35\xa5\xd3\x01\xfd\xeb\x84\x4b\x5c\x61\x53\x66\x69\x62\x35\x69\x06\x5a\xdf\x9b\x82\x15\x54\x83\x60\xdc\x09\xb8\x0e\xcb\x9d\x07\xf4\x9f\x05\xf1\x23\x19\xde\xe2\x22\x31\xe9\x48\x6f\x70\xb2\x91\x77\x76\xa9\x79\xda\x96\xa8\x6b\xe7\xa1\xc1\x48\x33\x54\x35\x38\xec\x3e\x22\xde\x40\xec\x9e\xe3\x4f\xec\x76\x77\x18\xc6\x73\x99\xb7\xb2\xbb\x85\x44\x5a\x3f\xbe\x49\xf3\xaa\xac\x5b\x04\x4b\x85\x4b\xb6\x1c\x0e\xf1\x7f\x39\x7a\x72\x1e\xd3\xa4\xdd\xb1\xb5\x58\x50\x8c\x48\x5e\x10\x59\x97\xed\x21\xc3\x6d\x2b\xd0\xe0\xb8\x13\x70\x3b\xd1\x8d\x52\xcd\x4c\x21\xba\x79\x04\x8a\x05\xd8\xe9\x04\x60\xa9\xcb\x80\x15\x0b\xa6\x42\x6a\x81\x19\x69\x0e\xee\xab\xa9\x30\x4e\x22\xd1\xdb\xfd\x54\x63\xfc\x3b\x78\x16\xfb\xbb\x3a\xcd\x4b\xfb\xf5\x8f\x75\x09\xc2\x21\x35\xaf\xed\x7f\xc7\x20\x22\xe2\xf5\x49\x23\xca\x6c\x61\xa7\xd1\xd1\xdd\xc9\x7d\x93\xe1\x41\x33\x49\xc1\xa1\x92\xa8\xcb\x47\xeb\xb1\x46\xd5\x00\x0f\x3b\x14\xd2\x4c\x15\x99\x7f\x2b\xa0\xa4\x9a\x2e\x32\x0e\xd7\xdf\x90\x02\x35\x20\xe4\xb6\x1f\x62\x14\x60\xac\xe1\xe8\x96\x15\xae\x91\x53\x16\xd9\xb3\x15\x3a\xa5\x03\xbe\x9f\x3a\x1f\xbb\x63\xba\x81\x8d\x5c\x20\x7b\xf3\x6e\xe4\x46\xae\x8d\x3e\x97\x64\xad\x8f\xcc\x52\x92\xb0\xf3\x3a\x65\x4d\x85\x65\x54\x95\x1b\xd5\x64\x4e\xa0\xf5\x29\x86\xad\x65\x63\xdd\xbc\x7b\xcd\xa8\x7a\x7d\x37\xb2\x0f\xec\xc8\x76\x7c\xbb\xff\x92\xbe\x03\xe1\x7b\xd2\x7f\x07\xc2\xb7\xef\x4c\xfb\xef\x89\xf0\xed\x3b\x33\x01\x7e\x26\xc0\x0c\xdf\x53\xe1\x7b\x26\x7c\xfb\xce\x5c\xa8\x9f\x0b\xf5\x4b\x01\xcf\x52\x80\x19\xbe\x17\xc2\x37\xcc\x25\x10\x80\x84\xc2\x42\x2c\xf8\x12\x1c\x4c\x7a\x2a\x50\x1e\x88\xd3\x13\x0a\x53\xb1\x30\x13\x0b\x73\xb1\xb0\x10\x0b\x4b\xb1\xb0\x12\x0b\xbe\x27\x95\x24\x1a\x7c\x89\x08\x5f\xa2\xc2\x97\xc8\xf0\x25\x3a\x7c\x89\x10\x5f\xa2\xc4\x97\x48\xf1\x25\x5a\x02\x89\x96\x40\xe6\x87\x44\x4b\x20\xd1\x12\x10\x5a\xfe\x0e\x06\xc0\x64\x78\x60\x8e\x74\xea\xfa\xf3\xf9\xe2\x2d\x38\xbf\xae\x38\x99\x2d\xde\x1e\x8d\x2a\x12\x0c\xdd\x96\xee\x04\xfe\xf4\xdd\xa0\xe8\x05\x13\xb1\xdb\x52\x52\x0b\xde\xcb\x0f\xdc\x59\xdf\x07\x0a\xd3\xf9\x4a\xec\x33\x97\xb4\xa7\xeb\x33\x77\xe7\x22\x85\xa4\xac\x90\x38\xe3\xa0\x81\x37\x40\xad\xdc\x95\x88\x7c\x36\x20\x0c\x3c\x77\x29\xd2\x4e\xca\x0a\xf1\xb2\xb2\x76\xfd\x06\xd2\x83\xa9\x8c\x7d\x21\x40\xad\x64\x86\x92\xb2\x42\xee\x44\x52\x78\xde\x6f\x32\x91\x39\x4a\xca\x12\x55\xa2\x41\xaf\x84\x7e\x0b\x81\xa5\x50\x90\x58\x1a\xf4\xac\x99\x0e\xac\x99\x68\xac\x11\xad\xdb\x13\x54\xc3\x97\x39\x4f\xca\xf2\x54\x44\x3d\x9a\xc9\x5c\x25\x65\x85\xab\x81\x6c\xb2\xbc\xe3\x6c\xa0\x6c\xaa\x50\xe6\x0b\xaa\x33\x53\x14\x75\xa6\x6a\xaa\xec\xb2\x04\x05\x9a\x29\xba\x3a\x53\x95\x75\xd2\x73\x69\x3e\xd0\x32\xd3\xb8\xb4\x94\xfd\x48\xd7\x45\x54\xe9\x79\xa0\xf2\x7f\x22\xbb\x9b\xae\x93\xa2\xd3\x73\x4d\xa7\x05\x8d\x5a\x28\xfa\xba\x50\xf5\x55\x5a\x56\x04\x95\x5a\x0c\x84\x2d\x14\x85\xf5\x05\x0d\x5a\x28\x1a\xbb\x50\x35\x76\xda\xf3\x67\xe9\x89\x50\x0a\x7f\xe6\xb2\x87\xec\xba\x28\x7a\xbd\x54\xf5\x5a\x5c\x90\x02\x41\xa1\x96\xa2\x62\x2f\x15\xc5\x96\x97\xa4\x40\x50\xa7\x95\xa2\xb4\x2b\x55\x69\x03\x41\xa9\x56\x8a\xd2\xae\x34\xa5\x35\xae\xfb\xe2\x5a\x1c\x88\xae\x8a\xa4\x80\x58\x03\x0f\x5d\x8c\xde\xbe\xf3\xee\x5a\x30\x16\x3d\xee\xd2\x16\x53\xdf\x4e\x76\xfd\x74\x13\xa7\x78\xf6\x1c\xb6\xca\x19\x66\xce\x9d\xd5\xc4\x98\x44\x96\x4a\xa0\xd9\x6f\xef\xf6\xb0\x8f\x71\x92\x1a\x6d\x59\xf2\x46\xaa\x66\x41\x31\x6f\x20\x71\x92\xa1\xb6\xd1\x2b\x75\x28\x3d\x8e\x12\x62\x44\x35\x02\x13\x9a\x8c\xb5\x12\x03\x0d\xc1\x6d\x1f\x3f\x44\x7d\x36\x44\x62\xb9\xb8\x93\x13\x73\x06\x3c\xeb\x40\xe2\x86\x3e\x3a\x22\x51\x11\x09\x08\x78\xa2\x67\x3a\x9d\xf2\xcf\x7a\xbb\x46\x57\x9e\x4d\x7e\xee\xf2\x3a\xd2\x62\xae\x37\xab\xd5\xaa\x1f\xdf\x12\xa0\x3d\x00\x56\xd3\x5e\x6f\x3e\xcc\xc9\x8f\x09\x6d\x08\xf5\x18\xf3\xf8\xc4\x49\x98\xbd\x6f\x42\x88\xdd\xa4\xd9\x38\x34\x37\x66\x4b\x2c\x31\x54\x51\xfe\x1c\x36\x69\x06\xa2\x0f\x49\xfe\x2f\x4d\xc2\x1f\xff\xeb\x63\x8e\xb6\xf8\x8f\x2e\xdb\xe1\xfe\x9a\xc6\x75\xd9\x94\x9b\xd6\xdd\x92\xd1\x40\x6d\xae\xe8\x86\xfe\x07\x42\x64\xd3\xd6\xef\xbe\x25\x99\x2e\xfa\xe7\x5b\xdb\x82\x8d\x83\xd0\xe0\xa3\xbe\xe1\x67\xde\xf9\x0f\x12\xd0\xca\xd3\x4d\xc9\x80\x7d\x24\xd3\x8f\x42\x14\x1d\xd5\xb6\x67\x01\x7b\x2c\x22\x00\x7b\x53\x97\xf9\x95\x90\x0d\xbc\xb6\x29\xa7\x20\xc0\x28\xab\x2b\x58\xaa\x6c\x91\xff\xde\xec\xfa\xda\x6e\xcb\x2b\xb1\xce\xbf\xbe\x3e\x31\x32\x1b\x70\x20\x40\x18\x49\xc5\x6c\xa9\xa3\xf9\x46\xc4\x44\x09\x35\xac\x65\xa5\x61\xf3\x2e\xc0\x55\xfe\xe3\xe9\xfb\x8b\x08\x8f\x06\x65\x1a\xd2\xb1\x92\x3e\xf2\xa4\xad\xd4\x81\xd5\xb1\x14\xd1\x0e\x25\x10\x82\x82\xa8\xe1\x47\xcc\x45\x1a\x09\x46\x4f\x8b\x06\xb7\x44\x15\xac\xb9\xd2\x1a\xf0\xc6\x68\x48\x28\xf0\xe4\xeb\x9f\x2b\x89\x06\x21\x7b\x26\x91\xd6\x55\x9b\x6b\x0d\x66\x33\xb4\x89\xf1\xab\xda\xd6\xcf\x8e\xe7\x4b\x34\xe6\x53\x33\x7e\xb9\xed\xe1\x82\xe2\x7f\xb7\x41\x59\x83\xaf\x3b\x04\x28\xab\x76\xe8\xaa\x24\xbb\xfb\xf6\xf9\xdd\x14\xec\xcb\xb9\x27\x39\x42\x87\x57\x85\xee\x94\x79\x54\xa1\x2c\x7c\xf2\x55\xa0\x28\xc9\xea\x90\x95\x8f\x38\x89\x04\xb1\xd0\x44\x92\xec\x5e\x68\xe6\x45\x4e\xdc\xff\x35\xff\xeb\x54\x75\x4a\x4e\x28\x64\x6e\xb2\xe5\x02\x64\x83\x8c\xb0\xc8\x08\xac\x1f\x20\x80\x36\x2c\x96\x78\xd1\xf9\xeb\xcd\xa6\x8b\xd0\x09\x93\xc5\xec\x2d\x71\xb5\x9d\x7a\xa8\x6d\x15\xc4\xfe\x24\x86\x1a\x6b\xc7\x39\x4a\xb3\xb1\xc6\x7d\x3d\xda\x94\xa0\x16\x8f\xb5\xe5\xb0\x00\xed\xc6\x1a\xdb\x34\x1f\xed\x48\x90\x5e\xd2\xee\xc0\xce\x02\x8d\x92\xf6\x88\xf1\xfd\x58\x1b\x4f\xc0\x8e\xb4\xf2\x8c\xe5\x18\xe5\x78\x74\x48\x2a\x22\xa9\x91\xa7\xf5\x85\x9a\x3e\xe1\x28\x2d\xc8\xee\x1c\x56\x64\xe3\xc6\x49\x5f\x86\xe3\x38\x16\x15\x9c\x3a\x10\xee\x79\x26\xf0\xf7\x4d\x92\x24\xca\x0a\x3b\xad\x9e\x46\xf6\x54\x5f\x65\x1b\x33\xb0\x85\x9d\xaa\x11\xde\xdc\x5d\xff\xb3\x27\xfc\x95\xa7\x26\x4a\x7c\x98\x5a\x40\xa6\x36\xeb\x73\x7f\x46\x13\x95\x3c\xef\xa8\xa1\x9e\x84\x62\xe6\x7a\x12\x84\x18\xed\x49\x00\x6a\xba\x27\x21\x98\x01\x9f\x04\xa1\x66\x7a\x76\x98\xcb\xa1\xb8\x49\x9f\x84\xa5\x86\x7d\x12\xa2\x3b\x5f\x39\x05\xd3\x1d\x4b\x9c\x9c\x1d\x3e\x43\x0a\x13\xbf\x0e\xc2\x23\x05\xad\xbe\x33\x7e\x75\xbf\xa1\xac\xff\x7e\xb0\xfa\xe9\xc3\x77\x27\xad\xe9\xcb\x30\x30\xaa\x61\xd9\x3d\x23\x0e\x76\x00\x76\x7a\xea\xdd\xc1\x99\x42\x49\xbb\x4b\x8b\xce\x70\x19\x15\x51\xd7\x44\x0c\x96\xa4\xe4\x2c\x03\x79\xec\xb3\x43\x6a\x6b\x2d\x94\xa2\xee\x70\x97\x7a\x10\xcf\x78\xfc\x61\xb4\x38\x6d\xf7\x34\x6a\x75\x67\x21\x99\xe5\x9d\x05\x23\xd6\x77\x16\x88\x5a\xe0\x59\x28\x66\x85\x67\xc1\xa8\x8d\x5d\x34\xe4\xcb\x20\xb9\x45\x9e\x85\xa7\x56\x79\x16\x8a\x5b\xe6\x59\x38\x6e\x9d\xe7\x67\x8d\x2f\x20\x8d\x59\xa9\x19\x8c\x59\xaa\xb9\xad\xb3\x56\xfd\x94\x5a\xda\x6b\x6a\x1b\x36\x8c\x70\x02\x0d\xbc\x14\xa3\x24\x48\x26\x27\x8d\xf9\xab\x0c\xf0\x09\x28\x4f\xc8\x81\x95\x69\xbe\xc6\xb6\x7e\xbe\x7d\xab\x61\x37\x8a\x31\xee\x06\x5e\x2c\x16\x8a\xdb\x81\x95\x5a\x9f\x28\xf5\x0f\xb0\x6a\x3f\xc0\xce\x23\x19\xf7\x86\x26\x08\xd1\x8f\x76\xed\xfc\x6a\xc4\x9b\xf5\x6a\x8a\xa6\x4b\x85\x00\xbc\x9a\x04\x41\x72\xde\xef\x75\xc8\x2e\xf6\x82\x17\x75\x50\x7c\xa2\xdc\xa7\xf3\x90\xe3\xa4\xf2\x0b\x1b\x3c\x3d\x16\xd0\x2b\x30\x63\xdb\x21\x4d\x2e\x4a\x5c\xc0\xe5\x9c\xef\xb3\x36\xad\xc8\xa5\x22\xf1\x42\x81\x00\x97\xa1\x35\xce\x64\xbf\x6a\x91\x5d\x8c\x08\xd3\x1f\xc3\x77\xc7\xed\xca\x51\xbb\xc7\x4e\xd9\xd5\xd8\x88\xe1\x67\x27\xef\x92\xab\x8e\x84\xe3\xec\x01\x13\x75\xe9\x6c\x84\xee\xea\x13\xa9\xec\x94\x6d\x32\x99\x44\xfa\xc5\x28\xce\x12\x3c\x23\x3f\x61\x5c\x72\x6c\x1b\xdf\xe3\xe4\x54\x7c\x64\x82\x31\xc5\x49\x26\x38\x2d\x5e\x32\x01\x29\x71\x93\x09\x44\x8d\x9f\x4c\x30\x5a\x1c\x65\x9c\x9c\x12\x2f\x8d\x0d\xf6\x12\x38\x3d\xbe\x32\x41\xab\x71\x96\x09\x46\x8f\xb7\x4c\x50\x7a\xdc\x65\x16\xe3\x59\x92\xb4\x38\xcc\x04\x44\xbd\x81\x09\x46\x8b\xd9\xfa\x16\x6a\x2c\xa6\x86\x3e\xa8\x93\xf5\xbc\x33\x2b\x7a\x13\xc5\x1b\x55\x4f\x29\x4a\xba\x14\x03\x0d\xe3\x3a\x0c\xb6\xa1\xa1\xa3\xc9\xd4\xa6\x4f\xb1\x6b\x61\x55\x3b\x9c\x55\x0e\x8b\xca\x44\xa0\x1c\x37\x0d\xda\x62\xde\x72\xd9\xb1\xee\x0d\xcf\xfc\x1b\xe3\x4f\xd3\x84\x7a\x5e\xea\xe7\xbb\x26\x70\xbe\x71\x64\x17\x37\x1d\x76\x69\x47\xf1\x22\x72\x3c\x76\xaa\x27\x77\x87\xc2\x49\x03\xbd\x9d\x6b\x0e\x54\xcd\x11\x75\xe7\xdb\x86\xeb\x96\x1e\x49\xb7\x93\xe4\xe0\x59\x22\x9a\xfe\xf6\x22\xf7\xc4\xd0\xd5\x37\x04\x93\xac\x4c\x3f\x1d\xba\x08\xe0\x44\xdf\x31\x4b\xcd\x07\x25\xe5\x0e\xd4\xa9\x07\x02\xfa\x6e\x9c\xb1\x44\xf1\xfe\xbd\xf7\xf5\xaa\xa7\xb1\x2e\xaa\x4e\x4a\x8d\x23\xb6\xd2\x91\x43\xf0\x0e\x9c\xf3\x2c\x07\xdc\xbc\x12\xdd\x9b\x6e\x98\xd2\x5b\xa4\xfe\x19\x92\xf4\xc5\xdb\x44\x18\x5f\xae\x3f\x03\x07\x13\xfc\x14\x4e\xce\xa0\x4c\xeb\xa6\x85\xc0\x25\xcd\x0c\x32\x50\x11\x0f\xb0\xf4\xda\xab\x3e\xb5\x29\xac\x69\xe4\xaf\xd7\xaf\x85\xf2\x72\x7a\x72\xfc\x30\x43\x5f\x42\x8a\xd0\x6d\x9c\xaa\x0b\xa9\x79\x01\x05\xca\xa8\x4e\xa0\xcb\x19\xd8\xc0\x19\x72\x76\x78\x7e\x10\xd6\xf9\xcb\x89\xea\x2f\x25\xa3\xf0\xf5\xb3\x4b\x0d\x26\x70\x26\xfd\xc1\xf5\x28\x90\xef\x04\xc3\x89\xfe\x38\xd0\xa4\xbf\xef\x70\x02\x48\xb8\x72\xa1\x03\xfd\x05\x87\xcc\x0d\xcb\xc9\xf0\xa6\x95\x76\x54\xf3\xf9\x7c\xc4\x87\x0d\x47\x87\xee\x72\xa1\x78\x4f\x3e\xa4\x62\xbd\x02\x4a\xad\xf3\xbf\xe5\x38\x49\x91\x45\xef\xce\x35\x71\x8d\x71\x41\xdf\x35\x5c\xf5\x17\x1b\xad\x70\xba\x04\xab\xbf\x3e\x08\xd3\xe6\xc7\x1e\xd2\x8d\xe3\x4e\xb8\x0b\xcd\x9d\xea\xeb\xe8\x68\xbc\xf8\xaf\x1c\xf9\xdf\x2d\x47\x2e\x86\x20\xd2\xce\xdf\x78\xbd\x55\x70\x36\xba\xb8\x85\x56\x5d\xde\x5a\xa3\x2a\x70\x0d\x40\x92\xb8\xd6\x2a\x88\x5c\x6b\x13\x65\xae\x35\x4a\x42\xd7\x49\x16\xa4\x6a\xc4\x7b\x11\x80\x2c\x77\x0d\x4c\x14\xbc\xd6\x28\x4b\x5e\x6b\x96\x45\x6f\x60\xf9\xf8\xb0\x3c\x5d\x2e\xcb\xfa\xfc\xbe\x48\xda\x9c\x19\x14\x45\xd8\xaf\x11\x2f\x17\x8d\x45\x9b\x5f\xb0\x01\xfb\x42\x57\x6c\x68\x51\x3c\xa6\xe0\x26\x69\xf0\x2c\x1d\x21\x40\x44\xbd\x04\xcf\xc9\x07\x27\x0f\xa2\x0e\x5f\xe5\x38\x83\x60\x76\x36\xe9\x13\x6c\x10\xfb\x2d\x15\x2d\x46\x74\xb5\xf0\xe8\xb6\xca\x8b\xd4\xad\x10\xed\x96\xa5\x4d\xb7\xc7\xa3\xe5\xb4\xc5\xf9\x41\xdb\x98\xa9\x3d\x0e\xe4\x3f\xfc\x99\x12\x3d\x44\xd6\x72\x0a\xde\x51\x43\xda\x9f\xbe\xf6\xc0\x3c\x9b\x21\x08\x95\x8f\x50\xdc\x8b\x34\x91\xd7\x65\xd0\x53\x61\xbd\xf1\x4e\x8a\x7e\x0d\x49\x44\xbc\x2b\xeb\xf4\x33\x48\x0c\x65\xc2\xd6\xe1\xe2\x3e\x96\xca\x04\xd3\x42\x7d\xbe\x2b\x61\x86\x7d\x16\x8a\xcf\xf9\x3c\x60\x83\x2b\x04\x1c\x28\xeb\x17\xdd\xcf\x3d\x15\xc7\xf5\x54\xaa\x54\x1f\x4c\xae\x9c\xb6\xd2\x0d\x60\xad\x1c\xc7\x0f\xfb\x7b\xb4\x6e\xca\x6c\xdf\x62\xa6\x8f\x94\xe9\x4c\x25\x75\xb5\x31\x6b\xa9\x79\xea\xfd\xa8\x82\x9a\xd3\x17\x15\x4c\xb8\xfc\x0a\x95\x88\x87\x26\x63\xd9\xcd\x24\x76\xab\xe2\xbd\x01\x9d\xc8\x73\x76\x85\xc2\x04\xa5\x06\x3e\xea\x5c\x25\xf2\x51\xd3\xf7\x7b\xaf\x28\x7a\x88\x36\xed\x70\x37\x81\xef\xef\x66\x74\x7f\xc7\xde\x2f\xbc\xfe\x33\x98\x7d\xbf\x7c\x2d\xb8\x1a\xfa\xa2\xef\x3c\x7b\x2e\x18\x55\x1a\xe3\xc3\x6b\x11\x27\xec\xf1\xc8\x73\x18\xf2\x4e\xa6\x7b\x54\xe3\x3c\x87\xac\x36\xea\x6b\x9e\xba\x77\x7b\xc6\x9e\xa3\x16\x73\xc2\x54\x5e\x86\xe8\xbc\xe9\x99\xa7\x64\xb8\xae\x28\xcc\x92\xcd\x49\x9c\x25\x55\x2b\x72\xa7\xb0\xaf\x13\x9c\x5f\xff\xfe\xab\x6f\xa3\x03\x11\x7d\x6e\xcb\x7d\xbc\x93\x83\x74\xef\x05\x74\x0a\xcf\xad\x68\xdd\x1a\xd5\xa6\x1b\x2f\x8a\x23\xd0\xd3\xca\x24\xd9\xdc\x39\xdb\x21\x0c\x63\xd9\xda\xf3\x8a\x34\x60\xe6\x5e\xb3\xea\x9f\x56\xfa\x74\xe5\xee\x03\x7d\x77\x78\x30\x23\x79\x6e\xe5\xf5\xe2\xbe\xaa\x70\x1d\xa3\xa6\x3f\x83\x98\xcd\x67\xc9\x7c\xaa\xae\x01\x87\xe1\x84\xc2\xe8\x6c\x4e\xa5\xcf\x8d\x0b\x89\x72\xcf\x4a\x22\x71\x24\x91\x22\xf5\x3b\xf4\xf7\x95\x66\xa6\x66\x4b\x35\x32\xf6\x3c\xf8\xe4\x63\xe2\x93\xbe\x46\xa5\x7f\x40\xaa\xd5\xb2\x6c\x87\xf1\xa0\x47\x56\x12\x76\x41\x49\x25\xd5\xbe\x00\x86\xbe\x4c\x25\x97\x9b\x84\xc7\xc5\x0c\xe4\x85\xcf\xe9\x22\x9c\x57\xed\xb3\x13\xe3\x2c\x6b\xc2\x66\x57\x3e\x9a\x8e\x47\xd6\xe4\x27\xe2\xb7\xf8\xd3\x73\x61\x78\xfe\xa2\x96\xbe\x93\xb6\x96\xb3\xb7\xb7\xbe\x85\xd4\x57\x6a\x7d\x46\x8a\x9e\x4c\x68\xb7\x8f\xa5\x11\xda\x4e\x33\x78\x71\xd7\xcd\x89\xad\x5b\x2a\x75\xdd\xc4\x98\x55\xf4\x37\x07\x05\x4f\xad\x3e\xf6\x8d\xd4\x57\xbe\x63\xf9\xbb\x8e\x1e\x43\x62\xaa\xa3\x4d\xca\x43\x09\x74\x76\xe4\xc8\x98\x88\x9a\x9b\xd4\xc3\x23\x3f\xe1\xb9\xb8\xb6\x0b\x57\x1f\x7d\xd3\x0d\xbb\x42\xe5\x25\x2a\x4e\x61\x9d\x32\x49\x8c\xf0\x6f\x36\x01\xf9\x49\xb0\x4d\x5b\xa7\x15\x49\x25\xd7\x21\x44\x5a\x6c\xa2\x57\x41\xe1\xf8\xd7\x17\xa3\x60\x6c\xc1\x6c\xcc\xb1\x63\x27\x5d\xd5\x84\x7e\xe4\x7f\x8b\xf0\x1e\x28\x18\xf2\x5c\xef\x55\x5c\x26\x86\x8b\x9e\x54\xd6\x2a\xa9\xa5\x57\xb0\x41\x85\x84\xbc\xdd\x45\xa4\x8a\xe8\x5e\x42\xec\xff\x07\x00\x00\xff\xff\x6f\x05\x93\x99\x86\x43\x00\x00")
+var _webCssPureMinCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x3c\x6b\x8f\xa4\x38\x92\xdf\xfb\x57\x30\xdd\x6a\x6d\x55\x0b\x28\x1e\xf9\x2a\x50\x8f\x6e\x76\x66\x76\x6f\x4e\xbb\xa3\x93\x66\x3e\xdc\xa9\xb7\x4e\x72\x42\x64\xa6\xaf\x00\x23\xdb\xd4\x2b\x37\xff\xfb\xc9\x60\xc0\x36\x26\x33\x7b\x76\xf6\xb4\x4a\x75\x37\x38\xc2\xe1\x70\xbc\x1c\x61\x9b\xbe\xfb\xf4\xcd\xbb\xff\x6c\x28\x38\x4f\x81\xbf\xf2\x83\x77\xdf\x93\xfa\x95\xe2\xfd\x81\x3b\x51\x10\x2e\x9c\xff\x46\x07\x42\xbe\x71\x7e\xaa\x32\xdf\xf9\xae\x28\x9c\x16\xc4\x1c\x0a\x0c\xe8\x13\xe4\xfe\xbb\xbf\xe0\x0c\x2a\x06\xb9\xd3\x54\x39\x50\x87\x1f\xc0\xf9\xe3\x2f\x3f\x38\xb2\xd9\x7f\x77\xe0\xbc\x66\xc9\xdd\xdd\x1e\xf3\x43\xb3\xf5\x33\x52\xde\xbd\x0a\x9a\x77\x75\x43\xe1\x6e\x5b\x90\xed\x5d\x89\x18\x07\x7a\xf7\x97\x9f\xbe\xff\xf1\xe7\x5f\x7e\xf4\xcb\xfc\xdd\xa7\xbb\x77\x82\xaf\x8a\xd0\x12\x15\xf8\x0d\xfc\x8c\x31\xe7\xe9\x7f\x62\x3f\x70\xfe\xee\xfc\xf5\xa7\x5f\x7b\xf2\xce\xdf\x9d\x3d\xe6\x3e\x26\x77\x03\xaa\x32\x81\x9b\xec\xd6\xf9\x19\x67\xa4\x40\xcc\xf9\x33\x2a\x0a\xb4\x3f\x00\x75\x50\x95\x3b\xff\x41\x2a\xc4\x0f\xa8\x72\x7e\x06\x54\xc8\xd1\x1c\x63\xb4\xd8\x0f\xfc\xe8\xe2\x70\xce\xa7\xbb\x03\x2f\x8b\xe3\x8e\x54\xdc\xdb\xa1\x12\x17\xaf\x09\x43\x15\xf3\x18\x50\xbc\x4b\xbd\x92\x79\x1c\x5e\xb8\xc7\xf0\x1b\x78\x28\xff\xdf\x86\xf1\x24\x0c\x82\x8f\xa9\xf7\x0c\xdb\x47\xcc\xed\xd0\xd3\x96\xe4\xaf\xc7\x12\xd1\x3d\xae\x92\xe0\x84\x28\xc7\x59\x01\x2e\x62\x38\x07\x37\x07\x8e\x70\xc1\xdc\x1d\xde\x67\xa8\xe6\x98\x54\xe2\xb1\xa1\xe0\xee\x08\xe1\x40\xdd\x03\xa0\x5c\xfc\xb3\xa7\xa4\xa9\xdd\x12\xe1\xca\x2d\xa1\x6a\xdc\x0a\x3d\xb9\x0c\xb2\xb6\x07\x6b\xca\x12\xd1\xd7\x63\x8e\x59\x5d\xa0\xd7\x64\x5b\x90\xec\xf1\x84\x9a\x1c\x13\x37\x43\xd5\x13\x62\x6e\x4d\xc9\x9e\x02\x63\xee\x13\xce\x81\x0c\x98\xb8\x2a\x70\x05\x5e\xdb\x21\x7d\x02\xc1\x1a\x2a\x3c\x54\xe0\x7d\x95\x6c\x11\x03\x01\xed\x08\x25\x15\xe1\x37\x5f\x32\x52\x71\x4a\x0a\xf6\x70\x3b\x90\xa8\x48\x05\xe9\x01\x84\x92\x92\xe0\xf4\xe5\x80\xf3\x1c\xaa\x07\x97\x43\x59\x17\x88\x83\x86\x77\x42\xc7\x2d\xca\x1e\xc5\x5c\xaa\xdc\xcb\x48\x41\x68\xc2\x29\xaa\x58\x8d\x28\x54\xfc\x84\x12\x94\x71\xfc\x04\x2e\x4a\x0e\xe4\x09\xe8\x91\x34\x5c\xb0\x20\xc4\xb6\xdd\xd2\x2f\x1c\xf3\x02\x1e\x8e\x5b\x42\x73\xa0\xde\x96\x70\x4e\xca\x24\xac\x5f\x9c\x9c\x70\x0e\xf9\x69\xeb\x32\x4e\x49\xb5\xef\x34\xf8\xdc\x31\xb5\x0e\x82\x53\xbe\xab\xba\x36\xc6\x5f\x0b\x48\x30\x47\x05\xce\x4e\x87\x50\x36\xe2\x37\x48\x22\x28\x53\xa9\x24\x7f\xb5\x86\xd2\x09\x4e\x25\xa2\x8f\x0a\xc3\xc9\x87\xdd\x2e\x48\x3b\xae\x3f\x04\x41\x70\x62\x25\x2a\xa4\xb5\x08\xa5\x27\x9b\xe0\xe3\x89\x35\x5b\x97\x35\xb5\xd2\xba\x5e\x7e\x4c\xc5\x24\xbc\x5e\x48\x69\x4d\x18\x16\x8a\x4b\x28\x14\x48\xcc\x77\x56\xf4\x82\x12\x27\x75\xe2\xf9\x4b\x28\x05\xed\xa3\x9c\xb4\xe7\x47\xa2\x05\x97\x7b\x29\x8d\x24\x38\xb1\xa7\x7d\xab\xa5\x84\x12\xc2\x6f\x8f\x42\x80\xbb\x82\x3c\x27\x9d\x4a\x4e\x9d\x5d\xf5\x86\x18\x42\xe9\x2c\x82\xfa\xe5\x74\xa0\x47\xaf\x24\x6f\xde\x96\xbc\x08\xc3\xc6\xd5\x3e\x11\x5a\x86\x8a\x8b\xa6\x74\xa6\x79\x50\x78\x4d\x61\x1c\x09\x35\x9c\x9c\x32\x92\x83\xfb\xb8\xcd\xdd\x9a\x82\xcb\x50\x59\x6b\xfe\x54\x92\x8a\xb0\x1a\x65\xe0\x0e\x4f\xe9\x28\xab\x10\xca\xd3\xb6\xe1\x9c\x54\x2e\xae\xea\x86\xbb\xa4\xe6\xc2\x5a\x6a\x97\x41\x01\x19\x77\x85\x87\x21\x0a\xe8\xd8\xa9\x01\x57\x07\xa0\x98\xb7\x14\x86\x17\x39\xc3\x40\x52\x1a\xd9\x7b\xc2\x0c\x6f\x0b\xe8\x47\xe8\x48\x1e\x05\x49\xaf\xb5\xc2\x1d\xa1\x65\x67\xa7\x12\x43\x44\x03\xa7\x65\xe4\x0b\x7f\xad\xe1\x73\xd7\xfc\xe0\x2a\x4d\x22\x78\x72\xad\x85\x35\xdb\x12\xf3\x87\x63\x1f\x14\x50\x5d\x03\xa2\xa8\xca\x20\xe9\xfa\xa7\x59\x43\x19\xa1\x49\x4d\x70\xc5\x81\xca\xc1\xbe\xe4\x98\xa1\x6d\x01\xf9\x83\x3a\xec\xd0\x78\x94\x9d\x72\xd8\xa1\xa6\xe0\xb2\x53\x92\xb4\xba\xdb\x91\xac\x61\x1e\xae\x2a\xa0\x1d\x27\xd3\xf6\xc1\x4c\xd2\x1a\xe5\xb9\xd0\x72\x70\x6a\x51\x8f\xaa\x6d\x76\xa1\xf0\xa4\xcc\x26\x3b\x40\xf6\xb8\x25\x2f\xfa\xa4\x51\x8e\xc9\xc3\x51\xb1\x8d\xc1\x25\x5f\x4c\xfa\x1d\x99\xaa\x29\xb7\x40\x1f\x92\xa4\x97\x4a\xcb\xac\xc7\x6a\x5c\x79\x52\xd8\xe7\xb0\x49\xc3\x75\xec\xa3\x64\xb8\xb5\x38\xa5\x27\x03\x44\xb3\x83\x55\xf8\x42\xcf\x3b\x0c\x45\x9e\x9e\xb3\xf7\xbe\xe3\x0c\xd8\xde\x6c\xe1\x60\xe4\xbd\x6b\xf0\x32\xc1\x44\x61\x99\xec\x5c\x87\x1c\x32\x42\x91\x88\x13\xb6\xd9\xb4\x66\xda\x4e\x87\x01\xef\x95\x2b\x42\x21\x23\x05\xce\x1d\x86\x8b\x27\xa0\x7d\x40\x0b\x9c\xa8\x1e\x15\xe3\xc7\x4b\x28\x1d\x7f\x25\x22\x88\xe3\xaf\x45\x1c\x29\x60\x0f\x55\x6e\xb3\x91\xc1\xe1\x74\x27\xef\xfd\x72\x12\x69\xb9\xb0\x61\x49\x48\x44\xf8\x02\xd5\x0c\x92\xfe\x21\x95\x00\xe1\xf7\x92\x7e\xee\xf2\xc3\x71\x1c\xcf\xef\xa2\x95\xdb\x2f\x24\xda\xfa\xf1\x0d\x2e\x6b\x42\x39\xaa\xf8\xc9\x17\x29\x87\x27\xe2\x5f\x89\x5e\xbc\x67\x9c\xf3\x43\xb7\x16\x2b\x86\x91\xf6\x7d\xdb\xf5\x4d\x76\xd9\x1f\x0b\xe0\x5c\xe1\xc1\xf3\xe3\x10\xca\xf4\x93\xd1\xdc\xb9\x42\xfa\xe9\x99\xd0\x5c\xc1\x5d\xc4\x50\xa6\x42\x28\x1e\x05\x91\x24\x09\x0a\xa4\xe6\xb8\xc4\x6f\xc0\x6a\x80\x3c\x55\xa3\xdd\x9f\x28\xc0\x2f\xa8\x62\xee\x77\x14\x97\xc4\x7d\xff\x03\x25\x38\x77\x44\xcb\x7b\xf7\xdf\xa1\x78\x02\x11\xf5\x05\x10\x15\xae\x92\x69\xf4\x7c\xf7\x7a\xdf\x15\x30\x5a\xa6\x78\xf1\x5a\x4d\x50\xf2\xec\x3c\x53\x54\x0f\xf3\x14\x19\x8a\x00\xb7\x86\x5c\x32\x1b\xaa\x40\x69\x17\x19\x4f\xda\x6f\x22\x3a\x78\x8c\x23\xca\x87\x21\x66\x11\xe6\x00\x27\x9f\xd4\x40\x91\x47\xaa\xe2\xd5\x49\x3c\xe2\xd5\x14\xda\xa0\xe4\xf6\x42\xb7\x88\x51\x2a\xa4\xb1\x67\x23\x9f\xf4\xd6\xf4\x8d\x88\xb5\x3e\xb5\x6b\x49\xa3\x2e\xdb\x8c\x35\x95\x93\xda\xd4\x5b\x1b\x3c\x24\x83\xce\x97\xac\x40\x8c\x39\x9f\x3e\xbf\x6f\x1b\x9a\xf7\x0f\x33\x79\x60\xcf\xb6\x17\xca\xc9\x35\x5e\xa8\x3d\x47\xca\x73\x3c\x3c\x47\xca\x73\xe8\x2d\x86\xe7\x58\x79\x0e\xbd\xe5\xf0\x1c\x29\xcf\xb1\xf2\xbc\x50\x9e\x97\xca\x73\xe8\xad\x94\xf6\xf1\x39\xf4\x36\xc3\x73\xac\x3c\x2f\x95\xe7\xb5\xf2\x1c\x7a\xe1\x38\x81\xa5\xfa\xb2\x56\x5f\x42\x0d\x2f\xf4\xa2\x71\x16\x91\xfa\x12\xab\x2f\x0b\xf5\x65\xa9\xbe\xac\xd4\x97\xb5\xfa\xb2\x51\x5f\xee\xd5\x97\x30\xd0\xde\x34\x1e\x42\x8d\x89\x50\xe3\x22\xd4\xd8\x08\x35\x3e\x42\x8d\x91\x50\xe3\x24\xd4\x58\x09\x35\x5e\x22\x8d\x97\x48\x97\x87\xc6\x4b\xa4\xf1\x12\x09\x5e\xfe\x15\x1c\x40\x18\x4a\xb4\x38\x76\x81\x74\xe1\x87\xab\xd5\xfa\x63\xfa\x69\x78\x8d\x97\xeb\x8f\x0a\xa6\xa2\xfa\x68\xec\xb6\xf1\xe3\x38\x8e\x87\x6e\x1b\x3f\x0e\xa2\x58\xed\x36\x5a\x59\x3c\xf6\x0a\x23\x7f\x39\xf4\x09\x23\x7f\xb1\xba\xff\x78\xb2\x59\xf5\x42\xe9\xb3\xf2\x57\x2a\x87\xe1\xca\x5f\x19\x2c\x2e\x25\x6a\x14\x8c\x58\xf7\xfe\xbd\x4a\x7c\x39\x12\x8c\x02\x7f\xa3\xf2\x2e\xde\x0d\xe6\x47\xa5\xad\x94\x7e\x23\xeb\xd1\x42\xa7\xbe\x56\xa8\xdf\xeb\x02\x8d\xee\x27\x12\x1d\x83\xc3\x66\xec\x17\xc7\xba\x44\xe3\xd8\x10\xa9\xea\xd0\xf7\x4a\xbf\xb5\x22\xd2\x78\xad\x8b\x34\x1a\x44\xb3\x18\x45\x13\x4f\x44\xa3\x7a\x77\x30\x92\x5e\x84\xba\xe4\x17\xa1\x29\x79\xd5\x8e\x96\xba\x54\x17\xcb\x89\x54\x95\x51\x14\x4b\x5a\x8e\x9c\x2d\x0c\xce\x42\xc5\x74\x96\x86\xa1\x2e\x4d\x4b\xd5\x43\x96\x62\x40\x4b\xc3\x56\x97\xa6\xb1\xc6\x83\x94\x56\x23\x2f\xcb\x89\x94\x46\xf1\x87\x8a\x35\xad\x54\x93\x5e\x19\x26\xad\x2d\x04\x8a\x29\xad\x0c\x9b\x5e\x4d\x6c\x5a\xb1\xa8\xb5\x61\xaf\x6b\xd3\x5e\xb5\x65\x45\x31\xa9\xf5\xc8\xd8\xda\x30\xd8\x50\xb1\xa0\xb5\x61\xb1\x6b\xd3\x62\x17\x83\x7c\x36\xa3\x7c\xd6\x13\xf9\x8c\xde\x1b\x29\x46\xb4\x31\xec\x7a\x63\xda\xb5\xba\x20\x45\x8a\x41\x6d\x54\xc3\xde\x18\x86\xad\x2f\x49\x91\x62\x4e\xf7\x86\xd1\xde\x9b\x46\x1b\x29\x46\x75\x6f\x18\xed\xfd\xc4\x68\xad\xeb\xbe\xba\x16\x47\x6a\xa8\x0a\x82\xbe\xab\x2c\x5d\xac\xd1\xbe\x8f\xee\x93\x62\x2c\x7d\x3e\x60\x0e\x6d\x72\x23\xb2\xfe\x36\xdf\x33\x22\x7b\x89\xf3\xbc\x80\x2e\xbb\xe9\x62\x7d\x06\xa2\xb2\x34\x0a\xcd\x21\xbd\x6b\x18\x50\x2f\xa7\x48\xac\x14\x15\xe8\xcd\x5d\x51\x2c\x01\xa2\x4e\xb2\xb4\xb2\x69\xe3\x14\x6b\x5a\x47\xc9\xdc\xbf\x4b\x4e\xc9\xdb\x0c\xc8\xda\xaa\x09\xd0\x52\xdc\x0e\xf5\x83\x2c\x30\x92\x40\x17\xb9\x9a\xc9\xa9\x7b\x06\x72\xd7\x41\xd4\x0d\x3d\x09\xb1\xd3\xe2\x88\x82\x40\x6e\xf4\x2c\x16\x0b\xf9\x48\xf7\x5b\x74\x13\xb8\xe2\xe7\x6f\x6e\xd3\x49\xcd\xf5\xe1\xfe\xfe\x7e\x18\xdf\x51\xb0\x83\xdb\x74\xb2\xed\xf5\xe1\xc7\x95\xf8\x75\x4a\x1b\x4b\xbd\x4e\x9a\x72\xe2\x14\xe5\xb8\x61\x49\x54\xbf\x68\xb3\xf1\xda\xbd\x31\x57\x13\x89\xa5\xa9\xcd\xbf\x8f\x3b\x5c\x70\xa0\x89\xd8\xff\xc3\x79\xf2\xc3\x7f\xfd\x54\xa2\x3d\xfc\xda\xef\x76\xf8\x7f\xc5\x19\x25\x8c\xec\xb8\xbf\x17\xa3\x41\xc5\x6f\xda\x52\xe0\x7b\xc1\x24\xe3\xf4\xf3\x1f\xc4\x4e\x57\xfb\xfb\x83\xeb\x40\x95\x2b\x80\x10\x0d\x80\x3f\xcb\xce\xbf\x8a\x0a\x58\x9f\x2e\x16\x03\x0e\x95\xcc\x30\x8a\x30\x7d\x44\xdd\xc0\x09\xdc\xc0\x11\x85\x9b\xbb\xa3\xa4\xbc\x51\x76\x03\x6f\xdd\x56\xec\x1e\xe3\xa4\xbe\x59\x04\x1f\x5d\x55\xfe\xc1\xf2\xf6\xd6\xe5\xe4\x46\x6d\x0b\x6f\x6f\xcf\x8c\xdc\x0d\x38\x32\xa0\x8c\x64\x52\x76\xcc\xd1\x42\x2b\x61\xe1\x1d\x13\xaa\xa4\x9e\x50\x0b\xae\xa0\x45\x7e\x7f\xfe\xfe\x41\x82\x9a\xc9\x49\x63\x1a\xb7\x63\x55\xa0\x27\x37\x6d\xb5\x0e\x5d\x5b\xb7\x45\x74\x40\x39\x79\x4e\x02\x47\xfc\x84\xbb\x68\x23\x2d\x6f\x1d\x5c\x31\xe0\xc2\x14\x9c\x95\x01\x8d\x24\xb0\xf7\x08\xe9\x3b\x41\x10\xfc\xed\x5e\xe3\x41\xd9\x3d\x53\x9b\xbd\xbe\xd9\xde\x6a\x71\x9b\x11\xa6\xd6\xaf\x26\x6c\x98\x9d\xdc\x2f\x99\x08\xbf\x75\xe3\xaf\xf7\x3d\xa8\x5a\xfa\x9f\x77\xa8\x60\x70\xdb\x13\x40\x45\x7d\x40\x37\x44\x94\xb7\xfc\xf5\xf3\x22\xb8\x4d\xbd\x47\xb1\x47\xe8\xc9\xa6\xc4\x5f\x74\x7b\x5a\xca\xbb\xf2\x28\x57\x81\x8a\x88\xd5\xa1\x20\xcf\x90\xa7\x8a\x5a\x04\xa7\xba\x3a\xbb\x9d\x97\xa1\x28\x99\x20\x7c\x7d\xfc\xf5\x6a\x8a\xc5\x09\x85\x2e\xcd\x6e\xfd\x80\xdc\x45\x56\x5c\x64\x45\x9e\x1e\x20\x7c\x08\x82\xf5\x06\xd6\x7d\xbc\xde\xed\x76\x72\x6c\x21\x64\x75\xf7\x56\x84\xda\x07\xd7\x0e\xab\x11\x63\x62\x13\x61\x0e\x0e\x25\xc2\xc5\x1c\xb0\xa1\xb3\xa0\x1c\x71\x98\x83\x95\xa4\xe2\x87\x39\x20\xc7\xe5\x6c\x47\x41\xf4\x1a\xb8\x57\x90\x0c\xcd\xb2\xf6\x0c\xf0\x38\x07\x93\x1b\xb0\x33\x50\xb9\x63\x39\x03\xe5\x30\x3b\x64\xab\x22\x0d\x28\xb7\xf5\x95\x96\x61\xc3\x51\x5b\x90\xfd\x15\x94\xa9\x35\x71\x9a\x2e\xc3\x59\x96\xa9\x06\xde\x06\x10\x19\x79\xe2\xfa\xc5\xf9\x90\xe7\xb9\xec\xd5\xaf\xb0\x8b\xfa\x65\x26\xa7\xea\x57\x8f\xdf\x35\x8d\x19\xc5\xd2\x9e\xd7\xb4\x22\x7f\xb8\xfd\xff\x9e\xf0\x3f\x79\x6a\xaa\xc6\xc7\xa9\x45\x42\x97\x4b\x28\x67\xd0\x85\xf2\x1f\xb4\xc8\x3b\xeb\xa8\x67\xb1\x3a\x77\x3d\x8b\xd2\xd0\x0b\x08\xc2\x8b\xce\x63\x74\x0e\x7c\x16\x45\xb8\xe1\x79\x8c\xc1\x99\xaf\xc2\x92\x2e\x7d\x16\xb7\x75\xec\xb3\x18\xfd\xf9\xca\x39\x9c\xfe\x58\xe2\x1c\x8e\x70\xf5\xb3\x08\x9d\xfa\xa7\x28\xb2\x52\x98\xb4\xf7\xce\x6f\xe6\x1b\xbd\xf9\xca\x18\x1f\x46\xf7\x7f\xfa\xf1\xbb\xb3\xde\xf4\xdb\x28\xb4\x9d\x3f\xef\x70\x71\x41\x1d\x22\x6e\x90\xf3\x28\xc3\xc1\x99\xc1\x09\x3f\xe0\xaa\x77\xdc\x6e\x1e\x69\x0f\x12\xb9\x91\xd8\x93\x76\x2c\xec\x75\x83\xf4\x44\xd5\x31\xbb\xc7\x96\xa3\xfe\x70\xb7\x0d\x99\x81\xf5\xf8\xc3\x64\x53\x48\xfc\x61\x92\x3d\xcd\x7a\xdd\x45\xcc\xce\xf3\x2e\xa2\x35\xf4\x0a\x24\xe1\x1a\x97\xb1\x3a\x2f\xbc\x88\xd6\xfa\xd8\x45\xac\xc1\x1b\xaf\xc6\x94\x1e\x79\x11\xbf\xf5\xca\x8b\x58\xd2\x33\x2f\xe2\x49\xef\xbc\x88\x27\x3c\xf4\x22\x52\xeb\x13\x33\x68\x9d\xa7\xda\x61\xbd\xb7\x4e\x4f\xa9\xb5\x5c\x73\x92\xb0\x01\x82\x1c\xf2\x3e\x61\xcb\x50\x1e\xe5\xf1\x59\x67\xfe\xa7\x0c\xf0\x85\x02\xca\xc5\x81\x95\x6d\xbe\x56\xd8\x30\xdf\x01\x6a\xc9\x46\x01\xa0\x1f\x78\xbd\x5e\x1b\x61\x27\xcb\xb2\xe9\x44\xdb\xf8\x90\xe0\xea\x09\x15\x38\xb7\x8d\x37\x8f\xa1\xc6\xd1\x1e\x2e\xaf\x46\x7c\xd8\xde\x2f\xd0\x62\x63\x30\x00\xf7\x71\x14\xe5\x97\xe3\x5e\x4f\xec\xea\x28\x78\x55\x07\x23\x26\xea\x7d\xfa\x08\x39\xcf\xaa\xbc\xb0\x21\xb7\xc7\xa2\xf6\x0a\xcc\x5c\x3a\x34\xd1\x8b\x51\x17\x48\x3d\x97\x4d\xc1\x71\x2d\x2e\x15\x49\xaa\xca\x91\x48\x8b\x57\xa0\x2d\x14\x7a\x5c\x75\xfc\x48\x4f\x60\x86\x63\x78\x89\x36\x9e\x9f\x77\x47\xed\x41\x77\xca\x6e\xd6\x46\x1d\xfd\xee\xe4\x5d\x0b\xd5\xe9\xb8\x73\xa8\x50\x12\x43\xcb\x03\xfd\xfe\xea\x93\x1f\x8f\xfb\x54\x71\x1c\xa7\xd3\x8b\x51\x52\x24\xb0\x14\x3f\x65\x5c\x71\x6c\x9b\x3d\x42\x7e\xae\x3e\xb2\xe1\x0c\x0b\xc1\x05\xbc\x49\xbd\x64\x43\x6a\xe8\x45\x14\xb3\x7e\xb2\xe1\x4c\xea\x28\x1b\x92\x59\x2f\xd9\x70\x86\xe0\x7f\x25\x9e\x0c\xfd\x17\xb0\xcd\x3a\xcb\x86\x23\xc3\xfe\x05\x2c\x19\xf4\x2f\x60\x19\xf5\x97\x0d\x65\x52\x87\xd9\x90\xda\x2c\xc8\x86\x33\xa9\xd9\x06\x48\xeb\x2c\x36\x40\x1f\xc9\x0c\x3b\xef\xdd\x4a\x38\xb2\x13\x9c\x2c\x1d\x27\x4b\xc1\xd5\x14\xda\x32\xae\xa7\xe0\x5a\x00\x3d\x4f\x36\xd8\x74\x8a\x3d\xa4\x43\x3e\x40\x51\x7b\xdd\xfe\xbd\x8a\x54\x02\x63\x68\x0f\x12\x72\xb4\x96\x6f\xe6\xb1\xee\x27\xb9\xf3\x6f\xad\x3f\x4f\x67\xf8\x3e\x1a\x3d\x38\xa9\x6d\xe8\x32\x71\xec\x2e\x6e\x7a\x62\x9d\xac\x8f\x46\x14\xd1\xeb\xb1\x73\x3d\x3b\x0d\x1f\x95\x93\x86\xf6\x0e\xb1\xbd\x50\xb5\xce\x68\x88\x6d\xe3\x75\xcb\x40\x6c\xb7\x8b\xcd\xc1\x8b\x4c\xb0\x3e\x10\x87\x32\x12\x07\x4e\x18\xea\xb1\xb8\x35\x98\xee\xbd\x7d\xf4\xda\x45\x00\xf2\x69\xc6\xac\x81\x8f\x7a\x7d\x2c\x2e\x83\x9a\x07\x02\xea\x28\xf2\xd2\x48\x2b\x12\x23\xfa\x0f\xd1\x37\xa8\x5f\xe6\xba\x98\x36\xa9\x01\x07\xfd\xf6\x42\xed\xa4\xd9\xb3\x13\x06\xf5\xcb\x28\xb9\xc0\xf1\xc2\xfa\x25\xd5\xb9\xb7\xdd\x30\x6d\x6f\x91\x86\x17\x58\x9a\x2e\xde\x36\xc6\xe4\x72\xfd\xe6\xe1\x2a\x87\x97\x24\x3e\xcd\xe0\x4b\x92\x98\x32\xee\x65\x07\x5c\xe4\x97\x09\x8f\xb8\x47\xc1\xf0\x74\x6a\x8b\xfa\xc5\x11\x7f\x82\x61\x2d\x4c\x82\xab\xc7\x4f\x0a\xf4\x5b\x58\x51\xba\xcd\x73\x75\x25\x37\x5f\xc1\x81\x31\xaa\x17\x4d\x86\x15\xea\x97\x02\xb9\x38\xbc\x3c\x08\x93\x68\x32\x2f\x99\x22\xb7\x32\xf3\xc2\xe9\xd9\xe5\x04\x27\xf2\x62\x89\xb5\x5a\xcd\x22\x85\x5e\x24\x91\x96\xf3\x94\xc2\x81\x52\x1c\x9f\x41\xea\x0f\x54\xa3\xa5\x05\xe9\x1f\x08\xc8\xd2\xb1\xbc\x02\x76\x5c\xcb\xa8\x56\xab\xd5\x4c\x0c\x1b\x8f\x0e\xfd\xcd\xda\x88\x9e\x72\xc8\x61\xac\xce\x7b\x15\x92\x93\xce\xff\x56\x42\x8e\x91\x23\x0a\x0a\x87\x65\x14\xa0\x6a\xbf\x6b\xb8\x19\x2e\x36\x3a\xc9\x62\x13\xd4\x2f\xb7\xc7\x71\x18\x47\x1e\x7b\x68\x37\x8e\x7b\xe5\xae\x27\xe1\x74\xba\x8e\xaa\xe6\x77\x26\x07\xbc\x94\xfb\x9d\xcd\xf9\xce\xe4\x7a\xe7\x72\xbc\xb3\xb9\xdd\xb9\x9c\xce\x96\xa3\x5d\x59\xbe\xff\x2b\xef\x91\xab\x25\xc8\xb0\x66\xc7\x50\x5a\xf7\x77\xd4\x60\x33\x55\xb7\x02\x9d\xea\x7b\x02\x34\x15\x3e\x41\xd0\x34\x3e\x81\x36\x74\x1e\xa6\xea\x7c\x02\xd4\x94\x3e\x81\xaa\x5a\x9d\x00\x4d\xb5\xcf\x22\xe8\x7a\x9f\xa0\xa9\x8a\x9f\x00\x75\xcd\x4f\xc0\xba\xea\x27\x60\x45\xf7\x13\x98\xdc\x2e\xd7\x75\x7d\x39\x2f\x92\x83\xcc\x1a\x8a\x92\xaf\x89\x28\x97\xce\x55\x9b\xbf\x21\x01\x53\xba\x7c\x4d\x28\x76\x2f\x46\x4c\x25\x4c\xae\x97\x6a\x3a\x26\x8e\x10\x02\xc7\xdf\x40\x79\x92\x83\x8b\x0f\xa2\x86\x3b\xf2\xbf\xeb\x71\x86\xa0\xec\xed\xf0\x0b\xe4\xc7\x21\xa5\x6a\x5f\x53\x21\xc7\x24\x48\x45\x3e\x10\xa4\x66\x2a\xd4\x76\x2b\x30\xeb\x73\xbc\xf6\x1d\x73\x28\x8f\x93\xc4\xcc\xec\x71\x14\x7f\xc9\xcf\x94\xc4\xc9\xeb\xb0\xb0\x0f\x02\x08\x4e\x13\xa2\x3d\x64\x44\x96\xbb\x19\x8a\x52\xe5\x08\xd5\xa3\xca\x93\xf8\xba\x0c\x57\x7b\x43\xf4\xd6\x3b\x29\xd3\x6b\x48\x2a\xe1\x03\xa1\xf8\x8d\x54\x1c\x15\x72\x95\x16\x23\x5f\xdd\xc7\xd1\x58\x64\xdc\xba\x50\x5f\xee\x2a\x24\xec\x5e\xc4\x92\x73\xbe\x8c\xc8\xa0\x46\x14\x71\x42\xaf\x2b\xe4\xae\xa8\xe3\x06\x2e\xd5\x61\x04\xd7\x47\x5b\x28\x6f\xb1\xdb\xb4\x93\x1a\xc7\xf1\x63\x7e\x8f\xb6\x8c\x14\x0d\x87\xce\x1e\x5b\xa1\x77\x26\x39\x35\x1b\xbb\x95\xda\xa7\x3e\x8c\xaa\x98\xb9\xd8\x19\x93\xb1\x42\x5e\xa1\x52\xe9\xb4\x9b\xb1\xdd\xcd\xa4\xee\x56\xc5\xb7\x16\x72\xaa\xcc\xbb\x2b\x14\xdf\x9e\x9b\xaa\xcc\xcf\xcc\xb9\x6a\xec\x23\x36\xf4\x53\x69\x15\xb8\x7a\x4c\xd0\x8e\x8f\x77\x13\x64\x7e\xb7\x6c\xf3\xbb\xee\xfb\x85\xf7\x7f\x8b\x96\x7f\xdc\xbc\x57\x42\x4d\xfb\x45\xdf\x65\xf1\x5c\x31\xaa\x36\xc6\x8f\xef\x55\x9a\x2c\xa3\xe2\x73\x18\xf1\x9d\x4c\xff\x51\x8d\xf7\x9a\x74\xad\xe9\xd0\xf2\xd2\x7f\xb7\x67\xed\x39\xeb\x31\x67\x5c\xe5\xeb\x08\x5d\x76\x3d\x2b\xbd\xe3\xd4\xe7\xc7\x39\xbd\xca\x39\xa9\xb3\x6c\xcd\x4a\x7c\x95\x32\xb4\x29\xc1\xaf\x0f\xea\x23\xac\x1d\x48\xc4\x6a\x4e\x9a\xec\x30\x58\xb7\xaf\xd6\x33\xd7\xf0\xa9\x7c\x6e\xd5\x92\xdc\x22\x6a\xbb\xf1\x62\x04\x82\xe9\xb6\xb2\x38\x7b\xef\x83\xed\x58\x86\xf9\xf1\x3c\x3b\x8e\x95\xb2\x8c\x9a\xf5\xf0\x69\x65\x28\x68\x0c\x6e\xec\xf8\xe3\x07\x33\x5a\xe4\x36\xbe\x5e\x6c\xea\x1a\x68\x86\xd8\x70\x06\xb1\x5c\x2d\xf3\xd5\x42\xed\x29\x5c\xa3\x3f\x23\x58\xaf\xd7\x2a\xa8\xb7\xe9\xe3\xb9\xed\xf3\x81\x88\xea\xcd\xfd\x01\x8d\x6b\x61\x71\x66\x23\x45\xeb\x77\x1c\xee\x2b\x2d\x6d\x60\xdd\x4a\xab\x47\xf9\x79\xf0\x84\x4d\xe5\x8e\xdb\xe9\x6c\xac\x31\xf9\x1f\x89\x4e\x5a\xbb\xdd\x8e\xa9\x44\x00\x0c\x23\x11\x5b\x86\x53\x56\xdd\x2b\x70\xda\x2f\x53\x39\x0c\x47\x37\xe2\xe3\xe2\xae\xdb\x57\x7e\x4e\x97\x42\x59\xf3\x57\x2f\x83\xa2\x60\x09\x3b\x90\x67\xdb\xf1\xc8\x36\xdb\x66\x5b\x95\xbe\x23\x3f\x3d\x57\x86\x97\x5f\xd4\xb6\xdf\x49\x3b\x9b\xe5\xc7\xbb\xd0\x41\xe6\x57\x6a\xbd\x5e\xdb\xdd\xbb\xe9\xed\x63\x6d\x04\x9e\xbb\xda\xeb\xa1\x9f\x53\x1b\x95\x27\xdc\xf5\x13\xeb\xbc\x62\xb8\x39\xa8\x44\xea\xfe\x16\x6f\xef\x20\xa9\xf9\x95\x6f\x3a\x63\x76\x3d\x3f\xea\x0e\x8f\xc1\x9b\x0a\x52\xf9\xec\xd9\xd1\x29\x09\x33\xb7\x99\x47\x00\x01\xa8\x9f\x8b\xab\x12\x12\xd4\xcc\x4c\xa1\xab\xec\x0c\x2e\xaf\x31\xf1\x76\x46\x1e\xc9\x73\x2b\xfe\x87\x5d\x24\x7e\x1a\x2e\xe3\x14\xd7\x90\x3b\x9c\x26\x15\x3f\x74\x13\xbd\x89\x2a\x2f\xbc\xbd\x9a\x44\x27\x16\x41\x63\x10\x91\xac\x38\xce\x9a\x9a\xd2\x4f\xfc\xb7\x08\xdf\x72\xaa\xec\xae\x7d\x6b\xd2\xb2\x09\x5c\x8d\xa4\xba\x55\x69\x90\xc1\xc0\x24\x09\x69\x40\xb3\x27\x64\x16\x56\x55\x72\x5f\xc3\xec\xff\x0d\x00\x6f\x05\x93\x99\x86\x43\x00\x00")
 
 func webCssPureMinCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -86,12 +86,12 @@ func webCssPureMinCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/css/pure-min.css", size: 17286, mode: os.FileMode(436), modTime: time.Unix(1461095360, 0)}
+	info := bindataFileInfo{name: "web/css/pure-min.css", size: 17286, mode: os.FileMode(436), modTime: time.Unix(1480012644, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd4\x59\x5b\x8f\xdb\xb6\x12\x7e\xf6\xfe\x0a\x46\x8b\x13\x24\x39\x91\xb4\x7b\x72\xd2\x0d\x5c\x59\x6d\x8a\xa0\x40\x81\x24\x28\x9a\x22\x2f\x45\x1f\x68\x89\xb6\x98\xa5\x2e\x20\xa9\xbd\xc4\xf0\x7f\xef\xf0\x26\x51\xb2\xe4\x75\x12\x20\x40\x9f\x56\x1c\x0e\x67\x3e\xce\xcc\x37\x24\xbd\xc9\xa3\xbc\xce\xe4\x7d\x43\x50\x21\x4b\x96\x9e\x25\xea\x0f\x62\xb8\xda\xae\x02\x52\x05\x4a\x40\x70\x9e\x9e\x2d\x92\x92\x48\x8c\xb2\x02\x73\x41\xe4\x2a\x68\xe5\x26\x7c\x15\x74\xf2\x0a\x97\x64\x15\xdc\x50\x72\xdb\xd4\x5c\x06\x28\xab\x2b\x49\x2a\xd0\xbb\xa5\xb9\x2c\x56\x39\xb9\xa1\x19\x09\xf5\xe0\x39\xa2\x15\x95\x14\xb3\x50\x64\x98\x91\xd5\x65\x74\x31\xb6\x93\x13\x91\x71\xda\x48\x5a\x57\x9e\xa9\xdf\x78\x5d\x89\x92\xca\x02\x85\xe8\x35\x12\xb4\x6c\x18\x79\x8e\x8c\x26\xca\x39\xbd\x21\x95\x56\xa6\x55\x5b\xb7\x02\xbc\x48\xb2\xe5\x58\x19\x41\xb2\xae\x19\x38\x01\x2f\x92\x4a\x46\xd2\x6f\x34\x95\xc4\xc6\x8c\x32\xc8\x68\x75\x8d\x38\x61\xab\x40\xc8\x7b\x46\x44\x41\x08\xec\xbf\xe0\x64\xb3\x0a\xe2\x4c\x88\xb8\x69\x39\x09\x4b\x5a\x45\x30\x30\x18\xb4\x22\x6c\x79\x11\x29\x1f\x98\x56\x84\xa3\x1d\x0c\x17\x0d\xce\x73\x5a\x6d\x43\x4e\xb7\x85\x5c\xa2\xcb\x97\xcd\xdd\x8f\xbe\x9c\x91\x8d\x2f\x2e\x31\xdf\xd2\xca\x69\xe3\x56\xd6\xbe\xd8\x28\x3b\xe9\x1e\x1c\x2f\x7e\x2e\x49\x4e\x31\x7a\x02\x68\x4c\x2e\x96\xe8\xea\x87\x57\xcd\xdd\x53\xe3\x7e\x0c\x67\x8c\xe7\xff\x17\xd6\xf1\x08\x50\x27\xdf\x3b\x47\x51\x06\x19\x23\x3c\x5c\xb3\x3a\xbb\x36\xc6\x72\x2a\x1a\x86\xef\x97\x48\xcb\xe6\x81\xce\xec\xca\x98\x95\xe4\x4e\x86\xc6\xb6\xb1\xaa\x05\x98\xd1\x6d\xb5\x44\x46\xde\x29\xc7\xcf\x24\x5e\x43\x42\x9e\xc5\x66\xa9\x1a\x84\x9c\x88\x06\x52\x0f\x09\x36\xeb\xbf\x04\xc2\xa2\xbe\x21\x7c\xc3\xea\xdb\xf0\xee\x00\xd7\xd8\xb8\x16\x18\x17\x36\xd0\x97\x17\x17\xff\xb1\xc6\xef\xc2\x91\xcc\xe2\x45\x84\xf3\x9a\x23\x00\x0c\x26\xcd\xf7\x30\x74\xb4\x82\x6a\x23\x61\x1f\xc1\x35\xce\xae\xb7\xbc\x6e\xab\x3c\xcc\x6a\x56\xf3\x25\x54\x62\xae\x67\xec\xf0\xb6\xa0\x92\x18\xd5\x9a\xe7\x90\x11\x8e\x73\xda\x0a\xc8\xd9\xb0\xb4\x96\x28\x7a\x49\x4a\x74\x49\x4a\x2f\x00\x0a\xa0\x51\x73\x00\xd7\x1c\x9a\x41\xc6\xdb\x72\x2d\x90\x89\xeb\xb9\x2f\xf2\x43\xba\xae\xa5\xac\xcb\x91\x89\xa8\xd7\x0e\x05\x69\x30\x70\xca\x6d\xd2\x02\x3e\xcf\xb2\x4c\x43\xd8\x40\x2d\x86\xb7\xc4\xa4\x60\x5d\xb3\xbc\x97\x0a\xfa\x99\x2c\xd1\xff\x0c\x56\xb0\xab\x0c\x37\x2d\x63\x3a\x8d\xc6\x1a\xa4\x09\xc3\x3a\x25\x30\x4a\x4e\x45\xe7\x74\xa0\xa3\x25\x56\x49\xe7\x92\x96\x44\x48\x5c\x36\x56\xab\xf7\x18\x5d\xbd\xb4\xf1\x71\x60\xaf\xae\xae\x0e\x2b\x79\xb8\x63\x56\x6f\x27\x4a\x6d\x86\xc3\x4e\xdc\x2f\x4d\x51\xc3\xa7\x6a\xb5\xd7\x5c\x24\xb1\x6d\x28\x49\x6c\x7a\x75\xb2\xae\xf3\x7b\xf8\x63\xfb\x19\xcd\x57\x81\x7c\x07\xbc\x0e\x90\x6a\xf4\x30\x00\xd2\xc4\x1c\x67\x12\x2a\x55\x75\xf8\x9c\xde\xa0\x8c\x61\x21\x56\x41\xdf\x01\x74\xdb\xda\xea\xce\xec\xcd\x6b\x69\x1b\x5e\x2a\xf9\x22\x29\x5e\x38\xb9\x47\xcc\x40\x77\x57\xf4\x41\xb5\x57\x40\xf4\x42\x69\xee\x76\xe7\x74\x63\xca\x7b\xaf\x72\x31\xb0\x39\x58\xab\xdb\x4b\x22\x1a\x5c\xb9\x69\xbd\x2a\x48\x77\x3b\xbb\x1c\xb6\x0b\xb3\x5a\x31\x89\xc1\x8c\xb1\x1f\xd3\x8d\xb6\xac\x0d\xab\x1d\x7b\x75\x19\x0c\xd0\x97\xa4\x6a\x51\xf7\x15\x16\x35\xa7\x9f\xd5\xae\x19\x3a\x00\x92\xb4\xec\x60\x69\xc8\xa8\x90\x0e\x27\xa3\x87\xf3\x40\xb8\xd2\xce\x2f\x12\xec\x4e\x82\x43\x0c\xa1\x3a\x39\x82\xf4\x77\x5e\x7f\x22\x99\x44\x6f\xc1\x6c\x12\x63\x6b\x38\x66\xd4\x7c\x99\xc8\x35\x46\xc9\xc4\xee\x04\xbf\xc3\x08\x4e\x91\x2e\x48\x63\x2f\x90\xbe\x47\xeb\xf2\x11\x34\x3b\xa1\x0e\xbc\xc7\x8f\xd1\xa3\xac\xe5\x1c\xe2\xf2\x41\xe2\x2d\x71\x20\xe6\x51\xf8\xd1\xc5\x22\xcc\x0a\xca\x72\x58\x1e\xa0\x9c\x64\xb5\xf6\xbe\x0a\xd4\x6c\x87\xb6\x8f\xd3\x79\xa0\x93\x67\xf7\xfb\x4e\x29\xcd\x05\x6e\xb7\xb3\x5a\x91\xba\x34\xa8\xba\xc0\xbd\xbd\xa9\xc4\x75\x38\x3a\xb5\x13\x02\x39\x02\x37\x8d\x05\xd5\x55\x98\x31\x9a\x5d\x43\x31\x03\x93\xb7\x84\xff\xd2\x82\xaf\x20\xfd\xd3\x8c\x90\x1e\xfa\x00\xfd\x78\xab\x41\xcb\xba\xcc\xf9\x89\x20\x4c\x9c\x10\xef\x89\x38\xc6\x36\x36\x71\x1f\x25\x9a\xef\xf7\x5f\x13\xcc\x01\x20\x47\xb3\xf1\xb7\x57\xa4\xaa\x60\x6c\xed\x7c\x9f\x7a\x3d\xc1\xfa\x03\x61\x81\x61\x87\xf8\x48\x88\x3a\x9d\x3e\x3a\x3e\x51\x1f\x8e\x87\xfa\x9c\xa2\xd2\xbf\x29\x3e\xf0\x3d\xdc\xc2\x91\x80\x0d\x15\x1f\x88\x9a\x23\x81\xd7\xd4\x75\x1f\x1a\xf4\xbe\xdd\x2e\xb5\x63\xa1\x05\x86\x22\x5e\xbb\x1a\xab\x79\x5a\xdd\x94\xaf\xda\x01\xb0\x6e\xed\x9f\xb3\x33\xf0\x0e\xa1\x55\xef\x14\xe4\x79\x1c\x9c\x5b\xa3\x4b\x9f\x3a\x4a\xcd\xbd\xcf\x0f\x88\x91\xf4\x9f\xa1\x80\x16\xd1\x90\x5c\x9f\xac\xd2\x3e\xae\xe0\x8b\x9b\x13\x47\x16\xee\x44\x80\x47\x46\xd1\xc9\x20\x84\xb2\x15\x03\xd1\x5b\x2c\x24\xfa\x68\xb6\x72\x38\xf1\xb6\xde\x1e\x0a\xff\x20\x8c\x60\x41\x66\x27\xd0\xaf\x94\x75\xb3\xf0\x57\x61\x52\x43\xfb\x02\x94\xe6\x5e\xa1\x33\xe3\x62\xb2\xa4\xfb\xfd\xc2\xd8\xe2\x48\xbf\x8a\x56\xc1\x6e\xb7\xa9\x79\x89\xe5\x1b\x2c\xc9\x93\x08\x62\x21\x01\x4d\x74\x5b\x90\xea\x29\x94\x8b\x3d\xe1\x64\x9e\x4e\x95\x9d\xa9\x37\x55\x3c\x5e\x27\x02\x0c\x79\xbf\x0c\xa6\x84\x0e\x87\x9a\xf4\x27\xc6\x67\xee\xd8\x2a\xfc\x75\x60\x7a\xba\xa7\x53\x52\xef\x20\xf6\x1c\xf4\xfb\xeb\x56\xc0\x15\xcd\xd8\x50\xa5\xea\x4b\x15\xd5\xfd\x71\xc4\x48\xb5\x85\xf7\x66\x0a\x37\xb7\x8b\xfd\x7e\x68\x22\x12\xed\x5a\x95\x45\xb5\x7d\x72\xf1\x1c\xe6\x21\x4c\x51\x14\xb9\xaa\x1d\xbb\xb3\x25\xfb\xc5\x7b\xe7\x26\xc9\x1f\x07\x5b\x1f\x0b\xa7\x77\x3e\xb8\x19\xd8\x25\xe2\x2f\xb0\xfc\x77\x7f\x3a\x75\xae\xed\xbc\x73\xfd\xd3\x06\x8a\x4a\xf9\xea\xd6\xc1\xb2\x48\x09\xdf\x77\x09\x5e\x4c\x1e\x78\xef\x6b\xe7\x0b\x29\x75\x84\x6f\x30\x65\x8a\x43\x87\xa7\x51\x87\xd6\x56\xad\x9a\xf4\x1b\x05\x88\xed\xa5\x38\xd6\x2c\xec\x88\xae\xf4\x0c\xcd\x41\x6d\x82\xf4\xdf\x89\xf3\x53\x3c\xd6\x6d\xf3\x61\x66\x7f\x35\x77\x5d\xb9\x9f\xc0\xe1\x43\xee\xce\xd7\xdb\xe8\xe0\x18\x51\xed\x01\x8a\x59\x72\x9b\xe3\x62\x86\x7a\x43\xca\x39\xaa\x4d\x52\xec\x14\x6a\x3d\x40\xa9\x51\xcd\xf7\xbb\x43\xff\x45\x6e\x37\xc7\x59\x30\x1b\x90\x43\x66\x4c\x5b\x9f\x23\xcb\xf1\xf2\x77\xd9\x3d\x5a\xfe\xf3\xf5\xdf\x1f\x92\x49\xc1\x15\x4f\x4e\x0c\xc3\x57\x44\xa0\xe7\x4e\xf7\x40\xd7\xdc\x59\xb7\x52\xc2\xa5\xc9\xfb\x0e\x1b\x4e\xe1\x19\x7c\x1f\xa4\x6f\xea\xdb\x0a\xde\xef\x79\x7f\xa6\x61\x4d\x66\x1d\x92\xb3\x83\x27\xeb\xec\xa3\x4f\x53\xf2\xe8\x33\x6f\xfe\x99\x63\x2f\x28\xc3\x5b\x4e\xaf\x23\x00\x59\x26\x49\x6e\x51\xd9\x27\xe5\x97\x30\x66\xfe\xd5\xf8\x9a\x31\x4b\x20\x77\x97\x02\x30\x9a\x38\x9a\xcd\x27\xc0\xf6\x51\x8f\xef\xa5\x68\xb5\x42\x8e\x86\xdf\xbe\x1f\xff\xea\xe8\xac\x1e\xb9\x34\xf6\xfc\x1f\xed\x2f\x36\xfb\x33\xf5\xac\xee\x8a\xee\xca\xa6\xea\xd3\xcf\x38\x50\x5a\x81\x3b\xdc\xa7\x09\x4c\xf1\xe2\xf0\x6a\x2a\x4a\xcc\xba\x22\xe8\x7e\x01\x52\x70\xbc\x36\x08\x76\x85\x6b\x85\x70\xd9\x56\x4b\x52\xf7\x0b\x47\xd2\x70\x92\x26\x02\x16\xc1\x1a\xad\xa8\x1b\x0b\xa8\x29\x51\x12\xab\xe9\x33\xff\x80\x1b\xe7\xcb\xe2\xea\x76\x7f\x12\xa2\x39\x34\x43\x38\x93\x50\x86\x01\x75\xcc\x99\x3c\x15\x61\xa5\xfe\x25\xa9\xff\x49\x49\xf0\x0c\x32\xfe\x49\xb8\x5f\x91\x22\xf5\xfb\xf6\x27\x11\xa4\x47\x54\x69\x95\x93\xbb\xb1\x52\xec\xda\x92\xf9\xef\xc3\x3f\x01\x00\x00\xff\xff\xb6\x5d\xdc\x1a\x8e\x18\x00\x00")
+var _webIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\xdb\x92\xdc\xb6\xd1\xbe\xe6\x3c\x05\xc4\xad\x7f\x4b\xb2\x4d\x72\xf6\x97\x65\xa9\x26\x1c\x3a\x72\x52\xa9\x72\x95\xec\x38\x51\x0e\x17\x29\x5f\x60\xc8\x1e\x0e\x24\x90\x60\x01\xe0\x1e\x0c\xcf\xbb\xa7\x70\x22\x41\xce\x61\x67\xad\x28\x55\xb9\x5a\xb2\xd1\x68\x7c\xe8\x6e\x7c\xdd\xe0\x6c\xfe\xac\x62\xa5\x7c\xe8\x00\xed\x64\x43\x8b\x45\xae\xff\x20\x8a\xdb\x7a\x1d\x43\x1b\x6b\x01\xe0\xaa\x58\x44\x79\x03\x12\xa3\x72\x87\xb9\x00\xb9\x8e\x7b\xb9\x4d\xde\xc4\x83\xbc\xc5\x0d\xac\xe3\x5b\x02\x77\x1d\xe3\x32\x46\x25\x6b\x25\xb4\x72\x1d\xdf\x91\x4a\xee\xd6\x15\xdc\x92\x12\x12\xf3\xf2\x15\x22\x2d\x91\x04\xd3\x44\x94\x98\xc2\xfa\x26\x5d\xce\xed\x54\x20\x4a\x4e\x3a\x49\x58\x1b\x98\xfa\x9e\xb3\x56\x34\x44\xee\x50\x82\xde\x22\x41\x9a\x8e\xc2\x57\xc8\x6a\xa2\x8a\x93\x5b\x68\x8d\x32\x69\x7b\xd6\x0b\x44\x5a\x09\x35\xc7\xda\x08\x92\x8c\xd1\xb8\x58\x2c\xa2\x5c\x12\x49\xa1\xf8\x44\x53\x79\x66\xcd\x68\x83\x94\xb4\x1f\x11\x07\xba\x8e\x85\x7c\xa0\x20\x76\x00\x32\x46\x3b\x0e\xdb\x75\x9c\x95\x42\x64\x5d\xcf\x21\x69\x48\x9b\x96\x42\x58\x0c\x46\xb1\x58\x44\x51\xaa\xd7\xc0\xa4\x05\x8e\xd4\x22\x8a\xa2\x0e\x57\x15\x69\xeb\x84\x93\x7a\x27\x57\xe8\xe6\x55\x77\xff\xbb\x50\x4e\x61\x1b\x8a\x1b\xcc\x6b\xd2\x7a\x6d\xdc\x4b\x16\x8a\xad\xb2\x97\xee\x17\x8b\x28\xfa\x7d\x03\x15\xc1\xe8\x79\x43\x5a\x1b\x8b\x15\x7a\xfd\xcd\x9b\xee\xfe\x85\x5d\x7e\x0e\x67\x8e\xe7\xeb\xa5\x5b\x78\x06\x68\x90\xef\xfd\x42\x69\x09\xad\x04\x9e\x6c\x28\x2b\x3f\x5a\x63\x15\x11\x1d\xc5\x0f\x2b\x64\x64\xa7\x81\x9e\xd8\x95\xc1\x9f\x4a\xb8\x97\x89\xb5\x6d\xad\x1a\x01\xa6\xa4\x6e\x57\xc8\xca\x07\xe5\xec\x0b\x89\x37\x14\xc4\x17\x99\x9d\xaa\x5f\x12\x0e\xa2\x63\xad\x20\xb7\x80\x54\xb0\xd8\x45\x10\x22\x76\x0b\x7c\x4b\xd9\x5d\x72\x7f\x80\x6b\x6e\xdc\x2c\x6d\x97\x70\x8e\xbe\x59\x2e\xff\xcf\x19\xbf\x4f\x66\x32\x87\x17\x01\xe7\x8c\xa3\x2f\x32\x6d\xd2\x3e\x4f\x5d\x47\x5a\x4a\x5a\x48\x46\x0f\x6e\x70\xf9\xb1\xe6\xac\x6f\xab\xa4\x64\x94\xf1\x15\xe2\x50\x99\x11\xf7\x7a\xb7\x23\x12\x8c\x60\xc3\x78\x05\x3c\xe1\xb8\x22\xbd\x58\xa1\xaf\x5d\xc8\x5c\x24\x57\x28\x7d\x05\x0d\xba\x81\x26\x70\x80\x06\xd8\xdd\x87\x00\x3b\xce\x3e\x40\x29\xd1\x96\x50\x40\x1d\x67\x1b\x0a\x8d\x70\x80\x87\xd7\xc0\xb1\x2b\xb4\x3c\x96\xc0\x7a\xad\x10\xa5\x07\xbd\x65\xad\x4c\x04\xf9\x05\x56\x28\x7d\xe3\x74\xcc\xd2\xa3\xf1\xf4\x0e\xf3\x96\xb4\x35\x52\xc1\xfc\xab\xcd\xcb\x6f\x36\xcb\x65\x88\x74\xc3\x01\x57\x25\xef\x9b\x8d\xc1\xb7\x88\xa2\xab\x50\x14\x06\x7f\xc3\xa4\x64\xcd\x6c\xb3\xe9\xa8\x9d\x08\xe8\x30\xc7\x92\xf1\xe9\xa2\x65\x59\x8e\xa8\xef\xc0\x9e\x91\x0d\xa3\x07\x7b\xf9\xff\x61\x27\xda\x70\xd7\x53\x6a\x1c\x61\xad\x6d\x29\xc3\x72\x85\xb4\xc0\x2a\x79\x15\x93\x7d\x13\x1d\x23\x71\x4a\xda\x92\x24\x0d\x08\x89\x9b\x0e\xa9\xd9\x8a\xe9\xeb\x99\x87\xaf\x5e\xbf\x7e\x7d\x78\xe6\xa6\x3b\xa6\xac\x9e\xf8\xe5\x2c\xdb\xdc\xbc\x9a\x4f\x2d\x50\xc7\xe1\x8c\x01\x1f\x19\xca\x6a\xd2\xba\x9c\xb1\xcf\x6a\x76\x2c\x5e\x2e\x97\xd3\x35\x9f\xc0\x10\xbd\xf0\xd4\xe0\xb4\x24\xeb\x56\x3e\xaf\xf7\x8b\x28\xcf\x1c\xfb\xe6\x99\x2d\x6c\xf9\x86\x55\x0f\xc5\x22\x77\xe4\x4f\xaa\x75\x2c\x7f\xc0\xa4\x8d\x91\xae\x8a\xeb\x58\x33\x4c\xc6\x71\x29\xc9\x2d\xe8\x72\x58\x91\x5b\x54\x52\x2c\xc4\x3a\x1e\xe9\xd2\x70\x7c\x6d\xca\x58\x30\x6e\xa4\x7d\x72\xa3\xe5\x91\x52\x57\x64\x8b\x34\xbc\xbd\x8e\xf1\x4c\x71\x88\xb7\x56\x30\xfa\x51\xa4\x94\x7e\xd9\xef\x51\x8e\x5d\x3d\xb9\x8a\x11\x6b\x93\x92\x92\xf2\xe3\x3a\xa6\xac\x66\xbd\x8c\x8b\x77\xac\x46\x7f\xee\x65\x9e\x61\x33\x2d\xcf\x2a\x72\xab\x9f\x94\xca\xc8\xd6\xac\x95\xef\x5e\xfa\x95\x02\x02\x8d\x4d\x15\x44\xef\x75\x45\xcd\xb3\xdd\xcb\x11\xa4\xa1\x9e\x43\x94\x93\xb9\x7a\x30\xca\x45\x87\x5b\x3f\x6c\x66\xc5\x85\x52\x6e\x7a\x9e\xe9\xd1\x93\x98\xf4\xf6\xb5\xb3\x83\x53\x19\x7b\x53\xc6\x71\x0d\xb4\x3d\x1a\x9e\x92\x1d\xe3\xe4\x17\xed\x70\x8a\x0e\x80\xe4\x3d\x3d\x98\x9a\x50\x22\xa4\xc7\x49\xc9\xe1\x38\x91\xd0\xb8\xf1\x68\xf0\x70\x76\x88\x21\xd1\x15\x3e\x2e\x7e\x72\xd4\xf7\x8e\x88\xc1\xd7\x51\x9e\x51\xe2\x83\xa5\x3d\xe7\xf8\xd1\xfa\xee\x82\x75\xa7\x1e\x3c\x46\x39\x71\x91\x05\x8e\x0c\x57\x74\x4b\x3e\xbb\x05\x2e\x74\x8f\x73\x7d\x8d\x9e\x95\x3d\xe7\xd0\xca\xf7\x12\xd7\x80\xae\xaf\x3d\x9e\x94\x33\x0a\xe8\xd9\x1a\x99\x0e\x0d\x78\xec\x01\x9e\x46\x18\x7a\x1e\x8b\xa4\xdc\x11\x5a\x71\x68\x63\x54\x41\xc9\x0c\x19\xae\x63\x3d\x3a\xec\x64\xf4\xe1\x55\x6c\x02\xeb\xd6\xfe\x41\x2b\x1d\xae\x61\x9d\xaa\x94\x47\xa8\x1b\xbf\xfd\x7e\x70\xec\xa9\xa0\x0e\x38\x06\xb5\x0b\x9c\x3c\x03\x77\xa0\x6c\xb0\x04\x27\x4b\x72\x52\xd7\xc0\xbf\xeb\x09\xad\xe2\xe2\x6f\xf6\x0d\x99\xd7\x10\x60\x18\x8b\xcf\x82\xa3\xc4\x6d\x09\xd4\xc1\xf8\x83\x79\x79\x0c\x45\x9e\xf5\xb4\x58\x1c\x0c\x28\x05\x54\xc0\xe3\x51\x1f\xa1\x0e\x40\x33\x17\xa1\x6c\x8c\x15\xa9\xf6\xfb\xdf\x12\xd2\x09\x20\x4f\x04\xf3\xe7\xe0\x18\xe9\x0c\x76\xd9\xed\x86\x3f\xf3\x89\xba\xc0\xfa\x23\x6e\xc9\x94\x1a\x10\x2b\xb5\xe1\xb8\x2d\x77\x7f\xe9\x81\x3f\x3c\xb7\xcf\x2f\xce\x7a\x6e\x98\x3a\x3a\x6d\x44\x77\x89\x9b\xb4\xc7\x42\x0e\xf8\x5f\x74\x5b\xa6\xd4\x74\x0b\x4f\xf7\xe3\x74\xfe\x23\xce\xf4\x47\x26\x28\x52\xda\xb9\xa6\x35\xb1\xfe\x53\xaa\x18\xdf\xec\x51\xd2\xc4\xeb\x36\x30\xe8\xb8\x77\x31\x53\x1b\x36\x36\x55\x0b\xb4\x86\xa1\x50\x75\x40\xe8\x70\xb9\x3f\x8b\x85\x52\x57\x1d\xe6\xfa\x02\x3d\x60\xcc\xb7\x8c\x37\x13\x77\x18\xc1\xf0\x94\x08\x89\xcb\x8f\x50\xd9\x09\xa6\x89\x10\xfd\xa6\x21\xd2\x74\x11\x44\x5f\xf3\xa3\x7c\x4b\x80\x56\x02\xa4\xf1\x05\x85\x1a\xda\xca\xf4\x16\xa4\x45\x92\x21\x01\x80\x1e\x58\xcf\x7d\xd2\x89\x3c\x73\x3a\x46\x1d\x6f\x80\xa2\x2d\xe3\xeb\x58\xf7\x2c\x9a\xcc\xe3\xe2\xef\xee\x29\xcf\xcc\xb0\xb1\x4b\xda\xae\x97\xa6\x3c\x0c\x7a\x41\x9f\x15\xa3\x5b\x4c\x7b\x58\xc7\xb6\xf5\xd1\xc3\xfa\xbc\xe8\xc6\xaf\x64\xfa\x8e\x2e\x21\x9c\xa8\xe5\x5b\x56\xf6\x62\x8e\xa1\xc3\x42\xdc\x31\x5e\xc5\xc5\x4f\xee\xe9\x38\x86\x41\xcf\x61\x18\xdf\x07\x1c\x5e\x74\x88\xc3\x25\x59\x32\x4c\x32\x28\x36\xbd\x94\xfa\x2b\x81\xb1\x67\x9d\x3c\x4d\x54\xa7\x10\x3c\x27\x1d\x27\x0d\xe6\x0f\xb6\x95\xfb\xbe\xcd\x33\xab\xa3\x83\x92\x8d\x51\xc9\x33\x1d\xd4\x62\xa1\x54\xe6\xe2\xbf\xdf\x4f\xb2\xc1\x47\x46\x27\x44\xd8\xb6\xcd\xee\xa6\xba\x89\xb5\xd7\xd3\x10\x96\x95\x8c\x8f\x89\x90\x9c\x74\x50\x99\xd4\x90\xee\x1b\x50\x94\x4b\xae\xff\x44\xb9\xdc\xf9\x86\x28\xcf\xe4\x6e\x90\xbd\x97\x58\xf6\x62\x22\x7a\x87\x85\x44\xff\xb0\x89\x7d\x38\xf0\x8e\xd5\x87\xc2\xbf\x02\x05\x2c\xe0\xe4\x00\xfa\x13\xa1\xc3\x68\x9e\x19\x4c\xfa\xd5\x7d\xa8\x92\xb6\xa3\xd7\x47\xe8\xca\xfb\x64\x45\xf6\xfb\xc8\xa2\xe4\xc8\x7c\xbc\xd1\x49\xa6\x1d\x8a\xe5\x1f\xb1\x84\xe7\x29\xc5\x42\xbe\x63\x75\x7a\xb7\x83\x56\xb3\x8b\x6b\xf0\x64\x55\x1c\x23\x2f\x4b\xf6\x9a\x6b\x82\x32\x97\x67\xb2\x1a\xa7\xf9\x42\x97\x0a\xe3\x14\x4f\xc5\x96\x01\xcc\xc5\xd9\x89\x8e\x4d\x3b\xbd\xa6\x52\x03\xd4\x13\x95\x66\x18\x1f\xa9\xb2\x38\x36\x6b\xe0\xc5\x09\x80\xd1\x3b\xc3\x0c\xca\x6a\xed\x10\x5b\x74\x42\x29\xba\xbe\x9e\xbc\xa7\x14\xda\x5a\xee\x50\x81\x6e\x5e\x2d\x35\x71\x4f\x06\x45\xbf\xd1\x49\xd5\xd6\xcf\x97\x5f\xdd\xbc\x5a\xbe\xd8\xef\xd3\x34\xf5\x0c\x38\x5f\xce\xd1\xdf\x93\x7d\xc3\x6d\xee\xb8\x84\x73\x5b\x9f\x0b\x8f\xef\xdc\x99\xb7\xdb\x74\x53\xc4\xbf\x52\x52\xfd\xec\x63\x17\x2c\xed\xc6\xfd\xd2\xdf\xea\x2f\x22\x7a\xad\x61\x1e\xa9\x7e\x4e\xb5\xf0\xc7\x21\x3d\xa2\xa3\xbd\xd8\x8f\xcc\xaf\x65\xbf\xaa\xe0\x5b\x4c\xa8\x3e\x81\x8b\x68\x56\xa8\x46\xb4\x2e\xe7\x75\xe7\xe4\x5c\x60\x8a\x4e\x9e\xb9\xd4\xcf\x33\x73\x9c\x87\xa2\xf1\x18\x65\xec\xf7\x0b\xa5\xae\xee\xf4\xf7\xd0\x51\x32\xcb\x54\xa5\x32\xad\x60\x9e\x82\xe6\xc3\x25\x19\xcc\x59\xe7\xfc\x15\xce\xb0\xca\xd9\x4b\xdb\xc9\x9e\x02\xb9\x6b\x8f\x5d\x77\xbf\x1f\x47\x05\x50\x28\x25\x54\xce\x65\xee\x6a\x78\x24\x59\x3c\x74\x1d\xb8\xd3\x17\xbe\xb7\x94\xa2\xef\xdc\xe6\x5c\xc2\xf8\xfe\x61\x24\x96\x61\xfb\x9a\x60\x2e\x80\x6d\xd5\xd1\x7a\x8d\xd2\x4f\x87\xfe\xad\xb5\xb6\x56\x0a\xda\x92\x55\xf0\x3c\x3d\xdf\x16\xa5\xfb\xfd\xc1\x46\xb2\x20\x7e\xee\xf6\xe0\x92\x26\xdf\x71\x53\x6c\xc8\x76\x16\xda\xcf\x51\x50\x4e\xe6\x54\xe4\xf8\xdf\x46\xc2\x93\x7e\x78\x2c\xf4\xa8\x3b\xd9\xf3\x5a\x54\xc3\xe3\x15\xe7\x37\xd7\x14\x4f\xa4\x17\xd4\x96\x69\x4d\x39\xbb\x57\x43\x44\x4a\x39\x71\x48\x80\xc1\x96\x1f\x21\x43\x6f\xda\x73\xe2\xa9\x3a\x31\xad\x0f\x83\xd6\x09\x76\xb4\x95\xcc\x36\xd4\x27\xea\xc5\xb4\x4e\xf8\xfa\x70\xb4\x2e\x5c\x52\x0f\x1e\xa9\x03\x33\xa2\x1e\x77\x8d\xbe\x44\x7e\x37\xe8\x4b\xe4\xf7\x89\x7e\xfd\x15\xc5\xf1\x8b\xf3\x64\x7e\xd2\x75\x86\xe0\xdd\xce\x7c\x6c\xae\x0f\xce\xdf\xe0\x51\x07\x7b\x52\x11\x2e\x05\x78\xaa\x6c\x04\xe1\x3f\x52\x08\x9c\xbd\xf3\x85\xe0\x6c\xf3\x38\x32\xbd\xd9\xa5\x97\x68\x58\xfe\xab\xbb\x1e\x0e\xbf\xcb\x38\x15\x7d\xa6\x95\xba\x1a\xbe\xd2\x7b\x2a\xf4\x9c\x63\x58\x53\x2f\x1d\x8c\x6b\xfd\xc0\xea\x84\x3a\x9d\x3c\x2e\xfe\x69\x1f\x56\x68\x66\x28\x98\x68\x39\xcb\x7b\xe7\xe4\xfe\x9c\x7b\xf4\x04\xcb\x6b\xa7\x73\xc7\xa9\xea\xd4\x39\xcc\x9c\xe3\x9f\x6d\xfd\x17\xde\x27\x1a\x34\x91\xf6\xa5\xe3\x92\x7c\x74\x96\x6c\x6e\x8e\x69\x32\x3d\xd7\xe3\xb1\xbe\xf8\xe2\x31\xb1\xe6\xce\xbf\x52\x99\x5e\xc1\x25\x94\xef\x23\x4c\x0e\xfe\x77\x2b\xfd\xf4\x36\xff\xa9\x45\x73\xea\xc4\x0b\xfc\x16\x94\xd0\xb7\x94\x3a\xe7\xb8\x54\xd4\x31\x17\x1a\x97\x0f\xe2\x63\x9b\x09\xf7\x32\xff\x58\x63\xba\x02\x47\xb1\xff\xd9\x5d\x7a\xe2\x7e\xea\x7e\x43\xca\x9f\xf7\x0d\xc6\xe4\x89\xae\x21\x4c\x0f\xca\x6a\x47\x0f\xb3\xed\xfb\x9f\x26\x0e\xbf\xd7\x88\x06\xd3\x21\x63\x86\x1f\xb9\xe2\x62\x52\x54\x29\xab\x85\xbf\xac\xe5\x99\x99\x52\xf8\x9f\x31\xf2\x8e\x43\x91\x0b\xdc\x74\x85\x52\x46\xd1\x5c\x61\xf2\xcc\x88\xf2\x4c\x0f\x2f\xc2\x46\x7c\x1e\x46\x87\x2b\x15\x4f\x41\x74\x0a\xcd\x14\xce\x51\x28\x53\x87\xba\x50\x7b\x97\x4e\x39\x2d\xcf\xec\x2f\x55\xe3\x4f\x56\x82\x97\xeb\x38\xfb\x20\xfc\xaf\x54\xa9\xfe\x67\x83\x0f\x22\x2e\xce\xa8\x92\xb6\x82\xfb\xb9\x52\xe6\x8b\xc6\x4e\x36\xb4\x58\xfc\x7b\x00\x98\x9a\xcb\x25\x1b\x22\x00\x00")
 
 func webIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -106,12 +106,12 @@ func webIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/index.html", size: 6286, mode: os.FileMode(436), modTime: time.Unix(1461856295, 0)}
+	info := bindataFileInfo{name: "web/index.html", size: 8731, mode: os.FileMode(436), modTime: time.Unix(1792201498, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webJsIndexJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x3a\xeb\x72\xdb\x36\xd6\xff\xfd\x14\x30\xbf\x99\x8a\x4e\x64\xca\x49\x3b\xdf\xee\xd8\x71\x3a\xae\xa5\xb6\xde\xca\x97\xb1\x9c\x6c\x77\x32\x99\x0c\x24\x42\x12\x53\x8a\x50\x40\xd0\xa9\xa6\xd5\xbb\xef\x39\x00\x28\x02\x20\x29\xcb\x8d\xdb\x2d\x7f\xd8\x14\x71\xee\x77\x80\xec\xf5\xc8\x39\x5f\xae\x44\x32\x9b\x4b\xf2\xf2\xe8\xc5\xff\x93\xbb\x64\x41\x46\x73\x9a\x65\x3c\x8b\xc8\x59\x9a\x12\xb5\x96\x13\xc1\x72\x26\xee\x59\x1c\xed\x01\xce\x9b\x9c\x11\x3e\x25\x72\x9e\xe4\x24\xe7\x85\x98\x30\x32\xe1\x31\x23\xf0\x73\xc6\xef\x99\xc8\x58\x4c\xc6\x2b\x58\x67\xe4\xf2\xe2\x8e\xa4\xc9\x84\x65\x39\x43\x4c\x39\xa7\x92\x4c\x68\x46\xc6\x8c\x4c\x79\x91\xc5\x24\xc9\x14\xdc\xf0\xe2\x7c\x70\x35\x1a\x90\x69\x92\x32\xe0\xf1\x8c\x7c\xcc\xe7\x49\x26\x09\xc9\xa5\x48\x26\xf2\x98\x48\x51\x30\xf2\xac\xb7\xb7\x77\x4b\x27\x32\xb9\x67\x51\x7f\xf0\xdd\x9b\x1f\xc8\x29\x99\xd2\x34\x67\x27\x7b\x7b\xe1\xb4\xc8\x60\x85\x67\xe1\x01\xf9\x6d\x8f\xc0\x15\x14\x20\xa7\xc6\x0f\x00\x00\x1f\xdd\x53\x41\x04\x20\x65\xec\x33\x31\x84\x42\x0d\x8c\x17\x4b\x8f\x49\x30\xe6\xf1\x2a\xe8\x6e\x9e\x49\xb6\x58\xa6\x54\x32\x58\xf9\x3f\x79\x49\x93\xcc\x5a\x8b\xa9\xa4\xc7\xa4\xc6\xb7\xbc\x04\x93\x85\xc8\xbc\x87\x78\x2d\x05\xff\xc8\x50\xa9\xac\x48\xd3\x6e\x6d\x19\x2c\x98\x03\xbd\xb6\xe5\x5c\xd2\x19\xcb\xdb\x56\x27\x85\x10\x2c\x93\x23\x04\x6a\x83\x49\xf9\xac\x15\xdf\xc8\x06\xeb\xef\xde\xd7\x57\x99\x10\x5c\xb4\xa1\x4e\xb9\x58\x50\xd9\x57\xc6\xaa\xee\xeb\x70\x82\xa5\x8c\xe6\xa8\xc2\x6f\x6b\x77\x75\x7d\xb2\xf9\x69\xad\xc4\x6c\xc2\x05\x95\x5c\x20\x86\x03\xbf\x60\x59\x61\x39\x20\x83\x20\xf4\x9d\x80\x17\x7a\xfb\xa6\x10\xac\x2f\xf8\x32\xe6\x9f\x0d\xe0\x49\x83\x60\x2d\x0e\xc3\x4b\x32\x2a\x10\x79\x8b\xc3\xeb\xb4\xea\x3c\x3c\xdd\x1a\x34\xf7\x20\xcc\xed\xfa\xc0\x84\x70\xce\xe4\x0d\x95\xf3\x3c\xc4\x07\xea\x89\x88\x40\x96\x4a\x92\x00\x22\x7e\x36\x63\xe2\xbb\x22\x49\xe3\xc0\x12\x97\xdd\x43\x60\xf8\x32\xab\x87\x11\x07\x94\x24\xa3\x69\xb4\x14\xea\x41\x9f\x4d\x69\x91\xca\xd0\x33\x12\xa6\x4f\xce\x26\xa0\x1b\xe4\xd0\xe7\x24\x03\x7b\x00\x06\x5f\x2c\x65\x18\xdc\x28\xa7\x12\x40\x66\x42\xa5\xb4\x11\xa3\x44\x80\x88\xd0\x15\xa3\x8c\xfe\xc0\x23\x6e\x8b\x1d\x8a\x68\xc6\x80\xa8\x81\x8d\x92\x38\x38\xe8\x1a\x4a\x07\xb5\x20\x59\x6f\x4c\x51\xea\xea\x12\x33\x54\x2e\xfa\x1b\x12\x96\x11\xe8\x47\xfa\x2b\x48\x7f\x3d\xba\x0b\xba\x24\xe8\x19\xc4\x5e\x40\x9e\x13\x0b\xaf\xee\x68\x4d\xe9\xd8\xfc\x6f\xf3\x9e\x2d\x54\x08\x45\x14\x8c\xda\x14\x35\xc6\x96\x29\x9f\x50\x25\xfe\x29\x48\x12\xb4\x86\xc4\x8e\x44\x45\x94\xa3\x0d\x55\xce\x82\x6e\xf0\xbf\x04\x8e\x16\x2c\xcf\xa1\x40\x78\x1e\x58\x9b\xdf\x6b\xdf\x9a\x55\xcc\x59\x6c\x30\x18\x96\xf8\xb4\x8a\x85\x52\xfe\x08\x9f\x67\x74\xc1\xa2\x7c\x99\x26\x20\x43\x2f\x28\xc3\x17\xaf\x64\x4a\x42\x85\x19\xa5\x2c\x9b\xc9\x39\x79\x75\x4a\x5e\xf8\x1a\x80\xff\x6f\x4c\x2d\xf2\xc3\xd0\xcf\xad\xb5\x43\x79\x5f\x91\x7e\xf7\xe2\xfd\x17\x51\xac\x0b\x0b\x14\xc9\x29\x38\xc6\x44\x45\xe0\x93\xaf\x00\x5f\xd6\x58\xbb\xeb\x5f\x37\xae\xbb\x30\xdf\xb4\xc2\x18\x55\x54\x85\xdf\x30\xec\x92\x92\x74\x79\x07\x04\x5a\xaa\x4f\xe3\x53\xa0\xf8\x56\xf7\x9d\x06\x9a\x0d\x94\xea\x54\x2a\xf3\x56\x66\xf0\xe2\xeb\x09\xdc\xe1\x05\x75\x80\x71\x49\xae\xb8\xd4\xe3\xc4\x7e\xd0\x16\xc2\x0e\x37\xcb\xb2\xaa\xcc\xf4\xa0\x23\xf6\x82\x47\x27\x18\x96\xb4\x10\xd3\x20\x81\x14\x38\x3a\x81\x7f\xaf\x88\x86\x8e\x70\x34\x30\xd1\x0d\xcf\x9f\x3f\x6f\x73\x66\xae\x1c\x29\x8b\x3c\xb4\x10\xdf\x25\x6d\xbe\x9b\xd3\xfc\x56\x77\x4f\x0f\x1e\x2a\x24\x18\xc3\x2f\xa9\x9e\xed\x2a\xf3\x56\x32\xe6\x5c\xc8\x6a\x74\xa2\x5d\x32\xde\x16\x9b\x34\xc2\xac\x26\xaf\xc9\x58\xdd\x3c\xdc\x00\xc9\x8b\xc7\x04\xa1\xc5\xe2\xd5\xee\x2c\x0e\x1f\xc5\xc3\x20\x1d\x35\x58\xaa\x69\x24\xd0\xe1\x56\x4e\x45\x10\x71\x96\xf1\xec\x9a\x56\x5e\xa6\x12\x02\x16\x8c\xd2\x8c\x17\x32\xb4\x22\xaf\x4b\x5e\x1c\xc1\xe5\xa7\xc5\x5f\x5a\xd9\x6d\x16\x76\xce\x26\x71\x4b\x5a\x40\x23\x84\xe0\xfa\x83\x22\x96\xc5\xd2\xb7\x9b\x0f\x8e\x9e\xb7\xc3\xd2\xcc\xc0\x79\x9b\xfb\x1f\x4a\xbd\x12\x7f\x97\x1c\xc4\xab\x39\xb1\x54\x56\x35\x91\xc5\x8c\x33\xf7\x3b\x57\xd9\xf5\xdf\xc8\xe9\x65\xa9\x47\xfd\x4a\x3d\xb6\x78\x1f\xfe\x04\xea\xd6\xc0\x3e\x5a\x76\xd5\x97\x2d\x43\x92\xdf\x7f\x27\xfb\xf5\x52\xe9\x3f\x7e\x77\x54\x99\xb9\xc5\x79\xc6\x2a\x06\x2a\xa8\xd4\x69\xc8\x6f\xd8\xe0\xc1\x84\xba\x23\x9d\x16\x31\x76\xe9\x84\x86\x96\xde\xa8\x6d\x0d\xfd\xff\x6d\x14\xe8\x11\xc2\x8a\x81\xae\xde\x5c\x3e\x26\x14\x36\xbf\x15\xe6\x1f\x55\x07\x77\xa4\x0f\xd6\x08\x03\x6b\xef\x70\x83\x52\xe2\xbf\x8d\x59\xad\x42\xd2\x92\x5c\xbd\x67\x3d\xb3\x03\xee\xbd\x32\xe5\xf1\x30\x89\x5f\xf7\x5e\x19\xd8\xd7\xcf\x7a\x9e\xf5\x4b\xf0\x27\x4a\x46\xa3\x6b\xb9\x0d\x8f\x36\x64\x37\x51\xf0\x27\x04\xec\x16\x6e\x30\xb7\xb1\x69\x92\xb1\xb8\xc1\xc4\x7b\x8d\x46\xae\xa6\x26\x63\x40\xc7\xbe\xbd\x5c\xad\xb1\x9c\xb8\x43\xbc\xd9\x4b\xaa\x80\x21\xfb\x30\xc9\x7f\xa6\x89\x4c\xb2\x59\x6d\x92\xb7\x20\x81\x0e\x34\x18\x07\xd5\x1a\x4b\x75\x41\xd1\x9b\x0e\x03\x92\xd2\x5c\x0e\xf9\x4c\x55\x32\xef\x59\x5b\x29\xab\xb1\xdb\x08\xd6\xc8\xaa\x85\x6a\x04\x3b\xd7\x05\x8c\xb6\xa7\x95\xb8\xc6\xe6\x6f\x1d\x80\x87\xb9\x8f\x8a\xc9\x04\x62\x7e\x5a\xa4\xe9\x8a\x98\x60\x8e\xeb\xb2\x34\xec\x7e\x3c\xc9\xb4\xa5\x71\xcf\x94\x72\x1a\x37\x59\xba\x59\x80\x21\x40\x93\xef\x69\x92\xba\x36\x78\xc0\x0e\x15\xb7\x29\x93\x93\xf9\xee\xec\xbe\x47\xf0\x2f\xe1\x37\xc6\x53\x86\xdd\xf9\xa9\x43\x89\x2f\xe1\x27\x59\xde\x18\xb7\xcd\xec\xee\x00\x3a\xff\x12\x76\x3a\x8c\x76\x67\x68\x42\x66\x3b\xcb\x9d\x1c\xd3\x4c\xc0\xdb\xf7\x43\x71\x58\x1f\xa8\xd3\xb0\x4d\x7d\x50\x67\x3a\x72\xb5\x64\x50\x5c\x44\xda\x55\x07\xb5\xd0\x29\x74\x5c\x77\xf5\x29\x66\xf3\x29\x31\x3e\x51\x87\xc4\xec\x93\x39\x26\xfe\xf9\x72\xf8\xa3\x94\xcb\x5b\xf6\xa9\x00\x43\x96\x3b\x55\x58\x8f\xf8\x92\x65\x15\x97\x72\x33\x80\xb6\x34\x9c\xb0\x08\x38\xbc\x36\x98\x19\x26\x04\x1e\x5e\xb7\x1d\x25\xea\x79\xf8\x53\x69\x8c\xd7\xa7\xe4\xe5\xd1\x11\xf9\xea\x2b\x62\x3d\x7c\x45\xbe\x81\xbd\x44\xcb\xb4\x55\x8a\x00\x28\x28\x22\x9f\x96\xea\x83\x4b\x4f\x49\xa7\x64\xdc\x69\x9b\xab\xb4\x11\xb0\xa8\x37\xcf\xb7\x52\xac\xb6\xee\xcc\x10\x13\x14\xfc\xd7\xe8\xfa\x2a\x5a\x52\xa1\xe6\xea\x4f\x50\x91\xf2\x25\x8c\xcf\xec\x8e\xfd\x2a\xdb\x06\x67\x32\xa1\x98\x8f\xe1\x03\x5b\x3f\xc3\x20\x08\x1e\xb3\xf9\x33\x36\x28\xdb\xd5\x4e\xc3\x5c\xc3\x41\xae\xb7\xa7\xee\xf5\xa6\x10\xa9\x2c\xae\x79\x50\x39\xdf\x72\x81\xfe\xfd\xb0\x03\x14\x1c\x1a\xac\xf5\x00\xc5\x3a\x2f\xd6\x01\x65\x48\x6f\x8f\xa8\x3f\x5d\x9e\x75\xf5\x9a\x25\x67\x59\xdc\x87\xbc\x3b\xd9\x64\x05\x32\x55\x7d\x17\xa6\x9a\xc0\x4f\x09\x68\xe9\x26\xc5\x7e\x64\x34\x66\x22\x0c\xce\x79\x26\x61\xce\x3b\xbc\x03\x34\x3c\xe9\xa1\xcb\x65\x9a\xe8\xf3\xc5\xde\xc7\x1c\x66\x74\x4b\x98\x92\x59\x19\x71\x98\xcf\xd9\x2c\x99\xae\x42\x6b\x86\x31\xc2\x69\x6e\x59\x1c\x96\x48\xb0\xbc\xb6\x8a\x07\xce\x5c\xaa\x6a\xec\x5a\x2f\xf4\x09\xf2\x0f\x03\x3c\x40\x56\x88\xea\xf5\x88\x8f\xee\x32\x31\x03\xa6\x4a\x86\xed\x95\xa8\x74\x6c\x65\x2e\x33\x90\x1e\x93\xe0\x2c\x33\xcb\x7c\xa2\xa6\xe2\xd8\x9c\x61\xad\xad\x5a\x64\x5c\x5d\x32\x53\xde\x0e\xb4\x7d\x1c\x27\x28\x42\xe5\xb0\x0b\xfc\x4a\x04\x63\x3b\xbf\x6a\xfb\xe0\x4e\xa2\x6b\x4c\x37\xdb\x4b\x58\xcf\x17\xea\x30\x46\x11\x73\x0d\x54\xbd\x40\x0a\x41\x58\xfc\xbf\xdd\x4c\xe0\x67\x66\x2a\xb6\x83\x54\x85\xdf\x7e\x6c\x11\xb1\x98\x97\x15\x64\x6d\x4b\x84\xb0\x91\xe4\x43\x3e\xa1\x29\x43\x42\x23\x65\x31\xc8\x2a\x98\xbe\x09\x95\x04\xc7\x58\x07\x08\x8f\x7b\x4a\x20\xa5\x4a\xa5\x8b\xf3\xee\x29\x36\x37\x37\x54\x58\xaf\x63\x5a\xde\x55\xde\xdc\x0e\xbe\xbf\xf8\x19\xf4\xea\x2c\x81\xc6\x61\xa7\x9a\xbe\xcf\xce\xef\x2e\xde\x0e\x3e\x9c\x0f\xcf\x46\xa3\x0f\x57\x67\x97\x03\x00\x32\xd0\xcf\x49\x07\x5f\x8c\x1d\xea\xf7\x9b\x36\xce\xed\xc5\xd9\x87\xdb\xeb\x21\xc2\x76\x04\x4f\x6b\x6b\x3f\x5e\xf4\xfb\x83\x2b\x5c\xa5\x22\xa1\x87\xf3\x24\x8e\x59\x66\x01\x5d\x0e\xae\xde\x7c\xb8\xbe\x51\x20\x47\xde\xe3\xf3\xe1\xf5\x68\xd0\x87\x85\x17\xde\xc2\xcd\xd9\xed\xe0\xea\xce\x95\x54\xab\xa3\xa4\x84\x0d\xd3\x21\x4c\x6b\x69\x2c\xea\xac\x8c\x92\xa3\xc1\x70\x70\x7e\x77\x7d\x8b\x88\x51\x85\x59\xd3\x4f\xe1\x0c\x2f\xae\x7e\x6a\xc3\x80\x69\xe2\x17\x1f\xbe\x05\xb4\x41\xa4\xfe\xc5\xe8\xf2\x02\x74\x18\xbc\x05\x7d\x00\x3c\x34\x67\x7d\xa0\xc1\x35\xf8\x13\xfc\xca\x84\x5c\x41\x79\x75\x6a\x64\x23\x50\xd8\x81\xe2\xc6\x8b\xc9\x1c\x5a\xb9\x90\x1d\x18\xca\xbf\xdd\x20\x75\xac\x05\x72\x0c\xbe\xe4\x10\x1b\x18\x31\x20\x8a\xe5\xae\xdb\xeb\x7f\x7f\xf8\x69\xf0\x1f\x10\xe7\xea\xec\xbb\xa1\xb2\x3c\xbe\x23\xb7\x60\xe2\x78\x81\x0f\xe7\x49\x7e\x02\x2d\x8a\x60\xe0\x11\xa4\xa3\x5e\x9b\x6a\x30\x5c\x8c\x3e\xe0\x3c\x81\xc9\x63\xf9\xf1\xc4\x5a\xcf\xe7\xfc\x73\x5b\x67\x51\x15\xc6\x22\xb2\x7f\x7a\x5a\x45\x89\xdf\x4a\x34\xa0\x9b\x00\xd1\x04\x06\xce\x7c\x98\xe4\x32\xa2\x71\x1c\xd6\xc2\xda\x7f\x49\xa8\x48\xa0\x02\xd8\x31\xce\x24\x64\xcc\xb8\x80\x74\xb7\xc2\xb7\xab\x3f\x09\x68\x44\x74\x34\x45\x11\xfd\x97\x48\x6b\x5b\x71\x88\x7f\xf6\x68\xc5\xb5\xfd\x1e\xa9\xba\x60\x0b\x7e\xcf\x9e\x46\x7b\x8c\x82\x46\x3c\x8c\xfe\x68\xca\x27\x45\xed\x7d\xcb\x96\x30\xd8\x62\x1e\xc9\x67\xb3\xb4\xd5\x40\x08\xf2\xce\x21\xec\x5a\x88\x7c\x4b\x3a\x18\x59\x2a\xc6\xd1\xd2\x9d\xf7\xa5\x58\xae\x13\xa8\x9a\xf3\xaa\xb7\xd8\x4e\xd3\x82\x4e\xcf\x97\x98\x55\x74\x46\xb5\x08\x27\xd6\x62\xf3\xdb\x6c\x87\xbc\xe7\x13\xe0\xe4\x3e\x38\xd9\x73\x0d\x68\x32\xaa\xe6\x4b\x18\x5d\xc4\x6a\x04\x3b\x9f\x89\x84\x51\xa9\x5e\x89\x0e\x6c\x42\xe8\xc1\x47\x10\x6a\xa4\x31\x4d\x44\x2e\x2f\x81\xd0\xd0\x96\x4a\xc5\xc6\x0e\xb2\x28\x42\x50\x14\x46\x4c\xaa\xd2\x0f\x2d\xcd\x04\x53\xee\x29\xec\x46\x9a\x69\x0c\x14\x1a\xfb\xb2\x58\x76\xba\x50\xad\x20\xdc\x3a\x35\xed\x1a\xe2\x13\x1b\x4f\x57\xb7\xa6\x87\xe0\x35\x97\x94\x8e\x59\x0a\x63\xf5\x78\x05\x7c\x2c\x81\x66\x0e\x68\x12\x43\xf9\xdc\x85\x5c\xd9\xcd\x5c\x91\xdf\xbd\x87\x94\x10\x03\x3a\x99\x47\xd0\xc5\xd3\x70\xaf\x21\xd7\x1c\x7b\x9e\x01\x50\x27\x4d\x3a\x07\x55\x63\xa8\x62\x33\xad\x7d\x5e\x91\xb6\x5b\x62\x89\x1f\x55\x65\x92\xea\xb1\xdb\x4f\xb5\x2f\x92\x8f\x3e\x81\x78\x48\x3a\x91\x6c\xd1\x28\x5a\x19\x3f\x77\xba\x06\xc0\x90\x33\x81\xe1\xfc\x17\x3f\x76\xa0\xa4\x0f\x30\x03\xb1\xc8\x31\xd8\xa3\x84\x1d\x05\x06\x4e\x78\xc2\x7c\xc6\x0b\x7a\x9d\xa9\x47\x9b\x2c\xb7\xa4\xfc\x89\xad\xc6\x9c\x8a\x98\x64\xf4\x3e\xd1\x84\xd5\x52\x0c\xc5\x70\x81\x49\x57\x97\xf3\x17\xb6\xd2\x3d\xb7\x45\x52\x9c\xce\xcc\x49\x34\x26\xa0\x7b\x2c\x8a\x72\x26\xd0\xb6\x47\xc9\x18\x0f\x30\xdc\xc5\x0c\xa6\xe1\xc6\x85\x12\xab\x4e\x0f\x51\xf0\xa9\xf5\xbe\x11\xb4\x4a\xa6\xea\x3b\x1b\x55\x4c\x92\x3c\xeb\x48\xa2\x27\xa2\x2e\x49\x66\x19\x17\xcc\xe9\x55\x68\xa0\x5d\x7a\xf4\x96\x57\xef\x35\x96\xea\x7e\xa9\xeb\x26\x6c\x31\x68\x46\xf0\x48\xa4\x6b\xc4\x80\x0d\xd0\x18\x01\xdb\xc5\x69\x2b\x56\xde\xd8\x57\x3b\xb2\xdc\x22\xa3\xe5\x12\x2c\xe5\x2d\x5c\x3a\xc7\xaa\x0d\x76\x0e\x5c\x83\xf6\x93\x7c\x91\xe4\x79\xa9\x88\x56\x13\x42\x7b\x30\x3a\x77\x84\x67\x11\x44\xc7\x39\x7e\xf1\x88\x3d\xed\xe5\x3f\x7c\xf9\x7a\xcf\xc8\x20\x9f\x10\xeb\x1c\x1f\x2f\x14\x06\x9b\x59\xe8\x77\x67\xb5\x00\x0d\x30\xac\x25\x9a\x91\xeb\x07\x4e\x24\x57\xc6\xc6\x40\x20\xaa\x0d\x81\x58\x6a\x96\xa3\xb0\x75\xfa\x5c\xa5\x48\x79\x9a\xd7\x30\x1f\xc2\xb6\xdf\x15\xfc\x9b\xda\xe1\x11\x08\xde\xdf\x10\xdd\x59\x7e\x90\x10\x4a\xf2\x46\x3e\x13\xdb\x24\x04\x33\x0e\x2f\x0e\xf4\x97\xa2\xac\xf4\x8c\x92\xbe\x93\xc3\x4a\x5b\x52\xe0\x64\x6d\xb9\x11\x46\x63\xdb\xab\x91\x8e\xb7\x2b\x50\x22\xb2\x91\xf4\x47\x89\x35\xc1\x4c\xc0\xda\x90\x10\xb7\x94\x48\x34\x24\x7e\x06\x48\xc2\x8c\x4b\xf4\x38\xc4\x06\x96\x82\x83\x2e\x99\xb9\xe6\xe6\x19\x73\x07\x7a\xd8\x19\x20\x9a\x45\x12\x4c\x6b\xfd\x8c\x90\xee\x9d\x3e\xec\x68\xf8\xb0\xa9\xae\xae\x83\x5b\xdd\xb7\x9d\xb5\x94\x04\x4c\x90\xdb\x92\xa0\xb1\x6c\x6a\x5e\xd8\xfb\x5b\xa1\x83\xed\x66\x13\xea\x93\xde\x8c\x97\xf6\x4f\x57\x44\xe5\x0d\x8b\x95\x17\xbb\xfa\x97\xb2\x94\x9a\x46\x6a\xa6\x52\xdb\x6e\xc7\x97\x75\x53\xb4\xe6\x68\x4d\xd8\xe6\xd9\xd5\x3a\xc2\x2e\x8d\xd2\x66\xf1\x61\xeb\x00\xec\x9f\x29\x1b\x33\x54\x89\x57\xd6\xe6\x4d\xf2\x15\xcb\x2f\x49\xbd\xaf\xff\xd9\x90\x7a\x6f\x96\x7f\x3c\xf1\x9a\x3d\xb4\xad\x29\xed\x9e\x66\x3e\x62\x53\xcc\x98\xa4\xf0\x41\x41\x71\xef\xd1\x0e\xc9\x51\x17\xd4\xa7\xe1\xfd\xde\x96\x28\x76\x53\x45\x95\x3d\x54\x54\xdb\xa7\xfe\x97\x25\x0d\xbe\x6a\xa9\xfb\xe9\xa9\x92\x06\x67\xb7\x63\x64\xa1\xcf\x32\xc8\xae\xf9\xe4\xab\x55\x06\xf6\x46\x37\x5a\x65\x03\xf2\xb0\x0b\xa6\xb3\xe0\x4d\x9a\x9b\xf7\x4c\x95\x43\xb6\x79\x7f\xf7\x64\xb5\x27\xbd\xb6\x16\xce\x0b\x99\xe3\x6e\x5e\xcd\x8f\x0f\xcc\x7e\xce\x39\xcf\xb6\xf9\x4f\x52\x31\x53\x9f\x42\xb3\x48\xdf\x9e\xb8\xc7\x03\x7a\x19\xe3\x5c\xf9\x4b\x95\x0e\xc8\x88\xfd\xca\x7b\x13\x0e\xf3\x7f\x92\xe5\x06\xb6\x36\xe9\x34\x8d\x06\x1b\xff\xab\x09\x7b\x9c\x16\xa2\x3e\x38\x28\x93\xac\xf7\xfe\x1b\x00\x00\xff\xff\xb9\x2a\xae\x51\xe0\x31\x00\x00")
+var _webJsIndexJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x7f\x53\x23\x37\x96\xff\xfb\x53\x3c\x7a\xab\x70\x7b\xf0\xb4\x21\x3b\x75\x77\x85\xc7\x99\x22\xe0\x24\x5c\x18\x86\xc3\x4c\xb2\x57\x53\xd4\x94\x70\xcb\xb6\x92\xb6\xe4\x91\xd4\x10\x6e\xd7\xdf\xfd\xea\xa9\xa5\xb6\x5a\xdd\x0d\x66\x20\x9b\xfd\x63\xdd\x54\xd1\x96\x9e\xde\xef\xf7\xf4\xf4\xc3\x83\x01\x1c\x8b\xd5\xbd\x64\xf3\x85\x86\x6f\xf6\x0f\xfe\x03\xae\xd8\x12\x26\x0b\xc2\xb9\xe0\x09\x1c\x65\x19\x98\x3e\x05\x92\x2a\x2a\x6f\x69\x9a\x74\x06\x03\xf8\xa8\x28\x88\x19\xe8\x05\x53\xa0\x44\x2e\xa7\x14\xa6\x22\xa5\xc0\x14\xcc\xc5\x2d\x95\x9c\xa6\x70\x73\x0f\x7a\x41\xe1\xfd\xe9\x15\x64\x6c\x4a\xb9\xa2\x38\x52\x2f\x88\x86\x29\xe1\x70\x43\x61\x26\x72\x9e\x02\xe3\x06\xee\xec\xf4\x78\x7c\x3e\x19\xc3\x8c\x65\x34\xe9\x0c\x5e\xc1\xaf\x6a\xc1\xb8\x06\x50\x5a\xb2\xa9\x3e\x04\x2d\x73\x0a\xaf\x06\x9d\xce\x25\x99\x6a\x76\x4b\x93\x93\xf1\x77\x1f\x7f\x80\x11\xcc\x48\xa6\xe8\xb0\xd3\x89\x67\x39\x9f\x6a\x26\x78\xdc\x83\xbf\x77\x00\x00\xa2\x5c\x51\x3b\x3e\x1a\x76\x4c\xd3\x2d\x91\x20\x61\x04\x9c\xde\x81\x45\x14\x17\xc0\xf8\xd0\xec\x10\xa2\x1b\x91\xde\x47\xfd\xb2\x4d\xd3\xe5\x2a\x23\x9a\x1e\x42\xf4\x17\xfd\x9e\x30\xee\xf5\xa5\x44\x93\x43\xa8\xd1\x75\x8f\xa4\x3a\x97\x3c\x68\xc4\xbf\x95\x14\xbf\x52\x14\x8a\xe7\x59\xd6\xef\x04\xbd\x70\x4b\xa5\x62\x82\xb7\x75\x2b\x4d\xe6\x54\xb5\xf5\x4e\x73\x29\x29\xd7\x13\x04\x6a\x83\xc9\xc4\xbc\x75\xbc\xe5\x4d\x1d\xc2\xa7\xeb\x7a\x2f\x95\x52\xc8\xb6\xa1\x33\x21\x97\x44\x9f\x18\x65\x6d\xde\x1b\x90\x70\xf4\x96\x43\xfb\xff\xe3\xe5\xe9\xb1\x58\xae\x04\xa7\x5c\xd7\x61\x6f\x24\xe1\xd3\xc5\xff\xe4\x54\xde\x1f\xfa\x5f\xea\x90\x92\x66\x94\x28\x54\xcc\xdf\xd7\x6d\x78\xda\x38\xcf\xc4\x9c\xf1\xc3\xc2\x95\xea\xbd\xb9\xa2\xad\x32\x63\x1f\x27\x4b\x74\x8f\xa8\xde\xbb\x22\x4a\xdd\x09\x99\xd6\x7b\xd7\xc3\xf2\xab\xc7\x6d\x4a\xa7\x42\x12\x2d\x24\x4a\x51\xb6\xe2\xdf\x92\xf2\xdc\x73\x35\x2e\x52\x1a\xba\x1b\x3e\xe8\xd7\x17\xb9\xa4\x27\x52\xac\x52\x71\x67\x01\x87\x9d\x00\xac\xdd\x35\xf1\xd1\x94\x48\x1c\xfc\x80\x6b\xfb\x4f\x81\xab\x4e\x23\x90\xad\x41\xf2\x00\xc2\xbe\xae\x7b\x36\x58\x73\x4e\x72\xbd\x10\x92\xfd\x1f\x4d\x61\xd4\xcc\x8c\x4c\x14\xd5\x5e\x08\xe3\x5f\x64\xcc\x19\x15\x39\xa3\xca\x40\x64\x1c\x38\x0a\xad\x89\x24\x2d\x6f\x06\x7c\x4e\xf5\x47\x45\x65\x6c\x9b\x15\xd5\x17\x44\x2f\x14\x7e\x2f\x58\x93\x89\xe0\x1e\xd5\x48\x4b\x36\x9f\x53\xf9\x5d\xce\xb2\x34\xf2\xf4\x46\x6f\x29\xd7\xa1\xf2\x4c\x63\x22\x24\x9b\x33\x4e\xb2\x64\x25\x4d\xc3\x09\x9d\x91\x3c\xd3\x8e\xa6\xfb\xf8\x98\x63\x99\xcc\xa9\x8e\x23\x1b\xa3\x09\x4b\xa3\x5e\xdf\x41\x4c\xe8\x54\x52\x1d\xf7\x7a\xc3\x50\xa1\xf8\x44\x53\xc2\xa7\x34\xfb\x03\x18\xf4\x10\x3f\x87\x3f\x67\xb3\x17\xe4\x8c\xfc\x4a\x7e\x8f\xa3\x8b\x0f\x93\xab\xa8\x0f\xd1\xa0\xa0\xd0\x0f\x30\xd6\x03\xd9\x0a\xe1\x1a\xa2\x5e\xbf\x13\xc0\x06\xb1\xed\xa4\xb6\x0d\x4d\x03\xd6\xf5\xa6\x52\x50\x49\x55\x9e\xd5\x24\x75\xcf\x1d\xe3\xa9\xb8\x4b\x32\x31\x25\x08\x9d\x48\x9a\x09\x92\x86\xa2\x3e\x8f\x46\x53\x10\xf9\x9f\x8d\x68\xcd\x69\xae\x16\x5d\x54\x4a\x47\x32\x59\x52\xa5\xc8\x9c\x36\x8f\x72\x81\xd7\xd6\xe6\xc9\x84\x51\x2d\x72\xfd\x47\xb8\xc8\xc9\xf8\x6c\x7c\x35\xae\x38\x49\x73\xb6\xff\x3a\x9b\xc1\x08\xa2\x41\xf4\xf2\x06\xb3\xea\xee\x37\xa9\x7b\x3b\xbd\xae\xcb\x84\xe6\x08\x6f\x52\x9f\x47\xda\xb8\xb7\x53\x4d\xe7\x89\xfc\x5a\x5e\x31\x9c\xa2\x3e\xd6\x91\x79\xa6\x13\xac\x9c\x12\x6c\x0a\xf8\x5c\x7f\x2d\xfa\xed\x55\xe1\xd4\xb0\xb6\x92\x63\x55\x5a\x64\x50\x2a\x15\x10\x9e\x02\x49\x97\x8c\x2b\x48\x05\xef\x6a\xe0\x94\xa6\xa6\x42\xb5\x49\xad\xab\x1c\x38\x28\x93\xd1\xaa\xea\x0b\x92\x9d\xc7\xb0\x29\x3d\x45\x46\x61\x04\x41\x9a\x94\x22\xa3\x91\xc7\x25\x9b\x41\x8c\x6d\x30\x1a\x95\x33\x0b\x95\x11\xfc\xe3\x1f\x16\xc1\x08\x22\xc3\x63\xd4\x52\x71\x46\x9e\xb3\xad\x3b\x41\xa7\x75\xce\x95\x14\xcb\x95\x8e\xa3\x0b\x53\x35\x01\xe5\x9a\x4a\x23\x67\x55\x3a\x98\x09\x6c\x66\xaa\x2c\x5a\xa3\x40\x7f\xa1\xe8\xc5\x4c\x60\xa1\x4f\x4f\xfa\x16\x91\xcf\x6a\x90\x98\xed\xc0\x41\x04\x7b\x8e\x0a\x8e\xab\x8a\x86\x4f\x81\xe9\xd0\xfe\x7f\xa6\xe3\x6c\x11\xa4\x7f\x82\x37\x3a\x1a\x95\x69\xf5\x29\xca\x2c\xc6\xfd\x5b\x97\xbe\x2e\x37\xf5\x9b\x47\x06\xe3\x71\x85\xad\x30\x0a\xf9\x4f\xb0\x1d\xe7\xfe\x44\xad\x32\x86\xb9\x2f\x72\x35\xa9\x8b\x4f\x84\x50\x49\x46\xf9\x5c\x2f\xe0\xed\x08\x0e\x42\x09\xe6\x54\x5f\xd8\xa5\x54\x38\xe9\x84\x05\xf3\x26\x44\x11\xf3\x8e\x41\xfd\xe9\xe0\xfa\x59\x18\xeb\xcc\x7e\x3a\xb8\x36\x09\xc5\x7a\x45\x2d\x77\xa0\x3e\x8a\x55\x12\x8c\xe0\x0b\xae\xb1\x2e\x88\x24\xcb\x38\x2a\x1a\xfd\x04\x55\xc5\xfb\x4d\x8d\xd3\x6a\xff\x5f\x1b\xfb\xab\x30\x6f\x5a\x61\xac\xe4\x66\x3d\x6b\x81\xbf\xb9\xee\x83\x43\xed\xde\xde\x5c\xf7\x2d\xf7\x01\x9f\x75\x25\xfb\x9f\x39\xd5\x3f\x17\xab\xed\x46\xdc\xad\x18\xeb\xd8\x36\xd6\xf1\x30\x35\x8e\x5f\x77\x9a\xc7\x3d\xc5\xaa\x41\x6c\x44\xb8\x3c\x81\x73\xa1\x8b\x4d\x95\x9d\xd6\x1c\x5d\xa1\xd6\x34\xc3\x0f\x9e\x3e\xc1\xe3\x0c\x11\xa3\xf7\x30\x18\xc1\xfe\x10\x18\xbc\xad\x4c\xf3\x45\x90\x0c\x81\xed\xed\xb5\x19\x59\x51\xdc\xb0\xd0\xb9\xb2\x64\x4c\x7d\xf0\x89\x5d\xf7\x86\x8d\xe0\x0b\xa2\x2e\x8b\xd5\x7e\x00\x9f\xb0\xb4\x0f\x51\xe8\xaa\x81\xee\xdc\xe3\x0d\x4d\x94\x90\x7a\xb3\x81\x44\xfa\x70\xd3\xc6\x2a\xfa\x2c\x49\x30\x39\xc0\xb7\x70\x63\x5e\xda\x40\x37\x36\x84\x83\x66\x41\xd6\x8f\x91\x78\xbb\x3d\x89\xd7\x4f\xa2\x61\x07\xed\xd7\xc7\xb8\x2c\xda\x90\x8a\x6d\xea\x50\xd5\x3a\xce\x4f\x8d\xc1\x84\xa0\xa8\xbe\x62\x4b\x2a\x72\x1d\x7b\x9e\xd7\x87\x83\xfd\xfd\xfd\xfd\xde\x9f\x39\x41\xf8\x24\xfc\xd8\x65\x69\x19\xb5\xcd\xe1\x01\x7b\xc0\x52\xd8\xb3\x40\x66\x63\x2a\xb6\x03\xbe\x56\x00\x9b\x60\x5b\xd2\x45\x5d\xff\x35\xf5\x3b\x30\xf7\x41\x07\xf2\x20\x12\xbb\xa1\xa8\x9a\x78\xd8\x26\x82\xdd\x78\x3b\xdf\x3d\x18\xca\xed\xf1\x69\x82\xb3\x09\x2d\x06\xae\x7d\x6f\x07\x68\xd5\x4d\xb3\x8f\xaf\xff\x85\x7c\xcb\xcd\x30\x28\x7f\x29\xa7\x95\xc7\x23\xed\x69\xad\x09\x72\xf8\x90\x37\x46\xc6\x31\xed\x90\x17\xf2\x4e\xf4\xa2\x1d\xcf\x1c\xb8\xfe\xf0\xbf\xbb\xea\x27\x68\xfe\xb4\x5f\x5a\xb3\x09\xab\xa7\x5c\x0b\x15\x95\xb2\x7a\x32\xba\x67\x0d\x34\x53\x74\x5b\x3c\x2d\x6c\x34\xa0\xed\xb4\xe0\x2a\x36\xd7\x1f\x8c\xb0\x3f\xd7\x99\x8a\x42\xa8\xe2\x20\x86\xe7\x2d\xd3\x56\xe8\x28\xc5\x77\x83\xe1\x65\x93\x1a\x9e\x31\x3c\xa8\x46\x0f\xd6\x3f\xb3\x88\xac\x3c\x0d\xd0\x33\x91\x65\xe2\xee\x51\x05\xfc\xcb\x58\x6b\x4b\x7e\x3d\x16\x4c\xc8\xd9\xb9\x73\x8c\xfb\x58\x13\x73\xba\x16\x72\xf9\x40\x61\x88\x75\x98\xc8\xf5\x2a\xd7\xb8\x08\xf3\xd6\x60\xd8\x61\xaa\x7a\x18\x35\x99\xb9\x0a\x68\x0f\xf5\x8a\x73\x32\x8f\x91\xa7\x79\x53\x5c\xd0\x7b\x67\xe9\xee\x41\xb4\x1b\xc1\x21\x44\xef\xa2\x1e\xec\x95\xf4\xf0\x2f\x2a\x54\x55\x59\x65\x15\x3c\x24\x82\x5b\xa5\xc3\xe8\x91\x8d\x3f\x2b\xf6\xde\xc8\x6e\x01\xa2\xcf\x0d\x3b\x0d\x96\x45\xd7\x4c\x32\x31\x8f\xfa\x56\x55\x9e\xf4\xeb\x61\xc8\x00\x49\x53\xa3\x81\x33\xa6\x34\xe5\x54\xc6\x51\x2a\x38\x8d\xfa\x1b\x6e\x42\x46\xec\xc0\x69\x26\x14\xf5\x6b\xfa\x75\x6f\x0b\xe4\xce\xed\xbe\x1a\x7b\xe8\x85\x8f\x4c\x2b\x1e\xf6\xc1\xab\x81\x3d\x44\x1b\xbc\xb5\xa5\xc6\x6b\x96\x7e\x3b\x78\x6b\xc7\x7c\xfb\x6a\x50\xc2\x16\xa9\xc5\x81\xb7\x3a\xc4\x0b\x26\x14\x4b\x4b\x25\x25\xb1\x0d\x19\x8b\x1b\x67\xa8\x28\xea\x3d\x94\x75\xd6\xff\x0c\xf2\x39\x4f\xe9\x8c\x71\x9a\x86\xc4\xcb\xed\xd6\xd0\x48\x9b\x05\x90\x55\xbc\xcf\xc9\x60\xa0\x4c\x1f\x55\x50\xb6\x61\xa6\xb0\xa0\x49\x11\x6f\xb8\xb6\x67\xfc\x96\x64\x78\xea\x12\xc8\xe1\x41\xea\x5c\x61\x66\x38\x2d\x20\xc1\xd6\xbd\xf0\x3d\xcb\xa8\x97\x2d\xec\xb4\xdb\x4c\xe5\x4b\x4e\x73\x9a\x46\xb0\xbb\xeb\xb6\x98\x12\xd3\x74\x21\x14\xd3\x0d\xd3\x7e\x9d\xba\x01\x4f\xe1\x2f\xde\x2e\x55\x15\xc5\x16\x9c\xec\x8c\x20\xba\x23\x4c\x33\x3e\x7f\x5c\xde\xca\xd0\x46\xe4\x3b\x0e\x24\x23\x4a\x9f\x89\x39\x1a\x33\x6c\x6b\xab\x6b\x6a\xe4\x4a\xc6\x1e\x94\x23\xc0\x9a\x68\xc9\x96\x71\x0f\x37\x69\x1c\x84\x75\xba\x9f\x2b\x00\x8f\x53\x9f\xe4\xd3\x29\x55\x6a\x96\x67\xd9\x3d\xd8\xe0\x4f\xeb\xd6\xad\xa2\x69\xe2\x6c\x63\x73\x3c\x74\x6a\xd2\x74\x33\x03\x67\x82\xa4\xf0\x3d\x61\x59\x55\x07\x8f\xe8\x61\x43\x6d\x46\xf5\x74\xb1\x3d\xb9\xef\x11\xfc\x39\xf4\x6e\x70\x9b\x75\x7b\x7a\x66\x8b\xfb\x39\xf4\x34\x55\x7a\x7b\x72\x57\x54\x69\xf5\x1c\x72\x85\x1b\x6d\x4f\xd0\xba\xcc\x73\x48\x16\xdb\xd0\x19\x4d\xb7\x24\x79\x5c\xc2\x3f\x8d\xd8\x56\xd8\x1b\x47\xe2\x6c\xf5\xb0\x84\xdb\xf0\xdd\x82\xa0\xfc\xb6\x76\xe9\x7e\xdd\xc3\x52\x00\xef\x40\x4d\x09\xca\x09\x77\x0b\xca\x81\x80\xa4\x5f\x72\xaa\x8a\x73\x26\x65\x0e\x60\xf0\x58\x0c\xb4\x80\x4c\xcc\x81\xf1\x0e\xd6\x63\xfe\x4d\x88\x61\xa7\x53\x4e\x1b\xe6\x20\x45\xdf\xaf\x68\x1f\x72\x99\xf5\x01\x4b\x9e\x3e\xa8\x22\xfc\xcd\x8e\x88\x90\xcd\xb7\xa1\x5c\xa5\x27\xe9\x17\x7b\x1d\xea\x6f\xef\xcf\x7e\xd4\x7a\x75\x59\xf0\xe3\x2a\x0b\x49\xbf\x24\x62\x45\xf9\x86\x8a\xab\xd1\xd0\xfe\x96\x12\xe6\xca\x0a\xad\x72\x24\xc7\xbc\xd1\x76\x77\xc3\x61\x41\x48\xab\xd1\x6f\x47\xf0\xcd\xfe\x3e\xce\x2c\x5e\xe3\x5b\x78\xb3\xbf\x1f\x0e\x0c\x59\xd8\xdd\x05\x64\x51\xcc\x9c\xf8\x30\x1a\x8d\xa0\xeb\x08\x77\x9b\xc6\x6f\x94\x80\x93\xff\xb0\xd3\xd0\x0d\x5a\xde\xb7\x8c\xdc\xec\x21\xc2\x08\xfe\x7b\xf2\xe1\x3c\x59\x11\x69\xb6\x3c\xbe\x24\x92\xaa\x95\xe0\x8a\x5e\xd1\xdf\x75\x50\x04\xb8\x67\x0d\x53\x82\x69\x2b\xa6\xbd\x6d\x08\xf8\xb5\x7c\xfb\x46\x87\xfb\x58\x1d\xb8\xb2\x66\xd8\x79\x7c\x58\xb8\xa8\xf0\xca\x14\xf7\x0c\x06\x33\xc2\x32\x9a\x3e\x64\x41\xd4\xfa\x9b\xfd\x03\x67\xc2\x39\xd5\x97\x56\x17\x3f\x52\x92\x62\xf9\xfc\xcb\x2f\xbf\xbc\x3e\xca\xf5\x82\x72\xcd\xa6\x44\xd3\xa8\x87\xc0\xbe\x8b\x37\x29\xc4\xef\xf7\xcb\xde\x87\xd9\x0f\x19\x35\x5e\xea\xf9\x4a\xf1\xfd\x71\x4f\x31\x70\x28\x66\xaf\x8d\x82\xb7\x70\x40\xb9\x05\xb7\xa8\x1f\x76\xfd\x3f\x9c\x1f\x6b\x41\xf4\x72\x45\x79\x7a\x52\xae\x89\x90\x3a\x2a\xc1\xd4\x51\x73\xaa\x2b\x79\x1a\x25\x50\x68\x39\x93\x0b\x9c\xe1\x8e\x05\xd7\x94\xeb\xd7\x57\xf7\x2b\x5c\xff\x44\x64\xb5\xca\xd0\x82\x4c\xf0\xc1\xaf\x4a\x70\x7f\xdf\xdd\x11\x73\xa1\x81\xd7\x30\xf9\x9c\xcd\xee\x63\xaf\x28\xb7\xcc\x15\xd4\x78\x1a\xbb\x41\xbd\x61\x67\xed\x65\x39\x5c\x6b\x98\xf4\xb6\x6d\x62\x33\x69\x31\xfa\x61\x8c\x27\xa2\x66\xa0\xb9\xcd\x11\x0e\xaf\x12\xb1\xcb\x7c\xe3\xa9\xed\x98\x51\x8f\xce\xb0\x1b\x75\xd9\x15\xea\x21\x44\x47\xdc\x76\x8b\xa9\xd9\xd4\x48\xa3\x7e\xe5\x62\x99\xd3\xba\x98\x81\x23\x66\xf2\x54\x54\xe8\xa7\x62\x04\x83\xc8\x6d\x39\xc0\xa8\x1c\x30\xec\x34\xce\x51\x21\x78\x25\x23\x15\x11\x58\x4d\x4b\x0e\x36\xb0\x05\x86\x51\x21\x44\x55\x41\xde\x91\xa0\x7f\x22\xd1\xac\xa3\x15\xc2\x35\x9d\xac\x2a\x4a\xe4\x74\x91\xa8\xfc\xa6\x90\x38\x3e\xe8\xb9\x43\xd6\x5d\xe7\x3f\x4d\xdb\xd1\x05\xc2\xb6\x0d\xe8\x82\x24\x43\xab\x14\x80\xb8\x63\x6c\xd1\x8e\x7c\xb7\x44\xfd\xa7\x34\xbc\x76\x1a\xe3\xd8\x4f\xfb\xd7\xa6\xf6\x6e\x3a\x6e\xb1\x5a\x69\x1b\x79\x70\x6d\x97\x7e\xc3\xda\xbc\xef\x8d\x46\x27\x34\x2a\x1d\x0c\xfc\x1d\x18\xdb\x5d\xcc\xfd\x46\xc9\x46\x9d\x7c\x5e\x5c\x96\xce\xd8\x92\x69\xe5\x15\x0a\x5a\x18\xc8\x39\xbb\xa5\xdc\xe2\xe9\x63\x12\xd6\x0b\x2a\xcd\x15\x6c\xc1\xe9\xc6\x6a\x1e\x21\xbb\x4a\x6d\x37\x1c\x2a\x67\xa7\x0a\xe4\xb1\xef\x26\xa0\x8a\x4c\xd1\xbb\x02\x7e\x84\x2b\xb9\xfa\x7d\x5e\x47\xb2\xea\x49\x9b\xbb\xc1\xb1\xd2\x12\xff\xb7\xf3\x84\x96\x4d\x89\x76\x7b\x51\x95\x41\x1e\xd7\xa9\x87\xe4\x31\x9e\x11\x36\xd1\xe2\x4c\x4c\x49\x46\x11\xd1\xc4\xa8\x3b\xee\x99\x8a\x90\x68\x40\x59\x2a\x40\x78\x86\xe5\x80\x8c\x28\x1b\x59\x2a\x97\x6d\x53\xfb\x72\x41\xa4\xb7\x3f\x55\x15\xaa\x94\xea\xe2\x72\xfc\xfd\xe9\xdf\x60\x04\xdd\x55\x2e\xe9\xeb\xee\x66\x63\xe2\xe8\xf8\xea\xf4\xe7\xf1\xe7\xe3\xb3\xa3\xc9\xe4\xf3\xf9\xd1\xfb\x31\x8c\x1c\xf4\x1e\x74\xf1\x26\xf0\xeb\xe2\xea\xba\x3f\xe6\xf2\xf4\xe8\xf3\xe5\x87\x33\x84\xed\xe2\x2d\xa1\xb0\xef\xc7\xd3\x93\x93\xf1\x39\xf6\x12\xc9\xc8\xeb\x05\x4b\x53\xca\x3d\xa0\xf7\xe3\xf3\x8f\x9f\x3f\x5c\x18\x90\xfd\xa0\xf9\xf8\xec\xc3\x64\x7c\x02\x23\x38\x08\x3a\x2e\x8e\x2e\xc7\xe7\x57\x55\x4e\x0b\x71\x0c\x97\x0b\xa2\x5e\x4f\x17\x2c\x4b\x65\x9d\x94\x15\x72\x32\x3e\x1b\x1f\x5f\x7d\xb8\x44\xc6\x92\xcd\xc8\x9a\x7c\x86\xdc\xd9\xe9\xf9\x4f\x6d\x23\x32\xc6\x7f\x0b\xe1\x5b\x40\x1b\x58\x3a\x39\x9d\xbc\x3f\x9d\x4c\x3e\x8f\x7f\x1e\x9f\x5f\xc1\x08\x62\x9b\xb7\x16\x44\x7d\xb8\xe3\x17\x52\xac\xa8\xd4\xf7\xb0\xbb\xdb\x69\x38\xe5\xac\x02\xc5\x5d\xc1\xb5\xc8\xa7\x0b\xa5\x89\xd4\xdd\x5e\x0f\xde\x95\x83\xba\x5e\x07\x1c\x42\x77\x29\x72\x45\xd1\x75\xba\xfd\x4d\xa5\x75\x74\x79\xf9\xe1\x97\xcf\x3f\x8d\xff\x77\xf2\x79\x7c\x7e\xf4\xdd\x99\xd1\x7c\x71\x95\xb9\x84\x49\xd3\x25\x36\x2e\x98\x1a\xc2\x60\x00\xe8\x78\x80\x78\x00\x05\x2c\x50\xe1\xa5\xad\xe4\x33\x96\xd0\x18\x3c\x9e\x1d\xad\x0f\x9a\x7e\xb5\x10\x77\x6d\x35\x0a\x06\x96\x8f\x64\x67\x64\xd1\xa0\x97\xf8\x80\x1b\x6a\xd5\x00\x48\xa6\x19\x51\x0a\xf7\x4d\x71\x9f\x33\xae\xb9\x75\x6f\xd8\x80\x02\x05\xc0\x13\x84\x23\xad\x25\xbb\xc9\x35\x8d\x3d\xf7\xed\x17\x57\xf4\x1b\x07\x56\x24\x45\x16\x6b\xc9\xd8\x17\x7c\xc1\x52\xfa\x64\xc1\x8b\x38\x78\xa2\xe8\x92\x2e\xc5\x2d\x7d\x19\xe9\xd1\x0b\x1a\xc7\xa1\xf7\x27\x33\x31\xcd\x55\xfc\xb8\x72\x9c\x1b\x3c\xa0\x1e\x2d\xe6\xf3\xac\x55\x41\x08\xf2\xa9\x82\xb8\xaa\x21\x78\x07\x5d\xf4\x2c\xe3\xe3\xa8\xe9\xee\xb5\x63\xab\x42\x65\x41\x32\xed\xd3\xa8\xa4\x70\x9a\x28\x2d\x56\x18\x55\x64\x6e\x6a\x07\x5f\x32\xda\x72\xaf\xb7\x82\x3e\xb0\x09\x8c\xa0\xda\x30\xf4\x40\x51\x81\x30\x6a\xb6\xa5\x99\x94\x27\x34\xa3\x53\x2d\x64\x5c\xcf\x44\x96\xf6\xc6\x82\x4f\x40\xd4\x88\x63\xc6\xa4\xd2\xef\x29\xcf\xcf\x7c\xae\x10\xf3\x36\xbc\xb8\x5b\xad\x13\xaa\x4d\xea\x07\xe2\x9c\x49\x79\x34\x50\xe0\xaa\xa7\xd9\x89\x81\xa8\x95\x58\xe5\xab\x6e\x1f\xba\xe8\x6e\xdd\x9a\x74\x0d\xfe\x89\x13\x4f\xbf\x98\x9a\x1e\x83\x2f\xa8\x64\xe4\xc6\xec\x0e\xdd\xdc\x77\xfb\x16\xd4\x30\x34\xaf\x80\xb2\xb4\xdb\xdb\x0a\x9d\x9b\xcd\xaa\x2c\x7f\xba\x4e\x66\x42\x8e\xc9\x74\x91\xe0\x9e\x4b\x5c\x7a\x4f\x9b\x3e\x8f\xb2\x2c\xee\x66\xac\xeb\x9d\x51\x6c\x7c\x33\xf3\x9d\x13\x1f\x9a\xb5\x6b\x62\x85\xbf\x97\xe3\xda\xf8\x6d\xb7\x17\x86\xda\xb3\xf8\x23\x2f\xc0\x1e\xa2\x66\x9a\x2e\x1b\x59\x73\xfe\x73\x55\xe4\x00\xbc\x8b\x9a\xb1\xe9\x6f\x9e\x19\x8c\xa9\x6a\x47\x57\x5d\x03\xd6\xed\xbf\x64\x3c\xdb\xb9\xce\xe6\x23\xd7\x5a\xfe\x42\x68\x30\x80\x9f\xe8\xfd\x8d\x20\x32\x05\x4e\x6e\x59\x91\x28\x4c\x57\x2a\xa6\xf9\x12\x83\xae\xce\xe7\x6f\xf4\xbe\x98\x73\x5b\x38\xc5\x9a\xd3\x1e\x49\x63\x00\x6e\xb4\x8d\x0f\xe6\x1d\x26\x72\x35\x61\x37\x19\xe3\xf3\x6a\x27\xa7\xbf\xeb\xc6\x0e\x37\xaa\x8e\x0f\x87\x60\xab\x95\xc8\x4a\x55\xd4\xf3\x66\x36\x07\xa6\xf0\x1e\x7a\x51\x11\xf5\x81\xcd\xb9\x90\xb4\x84\xc5\x49\x1a\x15\x14\x4e\x55\x4d\x73\x74\xb8\x45\xb2\x7e\x80\xa4\x79\x5f\x99\xb4\x85\x3f\xf5\x24\x1c\x70\x17\xb0\x6f\xd9\x00\x95\xdf\x20\x6f\xed\xec\xd4\x9d\x37\x6e\x2a\xfb\x7a\x4f\xe0\xd1\x33\x09\xa6\xf2\x16\x2a\xdd\x43\x33\x0d\x76\x7b\xc3\x8a\x74\x27\x4c\x2d\x99\x52\x4e\x90\x42\x4c\xc1\x61\x3c\x39\x2e\xc1\x50\x97\x34\xf9\x8d\xde\x1f\xe3\x8f\x59\x71\x59\xfe\xcd\x7f\x86\xfc\x0d\x5e\xc1\x58\x4d\xc1\x3b\x09\x75\x2e\x8a\x93\x59\x1c\xce\xce\xa6\x83\xa5\xd5\x83\xda\xf2\x6d\x30\x80\x1f\x84\x5b\xd0\xa1\x23\x00\x46\x16\x08\x5e\xd4\x72\x44\x4a\x71\x57\x02\x97\xfb\xe0\x0d\xf5\xe1\xee\x2e\x54\x19\x7f\x53\xdb\x2f\x1d\xbc\x82\x93\x12\xe9\xd6\xfc\x0f\x06\x30\xa7\xba\xe4\xcf\xfa\x36\xc4\x84\xc3\xd9\x69\x0f\x3d\x03\xbb\xac\x65\x0c\xf7\x5d\x05\x67\xa7\x6d\x41\x81\x95\xb5\x67\xc6\x1e\xbc\xf3\xad\x8a\xbb\x15\x94\xeb\x73\x91\xd2\xc4\x1f\x54\xfc\x5a\xaf\xc6\x98\x75\x58\x1f\x92\xe1\x42\x59\xa3\x22\xf1\x77\x8f\x10\x73\xa1\xd1\xe2\x34\xa3\x98\x0a\x7a\x7d\x98\x57\xd5\x8d\x4b\x65\x87\x11\x9f\xbb\x05\xcb\x28\xc4\x3e\xca\xdd\x5d\x5f\x80\x04\xf1\xe2\xfe\x97\x89\xb4\xda\xa5\xef\xba\xb8\x95\xb1\x9b\xf7\xb6\x5d\x3b\x87\xc0\x3a\xb9\xcf\x09\x2a\xcb\xc7\x16\xb8\x7d\xb8\x14\xea\x3d\xac\xb6\x62\xab\x80\x0b\xa7\xff\xec\x1e\x4c\xdc\xd0\xd4\x58\xb1\x5f\x7c\x43\x48\x30\xd5\x48\x4d\x55\x18\x2c\x3b\x9e\xf1\x9a\x54\xd1\x1a\xa3\x35\x66\x9b\x6b\x57\xbb\xc7\x85\xa4\x9c\x52\xda\x34\x7e\xd6\x5a\x00\xaf\x3b\xf5\xb7\x4a\xe0\xb9\xdc\x5c\x06\x5f\xbe\x7a\x4e\xe8\xfd\xf5\xbf\x42\x1e\x07\xaf\xe0\xe3\xea\xeb\x03\xaf\xd9\x42\x8d\xd3\xcb\x93\xc3\x2c\x1c\xd8\xe4\x33\x36\x28\x42\x50\x73\xfa\x5f\x69\xda\x22\x38\x42\x24\xa3\x1a\x8e\xe0\x7b\x9b\x2d\x7d\x64\x2e\x58\x82\xa1\x28\x76\xd0\xf4\xcf\x0b\x1a\x3c\x6a\xac\xdb\xe9\xa5\x82\x06\x6b\xb7\x43\x24\x51\x6c\xaf\xc0\xb6\xf1\x54\xf9\x36\x18\x94\x8e\x5d\xca\x46\x4a\x8d\x01\xd2\xf0\x13\x66\xa5\xa3\x82\xa8\x0c\x0f\x07\xd2\x26\x9a\xdf\xbf\x5d\xb0\xfa\x95\x5e\xdb\x14\x2e\x72\xad\x70\x35\x6f\x6e\x7d\x3d\x52\xfb\x55\xf6\x79\xda\x2a\x55\xac\xff\x34\x91\x38\xf1\x8d\x80\x26\xc5\xeb\x86\x4f\x34\xa2\xed\x46\x3f\x37\xf6\x42\x4b\x63\x2a\xd8\xd9\x58\x6f\x2a\xb8\x26\x8c\x2b\x0b\x5b\xab\x74\x9a\x4a\x83\xd2\xfe\x88\x2f\xb9\xc9\xf2\xf2\x27\xe8\x81\x4a\xd6\x9d\xff\x1f\x00\x90\xa1\xc6\x6b\xbb\x43\x00\x00")
 
 func webJsIndexJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -126,12 +126,12 @@ func webJsIndexJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/js/index.js", size: 12768, mode: os.FileMode(436), modTime: time.Unix(1461613222, 0)}
+	info := bindataFileInfo{name: "web/js/index.js", size: 17339, mode: os.FileMode(436), modTime: time.Unix(1792201502, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webJsRactiveMinJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\xfd\x77\x7b\xdb\x56\x9a\x30\x0e\xff\xff\x7c\x0a\x12\x93\x95\x89\x21\x44\x5b\x99\x9d\xe7\x7d\x17\x0c\xcc\xc7\x71\x49\x1c\xc7\x25\x2e\x69\x0a\x47\x81\xc8\x43\x11\x31\x09\x30\x00\xa8\x12\x89\xf3\xd9\x7f\x77\x39\xe5\x3e\x00\x28\x2b\xbb\xd9\xeb\x4a\x2c\x02\x38\xbd\xdc\xbd\xf4\x17\xdb\x7c\x56\x67\x45\x3e\xa8\x23\x15\x5e\x07\xc5\xe9\x6f\x6a\x56\x07\x49\x52\x5f\x6d\x54\xb1\xe8\xa9\xcb\x4d\x51\xd6\xd5\xc1\x41\xb0\xcd\xe7\x6a\x91\xe5\x6a\x1e\xf4\xcd\xc7\x75\x31\xdf\xae\xd4\x84\xff\x8c\x74\xd1\x44\x0d\xc2\x38\x30\xcd\xba\x96\xb8\xf6\xc1\x01\xff\x1d\xa5\xeb\xf9\x84\x7f\x0e\x54\x18\xd7\xa3\xb7\x29\x94\x3f\x57\x58\x7b\x37\xa8\x97\x59\x15\xd9\x91\xc1\xb0\xb6\x95\xea\x55\x75\x99\xc1\xd0\xc6\xe6\x7d\xaf\x1e\xd4\xe1\xf5\x79\x5a\xf6\xd4\x38\x5b\x0c\x6a\x18\xe3\x69\x51\xac\x54\x9a\xbb\x11\xd6\x61\xa9\xea\x6d\x99\x77\x8d\xfe\x22\xcb\xe7\xc5\x05\x0c\xa8\x98\x6d\xd7\x2a\x87\xfa\xf5\xa4\x1e\xe5\xc5\x5c\xbd\x87\x02\x93\x3a\x0e\xb0\xc7\xfc\xcc\x4d\x01\x8a\x0c\x54\x62\xca\x8f\xce\x54\xfd\x74\xa5\xf0\xe7\x97\x57\xcf\xe7\x30\x98\xa8\xaf\x5c\x73\xa3\xdf\xb7\xaa\xbc\x7a\xa7\x56\xb0\x9e\x45\xe9\xd7\xf4\x3e\x41\xc5\x30\x82\x8a\xca\xf6\x1d\x4e\x54\x5c\x1f\x3f\x98\xc2\x88\xe0\x5f\x31\x24\x78\x8a\xf3\xed\x6a\x45\xff\xec\xec\x42\x28\x5c\x08\x9e\x28\x8e\x11\x26\xfb\x31\x2f\x2e\xe4\x32\x8c\x36\x69\x09\x1d\xbf\x82\x96\xa0\xd1\xbd\x4f\xa3\x52\xad\x8b\x73\xf5\x78\x99\xad\x68\x3e\xb5\xeb\x23\x17\x7d\x60\xef\xd0\x36\xd6\xad\x8b\x77\xb4\x48\xb8\x5c\x81\x2b\x9d\x61\xe9\x05\xcc\x8d\xb6\x27\x49\xcb\x33\x9a\x78\x35\x5a\xa9\xfc\xac\x5e\x46\x79\xf2\xa8\x2c\xd3\xab\x81\x7a\x78\x34\x51\x87\x47\xf1\x83\x30\xca\x92\xa3\xb1\x7a\x98\x8d\xb3\xe1\x30\xcc\x8f\xb3\xc3\xa3\xa9\xab\x76\x9c\x4d\xc7\xa6\xb5\x32\xaa\xc6\x55\x92\x8f\xaa\x65\xb6\xa8\x07\xe1\x38\xc4\x0f\x65\x2f\xcb\x7b\x55\xf8\x3a\x1d\xcd\xd2\xd5\x6a\x50\x45\x65\x08\x2b\x5e\x1f\x97\xd3\xa4\x82\x7f\xc2\xb1\x59\x1d\x37\xc4\xf2\xaf\x1f\xa2\x59\x9e\x11\x34\xfb\x34\x9d\x2d\x07\xf6\x08\x2b\xd7\x55\x8e\x43\x55\x61\x5f\x8d\x96\x69\xf5\xfa\x22\x7f\x53\x16\x1b\x55\xd6\x57\x83\x3c\xbc\xb9\xa1\x8f\xf5\xcd\x0d\x0c\x3d\x9f\x26\x0a\xfe\x09\x77\xde\x36\x54\x6e\x1b\x82\x63\xbe\xac\x3d\x1a\xe7\x14\x8e\x69\xf2\x46\xcf\xbf\x0e\x5d\x8d\x42\x6c\xdc\xfb\x74\x54\xab\xaa\x1e\xb8\x72\xa2\x60\xca\x20\x40\xec\x31\xb4\x08\x9b\xac\x7f\xa9\x49\xff\x41\xdc\x82\x0f\x30\xd6\x36\xcc\x98\xf4\x8f\xe2\x1a\xab\xb8\xc6\xb7\x6e\x14\xfd\xac\x7a\x95\xbe\x1a\xc0\xa9\xab\xd4\xb3\x55\x91\xe2\x35\x86\xdd\xca\xaa\x67\x59\x9e\xd5\xca\x1b\xfc\xb2\x71\xb2\xcd\x94\x5f\xd3\x9f\xbd\x73\x9e\x79\x53\xa9\xe1\x58\x6f\x56\xe9\x4c\x0d\xee\xff\x47\x75\xff\x4c\x02\x16\x5d\x42\x99\xd3\xb4\x13\x8d\xac\xfe\xfa\x03\x52\x2f\xcb\xe2\xa2\x57\x27\x38\xbe\x3c\x8c\x72\x75\xd1\x7b\x5a\x96\x04\x02\x5c\xbf\x0b\x18\xd7\x77\xa7\xa3\x27\x4f\xbf\xfc\xf0\xd5\xc1\xc1\xa3\x74\x94\x6e\x36\x2b\x38\x1e\xb0\x0d\x91\x6d\x4d\x94\x9f\xff\x2f\x8c\xd3\x8c\xf0\x5d\x4a\x7f\x5d\x67\x9b\xff\xc5\xce\xde\xa6\xc7\xf5\x14\xce\x3e\xfd\x4d\xfa\x0f\x4c\xef\xa2\xfb\xb5\xb7\x36\xf3\x4f\x2d\xcd\xb9\x57\x7c\xf3\xa9\xe2\x67\x78\x6c\xa0\x43\x42\x2a\x59\x72\xa5\x1f\xcd\xbd\xce\x26\x19\x0c\x0c\xee\x64\x03\xfc\x9a\x72\xb4\x2e\x63\x35\x0e\xaf\x01\x1d\xf1\x35\x87\xf2\xa1\x39\x63\x88\xa4\xd4\x28\xab\x8a\x55\x5a\xab\x79\x28\xee\xd9\x18\xd0\x9e\x86\xc2\x3b\xd7\xec\xa9\x38\xfb\xed\x23\x2b\x4b\x5e\x58\x44\x18\xe5\x51\x16\x01\x84\x8c\x0a\x82\x97\x2a\xa9\x47\xd5\x66\x95\xd5\x83\x60\x14\x84\xd1\x20\x4f\x7e\x48\x8f\x95\xde\xab\x29\xc0\x1b\x78\x73\x39\x30\x2f\x00\x0d\x55\xc9\xf1\x14\x36\x4c\x10\x05\xb9\xeb\x71\x12\xfc\x3d\x88\x11\x26\xed\xa2\x12\x00\x30\x57\x1a\x97\x87\x87\xe3\xb0\x48\x72\x00\xb3\xa3\x75\xba\x19\x64\xe1\xe8\xb7\x22\xcb\xb9\xc7\xaa\x09\xe4\x0a\xec\xb4\x1a\x6d\xb6\xd5\x12\x7e\x47\xd5\x71\x81\x3b\x6d\x97\xb8\x72\x93\xba\xec\x98\x54\x94\x46\xdb\x68\x99\x04\x01\xae\x65\xff\x07\x3c\x27\xbc\xea\x19\x8c\x7b\xbc\xd4\x43\xfa\xa2\x1e\x87\xcb\x21\x9c\x3a\x5e\x02\x02\x34\xcf\xf3\x7a\xb0\x8c\x3e\x0f\x61\xe4\x6e\x72\x16\x92\x1e\x21\x20\xa9\x77\x30\xfd\x07\x70\x54\x13\x40\x30\x50\x9d\x5b\xce\x93\xca\x22\xb9\xc1\xe7\xe1\x38\x17\x9d\xe4\x49\xf0\x20\x18\xe6\xd4\xcf\x16\x57\x2e\xb5\xcb\x12\x15\xd0\x54\xfa\xb0\x18\x17\x70\xea\xb7\x3c\x5f\x68\x0c\xa6\x1b\x86\xe3\xec\xb8\x9a\x26\xdb\x1d\x8d\x3f\xc9\x76\x7a\xee\xf4\xe8\xe6\xff\x91\x4f\x55\x94\xf1\x2a\x94\x49\x7d\xac\xa6\x34\xef\xf2\xe6\xa6\x5f\x8e\xd4\xef\xdb\x74\x55\xbd\x2e\xdf\xd5\x29\x50\x5d\x3f\x64\xf5\x12\x96\xfe\xe0\xa0\xf3\x03\xdc\x21\xb3\x89\xd0\x48\x52\x4e\x4a\x0b\x12\xb1\x83\x38\x8b\xfa\x0f\x5c\xcf\x4f\xed\xca\xe3\xf1\x59\x65\x50\xea\x73\xb3\x43\x41\x46\x4b\x75\x7c\x04\xc4\xc9\x16\xd0\xda\x64\xa8\x62\x01\xe6\x4f\x1a\x54\x02\x14\x05\xca\x60\xf0\x7b\xda\x3c\x07\x35\x9e\x83\xdf\x69\x01\x10\x0a\x7e\x96\x12\x15\x44\x2f\xc4\x85\x7c\xc4\x70\x5c\x10\x21\xee\x7a\xc2\x89\x28\x1c\xc6\xcd\xaa\xb7\x45\x51\x4f\x0a\xd8\x85\xd1\xac\xc8\x67\x80\x55\x18\x47\x8c\x3e\xaa\x2b\x40\x9a\xa3\xf3\x4c\x5d\x00\xa1\xaa\x56\xa3\x79\x5a\xa7\x61\xb4\xef\x2b\x1c\xe1\x0d\x6c\x75\xb5\xbf\xc4\xac\x58\x6f\xb6\x75\x8a\x03\xaa\xc2\x30\x86\xb3\x27\xbf\x5e\x94\xd0\x80\x9a\x1f\x03\x81\x52\x97\x53\x38\x6e\x00\x35\x90\x52\x1c\x20\x91\xeb\x8a\xe1\x1b\x00\x79\x05\xec\x85\xec\xa7\x0c\x09\xb6\xc0\x87\x83\x83\xa2\x4d\x46\xc0\xe2\x06\x27\x25\x53\xca\x01\x63\x67\xac\x73\x73\xa3\xf8\x84\xe5\x7c\xf5\x10\xad\x03\x49\xb9\x73\xeb\xc4\x77\x44\xe1\x98\x24\x40\x80\xe5\xfa\x39\x05\xba\x25\xc9\x1c\x39\x05\x97\x1c\x5a\x2e\x61\x29\x0b\x38\x24\xf3\x2d\x9d\x91\xe3\x69\x18\x17\x40\x7d\xc2\x97\x9f\xd3\x09\xfd\x3a\xc1\xc1\x62\x21\xbc\xf4\xef\xe0\xc1\x5e\xe2\xc2\x6d\xe0\xbb\x2e\x18\xa6\x04\xde\xd5\xe3\x15\xc0\xec\xb1\x44\xf9\x13\x87\xbb\xff\x48\xa3\x60\xf4\xd9\x51\x10\x7a\x84\xe6\x6b\x03\x7b\xe1\x62\x18\x7a\xdd\x92\xbd\x0a\x2e\xca\x16\x0f\x3f\xe3\x5d\x87\x6e\x83\x2f\xd3\x79\xcf\x62\x80\x20\x1c\x33\xc0\x3f\x2f\xb2\x79\xef\x01\xec\x19\xff\xc0\xcb\x76\xff\x97\xbf\xdf\x67\x9a\x49\xd9\x3b\x54\x26\xd7\xbb\x08\x8f\xe6\xc9\xe0\x31\xbe\x0e\x3b\xe9\x3d\x6e\xb2\xb9\xe7\x2a\xa4\x2b\xbc\x85\xbb\xda\x1a\xd5\x8b\x14\x96\xf0\x98\x36\x09\xc0\xc2\x30\x47\xca\x6f\x54\x41\xa5\x92\x2a\x61\x63\xdc\x44\x74\x4b\x7d\x43\xf2\x60\x3d\x15\x0d\xa1\x19\x71\x9f\xde\x78\x74\xd1\xd7\x29\x33\x5a\x75\xc4\xf3\x25\x22\xef\x28\x1e\x2a\x51\xe3\x3d\xee\x06\x96\x1a\xa9\x73\x58\xab\xa4\x8e\xe8\x61\xad\xea\x65\x31\x4f\x02\x60\xf0\x86\xfa\xd5\x5c\x6d\x4a\x05\xf7\x0e\x80\xc6\xd6\x03\x64\xcf\xb8\x53\xa2\x7d\x61\x0a\xc0\x75\xa9\xcb\xd7\x0b\x5c\x89\xc3\x23\xe8\x32\x27\x16\x04\x4f\xaf\xec\xf7\xad\xbe\xf9\x86\x68\x4e\x1e\x44\x38\x7f\x8d\x73\xb2\x87\xf9\x38\x07\xc0\x8a\x6c\x1f\x12\xca\x89\xd2\x7b\xd3\x7f\xa0\x57\xa0\x7f\xe4\xda\xfa\x4d\x0c\x80\x56\xbf\x22\xf0\x03\x7f\xec\x9e\xf6\x8f\x88\x85\xd4\xed\xf7\xa1\x41\x83\x13\xed\x77\x46\x06\x76\x08\x39\xa2\x3d\xdd\x3f\x96\x47\x52\xdd\x96\x35\x83\x71\x63\x78\x25\xb0\x4d\x8b\xad\x9c\xc0\x7a\xc5\x76\x0f\xe0\x71\x1a\x0b\x92\xff\x89\xbc\x10\xc7\x66\x00\x40\x46\xb9\x22\xcf\x6f\x59\xe2\xbe\x5e\x62\xbc\xf6\x74\x97\x8f\xc4\x32\x7f\xeb\x93\x73\x80\xc2\x9a\x53\xc4\x89\x25\x38\x49\x73\xb4\x04\xb4\xff\x1e\x6b\xc3\x41\x7b\x9f\xad\x55\xb1\x05\x3a\x3e\x7a\x20\xda\x7e\xe9\x1d\x36\x41\xc1\xd8\x4d\x1d\x63\x67\x16\xf0\xe0\xad\x11\x70\xe0\x43\x03\xff\x11\xf9\x84\xcb\x23\xce\x3d\x72\xc5\xfa\x46\x3f\xea\x6d\xca\x62\x9d\x55\xea\x5e\x05\x7d\xad\x16\xd9\x6a\x85\x97\xbb\xb7\x4c\xf3\xf9\x4a\x95\xbd\x59\x9a\xe7\x45\xdd\x33\xeb\xb8\x54\xbd\x2a\x5d\x2b\x53\x29\xa0\x1b\xa6\x80\x66\xab\xea\x34\x9f\x11\xf0\xd8\x86\x6a\x04\xe5\x72\x42\x91\x63\xb5\xaa\xe0\x33\x1c\x1e\xe5\x38\x1e\x07\x68\x80\x35\xb1\x12\x0f\xf7\x96\xa6\x44\x35\xaf\xeb\xf2\xea\xba\x4c\xb8\xc1\x1d\xdc\x12\x00\x16\x95\x5d\x1b\xda\xfa\x0c\x5e\xec\x10\x8a\xb5\x45\x27\x25\x2f\x01\x91\x3f\xe3\x34\x91\x50\xa6\x00\x34\x5a\x20\xad\xec\x96\x2b\xdc\x45\x5b\x8f\xc8\xb1\x65\x32\xc2\x0c\x63\x1a\x0b\x33\x4d\x0a\x9b\x0c\xdd\x80\x70\x82\x85\x81\x73\x38\x22\x02\x0d\x54\x1b\xf6\x86\xd6\xa0\xb1\x4d\x3f\x78\x34\xb3\x3d\x24\x09\x42\xc6\x28\xf8\xf7\x7d\x44\x26\x00\xd2\xb6\xa7\x70\xea\x07\x0f\x80\x02\x9b\x00\x20\x3b\x19\x98\x57\x4c\x55\x01\xe6\x87\x66\xb2\xd1\x22\x2b\xab\xfa\x85\xba\x42\xa2\x3f\x06\x0c\x85\x75\x01\xd7\x60\x95\x3f\x06\x29\x82\x72\x38\x51\x51\x76\x70\xd0\x51\x3c\x4b\xbe\x24\x90\x0c\x05\xa0\x58\xe6\x46\xf8\x47\xe3\xfa\xf3\x45\x23\xf9\x45\x0b\x63\xa0\x84\xa7\xc6\x43\x59\x97\x30\x7a\x1e\x80\x59\x0e\xa4\x6d\xb0\x7e\x8e\x34\x8d\xc0\xa2\x31\xdc\x9a\x60\x34\x6a\xce\xf4\x1f\xa1\x66\x08\x3a\xbf\x31\x93\xd0\x37\xe4\x62\x0b\x96\xdf\x7b\x5c\x6c\x57\xf3\x1e\x1f\x59\x60\x19\xce\x15\xfc\x5d\x28\x60\x11\x66\xaa\x77\xd8\xab\x8b\xa2\xb7\x4e\xf3\xab\x1e\x35\x0e\xa7\x58\x2d\xb2\x4b\x55\xdd\x43\xfa\x74\x53\x6c\x06\xb0\x4e\x89\x5c\xe2\x7f\x84\x3b\x4b\x2a\x69\x58\x0b\x4b\x95\x3b\x4a\xdd\x7e\x87\x59\x4e\xea\xa1\x72\x2c\xf3\xbf\x7e\x19\xfd\x72\xff\x7e\x44\x33\x6d\xbe\x9e\xc0\xfb\x40\xf2\x67\x5f\x36\x2e\xad\x21\xda\x0d\xef\x83\x44\x9a\x63\x88\x70\x79\x2a\x18\xa7\xd9\xc7\x71\x4e\x20\x15\x99\x0b\xa0\xe1\x6a\x75\x09\xec\x07\xfc\x66\xce\x28\x42\x19\x5a\x8a\xc7\xb8\x68\x61\xd5\x92\x08\xa6\x41\x4b\x12\x01\x27\xbf\xe3\x3a\x15\x40\x26\x57\xc8\x9e\x15\x0e\xa5\xf3\x4a\x10\xee\xb5\x48\xf4\x33\x49\xf6\x45\x15\x49\xe5\xf4\x60\x0e\x0e\xfa\xb5\x65\xe5\xcc\xb8\x10\x9a\x21\x71\x58\xe4\x28\xe3\xe3\x82\xcf\xca\xf4\x0c\xe1\x10\xb0\x16\x27\x78\x9d\xb6\x78\x4a\xcd\x8c\x2a\x58\x29\xb8\x58\x70\x25\x1a\x14\xe8\xa0\x8a\xae\x8b\x32\x3b\xcb\x72\xdb\xa3\x18\x09\x50\x8a\x9b\xb4\x5e\xc6\x5b\x24\xf2\xe0\xe0\xdf\xdc\xa4\x13\x3e\xd4\xb1\xd7\x10\x93\x00\xfc\x05\x4b\xba\x6d\xfa\x5d\x5e\x89\xbe\xe9\xe2\xe6\xc6\xcd\xe9\xe6\xc6\x9f\xbd\x42\x7a\x5d\x25\x74\xbd\xe0\x06\x88\x49\xa8\xe8\x96\x59\xe3\xec\x10\xf9\xf8\xb3\x53\x77\x99\x1d\x90\x3f\x62\xc4\x9f\x49\x64\x12\xd0\x75\x02\xaa\x97\xa4\x65\x44\xd0\xdb\x07\x49\x9d\xdb\x97\x86\xa8\x77\xed\x7d\x47\x74\x0d\x50\x76\x69\x39\x2f\x2e\x00\x2b\xb9\x4f\x3f\xf3\xa7\x6d\x7e\x0a\x78\x54\x7e\xf8\xda\x7c\x80\x11\xcf\x55\x29\x3f\xbd\xe0\x4f\x33\xc4\x1d\x2b\xf9\xe1\x2b\xfe\x30\x57\x35\xd2\x87\xe2\xc3\x4f\xf2\x03\xca\x5e\x2b\xf9\xf5\x1b\xfc\xda\x47\xe2\x37\x9d\x5f\xe1\xbe\x00\x7a\x2d\x0b\x23\x65\xb1\x2f\x48\x50\x0b\xa3\x41\x81\xa1\x2e\xf2\x18\xe6\xbf\x52\xb5\xa2\x57\xbc\xb8\x13\xbb\xca\x73\x35\x2b\x49\x60\xfd\x9a\xca\x42\x27\x71\x63\x08\x51\xb3\x1d\x84\xfc\x11\x92\x14\x8d\xfe\xeb\xa2\x4e\x57\xa2\xff\x8e\x7b\x56\x13\x8e\x39\x4d\x67\x1f\xf1\x08\x98\xdf\xd4\x87\xb9\x45\xed\x91\xbd\xc7\x76\x07\xa1\xdc\xfb\x1f\x05\xcd\x40\xe0\x65\x3c\xdb\x8e\x34\x17\x64\x86\x04\x84\x4a\x22\xdf\x32\x10\xcc\x01\xb8\xb8\xa3\x47\xd2\x9f\xc7\x40\x10\x9c\xd1\x4c\x81\x2a\xda\x6c\x11\xf6\x28\xe2\x2a\x09\x1a\xd5\x35\x0e\x0f\x58\xf7\xfa\x0b\x68\x0d\xab\xda\x0e\x6a\x14\x09\x98\x97\x40\xb0\x8d\xb6\x1b\x38\x78\x6a\xc0\x15\x1b\xa5\x81\x5c\xb5\xad\xd4\x69\xf5\xb1\xd5\x0a\xbd\x84\x56\x06\x16\xda\x34\x4a\x42\x0b\xed\x59\x4e\x60\x29\x34\xa1\xe8\x96\x07\xc7\x7c\x6d\xd7\x26\xca\x78\x22\xc9\x7c\x6b\xfb\x24\x3a\x0e\x5e\x40\x7f\x91\x1a\xe9\x0b\x36\x81\x02\x9a\x26\xac\x81\x26\x8c\xe1\x56\x6f\x81\xa3\x1f\x95\x00\xa2\x23\x04\xf4\x8b\x48\x35\xae\x33\xde\xe5\xc1\x00\xe0\x0d\xc9\x5b\x80\xef\x21\x54\x72\x9d\xd5\x6a\x1d\x2b\x79\x71\x23\xbf\x6d\x40\x9b\x07\x07\x99\xe5\x92\x94\x94\x76\xaa\x9a\x2f\x02\x36\x32\xd2\xa8\x6e\x50\x9b\x41\x8a\x82\x79\xed\x51\x1a\x52\x20\x14\xcd\xa2\x55\xb4\x88\xe6\xd1\x26\x5a\x47\xe7\xcc\x2b\x21\x2a\x2d\xb6\x83\xb6\xb0\x07\x25\x29\x30\xc2\xb6\x24\x3b\xbc\xce\x11\xa8\x5c\x23\xf9\x94\x8f\x54\x5a\x01\xc4\x88\x96\xf0\x73\xbe\x2d\x09\x9e\x44\x29\x12\xc8\x33\xd4\x3d\xd4\x6a\x13\xad\x08\x4d\xf1\x2d\x89\x06\xb3\x9b\x9b\x15\x2e\xcf\x1c\x99\x43\x2e\x91\x90\xa0\xd1\x15\xe2\xe7\x45\x87\x00\x4a\xf0\xc6\x38\xc1\x39\x89\x45\x76\x3b\x3e\x5a\x84\xaa\x80\xea\x6d\x8a\x50\x2a\xda\x0d\xd3\xef\x26\x59\x20\x7e\xc9\x93\x6b\x1e\x79\xbc\x8d\xcc\xb8\xe3\xe5\x2e\x9a\x41\x11\x3d\xaa\x0d\x90\x5b\x62\x50\xab\xc9\x26\x7e\x96\x46\x29\x6f\x26\xd0\x34\xc4\x13\x02\x57\x78\x5c\x4d\x91\xb0\xb2\x07\xf4\xfc\x96\xa6\xcf\xb9\xe9\x8e\x99\xa1\x6c\x77\x8e\xe2\x88\x15\x1e\x01\xa2\xa7\x3b\x4a\xad\x4c\xa9\x73\x37\xb0\x32\x5a\x27\x66\x38\xbc\x92\xf8\xcf\x79\x68\x86\xba\x06\x2a\x0f\xba\x2d\x44\xb7\x02\x4e\x8c\xeb\x24\x65\x28\x30\x0e\x6b\x2a\x06\xbf\xd6\x07\x07\x6b\xfd\x7b\x17\x59\xc1\x1b\xd0\x18\xb4\xed\x6e\x51\xec\x50\xdd\x2b\x6f\xcd\x4a\xa0\x41\xcc\xd0\xf8\x50\x76\x0c\xc5\x48\x43\x44\x87\x4e\x3f\x56\x77\x11\x4a\x5b\x4b\x3e\x93\xba\xd0\x88\x17\x22\xd6\xb7\x25\xf8\x76\xdb\x21\x51\x08\xa3\xab\xed\x28\x3d\x2d\x4a\x44\xf7\x35\xac\xcf\x60\x0b\x23\x02\xa2\xd9\x9b\x8f\xf9\x0d\xaf\xeb\x22\x8c\x3e\x6e\x51\x74\xa5\x8f\x39\x34\x5c\x26\x1d\x10\xdc\x7c\x9f\x98\x1f\x80\x2e\xf8\xc7\xb1\x79\x03\xb4\x6f\x9b\xf3\x29\xa9\x41\x92\x64\xa1\x4c\xd9\xb2\xb6\x99\xbd\x4b\x93\xff\x7c\xf0\x20\x76\x8f\xb0\x9e\x78\x61\x2f\xb6\x83\x6b\x03\x46\x54\xb4\x00\xde\x0c\xce\x5a\x5d\xc4\x79\x84\x50\x29\xae\xdd\xb9\xab\x22\x3d\xa0\x32\x02\xd4\xa4\xca\x0d\x92\x2d\x45\x09\x4d\xca\xc7\x08\x4f\x65\x9c\xf1\x7d\x35\x0b\x10\xbb\xb5\xd8\xf1\xd2\xcd\xe7\x28\x8b\xae\x47\x27\x69\x9e\xad\x99\x74\xb0\x12\xea\x42\x80\xa0\xb2\x16\xf2\x77\x96\x79\x20\xea\x54\xf3\x89\xf7\x14\x0f\x58\x60\x02\x27\xfe\xb9\xf9\x39\x3a\x31\x72\xbb\x13\xc3\x63\x56\x27\x27\x24\x39\x09\x23\xaf\x76\x42\x4f\x0b\x0d\x72\x2d\xe5\x10\x9d\x68\x5c\xd5\x51\x45\x0c\xb1\xaa\xa5\xb4\x80\xfb\x9e\xf8\x2d\x2e\x90\xac\xa9\xc3\x86\x12\xa3\xa8\x25\x4d\x68\x6f\x06\x55\x3d\xc9\x88\x1c\x20\x0a\xef\x3b\x54\x5d\x4f\xfa\xf4\xbe\xd2\x1a\x6c\xa4\x07\x72\xe0\xa6\x91\x3b\x97\xef\x63\x56\xa7\x4f\x16\xe9\x80\x7f\x45\xde\x67\x1e\x42\x94\x4f\x78\x91\x68\xc5\xb9\x1c\x51\xa2\x7a\x99\x80\x72\x85\x47\x1a\xc6\x3a\xfd\xa8\x9e\x64\x08\xfb\x42\xa4\x2c\x5b\x98\x30\x15\x16\x01\x3c\x03\xd2\x80\x34\x90\xd8\x44\x8d\x80\xe2\x53\x38\x3a\x4b\xb7\xd2\x7d\xdb\x4f\xc7\xba\x3a\xcd\x2e\xb7\xb5\x50\x53\x68\x85\x03\xa2\xd9\x3c\xa1\xc1\x20\x47\x63\x64\xb3\x21\xbf\x74\xfa\x24\x21\x49\x59\x36\xe1\x01\xea\x33\x74\xb1\x8f\xe9\xa0\x8c\xae\xed\x9a\xc2\xf7\xd5\x16\x85\xee\xd1\x0a\x4e\x93\x79\xcc\x77\x51\x6b\x97\xcc\xb7\x0c\xbe\xa1\x08\xd3\x3c\xbf\xdb\x02\x6a\xc1\x65\xe7\x96\x99\x68\x35\x1f\x1f\x6f\xa1\x34\xdd\x36\xfd\xa2\x86\xe7\x0a\x60\x8b\x79\x7e\x8f\x05\xec\x4e\x98\xb7\xcf\xa8\x1a\x59\x0f\x98\x57\x6f\xf1\xd5\x5c\x16\xea\x1f\x45\x17\x65\x56\xa7\xa7\x2b\xf8\xfd\x00\x07\x01\x67\xa0\x14\x6a\x5b\x79\x06\x23\x2b\x4c\x30\xc7\x18\x96\x96\xf1\xb3\x39\x96\xb8\x00\x38\xd3\x4c\x55\x11\xa2\x7c\x54\x1e\x4c\xc8\x92\x02\xbf\x4c\x32\xbc\xf9\x44\x84\x90\x94\xfe\xb7\xad\x01\xd8\xfd\x3e\x97\x88\xfa\x47\x08\xb8\xf1\x27\xa1\x47\x3e\x81\xb0\x34\xc7\xc1\x49\x30\x44\xdd\x8c\xbe\x68\xde\xdd\x79\x84\xca\x66\xd8\x28\x14\xb5\x00\xef\x2f\x14\xc6\xdd\xc3\xef\x1a\xb5\xb7\x51\xff\xfd\xe1\x3f\xf8\xef\x0d\xdf\xf6\x5e\x99\x89\x08\xed\x73\x0b\x80\x78\xb5\x6d\x55\x4f\x65\x3d\x6f\x55\x42\xfe\x3d\xcd\xe0\xc6\x4c\xfc\x47\x79\xe5\xf6\x7d\xb1\xb0\xa4\x51\x37\x6e\x54\xe0\xf1\xe8\xa7\x36\x44\xdb\xb4\x06\x65\xd8\x21\xf7\xbb\x35\x9c\xe6\xeb\xc6\x58\xf8\x73\x2c\x8b\xe2\x28\xde\xd0\xcf\xf6\x10\xd6\xf2\x44\x38\x34\xe8\xd4\xdf\x9f\x4f\x27\xd7\xbb\x58\x3e\x93\xa4\x04\x48\x51\x96\xb7\x4f\xf4\x5f\x1e\x06\x5c\x73\x2d\x86\xbf\xc6\x67\x40\x91\x27\x79\xf1\xa8\x3c\xc3\xcb\xa4\x55\x18\xc8\xa0\x8f\x2e\x80\x2b\x9b\x01\x63\xfb\x12\xc5\x7a\xc8\xeb\x8c\xcf\x71\x20\x59\xa4\xab\xc3\x5f\xe8\xb2\x8a\x48\xa8\xe7\x94\xe5\x5d\x34\x49\xd7\xa0\xff\x73\x8a\x06\x1e\xf2\x39\x42\xf9\x07\x41\xbf\x0f\xdb\x91\xca\x7f\xdf\xaa\xad\x62\x9d\x7e\x65\x25\xe9\xe3\xea\x21\x70\x44\xd5\xe1\x61\x08\x04\xf8\xe8\x04\x65\x53\xc7\x0a\x68\xcc\xa9\x16\xec\x9c\x61\xf7\x25\x75\x7f\x70\x90\x92\xa4\x0d\x1a\x9b\x2b\xdd\x98\xe4\x1a\x53\x12\x9e\x15\xc4\x55\x9a\x8d\xe2\x31\x6f\x93\xe6\xde\x0d\x83\x51\x30\x54\x56\xe9\x7d\x78\x34\x1d\x23\x65\xb5\xed\x58\x25\xe4\x08\x35\xc5\x47\xf5\x13\x38\xe2\xb8\x28\x4e\xd2\x81\x83\x13\x2b\x76\xd6\x82\xda\x84\xd0\xaa\xa4\x7f\x34\x86\xb6\xfa\xf9\x88\xf7\x07\x9a\x05\x86\x29\xb7\x9a\xc9\x2c\xd4\xf2\x39\xbe\xdd\xd6\xb4\xa9\x00\xa6\x2f\x75\x0b\xc6\xea\x63\xe0\x17\xd5\x71\x31\xd5\xb6\x0b\x78\x5f\x61\x33\xfa\x47\xd0\x66\x25\x35\xe7\x7e\x7f\x15\x13\x60\x23\x16\xb3\xa4\xc4\x21\x94\xa3\x4d\x49\xdb\xff\x44\x2d\xd2\xed\xaa\x46\x5d\xb1\xff\x06\x96\xa0\x24\x6a\x15\x79\x8c\xf4\x8c\x28\x21\x2c\xd5\x78\x05\xdc\x79\xd4\x17\xb2\x94\x2b\x87\x03\x93\x6b\x3c\x58\x31\xd9\x80\x40\xe3\x45\x5d\x20\x45\xc8\x13\x65\x89\xb3\x3d\x36\xa8\x87\x18\xff\x60\x81\x9a\x14\x4f\x9d\xb6\xf0\x78\xaf\x26\x6a\x98\x2c\xe5\x18\x8c\xfa\x44\x70\x1d\xfd\xbe\x0d\x85\x1a\xcb\xbb\xcd\x28\xac\xc3\x27\x27\xaf\xdb\x9a\x6e\x51\xde\x17\x69\xb0\xb2\x07\xf5\x33\x69\xd0\xd1\x25\x8e\x45\xd8\x60\xd4\x03\xa3\x87\xec\xfb\x60\x93\xa5\x45\x40\xa5\xb5\xb4\x90\xef\x97\xaa\xf7\xe8\xcd\xf3\x1e\x30\x76\xbd\x19\xc9\x25\xe6\xbd\xc3\xde\x55\xb1\xed\xad\xb7\x55\xdd\xc3\xf5\xea\xfd\xaa\xc9\x46\xdd\xcc\xa0\x86\x05\x54\xf5\x71\xd4\x03\xac\xbd\x2c\xca\x69\xf8\x6b\xaf\x2e\x7a\xfc\x11\xab\x96\x3d\x6d\x49\x69\x75\x19\xa3\xde\x6b\x14\x1a\x9b\x61\x50\xfb\x80\xf3\x7b\x68\x53\x69\x5b\x87\xc2\x0a\xc9\xdb\x5f\x47\x5a\x15\x92\x90\xa2\x11\x70\x16\xdb\xa5\xe1\xb9\xee\xab\xf6\x14\x7e\x32\x83\xad\x36\x6a\x96\x2d\xae\x7a\x69\x0f\x70\x3d\x6c\x03\x8f\x13\xc7\xc6\x4d\xc3\x9f\xba\x80\xb6\x4d\x57\x5f\x2a\x38\xf5\x4c\xcd\x3a\x1a\x37\xd7\x08\x4b\xad\x12\x60\xa9\x55\x37\xd1\x8c\x82\xc8\xee\x2f\x42\x28\xd1\x45\x58\xd3\x24\x2e\x99\x6d\x13\xa7\xed\x92\x4e\xdb\x77\x86\xbc\x46\x40\xd3\xc6\x93\xc1\xdf\x83\x0e\x35\x2f\xd4\xc3\xda\x8e\x56\x95\xf6\x63\x1f\x9b\x02\x8b\xf6\x59\xce\xba\x0f\x56\x54\x91\x29\x07\xea\x27\x27\x70\x8d\xd7\x5b\x38\xa6\x69\xa9\xf9\x4d\x92\xc7\xf9\xb5\xd6\x0a\xd6\x9a\x00\x3c\xb1\xa2\x6b\x84\xc1\xf3\x01\x51\x57\x9a\xe0\xd6\x43\x39\x38\x10\x0c\xad\x30\xf8\xe8\x26\x5c\xf2\x84\xed\x2f\x60\x98\xc4\x1d\x77\x69\xb9\x33\xa3\xaf\xd6\xba\x69\x12\xa0\xef\x3c\xfd\xcb\x49\x17\x5e\x19\x5b\xf6\x16\x5a\x47\x09\xf6\x32\x42\xfd\xc0\x9b\xb4\x06\xee\x2d\xc7\x59\xe3\x21\xc3\x8d\xd4\x55\x23\xc9\xf7\x6e\xb8\xd8\xeb\x53\x38\x49\xe7\xaa\xd4\xbc\x43\x89\xe8\x06\xf9\x02\xae\xfc\xd5\xd6\x55\x2e\x47\x68\x5b\x38\xc8\xe8\x8f\xdf\x56\xa9\xce\x32\xe0\x0f\x81\x70\x47\x91\xd2\x24\x68\xb6\x1d\xa0\xd1\xa3\xf9\x8d\x2d\x91\x4c\x16\xf1\x1d\x4e\x26\x4d\x0c\xfd\x2c\x38\x7f\xe6\xa1\x50\xed\x56\x4d\x48\x67\x7c\xcb\xc8\x8d\xae\xb6\xfc\xc4\x0c\x85\xfa\xd6\x2b\x88\xd2\x68\x31\x81\xf6\xf0\x43\xdf\xf0\xa4\x59\x5e\xce\x4d\xab\xf9\x9c\x11\xc1\x49\xe1\xaf\x70\x1a\x46\xa9\xb0\xce\xe9\x92\xc8\x21\xf0\x40\x2a\x94\xa4\x69\x11\xa0\xfa\x88\x6c\xb3\x48\x26\x89\x82\xac\x32\x2c\xdb\xb6\x40\x04\x63\x4b\xe4\x9b\x32\x77\x7d\x47\xba\x73\xdd\x89\x15\x44\x75\x2c\x38\xe9\xd9\x32\x2b\x0a\xce\x58\xf0\xe3\xc4\xf1\xbb\x3d\x8a\x55\xab\x8b\xc2\xb1\x2a\x34\x6e\x48\x82\x20\x2a\x96\x52\xb2\x83\x13\xaa\x9c\x1d\x5d\x0f\xd6\x09\xcd\x16\x2a\xdf\x40\xa0\xd7\xa8\x64\x8c\xd2\x60\x4d\xad\xec\xb7\x40\x39\x6c\x9d\xa0\xcd\x5b\x54\xa3\x48\x86\x65\x0c\x5e\xc5\xff\xd1\x24\x9d\xd9\x8d\xbf\x33\x49\x63\x39\x45\xa3\xca\xd0\x15\x38\x04\x67\x13\x09\xfb\x60\xdb\x8d\xae\xf1\xd2\x20\xf3\x36\x47\x0d\x64\x4c\x50\x84\x7e\xee\x9c\x69\xa4\xb0\xdf\xf1\x81\x09\xf5\x4d\xc6\x15\x61\xee\xad\x22\x69\x82\x66\x4b\x80\xaa\xd9\x0a\x4f\xe3\x6a\x19\x76\xc1\x98\xda\xb4\x54\x8e\xe1\x2a\x65\x9a\x70\x04\x4e\x09\xcf\x0c\xc1\x09\x61\xee\x10\x91\xb9\x43\x89\x03\xd4\xf7\xa5\x44\xf1\x73\xec\xaa\x21\x8a\xd8\x69\x6d\xbe\x3d\x93\xcc\x97\x61\x89\x10\xae\x08\xc0\x46\xf1\x06\xea\x48\x3e\x54\x58\x1e\xf9\xf3\xc4\xd3\x6f\xe7\xda\x2d\x5c\xd6\xd7\x20\xdb\x23\xcf\x45\x90\x9f\xcb\xe3\x9f\x0f\xb2\xa8\x3e\xce\xa6\x9f\x3a\x14\x46\xde\x99\x3b\x79\xa7\x38\x14\x4e\xea\xfd\x89\xc5\x2f\x3b\x17\x7f\xe0\xd6\x81\x2c\x37\xe5\x42\x86\xd6\x6e\x27\x8c\x3a\xc6\x66\x6c\x3a\x47\xc5\x62\x41\x6b\x25\x8f\xe8\x1b\x8f\x35\x32\x13\xbe\xd3\xd9\xcc\xdd\xf4\x1c\xf9\x2b\x4c\x96\xf6\x2b\x0a\x70\x0b\x48\x3b\xf0\xcc\x94\x89\xfa\x99\x67\x89\x4b\xce\x04\xd6\x04\x06\xa8\x71\x73\xe1\x0e\x3f\x3f\xcc\x8e\x8f\xa6\xb0\xc9\xc0\x2f\x2c\x47\xeb\x2c\x87\xb3\x95\x1d\x3f\x98\x22\xd8\xac\x86\xf4\x6d\x8b\x0c\xce\xc3\xed\x78\x8b\x44\xfb\x92\x17\x67\xcb\xb0\x60\x5c\x34\xde\x1f\x1e\xe9\x0f\x65\xb3\xc2\xd0\x99\x71\x2d\x47\x75\xb1\x45\xda\xe5\x59\x59\xac\x93\x07\x70\xb6\xd3\x09\xf6\x19\xdb\x01\x2e\x85\x9d\x95\x9d\x77\x75\x91\xa1\x3d\x07\xac\xee\x2c\xad\x54\xc0\x57\x21\x88\xe9\xac\x68\xe3\x87\x24\x27\xff\x12\xfc\xf7\x8b\x07\xc8\xf6\xa0\x49\x9f\x69\x75\xc8\x53\x4c\x2f\xe9\x75\x74\x58\x5b\x03\x61\x67\xfe\xfa\xf9\x38\xd4\x87\x55\xf0\x20\xb0\x08\x6e\x79\xf0\x29\xb2\xe6\x4a\x39\x2d\x55\x3e\xe6\x11\x15\x65\x1d\xc4\xf4\x13\x19\x90\x12\xfe\xc6\x72\x17\xe8\x0b\x1c\x66\xfb\xd6\x34\x33\x11\xf6\x4f\xd1\xd1\x34\x3e\x7e\x10\x3d\x98\xea\xe2\x30\x16\x53\xde\x96\x82\xaf\x86\xe9\x02\xa0\xcc\x7d\xa3\xbd\x91\x2d\xf8\xc0\x8e\x70\x72\x14\x9b\xa6\xb6\x79\xb3\x90\x6c\x46\x1c\xe2\xb7\xf5\xa0\x79\xd0\x2c\x0c\x60\x68\x30\xaa\xcb\x34\xaf\x32\x92\x29\x3f\xcd\x51\xf2\x35\x67\xea\x2d\x2f\x9e\xa3\x4a\x15\xed\x4d\xba\x8b\x25\x24\x9c\xea\x20\xfe\xf0\x0d\x9e\x89\xed\x4a\xbd\x4f\xab\x8f\x83\xf6\x8d\x7b\xbc\x64\x5a\xb6\x00\xe0\x6d\xc9\xc5\x3b\xf0\x23\x3f\x31\x67\x80\x46\x27\xc4\x7b\x34\x58\x8f\xb0\x87\x32\x56\xf8\x6f\x45\x24\x90\xe3\x28\x0c\xf9\xdb\xef\x3d\x96\xd5\x9c\xa2\xbc\x47\x16\x1e\x92\xb3\xd0\x22\x5d\xb5\xb2\x2c\x06\x3d\x33\x53\x23\x98\x00\xf1\x36\xc9\x23\xe6\xac\xd0\xc0\x36\x07\x2e\x4e\x43\x0f\xe4\x98\x3b\xd9\x81\x71\x7a\x70\x90\xea\xdd\x3d\x38\xf8\xad\x46\xf2\x05\xd9\x8f\x5c\x95\x5f\xbf\x7f\xf9\x2d\xa0\xfa\x9d\x27\xb2\xaa\xaa\xe7\xd0\xea\xa3\xa5\x06\x3b\xe4\x94\x35\x18\x94\xfb\x9a\x0f\x27\xa5\xe0\x35\xe2\xbd\x3c\x09\x7e\x9e\x46\xf9\xa4\x8b\xf3\x69\x6c\x0a\xb1\x40\xb1\xd2\x33\xd4\x6e\x58\x9d\xe5\x42\x41\xe6\xef\x3b\x40\x55\xd4\x54\xb8\xd9\x13\xf2\xda\x9d\x10\xc1\x07\xfc\xa6\x75\xb1\x1a\x77\xba\xbb\xe1\x58\x9f\xef\x44\xf1\x57\x75\xd3\x48\xd3\x98\x8b\xe3\xc1\xb5\x62\x8c\x0c\xc9\x9e\x7f\x0b\x34\x8d\x28\x0d\x58\x4a\x8d\x3c\xf0\xa9\x03\x8a\x3f\x69\xa2\x57\x4b\x6a\x24\xf7\x8e\xd1\xe2\xe3\x50\x2f\xf6\x21\xec\xdb\xbf\x93\xe0\xfa\xde\x50\x0d\xef\xed\x82\xe9\xbd\x86\x89\xbf\x71\x21\x68\xa0\x01\x2d\xaa\x9f\x8e\xf3\xe4\xd5\x72\xa4\x2e\xd5\x0c\xc5\xf5\x46\x58\x7f\x0d\x6c\x4c\x4c\xc0\xef\x14\x00\x42\x4c\xa0\x0c\xe8\xe7\x6c\x91\x01\xb0\x82\xc7\xcf\xa7\x46\x39\x0b\xf3\x44\x5c\xfa\x6d\x8d\xb6\x3d\x76\xce\x5b\x9c\x73\x0a\xa4\x9f\x59\x0f\x38\x45\xc7\xdb\x69\x94\xc2\x3f\x49\x39\xc2\x46\x87\xd9\xb0\x1c\xd9\x36\x6f\x6e\x90\xf0\x14\x15\x0a\x2c\x99\x0d\x01\x59\x0f\xf1\x67\xa4\xb1\x43\xaa\x4d\xb6\x90\x0c\x2d\xdc\x6f\x81\x31\xf8\x5d\x04\x2f\x51\xe4\xfc\x7c\xc9\xd6\xcb\x75\x28\x4c\xa9\x9f\x2f\xd1\xe8\xdf\x3d\xff\xb6\x44\x33\x2e\xfb\xf8\x76\x19\x09\x07\x90\x36\x5f\xf8\x64\x69\x2c\xa2\xd1\xb6\x1f\x6d\x2c\x34\x61\x11\x69\xc2\xe2\x79\x8d\x07\x20\xa7\xdf\x65\x28\x46\x84\xd3\x89\x5c\xbf\x8a\xac\x15\xc5\x89\x7a\xee\x89\x6c\xe1\x4c\x67\xeb\x09\xff\x19\xc8\xf1\xde\xff\xd7\x2f\xd5\xf0\xbe\x37\xe6\xfb\xf0\xe6\x33\x7a\x25\x8c\x5b\xfd\xd6\x60\x4b\x85\xe9\x2a\x1f\x75\x16\x21\xc2\x6d\x2d\xb7\xa8\x5c\x01\xbc\xc8\xd6\xf8\x68\x4c\xd6\xc1\x2e\xa0\x9d\x4b\xd3\xf6\xb3\x9e\xac\x06\x01\x1e\xc8\x5e\xb1\x61\xa1\x2f\x8a\x43\x4e\x15\x42\x4a\xed\xad\x56\x00\x90\xb2\x2a\xfe\xa8\xf7\x6b\x30\xac\x87\xc1\xaf\xbd\xac\x22\xfb\x3e\x92\x97\x04\x61\xbc\x1e\x04\xcf\x17\xbd\x6a\x0b\xc0\x27\x03\x2c\xa1\x9b\xab\xc8\xbc\x09\x38\x61\xb2\x07\xc4\x66\x7b\x30\x63\xa0\x20\xbf\x49\xcf\xd3\x77\xb3\x32\xdb\xd4\xa6\x9b\xc3\xde\x16\x55\xa2\x50\x22\x2f\xf2\xc3\x37\xaf\xbf\x79\xdd\x4b\x2b\x32\x75\x45\x7d\x8e\x29\xb5\x4e\xaf\x7a\x17\x45\xf9\x31\xea\x9d\x6e\x6b\x1c\xc3\x3c\xab\x66\xc5\x16\x00\x8c\x82\x51\x48\xbb\x9b\x97\xfa\x0a\x7e\x4f\x66\xeb\x7c\xc3\xbb\x56\x05\xf6\xba\xe3\xb5\x15\xdd\x29\xb8\xeb\x64\x3c\x76\x8d\x5a\xa7\x9b\x9b\x6c\xd2\x60\x95\xd1\x47\xe2\x03\xe9\xae\x19\x90\x02\x8d\x95\xe3\x8b\x5a\xbf\xa8\x4d\x4b\x3f\xd4\x40\x73\x55\xe1\x2e\xfe\x81\x35\xdd\xc2\x58\xd8\xa7\x26\xb5\x71\x2b\x9b\x6b\x1a\x17\xe0\xe6\xc6\x01\x1f\x03\x3b\xf7\x04\x57\xd7\x09\xec\x71\xef\x74\x6f\x76\xff\x02\xd6\xfd\x77\x9d\x92\xf3\x66\x03\x5c\x17\x70\x62\x55\xa0\x8d\x3c\x6e\x47\x01\x3b\x00\x04\xfa\x12\x31\xe7\xbe\xbd\x1b\xf5\xde\xc3\x54\x7b\xeb\xec\x6c\x59\xfb\xbb\x03\x9d\x16\xf9\xd9\xea\xca\xdf\xa6\x48\x40\xca\x1f\xf4\xdc\xd9\x07\xba\xed\x69\xda\xe1\x53\xea\xdc\x61\xcd\x8f\x9b\x1b\x21\xcb\xfc\xa3\xf6\x7c\xaf\x9c\x3c\x60\x8d\xa2\x72\xed\x0b\x15\x24\x41\x38\x61\xfd\x6a\x81\x92\x54\x58\xf1\xe2\xe2\x87\x65\x06\xa0\x61\x93\x12\x08\x23\xd1\x07\x55\xd1\x62\x9d\xc1\x6f\xb3\x10\xe1\x90\xf7\xee\xd5\x8c\x4c\x82\xbb\x4a\x0e\xba\x5a\xed\x18\xc5\x31\x0c\x72\x8a\xb6\x8f\x30\x12\xa0\x19\xd8\xee\xa0\xf1\xcc\x7e\xd3\xcd\x32\xbe\xba\xe6\xcb\x6e\xfd\xb1\x37\xb0\xe7\x38\xb0\xeb\x3a\xde\xcc\xa2\xf3\x58\xed\x1a\x2d\xfc\xee\xa9\x83\xc9\xa5\xc0\xd9\x23\xa8\x63\x92\x14\x66\x73\x35\x25\x11\x7e\x8b\xf1\xc8\x81\x7f\xc8\xbf\x00\x80\x97\x9e\x59\xa1\x41\x8e\xac\x01\x91\x4d\x9f\xd1\x8d\xa0\x8f\xb8\x87\x61\x5b\x8b\xfc\x59\x9b\x25\xd5\x06\xcb\x7a\x8f\xbc\x95\xfb\xe5\x97\x60\xa8\x46\xc0\x7c\xe6\x21\x1d\x1e\x14\x9c\xc3\xf5\xc4\xf7\x70\xc2\x09\x5e\x1e\xe7\x87\x47\xce\x81\x91\xca\xee\xac\x21\xbc\xdf\x9c\x69\x49\xce\x89\x58\xad\xb7\x88\x58\xc3\xce\x23\xa4\x46\xb3\x55\x51\xa1\x90\x93\x6b\x27\xc8\xdb\x44\xfa\x6d\x92\x31\xff\x80\xbc\xc2\x4b\xb8\x96\x28\xc3\x7d\x0f\x73\x87\x23\xf0\xfd\x8c\x77\x8e\x17\xf8\x93\x47\xe4\x3e\x80\xb5\x6b\x5a\x82\xc3\xe4\x68\xcc\x8a\x16\x7a\x1c\x3f\x99\x31\x62\xc4\x33\x51\x98\xc3\x51\x1c\xea\x11\x18\x1e\xa2\x1e\x29\xed\x63\x00\x47\x60\xbd\xa9\xe1\x76\xd7\x45\x8f\x8a\xc0\x8d\xae\x94\x36\xc3\x5b\xa6\x70\x75\xd3\x2a\xbf\x07\x97\x1a\x26\x43\x76\xd1\x44\x21\x00\x53\xf8\x85\x22\xb1\x21\x0a\xd2\x8c\x36\x4c\xef\x2b\x92\x97\xfa\x13\x1a\x5c\x65\x49\x49\x7b\xe8\x16\x3d\xab\xde\xa1\x39\x2b\x5a\x59\x65\xa3\xca\x9a\x63\xce\x56\xdb\xb9\xfa\x36\xcb\xd5\x9b\x42\x93\x7d\x54\x60\xc3\x82\x58\xfd\x01\x7d\x9f\xe4\xfd\xc5\xe9\xe5\x91\x7f\x64\xbf\xbb\xc3\xa1\xff\x43\x1f\xfa\x65\xe7\xa1\xff\xb9\x96\x0e\x83\xa5\x5a\x03\x88\xc3\x85\xb7\x3e\x83\x00\x37\x55\xc3\x02\xfe\x3f\xe9\x76\xc3\x78\x86\xc9\x7f\x46\xd0\xf2\x1c\x5b\xe6\x82\x3b\x0c\x1f\x91\xc2\x29\x6b\x54\xf9\xa7\xab\xf2\x4f\x57\x85\x4b\xee\x9a\x57\xf9\xeb\xae\x59\xbd\xa0\xa3\x38\xf9\x66\xa6\xe9\x97\xd1\x39\x1a\x76\x9c\xc7\xf7\x02\x20\x1e\x47\xe7\x8e\x98\x08\xee\x9f\x45\xf7\xe0\x1e\xdc\x0b\x87\xf0\x2d\x86\xaa\x3f\x70\x55\x2c\xdd\x5e\x9e\xaf\x70\x79\x54\xcb\x36\xe4\x45\x37\x18\xbd\x0d\x64\xe6\xc9\x4f\x33\x52\xe1\xb3\xcf\x7d\x3e\x69\x00\xb1\xbb\x1c\xf7\x38\xd8\x03\x39\xb3\xe4\xf5\x4a\x34\x9e\x35\x1b\x87\x35\x3d\x9f\x45\x1f\xe3\x1c\xd6\x35\xdb\x35\x01\xa8\x34\x6a\xae\x1b\xbe\xb9\xcd\xa9\x65\xc9\x8f\x33\xaf\x27\x06\xbe\x79\x02\xc4\x7e\x73\xb8\x51\x40\x4a\x0e\x6a\x33\x2a\x27\xb9\x61\xb4\xcb\xd6\x00\x62\xe9\xca\xf6\x13\x8d\xc1\x99\x91\xe2\x93\x11\x1e\x87\x3b\x09\x01\x9d\x2d\xd3\xde\x65\x2f\xfd\x95\x29\xf5\x78\xb3\xe4\xb8\x9c\x76\x56\x68\xcf\x81\xf4\xb1\x34\x28\xd3\x4a\x85\xc4\x25\x43\x8e\x97\x33\x74\x85\xb6\xf6\xaf\xa1\x6f\x72\xf1\x8d\xa4\x53\xe9\xa0\xdd\xdc\x7c\xc9\x7f\x5e\xf0\x1f\xb5\xa2\x3f\x19\xff\x21\xa8\x25\xcc\xa3\x9b\xbb\x41\x62\xf8\xd6\x8e\x34\xce\xec\xfd\x7f\xfd\xbf\xc1\x24\xd6\xc6\x76\x37\xc4\x9b\xdd\xc0\x53\x78\x1f\xc6\x46\x7e\xe1\x8d\xf2\xc5\x2a\x24\x1e\x25\x4b\xfa\xe4\x4e\x56\xaa\x55\x7a\xa9\xe6\xaf\xd2\xb5\xa2\x89\x7a\x85\x67\x58\xb8\xf1\x6e\xb9\xa2\x96\x83\x11\x62\x97\x9c\xba\xa0\xe6\xc8\xfb\x24\x82\x23\x82\x35\x64\xab\x37\x37\xfd\xcf\xf4\x4d\xcd\xc2\x09\xf6\xfa\xbb\x7b\x84\x13\x03\x4f\xc4\xb6\x65\x21\x62\x0d\x7d\x54\x80\xa7\xd2\x50\x1b\x4e\xf3\x1a\x21\x44\x89\x27\xb9\x4a\x06\x88\xda\x80\x03\x79\x3c\xc8\x5a\xdb\x37\xa0\xed\x23\x49\x7d\x5a\xd5\xcf\x35\x9f\x4a\x1e\xb2\x24\x50\x2e\x26\xd0\x40\xe5\x20\x11\x19\x0b\x72\x77\x56\x0d\x10\x1b\x04\x13\x62\xcf\x67\xb3\x28\x8f\x2b\xc1\xb0\x20\x25\xfb\xcb\x08\xdd\x68\xee\x4b\xae\x85\xde\x7f\xc6\xde\x35\xad\x1b\xd7\x00\x69\x14\x1a\xc3\xd2\x16\x2d\x60\xd2\x9c\x51\x37\x0c\xc8\xcd\x49\xc7\xd5\x76\x87\xf3\x0e\x67\x3c\x0c\x42\x57\xe7\xc3\x8c\xa6\x79\x39\x8b\x2e\xd1\xde\xbb\x19\x8f\x46\xf9\x80\x8f\xa5\x3e\x7b\x6f\x5f\xa3\x23\x3c\x0f\xd7\x7b\x30\x7b\xeb\x58\x22\xe8\xd5\xc2\xf1\x3a\xbe\xc2\x55\xcf\x77\x63\x8b\xb0\x9f\x5e\x6e\x00\x3b\x03\xbe\x4e\xd1\xd1\x8f\xc4\xec\x3d\x34\x0a\x09\xac\xbb\x53\xa3\xef\xe3\x4f\xc1\xce\xec\x4f\xaf\xdb\x54\xae\x9b\x1b\xd1\xbd\xe9\xbd\x80\xd6\xf0\x0a\xd7\x30\x6b\xad\x61\xae\xba\x61\x2c\x5c\x9b\x77\x14\xb8\xe5\x76\xda\xbb\x31\x88\xc9\x5f\x3f\xaf\x58\xce\xeb\x9e\x9d\x17\xbc\xbf\xd7\x5d\xdf\x00\xd9\xd2\xef\x0a\x96\xe0\xe3\x2c\x2a\xe2\x63\x9a\xe5\xd4\xbb\x05\x2d\x0a\x3d\x93\xa1\x8e\x1e\xfb\x41\x5f\x48\x79\xdf\xc0\x08\x5a\x12\x0e\x54\x33\xcb\xc2\x7b\xf3\x19\x89\x9d\x7b\x6b\xfd\x77\xa9\xff\x6e\x66\x4e\xd4\x7c\x4e\x52\xe0\xde\xcc\xbe\xfa\xe6\xdd\xeb\x57\x23\xf6\xa4\xcb\x16\x57\x03\xbd\x02\x50\x30\x64\x99\x72\x6f\x65\x8a\xc2\x01\x1a\xc2\x07\x94\x5a\xac\xd9\xd5\xc9\x8a\x3f\xc8\x71\x3d\x1c\xc2\x79\xe0\x3a\x0b\x5b\xe7\xfa\x93\x75\x76\xba\xce\xb9\x18\xe5\xc7\x21\x2c\xf5\x50\xd1\x30\xf8\xeb\x85\xf9\x3a\x08\x98\xc5\xa5\x10\x01\xa3\x6a\xa2\x1f\x61\x6f\xe0\x29\xa4\x3a\x85\xae\xf3\xd4\xb6\x48\x6f\x51\x48\x3f\x1c\x04\x59\xae\xab\x7a\x8e\x9b\x28\x8b\x82\x77\x28\xc4\x11\x0d\x01\x95\xae\xdb\x3a\xf1\xda\xba\x84\x71\x0f\x68\x66\x05\xcc\xac\xd8\x33\xb3\x50\xcf\xec\xd2\xae\xc6\x80\x27\x75\x29\x3e\x9e\x36\x1b\xc6\x3f\xa5\xee\xf5\x4a\xac\x49\x3e\x41\x6b\x2f\xf8\x1b\xe3\x46\x98\x66\xcc\x8a\x7f\xec\x98\x2b\xdc\x0c\x37\x0d\xbb\xa2\xc5\xf1\xe7\x66\x52\x67\x76\x60\x27\xc1\xd0\x09\x31\xa1\x8f\x70\x3c\x67\xb3\xa9\xb8\x25\x48\xb7\xb7\x61\xa5\xce\xd2\x95\x60\xf7\x01\xf0\xec\x3c\x0b\xeb\x8a\xec\xe4\x50\x99\x16\x5d\x97\x40\x79\x55\xb1\xf2\xc3\x21\x50\x01\x21\x83\x23\xc0\x88\xc1\x95\xce\x80\x23\x20\x07\x7a\xe5\x0d\x0a\xcd\x47\xb5\xfe\x82\x9e\x09\xf3\x17\x84\x8d\x43\x52\xcd\x67\x61\x08\x6d\x66\x91\x12\x5a\xd0\xdc\xaa\xb9\xd8\xe9\x1b\x0b\x00\x8f\x89\x65\x60\x09\x0f\x0e\x2a\x5a\x4a\x34\x05\x81\x75\xd7\x8f\x25\x3f\x9e\xeb\xc7\x73\xcf\x82\xab\x50\x0d\xa7\x5b\xed\x66\x3e\xe6\xb1\x5f\xce\x90\x64\xb8\x44\x6d\x38\xfe\x71\x02\x3f\x9a\x17\x50\xd9\xa8\x82\x43\xef\x40\x7c\xb1\x84\xc2\xcf\x56\x5a\x8c\xc9\x24\x3b\x7e\x3e\x47\x7a\x32\x55\x9a\x2c\x2f\x2f\x93\x3c\x56\xa3\xcb\xe4\x0d\xc1\x18\x25\x56\x30\x55\x9e\x93\x3b\xad\xb6\x1e\xc7\x29\x8d\xa3\xa4\xdf\x57\x33\x74\x92\xc2\x79\xe5\x76\x01\xa1\x41\x68\xfb\xd2\x8d\xeb\x32\x86\xf6\xe1\x0f\x0a\xe9\xd1\x58\xa9\x31\xfc\x3e\x15\x23\xe2\xf1\x9a\xac\xdf\xa3\x35\xe0\x23\x61\x2d\xee\x6f\x26\x03\x44\x17\x5e\x68\xd0\xcd\x1e\x77\xa1\x0f\xfc\x82\xc2\xa5\xb9\x5a\x65\x6b\x00\xb2\x65\xef\x1e\x72\xf3\x54\x61\x18\x20\x6a\xc9\x13\x80\xac\x2f\x96\xbb\xe8\xfd\x0a\x76\x3b\x27\xcd\x82\x0f\x4c\x97\xaa\xdb\xdc\xda\x87\xf3\x07\xfb\x50\xc7\x40\xd3\x12\xe1\x5f\x3b\xee\x4c\x8c\x3b\x67\xeb\xe4\xb8\x8b\x1c\x9a\xa9\x96\xb0\x43\x04\x84\xe9\x26\x8b\x1e\xee\x47\x83\xa6\xbc\xa4\x40\xd1\xa9\xd7\x60\xad\xe6\x97\xa3\x4e\x1c\x57\xd9\xd2\x1d\xf8\x6f\x02\x24\x26\xcc\xad\x9e\xd1\xdc\x4a\x26\x23\x3b\xda\x20\x5a\x14\x0a\x7e\xb5\x04\x72\xe6\xf5\x2c\x5a\xc4\xc7\xc5\x94\xea\xc0\x0c\xc3\x26\x22\xfe\x9f\xad\x76\xd1\xbd\xb8\x2b\xd5\xe1\x0d\xd2\x58\xcc\x3e\x2e\x66\xee\x73\xfc\x4e\xc9\xa3\x47\xc5\x04\x74\x6e\x39\xf7\x7c\xd8\x10\xae\xc0\x3c\xd5\x6c\x67\x7c\x39\x9a\xe3\x58\x34\x37\x59\xca\xb3\x0c\xed\xd2\xa9\xc2\x7f\x30\x2e\xbf\xb0\xea\x98\x52\x0b\x5b\xfc\xb1\x5a\xdc\xa6\x30\x76\x95\xa6\xe3\x29\xe4\x40\x69\x85\x5e\x2d\xe1\x49\xe5\x13\x1d\xf3\xf6\x29\x1c\xeb\xf1\x51\x9c\x85\x2c\x79\x8e\xde\x02\xc7\x7a\xd6\x53\x13\x6b\xa1\x00\xda\x24\x29\xd0\x2c\x09\x46\x4f\xc1\x6b\xfa\x1a\xe5\xd6\xe5\x68\xb6\x4c\xcb\x47\xb5\x8c\xda\x24\x24\x37\xa4\x5b\xd6\xba\xdb\x6a\xd7\x25\x88\xe3\x95\x47\xcd\xf2\x9f\x3d\x10\xbd\x74\x81\xef\x6c\xa4\x81\x80\x16\xd7\x1b\x5f\xae\x2e\xeb\xc7\x30\xbe\x81\x2f\xea\x6b\x77\xa5\x2e\x37\xa5\xaa\x2a\xc2\x06\xa5\x46\x84\xa2\x61\x67\x97\x02\x27\xe0\x6b\x03\xa6\x4a\xb8\x26\xc2\x82\xbf\xb5\xfb\xfe\x49\x34\x4c\xc0\xcb\x95\x3e\x89\x7b\x38\xdd\xe3\xf4\xf0\x8f\x47\x87\x3f\x9f\x7c\x36\xb5\xbf\x1e\x1c\xfe\xd7\x2f\x87\xd3\xbf\xdf\xbf\x0b\xa9\xdb\x71\xc3\x94\x8f\xe0\x37\x69\x59\x67\xf0\x97\x79\x8b\x88\xe6\x54\xc0\x35\xcf\xc8\xec\x3a\x47\x47\x8c\xb2\xe5\x1b\xd0\x71\x72\x6e\x85\x5e\x5a\xc2\x7a\x27\xa9\xf8\xfd\xdb\x00\x9d\xb8\x03\x18\x9f\x6a\xcf\x9d\x2d\x91\xe9\x85\x79\x7c\xb3\x8c\xd0\xad\xce\x5e\x96\x32\x14\x76\x44\x40\x43\xed\x22\x7d\xb5\xcb\xbf\x14\x30\x55\x96\x0f\xc8\x1b\x02\xfd\xbc\x5b\x78\x7f\xae\x7c\x15\x10\xde\xc0\x3d\x52\x67\x5e\xc7\xa6\x8c\x73\xf5\x17\x23\x32\x58\xbb\xf7\xb3\x5d\x73\xd8\x8d\x51\x9f\x35\x28\x82\x3f\x3f\xee\xdf\xf9\xf0\x1b\xbc\xf3\x97\x8e\xff\x99\xe1\xef\xf5\x1c\xb2\xce\x39\x5c\x75\x61\x62\xdf\x33\xfb\x16\x35\xc4\xbf\x80\xdd\x2f\x35\xba\x5b\x60\x2c\x95\x3c\x7e\x37\xdb\x71\xd4\x9c\x36\x74\x0b\xfe\x16\xf8\x80\x47\x56\xdd\x35\xdb\xd6\xf7\x32\x60\x60\x48\xbb\x70\x88\x20\x36\xcd\xe7\x69\x39\x7f\x62\xa6\x5e\x61\x94\xdd\xa6\xb0\xff\x8d\xbe\xd3\x14\x37\x98\x25\xec\x64\x36\x5f\xe4\xab\x2b\xd2\xc3\xd6\xa4\x5a\xad\x8b\x0d\x00\x81\x73\xb5\xea\xa1\x2a\x14\x5f\xa8\xf5\x06\x95\x3d\x11\x82\xbd\x6c\xbd\x56\xf3\x0c\x9e\xa0\x0e\xab\x7e\x7a\xd6\xd1\x00\x4d\x73\x23\x72\xe8\xf5\xf6\xf3\xc5\x2a\x64\x3f\xf2\x6d\x84\xa0\xe3\xb3\xd5\xf1\x76\x4a\x81\x81\xee\xc8\xbe\x77\x41\x60\xd8\xcd\x45\xb3\x9f\x9f\xa1\x1f\xda\xb2\x8d\x09\x77\x56\x8e\xb2\x64\xb0\x69\x96\xfb\x1a\xca\x4d\x16\x43\x60\xd9\x86\x9b\x78\xb1\xfb\x6f\x81\xcb\x3f\x71\xea\x30\x72\x19\x1d\xab\x27\x6a\x53\x63\x14\x47\x8c\x91\x37\x5a\x44\x33\xa4\xda\xe7\x45\x8f\x2c\xab\x3e\xac\x58\x49\x32\x07\xe6\x63\x84\xfa\xd8\xb9\x93\xb5\xba\xce\x8c\x7a\x6b\x08\xe0\x70\x38\x1f\x5a\xf0\xe6\x77\x71\x08\x5d\xac\xd0\x9c\xdb\x28\xb6\xaa\xe4\x4b\xdd\x3c\xae\x7f\x92\xbc\x9b\x89\xc6\xaf\xaf\xb1\xd8\x6e\x47\x9a\x7b\x5a\x09\xb2\x7f\xea\x5d\x5f\xff\x6d\x9b\xaf\x60\xb5\x77\x3b\x98\x44\x2a\x6a\x64\x2b\xc6\x11\x5c\x33\x5b\x8c\x46\x23\xa8\xce\x88\xd6\xb4\x06\x55\x96\x37\x37\x83\x65\x72\x8a\x9c\x19\x74\x0b\x27\x63\x39\x5a\x68\x5b\x14\x4d\x09\x3e\xc2\xcb\x08\xec\xc7\xa5\x1a\xcc\x8c\x90\xbc\x02\x56\x04\xca\x2e\x62\x0c\x7c\xb8\x0b\xa3\x19\x57\xb9\x50\xfc\x45\xcc\xe9\x87\xbf\x72\x4e\xa8\xd1\x56\xde\x6d\x80\x13\x6d\x67\xd3\x3b\x5d\x15\xb3\x8f\x91\xb9\x21\x2a\x9f\xe3\xdd\xb0\x9a\x32\x6c\x0b\x29\xeb\xe5\xe4\xd6\x29\x8a\x69\xc5\x72\x65\xe0\x3c\x40\xbd\xd0\x02\x88\x2a\xe1\x30\x29\x83\x7a\x8e\x6e\x49\xe1\x29\x3c\x7c\x1c\x17\xdc\x70\x15\xee\x2e\x96\x19\x50\xb3\xfd\x95\x33\x91\xd1\x48\x39\x49\x5e\xcf\xf4\xcf\x37\x33\x34\x7f\x5d\x25\xcb\xd0\x91\x21\x30\x36\x13\xed\x44\x1b\x05\xe3\x31\x14\xd4\xc9\x69\x17\x8d\xac\xa8\x59\x04\xc8\x62\x4e\x0c\x9b\xa0\xe5\x0b\xf2\x2f\x41\xb1\x96\x28\xf0\x4e\x14\xa0\x70\xb7\x52\xb9\x7f\xa1\x7c\x8b\x18\x68\xfe\x02\x46\x6d\xa9\x45\x14\xad\xa0\xe2\xe6\x62\x16\x55\x31\xbc\x8d\x8a\xf8\x63\x43\x82\x70\x29\x9b\x38\xa2\x6a\xda\xe2\x91\xe2\x89\x43\xe5\xa7\x54\x19\x5a\x45\x31\x1c\x56\x27\x5b\x4d\xf8\x81\x55\xb5\x45\xd2\x11\x9c\xb3\xa9\x68\xf5\xa3\x68\xd5\x48\x82\x65\x4c\xda\xa7\x4d\xf9\xa5\x23\x6f\xba\x65\xd6\x3f\xad\xb4\x1d\xc0\x3e\xf2\xe4\x9b\x15\x52\x26\x09\xd9\x77\xdb\x93\xf8\x5c\xdf\x2e\x34\xe8\xeb\x1d\xf6\x54\x13\xd0\x00\xb4\xa5\x30\x70\x15\xfa\x11\xa2\x1b\xd4\xe0\xde\xe1\xe1\xc3\x7b\x21\x31\xc8\x1e\x55\x63\xc9\x98\xe1\x3f\x22\x22\x7c\xd4\x2c\x9a\xa1\x5f\xf5\x5e\x6d\x6b\xd5\xd4\xb6\xa2\x62\xa7\x6a\x62\xc7\x13\x7f\x07\x8d\xe8\xff\xe3\xaa\xcb\xc8\xca\x51\x80\x80\xe8\xfa\x3a\xfa\xd9\xc5\xea\x58\x4d\xe3\xe0\x92\xc3\xa1\x1d\x4d\x27\x36\xa2\xad\x1f\x45\x2d\x3a\xfa\xbf\x61\xdc\xf9\xed\x08\xbe\x3d\x40\x97\x6f\x5e\xeb\x11\x46\x56\x40\x22\xfe\x71\x01\xfc\xcb\x23\x85\x9c\x06\x6c\x9e\x0c\xbc\xea\x0d\x7a\x72\xc4\x71\x09\xff\xf1\x79\x7c\xf4\xf9\xff\xff\x21\x86\x74\x3d\xfa\xe7\x7f\x3d\x84\x37\x97\xab\xe3\xfa\x10\xde\x4d\xe3\x7f\xfe\xf3\xf3\xff\xfa\xbf\xf4\xe9\x9f\xff\xbf\x7f\xfc\xe7\x3f\xf0\xe3\xff\xfd\xe7\x3f\xff\xf1\x8f\x18\xff\xfd\xe7\x43\x8a\x03\xeb\x5e\xfc\x43\xf8\x2a\x74\xaf\xcf\xa3\x55\x14\x1c\xa4\xeb\xcd\x58\xa8\x4b\x9e\xe2\xbb\x55\x2d\x5f\x9d\xe0\xab\x33\x7c\x25\x3c\x10\xd4\x6d\xc1\x16\x85\x05\x7f\xfb\x6e\xa9\x19\xc5\x36\x42\xd5\xeb\x4c\xd8\xaa\x8b\x82\x5a\xfa\xf6\xd5\xd2\x14\xfc\x69\x49\x11\xae\x16\xc2\x00\x5d\x19\xc7\xa3\x48\x46\xee\x13\xd4\x10\xb2\xa8\x4b\x14\x8a\x51\x2c\x33\xe9\x1e\x92\x26\x35\xba\x87\xa4\x23\x75\x49\x67\x6e\x62\xad\x31\x0b\x0c\xd2\xa3\xd0\xa2\x95\x07\x2a\x62\x3a\xe2\x27\x8e\x42\x84\x76\xf6\xd9\x64\xb3\xe0\xc8\x0a\xe5\x64\xbd\x30\xf1\x64\x1b\xfd\x20\xe4\xb4\x7d\x2d\x0c\x21\xc0\x6d\xff\x08\x10\x72\xbe\x60\x81\x1b\x0c\x24\x1c\xcf\x50\x5f\xb7\x89\x50\x05\xb7\x39\x38\x80\x4e\xa0\x4a\x74\xbe\x88\xce\x16\x80\x71\x00\x6f\x6d\xb1\xa1\xc3\xa3\x69\xb4\xc4\x1f\x43\xf8\x31\xe8\x6f\x6f\x6e\x5a\x6b\xbf\x3d\x38\x58\xeb\x76\xb7\x44\xe4\xac\xc8\x9c\x61\xd0\x5f\x76\x14\x86\x41\x6c\x74\xe1\x25\x15\x5e\x60\x61\x00\xd0\x8a\xba\x57\xbc\x98\x44\x19\xa5\xa3\x15\x7c\xa7\x0f\x2b\xfa\x94\xd3\xa7\xc8\x2d\xd0\xf0\x28\x7a\x00\x13\x85\x85\xd0\xb0\x9c\x7e\xa7\xa3\x94\xb2\x07\x10\xb6\xc3\x07\xf8\xbf\xe9\x95\x31\x0f\x3b\xc2\x04\x42\xb9\xe3\xf9\xf4\xe0\x80\xba\xc4\x9f\xb6\xcf\x31\x0d\x67\xad\x3f\xad\xdd\x58\xd2\xd1\xb9\xec\xeb\x1c\xfa\x3a\xef\xea\x6b\x50\x41\xbd\x73\x68\x92\x64\xb9\xd4\x0a\x3f\xb9\xa6\x6c\x89\xb9\x57\x62\x6e\x4b\xb0\xe1\x49\x73\xcb\xdb\x57\x01\x76\x1f\x0d\x1d\x3b\xde\x0f\x31\xbe\xf3\x00\x0b\xd0\x19\x19\xea\x6d\xf5\x16\xf4\x28\x24\x35\xa2\x2b\x65\x2f\xe5\x62\x11\x91\x51\x6a\x14\x70\xb0\x68\xec\xc6\x3b\xac\xe2\xb2\x3e\xfb\x13\x5a\xcd\x2f\xee\x1b\x91\x93\x47\xa1\x9e\x2e\xc8\xaa\x8c\xe9\xeb\x83\x83\x9c\xac\x97\xf8\xa9\xc3\xc4\xa1\x9c\x45\x18\x2e\x43\xb3\x34\x87\xc9\xe7\xec\x36\xdc\xc2\x28\x06\x83\xd4\xe9\x59\xd0\x62\x36\xdf\xaa\x3d\x16\x72\x77\xb1\x82\xfb\xb8\x60\xed\x1d\x3b\xf1\x03\x86\xc9\x92\xdf\x94\x31\x1c\xe8\x27\x28\x34\x50\x23\x8a\x98\x81\x82\x03\xd5\xec\xfb\xb7\x3d\x9a\x41\xbb\x6a\xf7\x8f\x93\x5f\xee\x3f\xfc\xa5\x9a\xde\x37\x42\x73\x21\xb4\xe9\xa2\xca\x7f\x4d\x7e\x8d\x7a\xbf\xde\xc7\x7f\x1e\xfe\x8a\x6c\xcb\x85\x9d\x40\x70\x27\x5d\x60\xb2\x5f\xdd\xec\x84\x32\x92\xe8\x06\xc4\xfe\x1c\xa1\x24\x92\xfc\x37\x37\xf4\xf3\x5e\x70\x0f\x6d\x1b\x94\xb4\xc3\xe8\xa2\xea\xd9\xbd\x18\x96\xb2\xcc\x4e\xb7\x70\x93\x69\xa1\x5a\x8c\x03\x9c\x81\xcc\x31\x7d\xc2\x84\x2b\x6f\x56\xd5\xbe\xd6\x1c\x47\x02\x0d\x66\x29\xae\x26\xf2\x0d\xb8\xfb\xd6\xba\x2b\x3d\xab\xf0\xa3\x39\x15\xf2\x3d\x9e\xf4\xd2\x7a\x91\xe0\xb8\xad\xd3\x41\xeb\x6e\x95\x88\xd0\x81\x24\xc0\xbf\x61\x5c\xa2\x12\xac\xa1\xf5\x17\xf6\xf5\x9f\x26\xa1\xda\x87\xeb\x29\x1d\xae\x0c\xe6\x8c\x4a\x12\xb2\x24\x44\xcd\x5b\x47\xcc\xad\x9a\xed\xf9\xb4\xb8\x07\xe8\xe8\x8b\x05\x91\xbe\x08\x84\x30\xec\xa6\xa5\x8d\x2a\x67\xee\x60\xe3\x95\xb6\xb5\x09\x4f\x54\x33\x12\x0e\x5e\xc2\x47\x66\xb1\x91\xe2\xd7\x01\x86\xbf\x65\xa3\x16\x9a\xde\x58\xc7\xb6\x6a\xc4\xc9\xf1\x8a\x58\x53\x38\xbd\xc4\xcd\x86\x8f\xda\x77\xe4\xf9\x6d\x72\xe3\xa6\xc0\x34\x6c\x89\x8f\xfd\x0e\x14\xb9\xb0\xc2\x7a\xea\x51\x7d\xcb\x8d\x9b\xa1\x97\xe4\x8b\xa9\x1d\x90\x5b\x85\xba\x45\x39\x61\xc7\x24\xb2\xa6\xa8\x48\x58\xb2\x7f\x5a\x56\x58\x36\x28\xe7\xea\xae\xbb\x5f\xd9\x80\xb0\x19\x9e\x00\x34\xb0\x66\x32\x3b\x13\xb7\xef\xbb\x6d\x41\x66\x14\x5d\x57\x67\x99\x9e\xa3\x1d\xa4\xb9\x19\xbf\x63\x51\xd4\xe3\x58\x99\x3f\xf2\x6d\xf6\x30\x65\x2d\x58\xfa\xfd\x9f\x80\xa5\x8a\xed\xe3\xe8\x84\x63\xa4\x30\x0c\x83\xf4\x3f\x31\x89\xd3\xfa\x0b\xe0\x1e\xa9\x4d\x0d\x75\xf3\xd1\xb9\xaf\x75\x6a\x0e\xf9\xe5\x6d\x12\xaf\xb1\x08\x45\x2f\x1d\x40\xc9\x1a\xf6\xf1\xc2\xf8\x9a\x70\xed\x15\x22\x69\x69\x74\x04\x9c\x89\x59\x81\xd7\x0b\xe3\xbb\x81\x01\xfe\x0d\x7a\xca\x19\x00\x66\xf9\x66\x5b\x6b\x31\x02\x47\x63\x67\x9f\x2d\x21\x76\xbf\x47\xea\x7a\x42\xba\xab\xe1\x51\x68\xd5\x82\xeb\x18\x4d\x6b\x31\xdb\x05\x6a\xc9\x4d\x91\x7c\xb4\x36\xee\x7f\x47\xd1\x8a\xd4\xe6\x11\x3b\xd2\x9f\x70\x64\xc1\x51\x8a\xca\x55\xf4\x7f\xaf\xb6\x2b\x66\x11\x73\xa4\xbe\x0e\x99\xa7\x34\x6c\x1a\x2e\x76\xe8\xb9\x70\x0c\xc2\x71\x8d\x41\xb1\x76\x74\x07\x29\xbe\xe2\xf1\x94\xbc\x70\x22\xa7\x16\xb6\x6e\xcd\x0b\x22\x5c\x74\x54\xf0\xa8\xb5\x90\x85\x26\x5d\x0b\xaf\x43\x86\x61\x69\x78\x9d\x1e\x1c\xe8\x6c\x1b\x85\x3b\x73\x69\x88\xce\x32\xdc\xc3\xc3\x74\x88\xb1\x53\x96\xe8\xef\x58\x08\x16\x09\x5e\x87\xe1\x98\x84\x07\x3b\xd3\x42\xc8\xc6\xce\xf6\x71\xbc\x4c\x96\x46\xf2\x52\x5b\x0d\x86\x89\xb3\x39\x59\x5a\x81\x41\x8b\x60\xdc\x92\x44\x20\x8f\x71\xa9\xb6\xfb\xb1\xc3\x16\xb1\x03\xfe\x13\x6f\x77\xe4\x65\xbe\xdc\x5f\x76\x49\x81\xb1\x67\xc9\xa3\x05\x9a\x2d\x0d\xf1\x11\xf7\x8c\x37\x6a\x36\x99\xf1\x59\x8e\xf1\xbd\xde\x05\x38\xc5\xa3\x79\xb2\x84\x3f\xc9\x36\x26\xeb\x3b\x21\x68\xf8\xd0\x46\x36\xfb\xc3\x6b\x3a\xfa\x8c\xc9\x2c\x8e\xd7\x66\xa1\x59\xd3\x08\xbc\x29\xc3\xfd\x22\x68\xd9\x89\x07\xf7\x6f\x55\x2f\x99\x93\xb3\x97\x17\xcf\xbb\x78\xf1\xb6\xc2\xd2\x36\x0a\x8c\xce\x76\x16\xb5\x34\x45\xf3\x62\x86\xcb\x7b\x3f\xeb\xa4\x93\x9e\xbc\x7e\xfc\xfe\xa7\x37\x4f\x7b\x73\x35\x5b\xa5\x1c\x88\x50\xaf\x77\xab\xa1\xc1\x68\x38\x09\x1f\xde\x0f\xd9\x0b\x00\xbb\xfb\x71\x89\xe1\x97\x9a\x25\xdf\x03\x3d\xdf\x87\xf7\x2d\x14\xf4\x6c\x71\x3b\xf1\x66\xc8\x54\x80\xf1\x46\xed\x54\x89\xc8\xf0\x2e\x24\x51\x36\x42\x9f\x9b\xf1\x2b\xdd\x5e\x69\x65\xe1\x87\x96\x4e\x71\xe4\xd1\x63\xf6\xfe\xc4\xd0\x30\x70\xcd\x31\x5c\xc0\xbc\x47\x11\x82\xa8\x8f\xaa\x37\xe0\x30\x35\x11\x7d\xad\xa3\x9e\x89\x9f\x1c\xf5\x38\x1a\x6e\xd4\xb3\x7e\x32\xf4\x73\x91\x9d\x45\x3d\x14\x9f\x47\xda\x5b\x14\x0a\xe6\xe6\x17\x07\x66\x89\x74\x80\x98\x30\xa0\xb8\xa4\xe7\x98\xa0\x26\xeb\x84\xea\xe3\xc2\x20\xd7\xcb\x05\x39\xeb\x15\x14\xe1\x09\x71\xc1\x93\xc5\x31\x3f\x4c\xc3\x49\x7e\x9c\x4d\x93\x77\x00\x46\xf8\x06\x00\x8c\x89\x07\x65\xf2\x9b\x06\xbc\x5c\x0c\xa1\x3d\xf4\x85\x56\xa8\xa3\x73\x72\x4c\x4a\xfd\x2a\xc0\x64\x95\xe8\x7f\x90\x52\xf8\x8c\x2a\x45\x0d\xc0\x1f\xea\x29\x2e\x85\x3d\xe6\x70\xee\xde\xea\x55\xd5\xad\x52\x7b\x29\xff\xcb\xee\x4e\xc0\x1d\xea\x81\x25\xba\x71\xf4\x21\xc3\xb3\xae\x1f\x27\x41\x10\x3f\x20\x81\xe0\x68\x4d\x15\xd7\x64\x0c\x84\xd0\x58\x43\x9d\x4e\x0a\xfc\xee\xae\x0f\x14\x34\x14\xd9\xec\xe6\x2d\x7c\xd8\xb8\x85\x78\x5c\xce\x30\xf2\xed\xa8\x2e\xbe\x2d\x2e\x54\xf9\x38\xad\xb0\xc9\x2b\xbc\xec\xfa\x34\xb8\xbe\x38\x8d\xc9\xc1\x41\xff\x54\x9b\xe6\xe0\x21\x46\x37\x0b\xc5\x29\x11\xdf\xc1\xde\x7e\xe4\x29\x9c\x01\x9e\x0d\x2a\xb6\x7f\x42\x43\x1a\x82\x8f\x57\x2b\xb2\xef\x3f\xe3\xe3\xc8\x60\x04\x9e\x18\x2d\xcc\x92\x8b\x94\xd2\x74\x85\x5a\xe4\xbf\xd1\x00\x67\xdd\xa0\x72\x7e\x50\x83\xb3\x68\x1d\x22\xd6\x38\x4f\xae\x16\x84\x56\xe7\x26\x36\xcb\x69\x72\xde\x9c\x0c\x8e\xfa\x14\xad\x74\x2c\x6f\xb0\x89\xfa\xff\x6e\x0c\xda\x20\x96\xd3\x50\x23\xe9\x8b\x24\xf8\x90\xb7\x44\x8b\xc8\x18\x8e\xcd\xec\x4f\x71\x1e\x17\xc3\x24\xe8\x0d\xbe\x08\x86\xa7\xc3\xe0\x21\x3a\x6f\xa5\x9c\xda\x41\xb7\xde\x3b\xec\x65\xb5\x75\xb0\xd6\x0c\xc7\x4c\x87\xd0\x0e\x89\x85\xe1\x6b\x78\xa1\x73\x2d\x0c\x16\xac\xe9\xb8\x46\x52\x2d\xde\xa3\xb8\x8a\x48\xab\xd1\xfd\x15\xb0\x3c\x9e\x74\x5c\x11\x4d\xc2\x6f\xe0\xa4\x2d\x0c\xcd\xa3\xe6\xf8\x75\x76\xbc\x18\xe5\x53\xb1\x20\x16\x1a\x3c\xd9\x22\xcb\x0e\xd7\xda\x2a\xb8\x9d\x32\x0c\x6f\xeb\x6a\x31\x58\x8c\x16\x1c\x45\x2b\xdb\x3c\x66\x51\x6b\x15\x5d\x45\x7d\xfc\x2f\x8c\xb8\xe5\x04\xcb\x90\xa4\x47\x74\x5d\x63\xd7\xda\xa7\x75\x11\xc6\x73\xab\x80\xa1\x5f\x5a\x66\x3f\x0f\xc7\x0e\x01\xc2\x01\x5b\xa0\x80\x7e\xa5\xa1\x3d\x59\xa9\xfa\x87\x8d\xc2\xec\x5a\xd2\x43\x9f\x29\x92\x84\x89\x2b\xcc\x35\x2a\x34\x9e\x63\x37\xa7\xc6\x07\xbb\xfd\x67\xe1\xe4\xf9\x22\x96\x8e\x76\xdd\xf6\x52\x79\x72\xff\x5f\x5f\x0c\xb4\x65\x81\xb1\x30\x78\x70\xf8\x5f\xd3\xbf\x87\xf7\x19\xe6\x10\x6d\xfd\x66\x41\x71\x41\x0f\x0e\xb2\x49\xff\xdf\x4e\xaa\x8d\xae\xc5\xfe\x19\x0d\x63\x99\x38\xe6\x8f\x7d\xdc\x7e\xde\x12\x95\x5b\xa1\x07\x0a\x4a\x86\xe6\x29\x06\x74\x1b\x39\xe9\x48\x7f\x8f\x47\xda\x44\x89\x48\x2a\xa5\x4e\x6b\x75\x27\x06\x22\x4b\x32\x4b\x14\xdd\x56\x83\x1c\xce\x4c\x2d\x76\x62\x72\xfc\x4f\x02\xdb\x3e\xd1\x6c\xd4\xbd\xe0\x1e\x0a\x06\xa2\x20\x81\xff\x61\xf0\x00\xa9\xa2\xe0\xd7\x00\xc3\xb8\xe8\x18\xb1\xa2\xe6\xc4\x7b\x8a\x4b\x0c\x10\x07\x6c\x0c\x25\x42\x89\x00\x37\xb0\x89\x24\x05\x18\x72\xcc\xab\x66\x4c\x94\x5d\x97\x89\x60\x77\x81\x99\x3c\x41\x72\x58\xbc\x68\x7b\x0a\x76\x0b\xad\x7f\x58\x44\x30\xcb\xcf\x0e\xa4\x34\xfa\x77\x25\xbd\xa3\x10\x88\x91\x9f\x7c\xe7\x55\xce\xba\xbf\x1c\x4d\x6d\x4e\x2a\x1b\x4d\x59\xd2\x55\x86\x98\xf8\x72\x81\x69\x8d\x7c\x30\xdf\x34\x22\x62\x29\x83\xf5\x82\xf3\x4d\x09\x58\x7e\x79\x8e\x5c\xc6\x0a\xe8\x28\x7b\xed\xb5\x16\x05\x00\x5a\x09\x37\xd4\x24\x7a\x9a\x8f\x7e\xc9\x3f\x54\x3a\x58\xce\x08\x1e\x7a\xa8\x32\x34\x75\x82\x61\x31\x0c\x76\xbb\x1e\x7c\x81\xd7\xf7\xf5\xeb\xdd\xee\x97\xfc\x97\x1c\xde\x61\x88\x04\x00\x04\xac\x35\xcf\xaa\x18\x6b\x7f\xd1\x3f\x3c\x84\xb2\x0f\x4d\xd5\xc3\xc3\x87\x54\x5d\xbf\xbf\x2f\xde\x23\x2b\xd3\x98\x69\x26\x26\x6f\x66\xf4\xd9\xa2\x7b\xfa\x14\x5f\x97\x83\x47\x20\x87\xf3\x56\x9d\x01\x91\x37\x08\xfe\x85\x3d\xfd\xf2\x4b\xf5\xf7\x60\xf8\x01\x3d\x87\x87\x01\x3e\xfc\xf2\xcb\x7d\x7e\x57\xf0\x33\x7d\xcc\xf4\x47\x18\x4b\x40\x58\xaa\xd1\x73\x1a\x4e\xd0\x7e\x30\x1e\x7c\x2b\x61\x5e\xf4\xed\xa2\xcb\x8a\xa8\xa9\xad\x1a\xdc\xa3\x29\x07\x43\xd6\x5d\x17\x43\x0c\x04\x60\xf4\x56\x3a\xea\xc4\xb7\x30\x35\x03\x28\x2b\x1b\x21\xa8\x8e\x53\x54\x27\x96\x51\x1e\x17\x42\x1b\xf7\x59\x87\x28\x49\x1f\x47\x7b\x14\xda\x07\xaf\x83\x76\x27\xab\xe9\x7d\xf4\xbd\x35\x2d\xd8\xb3\xea\x2d\x61\xd5\x27\x4c\xb3\xf2\x3b\x5b\x5c\x35\x07\x79\x34\xbd\xbb\xf9\x01\x96\x36\xa6\xa7\x64\x63\x00\xd4\xe2\x5f\x82\x76\xad\xf9\x09\x42\xb7\x72\xd4\x29\xcd\xc4\xee\x91\x71\x33\xf7\x83\x87\x63\x02\xd9\x0d\x4a\x79\x76\xca\xae\x29\xed\x6b\xc0\x8a\xa4\xf6\x1d\x92\x3c\xce\xe1\xa0\x64\xe2\x90\x7c\xe7\x1b\x4a\xb3\xb0\xce\x10\x62\xe8\xf8\x75\x44\x48\xa6\x83\x16\xa4\x5d\xfe\x82\x8d\x17\x0d\x23\x4f\xa7\xad\xd2\x20\xcf\xc4\x91\x05\x32\x5b\x83\x2f\xd4\x3e\x49\x2a\x24\x3f\x4e\x25\x15\x52\x75\x50\x21\xf3\xfd\x64\x48\xda\x41\x86\x94\x51\x1f\xff\xc3\x78\xc8\xd8\x74\x82\x65\x32\x5e\xd6\xc2\x23\x43\x94\xa1\xb4\x63\xdb\xa7\x20\xf7\x8c\x3d\x0f\x51\x6c\xd0\x30\xdc\xf6\xff\xf3\x7f\x76\x1c\x6c\xf6\xfa\x3c\x2e\xd2\xa8\x8e\x95\x8d\x99\x87\x42\xe7\x2d\x50\x27\x79\x18\x6d\x85\x87\xac\xf2\x73\x2b\x03\xc8\xf9\x91\x42\x62\x61\x60\xe8\x50\x4b\x54\x84\xf3\xaa\x40\x19\xb0\xfe\xc5\x5c\xc8\x40\x49\xbf\x2b\x19\x3d\x97\xa0\xf5\x05\x25\x32\x81\x43\x12\xee\x64\x64\xd2\x17\xca\xc6\x07\xe8\xbf\x58\xb4\xa3\xfe\xbc\xcc\x2a\xba\x14\x3a\x6e\x28\xf7\x00\xb4\xaa\x26\x54\xf9\xd1\xac\xc2\xa8\xf7\x34\xa3\x78\x06\x70\x0a\xf8\x4b\x51\x12\xbb\x88\x96\x1e\x18\xc9\xc9\xba\x42\x6b\x46\x9d\x03\x50\x50\xd1\xd2\x89\xb6\x5e\xe8\xd9\x73\xdc\x2f\x0e\x74\x21\x1d\x4c\x9b\xee\x03\xfd\x1f\x59\xee\xa3\xd3\xa4\xbf\xc7\x39\x68\x10\x33\x6e\x4d\xe8\xb1\x4d\xf5\x56\x66\xc0\xbc\xba\x1d\xfc\x1b\x05\xde\x40\x31\xbe\x8d\x91\xca\x21\x38\xca\x6d\x4e\xd2\x7e\x54\x00\xf4\x4e\xa1\x39\x18\x2c\x7a\xe4\x41\x8f\x3f\x29\x8e\x4f\x48\x59\xc1\x84\x42\x1d\xd0\xcf\x20\xbf\x25\x17\x7c\xf8\x27\xc6\x6b\x53\x7d\x61\xdc\x51\x37\x5c\xc3\x36\x5c\xc0\x8a\xf7\xe0\xd2\xe0\xf0\x39\x45\xdc\xbb\xc7\x6f\x9f\xbf\x79\x4f\x8e\x94\x48\x71\xa1\x49\x3a\xd0\x90\x1f\x36\x1b\x4b\x43\xde\xb9\xf3\xf7\xb7\xf5\x36\x0c\x22\x17\xce\xa4\xf7\x05\xf3\x70\x0f\x4d\x51\x6b\xc4\x1b\x60\xaa\xae\xc7\xfa\x7a\xc0\x1a\xe6\x40\x4e\x89\x57\x71\xee\x82\x2e\x09\x07\xde\x66\xd6\xfa\xbf\xb1\xb2\xf0\x81\x88\xa8\xfe\x8d\x28\xd3\xef\x12\xaa\x0a\x2f\x58\x9f\x22\xd3\xae\x39\x95\xde\x39\xf3\x08\x80\x75\x6e\xb2\x9a\x76\xde\x21\x9b\xee\x10\x6e\x50\x74\x2d\x4d\x22\x6a\x19\x4d\x08\x23\x58\xb3\x78\x63\x64\xb6\x8b\xe4\x3c\x18\xc1\x6f\x91\x5b\xe7\xfd\x44\x51\x0a\x65\x7c\x15\x29\xda\x2d\xbe\xea\x28\x7c\xe0\x5f\x14\x77\x12\x9d\xd3\x23\x38\x32\x2d\x0f\x72\x25\x6d\x43\x92\x1c\xa8\x8a\x39\x9e\xb4\x37\x78\x9b\x5e\xf3\xad\xc1\xa3\xe6\xa0\x03\x67\x42\xf7\x32\x76\xe7\xb9\x07\x4c\xd2\x3b\x02\x13\xe8\x89\xbe\x76\x82\x94\x32\xb7\x20\xa5\x63\x53\xdc\x4e\xd2\xea\x43\x4b\x68\x6f\xc2\xb7\x22\xaa\x13\xba\xfb\x1d\x13\x51\xa1\x33\xea\x32\x91\x9c\xeb\xee\xa0\xc9\x0e\x26\xf3\x55\x87\xe3\x49\xa7\x55\x07\x2f\x0e\xf2\xed\xfa\x14\xc0\x8d\x8b\xd1\x33\x3a\xff\x44\x43\x0c\xa0\x30\x7e\x03\xfc\xac\x2a\x72\x19\xc5\x28\x3a\x3c\x3b\x61\xd4\x89\x61\x59\xa4\x99\x67\x6f\x5e\x28\x8a\xf9\xa0\xd5\x20\x1a\x06\x8e\x7a\xbd\x97\xe9\x47\xd5\xab\xb6\x40\x31\x5f\x15\xdb\x7b\xa5\xa2\x86\x35\x94\xf1\x5a\xc0\x80\xcc\x18\x2a\xe6\x23\xfd\x02\x12\x5b\x4f\x03\x46\x8d\xce\xc7\x69\x27\xc8\x26\x7a\x47\xa2\x27\x03\x7c\x07\x4a\x90\x06\x29\xde\xe0\x33\x58\x21\xe4\xbc\xce\x87\x41\xd8\x7b\xb3\x52\xe8\xd6\xa6\x72\x33\x32\xa2\xe9\x39\x70\x10\x8e\x0a\xdb\x82\x0b\x6f\x5a\x83\xc5\x33\x78\xe1\x37\x4a\x44\x44\x21\xa6\x4f\xb7\x19\x40\xac\x4d\x59\xcc\x54\x45\x6a\xd1\x0b\xb5\x5a\xe1\x5f\x53\x20\xdd\x6c\x84\x8f\xad\xf0\x66\xcb\x45\xbe\x5e\x15\x1a\x5a\x23\xe3\x48\x35\xe8\xa3\xdd\xef\x88\x8b\x49\xf0\x17\xa5\x7a\x14\x2c\x4c\x78\x99\xc9\xd6\xfa\xf7\x4f\xaa\x2d\xd4\xd0\x4a\x6f\x11\x8a\x44\x87\xc6\x6f\x44\x1d\xaa\x81\x98\x49\xf3\x41\x36\x3a\x31\xe1\xdd\x91\xea\x0a\xb8\x91\x40\x47\x05\xd5\xf1\xfc\x46\xfc\xd6\x4b\x48\xc1\xaf\xd0\xea\x1d\xae\x75\x77\xa0\xca\x6a\x22\x4b\x16\x71\x23\xb8\x28\xbc\x8c\x6a\x47\x39\x98\x81\x60\xc8\xdc\xd1\x89\xce\xb0\x9b\xcb\x48\xca\x69\x77\x3c\x2e\x4e\x72\x47\x86\x11\xc7\x6a\xda\x1d\x8d\x09\x40\x72\x47\x68\x4e\x34\xed\xce\x92\x67\xa9\xec\x64\x6b\x17\xd5\xc4\x4d\xd2\x71\xa8\x18\x79\x62\xac\xf2\x53\xa5\x72\xc1\x06\x62\xf7\x8b\xf4\x1c\xf7\x1d\x15\x34\xb6\x38\xc7\xde\x1f\xe4\x93\xa0\x27\x22\x0b\x9a\x40\xe1\xa7\x05\xe0\x19\x5d\x38\xea\x6d\xf8\x58\x22\x25\xd1\x68\x20\xf6\x62\x7a\x2d\xbd\x03\xc4\x91\x54\x6d\xba\xfd\x36\xb4\xd8\x12\x50\xa3\x34\x84\xe3\xb5\x7e\x42\x20\xc4\x50\x5e\x49\x83\xc6\x19\xc1\x49\x6a\x3f\x38\xa5\x10\x7d\xcf\x81\xc4\x0c\xa2\xa0\xc8\xad\x4c\x1b\xed\x74\xa9\x40\x66\x3e\xb1\x2c\xdb\xbe\x37\xc1\xb5\x75\x35\xfd\x60\xbe\x9a\x58\xfb\xc6\x9e\x3c\xd0\xaf\x30\xf6\x32\xe6\x18\x4f\xe7\xe9\xa6\x2e\x4a\x4c\xec\xc5\x15\xcc\x8b\x40\xff\x94\x2b\xb1\xca\x5d\x58\xed\xab\x39\xdc\x12\x9b\x46\xa0\xc4\xb5\xc8\x50\x26\xba\x9e\x37\x2f\x53\x19\x1a\xc2\x3c\x3b\x2e\xa7\xe3\x40\xad\x02\x8e\x98\xdb\x71\x66\xaa\xc9\x7a\x10\x04\xc3\x12\x76\x9d\x64\x99\x86\x68\xd2\x71\xce\x88\xca\x43\xe8\x47\xa4\x0b\x43\x1d\x11\xe7\x0c\x4e\xeb\x1c\x88\x09\x80\x0b\x00\x9d\xb3\xb3\x1c\x16\x74\x1e\xe8\x95\x23\x6b\xc2\xdc\xa8\x56\x61\x20\x49\xb5\x3b\x9f\xb7\xe3\xcf\x96\x70\x08\x31\x49\x9e\xce\xbc\x10\x46\xef\x97\xee\x31\x5a\xce\xc5\xc3\x4b\xf9\x65\x81\xa9\x3c\x6d\xfa\x01\xae\xec\x7c\xca\xec\x11\xf2\xc0\x4f\x8e\x0b\xd6\xdf\xcc\x01\xc4\x20\x16\x6f\x03\x21\x93\xe2\x01\x0a\x8c\xbb\x92\xf4\x52\xca\x85\xb3\x39\xc5\x61\x2f\x51\xf8\x84\x10\xab\x14\x27\x6c\x2e\x30\xf1\xb5\x88\xb2\xdd\x15\x76\xd7\x92\x23\xf5\x14\x58\x96\x9d\x97\x6a\x60\x83\x37\x98\x43\xdb\x63\x76\x9d\xef\x49\x67\xed\x9e\x1f\x95\x67\xe4\xb9\x48\x6f\x4e\x8b\x2d\x86\xca\xec\x0a\xdb\x46\xa4\x37\x26\x35\x1a\x9d\x6e\x4f\x4f\x57\x26\x67\x82\x7c\x25\x53\x52\xae\x2d\xe0\x1c\x4b\x5b\x62\x4a\xac\x00\x48\xc8\xa6\x28\x74\x6f\x50\xf3\x68\x42\xfb\xc7\x98\x91\xd0\x10\xcb\x33\xe0\xbd\x6a\xf5\x44\x3f\x9a\x4c\x0b\x26\x92\x25\xb7\xb6\x2f\xa5\x3a\x86\x1b\x34\x8d\x8e\x29\x12\x89\x0c\x96\x99\xe3\x21\x91\x64\xe1\x79\xde\x14\x9f\x22\x62\xb5\xdd\xf0\x19\xc8\xdb\xd3\x00\xaa\xec\xc1\x38\x7f\xa8\xc6\x4a\xbb\x0e\x66\xa2\x0c\x41\x59\x4a\x12\x40\x7b\x9e\x99\x94\x5a\x2e\x9d\xec\x58\x48\x48\x64\xc2\x90\xbc\xc3\x2c\x45\x8c\x86\xa3\x86\xb7\x07\x83\x81\xc1\x38\xe7\x39\x0c\xa6\x94\x23\x01\x42\xb5\x34\xe9\x0a\x30\x55\x87\x4b\x50\xa4\xba\xf2\x4c\x5d\xfd\xaf\x0e\xc0\xe5\x4b\x10\x43\xf1\x92\x0d\x75\x0e\xea\xf4\x2e\x7b\xa4\xf6\x8c\x4a\x99\x51\x35\xf7\x28\x37\x7b\xf4\x58\x24\xf9\x32\x9b\x25\xd3\x18\x7d\x72\xd7\x2e\x1a\x94\x3f\x49\xc9\x85\x25\x96\xe8\x73\x78\x34\x9d\x34\x9e\x39\xbb\x31\xa6\x57\x1d\xe8\x74\x0c\x74\xc3\xcc\xdd\xc1\xd0\x8c\x13\x71\xf1\xac\x7b\x50\xe7\x4b\x1a\xfc\x2b\x60\xad\x74\x7b\xe4\x6a\x2e\x0a\x7a\x9f\x9b\x79\x2e\xf2\x46\xf6\x3a\x1a\xa3\xbe\xf5\xe6\xc2\x36\xae\xaf\x1c\xbb\x2f\x01\xff\x28\xd0\x8f\xa1\x60\x30\x91\xc3\x03\x92\xc5\x6d\x5a\x01\xdc\x3d\xf7\xec\x9a\xd8\xc3\x09\xff\x89\x6b\x1b\xde\x16\xdd\x19\xec\xef\x7d\x8a\x07\xec\x99\x47\x68\x32\xf2\x98\xa8\x12\x01\x49\xcc\xf2\x61\x70\x18\x0c\xb3\xe1\x90\x8c\x9e\x75\x84\x23\x0a\xcf\x54\xd3\x6a\x8b\x64\x08\x17\x25\x02\x0f\x40\x23\xa6\x18\xe5\xb2\x08\x27\x95\x36\x9e\x20\xbe\x9e\xe0\x2b\x46\x03\x46\x3c\x55\x44\xc1\x67\xd7\x84\x13\x77\x81\xec\x58\x38\x3e\xe4\x8d\x74\xac\x72\xc9\x2d\x80\x26\x85\xc4\xeb\xb9\x38\xe6\x40\x47\xa2\x81\x83\x39\x13\xa3\x93\xb3\x6d\x36\x47\x7b\x1d\x6d\xe0\xa1\xc8\x22\x87\x23\xab\x02\x7f\xcd\x03\x3c\xe6\xd8\xbf\x85\x16\x7c\x86\x53\x1d\x30\x19\x7a\xf8\x36\xab\xea\x24\x8b\x9a\x78\xe1\x28\xf4\x8b\x08\x47\x05\x3b\x70\x8e\x61\xcd\x9a\xd7\x7a\xb4\xd1\x82\x0d\x21\xd2\xd5\x6f\x28\x57\x9f\x16\x2f\xd6\x36\xd1\x5e\xe8\xcd\x98\xe6\x62\x52\xb0\x68\x81\x0f\xbd\x53\xe2\x30\x3d\xfa\xe4\x9a\xd1\x26\xfc\xb9\x45\x53\xcd\xc5\x6a\xac\x95\xce\xa2\xc2\x76\xb6\x51\x13\x9f\xda\x85\xa2\x02\xc2\x5d\xc1\x22\x5f\x93\x47\x03\x13\x0a\xb9\x11\x74\x66\xda\xa0\xcf\xfa\x88\xc9\x9c\x8f\x3a\xdf\x6d\x69\x1a\xd0\x8f\x1d\xb9\xa7\x1f\x37\x2f\x6f\x63\x58\xaf\xf3\x46\x2c\x66\x98\x39\x80\xec\x2f\x88\x0c\xda\xd8\x50\xcc\xc3\x61\x28\x32\xe0\x27\xcc\x32\x50\x11\x20\x56\x84\x60\x5f\xf8\x3e\x34\x31\x06\xdb\x05\xb1\x19\x57\xff\x88\xe3\x28\xdf\xdc\x0c\x52\xcc\x18\x5c\x25\x68\xa5\x13\x8e\x6b\x76\x30\x20\x39\x2b\x01\xa6\x90\x52\x60\x69\x9b\xad\xb7\x50\xd0\x4a\xa8\xe0\xc2\x16\x78\xcb\x9e\xeb\x2f\x94\xf1\xd4\xc8\x20\xb0\xcd\xe4\xda\x40\x84\x18\x58\x36\xb5\x88\xcb\x5d\x94\x92\x4c\x90\xf9\xc7\x8a\xa9\x5d\x80\xec\x94\xb0\xf6\xb8\x1c\xe5\x53\xf2\x49\xc0\x8c\xec\xf4\xd4\xd1\x42\xb8\xeb\xcb\x24\xd4\x34\x46\xfb\xc3\x4b\x0a\xd7\x82\xc0\x7e\x7a\x28\x52\xa8\x36\x8b\xd8\xcc\x4b\x26\xe3\xd4\x04\x96\xc7\x7e\xfe\x12\x49\xb2\xb4\xbc\x22\x1a\x2d\xf9\x54\x07\x80\x33\xec\xcd\xb2\x16\x67\x93\xb4\x25\x2a\x7a\x9f\x7b\x59\x0f\x4c\xe8\xc5\xff\xc7\x41\x14\x75\x44\x81\x07\xe1\x04\x79\xa3\x1f\xe6\xba\x2c\x6a\x79\x7f\x9f\x0f\x5a\x69\xdb\xd1\x3e\x06\x0b\x7e\xc9\xd4\x2c\xda\xfa\xc2\xd3\xf7\xa6\x9a\xb0\xde\xcf\x5b\xd1\x69\xbe\x46\xaa\xdc\xec\x20\x3d\xd8\x64\x28\x63\x85\x7e\x08\x19\x5a\xf8\x60\x34\x1d\xe5\x54\xd9\xd8\xfc\x33\x03\xea\x33\x17\x24\x28\x0a\x4c\x44\x23\x64\x3e\x51\xfb\xf5\x35\x27\x47\x96\xa6\x6b\x6f\x7d\xb5\x34\xc9\xc0\x64\x54\xf3\xdc\x13\xbe\x8b\x30\xd2\x27\x83\x63\xd4\xd3\x0f\xc3\xfb\x67\xd2\x19\xab\x95\xb1\x69\x98\x3f\xb4\xa1\xbd\x27\x14\x07\x08\x17\x0e\x45\x85\x2e\xe5\x58\x36\x09\x60\x5b\x91\xb7\x03\x36\x07\xd3\xd0\x56\xef\x90\xd1\x4d\x57\x13\x22\x3a\xd8\xae\xc8\x08\xa6\x1c\xd3\x30\x29\x29\xfa\x64\x89\x71\x26\x43\xca\x54\x5b\x7a\x11\xb1\x5f\xc9\xb9\x9d\x0c\x08\xf9\x88\x19\x1c\xff\x32\xfa\xe5\xf8\x97\xe9\x14\x26\x00\x48\x4f\x86\xc3\xfe\xfb\xfd\x28\xf8\xdb\xcb\x0f\xdf\xfe\x8d\xe3\x47\x89\x26\x9f\xc8\x26\x2d\x28\x40\x09\xec\xff\xa3\xc0\xaf\x9e\x04\xf6\x79\x27\xc1\x28\x42\xdd\xe7\x05\x22\x51\x8b\x1b\x38\xe6\x6b\xe0\xbe\xa3\x13\x2e\x41\x65\x24\x9d\x50\x7c\x63\xa5\x32\x50\xf2\x3e\x82\x32\xeb\xa5\xe0\x40\x33\x40\x87\xcb\x94\x8e\x9e\xce\x1c\xfa\x62\x6e\x44\x9b\x2a\x8c\x58\xf0\xba\x2d\x4d\x1e\x51\xe6\x7c\xcb\x3d\x69\x55\x4a\x23\x3d\x42\xf4\x5d\x63\x68\x0f\x27\xff\x3c\x21\xf6\xc8\x1c\x3c\x6d\x09\x7d\xbd\xc8\x01\x4e\x20\x4c\xc4\xb0\x6c\x94\x81\xc5\x5c\x3c\x1a\x54\xd0\x9c\x7c\x10\xd9\x54\xa9\x94\x45\xb8\xf1\x59\x98\x71\xfb\x07\xb5\x01\xc2\xbf\xef\xda\x17\x81\x9f\x5f\xd2\x77\x62\x17\x67\xea\x2d\xa2\x8a\x2d\x4b\x73\x84\x11\xa7\xc1\x02\x56\xf8\x8b\x6c\x53\x6d\xf3\xaa\xcb\x10\x4d\xd9\x40\x04\x4b\xd0\xb8\x69\xac\xfa\x49\x4e\x3a\x38\x57\x23\x72\x29\x43\xc8\xcf\xcd\x90\x67\xde\x43\x27\x0f\x8b\xad\x10\x1e\xcb\x31\x36\x54\xc8\x51\x39\x99\x0e\xc4\xb0\xf9\x0d\x98\x53\xc0\x2b\x2b\x66\x65\x4a\x2d\x61\x9c\x1a\x35\xe1\x13\x69\x07\x0d\x11\x02\x85\xcc\x4f\x8c\x8e\xac\x5b\xf0\x1b\x23\xb4\x63\x02\x65\x31\x8d\x6a\xc2\xea\x8a\x82\x23\x6c\x16\x2f\xa6\x7c\x57\x53\xe4\x1f\x83\xe9\x51\x07\x4a\x18\x4b\x9b\x51\x1a\x8c\x9d\x7c\x37\xe7\xec\x95\xc8\xf7\xbb\xca\x97\x7e\x21\xca\x4a\x86\x05\x2b\xaf\x10\x9b\xaa\xb8\x17\x65\x47\xb5\x7a\x83\x3b\x2b\xcb\x50\x2d\x37\xe1\x11\xba\x3d\xbd\x23\xf7\xc5\xc6\x05\x08\xb4\x87\x0c\x7e\xaa\x0c\x39\xab\xc3\xed\x08\xe3\xa6\xbc\xdb\x0a\x1f\xb7\x59\xc0\x32\x49\xc9\x24\xb5\x76\x9d\xd2\x39\xe6\xb8\x65\x7d\xae\x29\x5e\x47\x93\x4c\x1a\xfc\xb7\xe9\x24\xb9\x01\x2a\xb1\x49\x4d\x1a\x23\xc1\x0b\x43\xde\x2b\x5d\xfd\xe8\xdc\x7a\x5d\x9f\xdc\x00\xf6\xf5\x6a\x3a\xb4\xd3\xcc\xf9\x62\x10\x09\xa3\xf9\xce\xfa\xa2\xb8\x48\xaf\xbe\x84\x43\x06\x40\x2c\xc4\x04\x5f\x70\xfe\x11\xc0\x0c\xbc\xb0\xfa\x7f\xe8\x4b\xea\x25\xf6\x30\x7c\xd8\x2d\x37\xca\xe9\x66\x72\x73\xb1\x28\xa9\xd2\x27\x68\x49\x59\xd4\xd9\x2f\x59\x2a\xd6\xbf\x59\x1d\x72\x9f\xdf\xf7\xcb\x7d\xdc\x60\xa5\xec\xc7\xbe\xfd\x6f\xca\x7f\x6e\x59\x02\x02\x66\x52\xde\xe3\x64\x41\x4d\xc1\xcf\x67\x8d\xf3\x2c\xe4\x3c\xcd\x61\xef\x93\xf5\xb8\x89\xa8\xa9\x15\xf1\x34\xe3\x42\x35\x82\x69\x37\xe8\x21\x21\x4f\x69\xf5\xea\xc9\x54\x1a\x1d\xe6\x53\x4f\x92\x23\x2c\x09\xfe\xf2\x0e\x1a\xf2\x19\x61\x85\xf0\xbf\xb0\x82\x9e\xdc\xe5\xf6\xa5\x7c\xe1\x21\x4b\xbf\x35\x0d\xd1\xad\xb4\xa5\xeb\x4b\x5b\xee\xd2\x38\xea\xb7\xc9\x4a\xbe\xf2\x18\x52\xa2\x79\xba\x67\x1e\xb2\x6c\xea\xc1\xb8\x16\xb3\x6f\xae\x12\xcf\xde\x8e\xc6\x91\x40\x72\x76\x7f\x62\x74\x3f\x35\x05\x66\xc6\x57\xc3\x26\x9f\xd2\xf9\xf9\x97\xdb\xc5\x62\x05\xc0\xc8\x64\xc0\x05\xbe\x92\xe5\xc1\x9c\xf0\x68\x5b\x62\x97\xef\xb6\xa7\x84\xf4\x92\xe4\xf1\x8c\x03\xef\x7a\x75\x91\x4d\xf9\x74\xca\xa9\xad\xac\x70\xb4\xb3\x69\x7a\x1b\x48\xbe\x22\xa7\x9f\x0e\x6a\xc1\x9c\x69\x9c\x0c\x4e\x65\x69\xd7\x06\xf9\x97\x09\x81\x5a\x4a\x3b\xb7\xf5\x96\x95\x38\x18\xff\x95\x23\xc9\x29\x9d\x73\xa2\xb4\x3b\x5b\x3d\x19\x88\x82\xef\x8b\x0f\x5a\x77\xc3\x44\x5f\xa6\xe9\x9c\xcc\xf2\xde\x6c\x7d\x75\xa8\x80\x30\xd9\x5a\xf1\x10\x31\x26\x0a\xbd\x05\x1a\xef\x50\xd4\xa0\xc9\x8c\x3a\x1a\x2c\x93\x4c\x60\x8f\xe7\x96\xe9\x3d\x38\x58\xda\xb9\x7f\x83\xb1\x39\x0d\x74\x86\x29\x6b\x7c\x42\x93\xcc\x42\x82\x67\xe5\x7e\x3c\x26\x90\x5f\x68\x2e\xa1\x9b\xb8\xdb\x46\xfe\x84\x3e\xa9\xc6\x02\x44\x1f\x4e\xfe\x40\x8e\xe2\xb2\x60\xe7\x21\x4f\x4a\x83\x62\x38\xe1\xd7\xc1\x01\x1c\x88\x74\x3e\xff\x1e\xc6\xa4\x33\xed\x62\xc0\x42\x4d\x89\x68\x39\x8b\xa1\x4b\x16\x11\x8e\x3f\xb6\x33\x89\x88\xdf\xa5\x67\xf4\x7c\x86\x4b\xf0\x10\xf8\x46\xbc\x39\x69\x52\x61\x7c\xf8\x54\x4b\x89\xc4\x5e\x3d\x26\x84\x61\x77\xaa\x71\xbb\xb2\x69\x92\x0a\x71\xed\x37\x3e\xf1\x19\x04\x7e\x56\xa0\x1f\x1b\x62\x2e\x4f\xd4\x54\xcc\xf0\xa8\xfe\x35\x98\xca\xd9\xa2\x70\xab\x0d\xc4\x65\x33\x79\x39\x04\xce\xab\x6b\x7a\xa9\x9a\x9b\x61\x52\x43\x35\x57\xe7\x2d\x55\xa4\x9b\x25\xdb\xb1\x3a\x20\xdd\xbf\x30\x65\xc9\x3c\xf8\xc1\xeb\xc0\x00\x02\xbd\x78\xe0\x0e\xa3\x5b\xbf\x7c\xb6\x6d\x39\x71\xaa\xea\x3c\x9c\x56\xa2\x2a\x0a\xb3\x50\x95\x6c\x41\x14\x9d\xde\xb0\x3d\x07\xbd\xc3\xc6\xc3\x37\xdf\x7f\x9c\x6e\x6e\xd0\x17\xd7\x3f\x52\x86\x01\xd0\x70\xde\xb0\x03\xf2\xa4\xed\xe9\x70\x5f\xbe\x51\x35\xa6\x44\x04\x28\x9f\x86\xbb\xdc\xbc\xea\xb9\xbd\xea\x8a\xa8\xf3\xab\xd3\x01\xdd\xe6\xd6\xa6\xe8\x23\x2b\x11\xd4\x34\x71\x34\xdb\xbe\x05\x48\x30\x10\x66\xa6\xf3\xc2\x86\x86\x6e\x36\xb4\xd9\xa7\x2e\xa3\x27\xd9\x64\x8a\x56\x6c\xe4\x91\xb8\x0b\x2a\x93\xda\x74\xc2\x00\x44\xef\x1b\x80\xe5\x3d\xa0\xe5\xaf\xc9\x27\xeb\xbd\x1d\xa3\xdd\x53\x90\xa1\xa4\x29\x1b\xd5\x37\x37\x18\x27\x24\xf8\xa8\x1f\xc9\x9b\x1c\x8f\x93\x9d\x5a\x54\x77\x81\xe1\xa4\x6e\x9d\x74\xca\x74\xd2\x05\xb1\x6f\x51\x98\xd6\x4e\x76\x8a\x81\xb1\x60\x00\x49\x3e\x81\xc1\xc4\x30\xbe\x5d\xdd\x44\x79\x82\xfb\xce\x33\x69\x6a\x25\x4e\x5f\xf7\xd1\x6b\x9e\xbb\x26\xfa\x6e\x9e\xc1\x1d\xcb\x4d\x80\x35\x7b\xac\x4f\x15\xb9\xe4\x54\x3c\x90\xd0\xc6\x29\x37\x2f\x38\x5a\xf9\x23\x11\xdb\x5a\x56\x3d\x8a\x52\xde\x39\xf8\xa5\xf3\x5a\xf6\xde\x7d\xb2\xec\x03\x5b\xf6\xb5\x2d\x5b\x64\x24\xf0\xe2\xd7\x6f\xec\xeb\xca\x1c\x0c\xfe\xf0\x78\x16\x53\xec\x68\x91\x97\x26\xa3\x64\x44\x7a\xb8\x94\x65\xb2\x34\x75\x9c\x0b\x4e\x51\x6a\x48\xd4\x2f\x06\x14\x78\x4d\xbf\x98\x0c\xa8\xfe\xe3\x59\x44\x49\x2e\x4d\xc5\x30\x5e\x52\x6a\xc8\xae\x6c\x62\x13\xb1\x11\x99\xa8\x2f\x3b\x06\x94\x4d\xef\x5f\x73\xbb\x7a\x6e\xe6\xed\x23\x7e\x7b\xdb\x4a\x4a\xf1\x48\xd6\xca\x22\x3b\xd6\xe9\x7a\x34\xba\x2d\x45\x28\x2d\xbd\x2c\xfd\x23\x2a\xf3\x85\x7d\x7d\x87\xa3\x6e\xf2\x14\xdb\xc4\xa6\xe5\xa7\x8e\xfd\xcf\x2e\xae\x5b\xf9\xb0\x96\x84\x68\xe6\x42\xbe\x58\xac\xea\xe0\x58\xed\xc3\xb1\xcc\xc1\xb1\x0c\x53\xae\x59\x38\x56\xef\x83\x63\xb5\x8f\x74\x2b\x11\xdd\xc0\x12\x0a\xd2\x77\xa9\x6c\xaf\x21\xd3\xa9\x3a\x75\x22\x6d\xc5\x0b\x75\x45\xe0\x81\x7f\x92\xd7\xa4\xbf\x42\x66\x42\x28\x3b\x2e\xe4\x17\x4c\xce\x52\xe0\x9c\xc8\x7a\x0c\x75\x10\x78\xa9\x0a\x0b\x01\xf6\x2c\xa3\x36\xf6\x8e\x3a\x77\x01\x0e\x48\x75\x4c\x8d\x4e\x11\x54\xda\x7c\xc0\x77\x1e\x0e\x2d\x28\x9b\xd4\x0c\xf4\x13\xac\xca\x60\x8b\x32\xa3\x4e\x8a\x70\x6b\xf7\x75\x96\xa1\x29\x67\x57\x67\x36\x6d\x34\x40\x0a\x58\x79\x33\xd5\xdb\xb7\x16\xde\x60\xc0\x4e\x33\x06\xd2\x87\xde\x61\x93\x1b\x2b\x63\xde\xd1\x8e\xf7\x1f\xb4\xb7\xbc\x3d\x5c\x99\x23\xde\x02\x12\x6b\xd1\x32\xd1\xd7\x32\xde\x66\x5e\x24\xe5\x22\xeb\x0c\x39\xad\x29\x02\xa6\x6d\x63\x0c\x2e\xd9\x9c\x72\xa4\xe5\x7a\x80\x59\x72\x33\x41\x75\xc7\x53\xfc\x60\x9a\xd0\x5a\xe8\xb9\x1c\x21\x3c\x11\xf6\x76\x99\xd3\x71\x1b\xd9\x25\x7a\xc4\xcf\x38\xbf\x2f\xc2\xb4\x83\x83\x07\xa4\x60\xb1\xf9\xa2\x01\x8e\xf4\xf9\x03\x81\xcb\x6b\xdc\x28\x8e\xcd\xc5\xfb\x87\x2f\x8e\x74\x9c\x01\x17\x03\x39\x9f\x54\x37\x37\x40\xf8\xf6\x15\x86\xf8\xea\x03\xe2\xed\xa7\x51\x39\x71\x91\xfa\x4c\xf4\x82\xa3\xc9\xe0\xce\x30\xe5\xe8\xd3\x80\x04\xe7\x1b\x9b\xb5\xcd\xec\x3a\x6e\xcd\x3a\x66\x77\x5f\xc7\x6d\x73\x1d\x9b\x1b\x4c\x8f\xd7\xcd\x8d\xbd\xfb\x74\x3c\xc2\xc1\x25\xa6\xe5\x04\xe6\xcb\xe6\x48\xbb\x26\xdb\x75\x66\xed\x9c\x2c\x79\xe2\x96\x44\x98\x1d\x66\x0d\x7f\x3f\xa6\xbd\x84\xf5\x60\x76\x2b\xd3\xb1\xca\xda\xb2\x14\x45\x11\x18\xa0\xc3\x4c\x72\x64\xbe\xd4\x46\x0d\x9b\xa2\x84\x7c\xea\xf4\x24\x75\x97\x35\xcd\x22\xf3\xf9\x9b\x3d\x9c\xca\xcf\xfb\xf8\x88\xdb\x78\x99\xe7\x72\xb7\xa2\x4e\x3a\xd6\x31\x23\x4f\x8c\xce\xc6\x49\x54\x1d\x09\x28\xa4\x11\x89\x44\x19\x73\x5e\xc7\xee\x31\xd7\x93\x4d\x16\xaf\xb3\xbd\xfc\x52\x9b\x03\x3a\x12\x56\x73\x7a\x87\x6c\x56\x6a\xef\xaa\xaf\x5b\x5f\x25\x9d\x7c\x9e\xf9\xf6\x09\x3a\xd9\x80\x90\x88\xb5\x06\x33\x6e\x0a\x8c\x24\x06\x32\x69\xfa\xe5\x58\x00\x52\xeb\x83\x2e\xb2\x8c\x84\x26\xb2\x5f\x47\x73\x7a\xbb\x74\xa4\x14\x6c\xce\xb0\x94\x63\xcb\xd5\x3b\x26\x61\x50\x76\x09\x64\x90\x1d\xd3\x42\x29\x63\xea\xdc\x82\xe6\x98\xc0\xb0\x78\x58\x71\xc6\xc2\xe6\x30\x50\xe7\x2e\x3d\x87\x6b\x0c\x1f\x84\xae\x0e\x95\xd6\x15\x74\x31\xbf\xb5\x93\xda\x72\xe0\x16\x8c\xda\x66\x66\xaf\x10\x07\xdb\xa7\x2a\x7a\x40\x9e\x11\x5e\x5b\xe4\x33\x8f\xc3\xae\x9c\x77\x38\x92\x3e\x42\xc6\x16\x95\x1d\xd9\xb6\x75\x7d\xd4\x9c\x8c\x1b\xcb\x73\x97\xf6\xef\x24\xa5\xfb\x54\xc7\x7f\x8e\xd7\x17\xa6\x83\xe2\x08\x5a\xfb\x38\xdd\x2e\x9b\x7d\xe8\xad\xc9\xc5\xb8\x23\x4f\x32\xb9\x7f\x3f\x6c\x3d\x14\xa9\x75\x49\x44\x84\x78\xe5\x2a\x6b\x5a\xeb\x89\x6b\xd0\xea\xbc\x43\x28\x6c\x3a\xa2\x00\x37\x19\x3d\xbe\x27\x96\x07\x8a\x2c\xd2\x41\x16\x49\xd1\x30\x91\xdc\xd9\xe8\xf7\xad\x2a\xaf\xde\xa9\x95\xc2\xfc\xbb\x42\x76\x5c\xee\x3a\x65\xc7\xa7\x59\xa7\xd1\x8a\x94\x96\x7b\x23\xf5\x60\x2e\x75\x29\x46\x9a\xf3\x48\x4b\x3b\x52\x8c\x45\x99\x22\xc5\x4e\xc9\x76\x4c\xe4\xaf\x0a\x4a\x78\xc3\x24\xe1\x3d\x30\xa1\x1c\x8b\xd1\x11\x47\x74\x9d\xd2\x71\x4a\xf0\x5d\x87\x29\x3e\x4e\xa5\x5f\xc3\x45\xd6\x30\xf6\x69\x9c\x53\x1e\x97\x31\xd6\x33\x4f\x7f\x5a\xc8\x7d\x29\x91\xda\xb3\xcd\x71\x8d\x84\x25\xfd\x4d\x56\x29\x0e\x5d\x58\xfb\x35\xf0\x17\x5a\x0b\x54\x34\x4f\x1d\xb6\x27\x5d\xa3\xfd\xf0\xe8\x94\x95\x5f\x24\x1e\xfa\x76\xc0\x06\x62\xd6\xbf\xcf\xe0\xea\xa7\x84\xab\x01\xf2\x58\xb7\xfd\x41\xb0\xde\xae\xea\x6c\xb3\x52\x41\x38\xc9\x74\x4a\xf5\x4e\xee\x9e\x75\xe6\x14\x3f\x19\x63\xe3\xb2\x5d\x91\x36\xf0\x12\xaa\xea\x4c\x0c\xc6\xe9\xec\xa8\x5f\x23\x45\x91\x26\x7b\x3e\x76\xe7\x89\x49\xec\x7e\x82\x1b\x62\x2e\x9d\xd9\x8c\xb6\x5b\x8e\x97\x1f\x96\x8b\x61\x16\x69\xb4\x54\xb3\xe9\x61\x7b\xe9\x0a\x3d\x4f\xaf\x7a\xa6\x99\xa0\xf3\xc2\xdd\x55\x04\x49\x9b\x9f\xbc\xdd\x0c\x9c\xc8\x27\xba\x15\xce\x7b\x22\xc1\x30\xfa\x4d\xd7\xb4\x46\x7e\x77\x95\x21\x3e\x72\x27\x62\xfc\x3f\x95\x05\xf6\xb5\x3e\xf1\x5c\x1b\xf9\xb5\xc5\x57\x7b\xb4\x94\x9f\x94\x84\x09\xbb\x3d\x71\xa7\xac\xae\xd8\xf6\x83\x21\x19\x83\x60\xe8\x5e\x60\x7a\x32\x61\x7d\xe7\xa8\x12\x71\x15\xcd\x48\x19\x94\xb8\xec\x9e\x2d\x22\x44\x0c\xe3\x75\x17\x18\xb7\x27\x4a\x07\x43\xb3\x8d\xca\xfb\x6e\x1d\xab\x3d\x38\x4f\xa4\x84\x35\x90\xc0\x2d\xc6\xa0\x20\xc5\xb9\xd2\x90\x3d\x1c\x77\x2a\x62\x9a\xe7\xa1\xeb\x18\xa9\xc6\x51\x51\xb7\x20\xb6\xbb\x81\x9e\x8e\xe3\x26\x10\xcb\x1b\xb1\x34\x6e\x8a\x96\xc2\x9d\x0c\xe8\xcc\xb8\x89\x86\x9c\x86\xd4\x4d\x56\x51\xb0\xd4\x96\x61\x9c\xd8\x78\x1f\x4b\x3c\x6b\x82\x59\xec\x4f\x98\x95\x65\x4d\x05\x64\x2a\x22\x3f\x35\x5e\x00\xd0\x9c\xb4\x5f\x69\xc3\xd3\xe6\x90\x7e\xf3\x49\xf4\xd1\xb6\x52\xc6\x5a\x03\x1d\xe0\xe4\x91\xf0\xf5\x9b\xc2\x1c\x39\xf6\x3f\x38\xdb\xa9\x71\xaa\xad\x18\xf4\x41\xc6\x78\x53\xd9\x3c\x30\x3a\x7b\x0d\xa9\xc5\x75\x93\x5e\x69\x74\x83\x19\xa1\xb8\x22\x53\x5f\x9e\xac\xed\x49\x3a\x1a\xc4\x8a\xf2\x52\x8c\x8c\x25\x94\xa9\xfb\x67\x2e\xec\xab\x4c\xc6\x4d\xb1\x13\x25\xb3\x5f\xbc\x39\x47\x82\xef\xb6\x52\x49\x0a\x76\xc7\xca\xcc\xaf\x97\x13\x7c\x6c\x2d\xfe\x93\xd6\xb6\x52\x85\x6c\xc6\x63\xd3\xbe\xcd\x89\x8d\x2d\xe4\x0c\x46\xb4\xfd\x78\x54\xad\xb5\x64\x9e\x83\x7e\x69\xaf\x95\xea\xcb\xa2\x58\xa9\x34\x4f\xae\x4c\xae\x3a\xb3\x3a\x94\x26\x8f\xd7\xba\x15\x18\x50\x7f\xd1\xc4\x72\xc3\xcc\x49\x0e\xa8\xa9\x49\xf5\xb6\xdf\xb0\xeb\x52\x6c\xcd\x97\x78\xbf\xee\xcd\xd7\x12\xec\x39\x65\x7a\x6e\x36\x60\x50\x51\x26\xc5\x5a\x72\x74\x30\x6d\xb8\xe6\x68\x89\x97\xf4\xfb\xad\xc2\x46\xef\x2c\xdf\x59\xcb\x2b\xd6\x77\xea\x03\x01\x18\x91\xc2\x7b\xc7\xee\xa5\x18\x99\x5d\xdc\x49\xff\x81\x99\x1a\xa5\xf3\x15\x86\x8a\x59\x87\x55\x4d\xc3\xa8\xa6\xdb\x16\xe6\x5b\x81\xc9\xec\xd1\x45\xc4\x43\x9b\x47\x01\x2e\x3e\xbc\x7d\x8e\xc0\x46\x3e\x23\x91\x91\x8e\x96\xf5\x7a\x05\x17\x4c\x25\xb3\xf5\xb1\xdd\xef\xa9\x56\x60\xe2\x6f\x47\x92\xa0\xbb\xa1\xb9\x1c\x26\x19\x2d\x3a\xee\xa3\x82\x7a\xe0\xcf\x52\xd7\xcf\xaa\xf7\x64\x51\x64\x15\x40\x02\x4e\x90\x45\x92\xbb\x89\x74\xed\x5a\xd7\xcd\x01\x81\x7d\x68\x9d\xa3\xf6\x49\x3e\xe3\x7b\x1f\x36\x21\x28\xe6\x89\xe4\x89\x58\x80\x37\xa5\x5a\x64\x97\x14\xaf\x48\x9f\xb3\xa4\xf6\x76\x99\xe2\xcf\x9a\x75\xc7\xeb\x6a\xcc\xd0\x90\xdb\xc3\x00\x70\x4c\x3c\x1a\xcc\x6f\x0e\x39\x03\x13\x8a\x27\x00\xc7\x3d\xed\xfc\x8e\xcb\xe1\x35\x66\xf5\xde\x5e\x51\x9f\xbe\xd4\xc1\x3b\xd4\x3c\xab\xf1\xb0\x06\x3a\x3e\x43\x40\xf1\x63\x78\x01\x03\x0a\xa9\x1a\x34\xdb\xe1\x21\x19\xf5\x39\x55\x48\xae\x31\x65\x6b\x29\x69\x9a\x9b\x1b\xb4\xb4\x5a\xa0\xad\xed\x2e\x70\xfe\x4b\x66\x47\x2d\x43\x33\x51\x40\x58\x70\x0a\xb7\x6b\x0d\xc4\x7c\xa7\x26\xe0\x84\x29\xf8\xb5\x70\xc2\xe1\x2d\xd4\xfd\x43\xe5\x44\x7a\x31\x58\x06\x88\x43\x5c\x71\x0a\x53\x8c\xbe\xab\x86\xf7\x92\xe0\xde\xf0\x65\x86\xa1\x8a\x30\x8b\xbd\xcc\x89\xf9\xb2\x29\xcf\xd2\x66\xc3\x07\x68\x48\xdc\x4c\xbb\x40\xe9\xf0\x83\x03\x0c\xec\xeb\xbd\xbe\x47\xaf\xff\xf6\x8f\xff\xf2\xb2\x2f\x7c\xc8\x06\x9f\xb8\x85\x56\x3e\xdf\xc6\x4b\x9f\xc6\x45\xc2\x62\xb1\x25\x94\x11\x27\xde\x19\xc1\x60\x2e\x1a\x20\xad\xac\x48\xa4\x0b\x31\xa1\x13\xb7\xfb\x68\x9c\x92\x85\x36\x25\x23\xc3\x75\x0a\x7c\x8a\x26\x0a\xe8\x84\x68\x9a\x98\x34\x5b\x8b\xf5\x5f\x28\x95\x94\xe1\xb5\xb2\xfc\x04\x4a\x82\xb5\xe0\xd7\x19\x03\xde\x3a\x09\x52\x93\x00\x73\x09\x20\xa6\xc4\x5c\xf4\x61\xe7\x38\x95\x1b\x27\x19\xd8\x5b\x3f\xf0\xdc\x0d\x32\x6f\x0e\x32\x37\x83\x74\xc3\x7b\x0b\x3c\xad\x74\x94\xfd\xb2\x0b\x14\x40\xc7\x04\x0a\x78\x80\xe8\x59\xa0\x70\x7d\x93\x3c\x49\x9a\x0b\x21\x6c\x08\xf7\xcc\x92\x08\x3c\x32\xae\x2a\x4d\x43\x51\x29\xa1\x57\xf7\x65\x36\x21\xdf\x6d\xa5\x3b\x14\xbe\x1d\x3c\x98\x30\x52\x80\xb0\x6c\xa3\xfa\xd8\x9a\x0a\x8e\xb5\xcd\x93\xae\x0f\xa3\x2a\x3b\x45\xf3\xa7\x8a\x16\x5e\xd3\x25\x9a\xa2\xcf\x4c\xca\x0d\x45\xee\xc3\x51\x5f\x39\xf0\x82\x94\xac\x36\xcd\xc1\x02\xde\x07\x33\x14\x03\x3e\x98\x5c\xd2\x7e\xd8\x68\x43\x02\x57\x04\x09\xf3\x65\x9a\xcf\x57\x40\x05\x63\x6c\x56\x00\x09\x5d\xcc\x18\x30\xc1\x50\xc1\xa8\x4c\x8c\xf9\xaf\xb0\x97\xf4\xb6\x88\x37\x1c\x27\x62\xa8\xa0\x12\x7e\xd3\xe6\x17\x86\xdd\x8e\xd2\x24\x33\x93\xe7\x4d\xcc\xf6\xec\x53\x85\x51\x4c\xb5\x17\x67\xe1\x1d\x55\xaa\x96\x14\x28\x76\x12\xbc\xd9\x20\x05\xc8\xf9\x58\x6f\xad\xdb\x64\xd4\x3e\xed\xf9\x72\xc4\x91\x9a\xbb\xbf\x16\x89\x34\xa3\xff\xce\xc2\x27\x5e\xe1\x55\x5a\x55\x84\x87\x73\x41\x76\x48\x8b\xcb\x4f\xdf\x82\x26\xb8\xca\xa7\x18\xd6\x65\x94\xc1\xad\x10\xe6\x94\x92\xf3\x13\xd7\x38\x92\x88\xda\xd9\x70\xb1\xb3\x5b\xc0\x89\x0d\x30\x5a\x2a\xee\xa0\x44\x68\x55\xf5\x1e\xb0\x64\xe0\x91\x32\x2f\x1a\x8c\x05\x0f\xcf\xe1\x47\xe2\xbb\xa9\x4d\x07\x19\x8d\xa9\x0f\xad\x86\x8d\xd3\x93\x48\xcd\xcd\x57\x77\x01\x04\x0d\x90\x9a\x37\xfa\x30\xef\xcd\x60\x72\x0c\x7c\x2b\x5d\x89\x7e\xb2\xfb\xd2\x1e\xd7\x71\x8b\x6a\x9a\x26\x9d\x9b\xf5\xcd\x6d\x23\xb5\xd4\x0b\x41\x76\x22\x6a\x4a\x4b\xc0\x78\xf4\x4a\x3e\xf1\x97\xfb\xd5\x3b\x0c\x28\x19\x0d\x2a\x40\xf3\xa1\xf4\x53\x89\x1b\xe4\x69\xd9\xa8\x38\xc8\x50\x0f\x14\x1b\xa6\x5c\xbc\x67\x7f\x5a\x4c\xb3\xd9\xf9\xb1\xd5\x4c\xbb\x6f\x61\xd2\xb6\xe7\xee\xea\x29\xba\x4b\x5c\xe9\x4b\x3c\xd6\x98\x17\xa8\x84\xe4\x74\x1d\x3b\x3a\x32\x9b\x08\xb1\x5e\x69\xc8\x31\xf1\x19\x70\xe5\x7e\xc9\xdd\x66\x1d\xcf\xa1\x35\x4b\xbd\xd9\x36\xa0\xda\xc7\x75\xcc\xf9\x12\x9a\xf5\x5b\x94\x19\x14\xbe\x84\x56\x2c\x35\x66\x86\x41\x58\xc2\xaf\x8b\x4c\x54\x80\xb6\xa6\xc1\x22\xe3\x78\xc2\xf5\xe4\x59\x1a\x07\x65\x3a\xcf\x8a\x80\x4f\x7c\xe9\x20\xb7\xa5\xf6\xec\x3b\x1e\xdd\xf9\x3a\xfe\xb8\xb6\x7b\xc9\x44\xb7\x28\x0d\x8b\x62\x1b\x64\x6e\x11\x86\xb8\x86\x21\x12\x80\x39\x2d\x2e\xdd\x07\xba\xb4\x67\xd0\x96\x8b\x70\x9c\x61\x8e\xc2\xf6\x0d\x86\x26\x2e\xb0\x09\x04\x3f\x48\xc7\x66\x37\x37\x55\x83\xe1\xa8\xf6\x30\x1c\x93\x26\x33\x40\xbd\x3e\x85\x5e\x55\x72\xb5\xc6\xb0\x6a\xf0\x78\xb2\x0e\x25\x89\x9f\xa8\x7d\x04\x7f\x5d\x4a\xc3\x27\x05\x07\xe0\xfc\x0c\x88\xc8\x79\x76\x1e\x18\xd9\xcb\xe3\xb5\x00\x0c\xc1\x17\x14\x96\x92\x03\x2e\x3d\xc4\x48\xb3\xf0\xf4\x30\x88\xbe\x1d\x40\x31\xa7\x39\x41\xc2\xd5\xc9\x42\xa4\xcd\x59\xd9\xf0\x54\x75\xd4\x4b\x6e\x50\x02\x6a\x39\x89\xd0\x46\xa2\x82\x68\x7e\x6b\x5f\xa3\x7f\x08\x86\x3e\x2f\x5d\x76\x79\xeb\x2a\x49\xed\x04\xf0\x72\xed\x04\xd4\x61\x3b\x1e\x51\x56\x1a\xa0\x63\xc1\x97\x39\x19\x0d\x9c\xea\x8c\x5a\x4a\xeb\x0f\xf1\x7c\xed\x69\x38\x13\xbf\xa1\x7d\x66\xbb\xad\x9e\x8c\x1d\xaf\x64\xc8\x6d\x00\x2c\x04\x93\x32\x80\x52\xe9\x43\xf8\x66\x63\x1a\x9b\x8c\x81\x19\xac\xb3\xb5\x2a\xb6\x70\x03\x66\x00\x9a\xca\xf7\xfc\x34\x70\x1f\xc8\x57\x4d\xff\x4e\xe0\x68\x9a\x12\xc2\xb8\xbb\x16\xa2\x1a\x33\x59\xe5\xd5\xd3\xd2\x15\x17\x8a\x79\xb4\x4a\xff\xb8\x92\x66\x1d\xa5\x67\x03\x94\xd4\x43\x35\xb4\xe6\x1d\x3f\xac\xd9\x9a\x85\xfe\x62\xdc\x73\x61\x7b\x51\x7a\x1c\x8a\xc5\xeb\xc2\x9e\xc0\x2f\x71\x2b\xf5\x27\x34\xf9\x65\x87\xed\x3c\x1c\x10\x77\x56\x9d\xed\x89\x23\xf9\x1c\x95\x67\x22\xde\x93\xd4\x55\xbf\x4c\x38\x42\xe6\xe0\x93\x4c\xe7\xcd\x4d\x31\x6a\xbc\x84\xed\x29\x07\xad\xb7\x18\xf2\x8a\xde\x33\x82\x9b\xe4\xc9\x4b\x09\x12\x6b\x1d\xf8\x5e\x25\xcd\x2e\x35\x44\x74\x00\x0b\x99\x6d\x09\xa7\x28\x5c\x3e\x35\xcd\xa2\xa9\x92\x1f\x0c\xa1\x89\x29\xab\xcb\x83\x83\xf5\x20\x78\xd4\xa3\x36\x74\xa2\x11\xcc\xf5\x49\x11\xce\xea\x8b\xe2\x10\xc0\x63\x4f\x4f\xbd\x87\x97\xa8\xae\x28\x0e\xab\x4b\x0f\xc3\xb9\x6f\xe1\xb5\x6e\x56\x24\x8e\x39\xa4\x68\x41\x18\xfc\x29\x88\xae\xf5\xe1\xd5\xe6\x94\x18\x4b\x1a\x66\x2a\xc6\x3e\xf9\x6c\x1d\xff\xbc\x8e\x4b\x22\xba\xe5\xfb\x2f\xd7\xf1\x8b\x35\xa0\x5f\x0b\xf7\x55\x73\xbd\xd4\x79\x2c\x5e\x70\x03\xd6\xfb\x96\x56\xa5\xc4\x9b\xcd\xcd\xe5\xe7\xf1\xb7\xd4\x5c\x5b\xa7\xe5\xb7\x7a\x8b\xda\xea\xc7\x75\xfc\x53\x03\xf9\x75\xb4\x41\x03\x81\xbe\x30\x10\xf8\x80\x8d\xee\x50\xc1\x15\x22\xb4\xd7\x70\x60\x52\xb5\x64\x96\xb3\xd2\x8f\x95\x58\x0b\xb9\x9b\x30\x32\x69\xc0\x06\x1c\xaa\xbe\xc9\xe3\xda\x88\xe2\x96\x29\x85\x7d\x50\x00\xce\x19\x8f\xac\xe8\x69\x10\xc6\x7d\xc3\xa8\x8b\x22\xfa\x8d\xb5\x53\x90\x61\x94\x68\x4c\x3f\x6c\x9d\x4f\x5f\xd4\xec\x35\xba\xa6\xf0\x56\x7e\x6a\xc9\x79\xd9\x90\xba\xa7\xf4\x5e\x50\x36\x3a\x5d\x89\x30\xdd\x28\x3b\xec\x08\x85\x75\x8b\x95\xd9\xb6\x65\xb5\x9d\x62\xd5\x4e\x59\x2a\xe1\x1b\xa5\xed\x11\x5c\x72\x99\xbf\x53\x06\x85\xd5\xe0\xde\x6b\xcc\x74\x6b\xbd\xf4\x31\xf8\xdd\xe5\xd5\x21\x87\xef\xea\xad\xd3\x2b\x1b\xd0\x1f\x6a\x60\xd8\xab\xf9\x0c\xe0\x44\x15\xf5\xbe\xf8\x8f\x0a\xee\xc8\xe1\x7f\x54\x49\x30\x1a\x8d\x82\xfb\x0f\x4d\xcc\x51\x4a\xe3\x73\x4f\x4b\x19\x23\x2b\x46\xe6\xf4\x3e\x09\x1b\x84\xac\xd1\x79\x3c\x1f\xa5\x23\xed\x80\x61\x82\xc2\x8d\xd6\x9e\x35\xbe\xb3\x7c\x59\xe4\xc9\xcf\x73\xcc\x3e\x31\xaa\x6c\x4a\xb2\xf0\x6e\x8b\x50\x25\x8d\x8d\x2c\xd5\xe2\xad\x76\x22\xa4\x0e\xba\xec\xae\x1d\xe1\x60\xc2\xfa\xe6\xc9\xf6\xdc\xa6\x38\x9a\x14\x76\x88\xe8\xf4\xcf\xc7\xe1\xf5\xe9\x6f\x70\xc7\xe2\xfe\x03\x8c\xc5\x90\xe5\x1c\x75\x3f\xc6\x38\xf8\x13\x0a\x86\xaf\xb3\xcb\x8f\x80\x6e\x3e\x9e\xee\xe2\xc2\x1b\x08\xeb\xcb\xbf\x43\x8f\x99\x3a\xea\xd0\x10\x17\xce\x73\x19\xdd\x01\x9d\x77\xc5\x22\x2b\x81\x68\xe3\xc0\xf6\x39\xa6\x44\xc9\xa3\x96\x30\x3e\x63\x6d\x72\x53\x96\x9e\xdd\x26\x45\x37\xe1\x54\x68\x20\x64\x6f\x39\x37\x96\x2f\x57\xb0\xb3\xd9\xec\x4d\x5a\xa6\xeb\xaa\xdd\x2a\x14\xdc\xdf\xae\x39\xd2\xde\xe8\xcf\x4a\xcc\xe0\x93\x5a\x21\xb2\x6e\x78\x94\x8a\x32\xe7\xa5\x64\x0c\xd6\x3e\xb2\xd3\xa9\x7b\x6c\x7f\xce\xce\xda\x2e\x81\x66\xb5\xf8\xa0\x4d\x5b\x2a\xee\x7b\x9e\x8a\x9b\x12\x4e\x71\x9c\x4c\x75\x49\x10\xa3\x36\xb9\xa8\x06\xc1\xbd\xa1\x68\x69\x78\x2f\x08\xef\x85\xc6\xf7\xc8\x9c\x09\x5f\xcd\xdf\x34\xb5\x16\xee\x4c\x9e\xde\x96\x13\xea\x88\xa3\xa4\x53\x6b\xb1\x8c\x42\x9c\x29\x69\x19\x8d\x46\x4c\xa5\x31\x62\xca\x92\xec\xd8\x2b\x79\x5c\x4d\xa7\x2c\x41\x40\xe3\x03\x9f\x66\xcb\xad\x7e\x1e\xa3\x01\x7c\xd8\x8e\x54\xfe\xfb\x56\x6d\x95\x89\x2f\xa3\xef\x9d\x0e\x01\x49\xd9\x26\x30\xda\xab\xb7\x8e\xfa\x23\x66\xe5\xc1\x16\xe6\x4a\xb7\x20\x4d\xcc\xfe\x04\x38\xc5\x18\x93\x55\x2c\x0e\x81\x84\xaf\x67\xa5\xd0\x9b\xb5\xce\x21\xb5\xa7\x43\xff\x00\x5a\x68\x45\x6f\x65\x0e\xc6\xe4\x0e\x1e\x1c\x45\x46\xc5\x76\xf8\x39\x1c\xf5\x3f\x33\x3c\x25\x07\x75\xd5\xa4\xba\x30\x00\x9e\xf2\x49\x58\x92\xaa\x32\x40\x3d\x66\x3f\xfc\x69\xc4\xf1\x49\xf2\x3d\x4a\x30\x0a\x0c\x5a\x42\x09\x7b\xe9\x33\xca\x21\x84\x37\x61\x70\x8d\x8c\x2e\x2d\x52\x54\x94\xd9\x59\x96\xa7\x2b\x18\x1a\xc1\xf5\xb8\x8c\xf4\x11\x8c\x95\x14\xe2\x47\xda\xb6\x38\xee\x24\xde\x6d\x51\x2f\x40\xc7\x69\xd9\xd2\x5a\x03\x3c\x77\xe2\x67\x0d\xd0\xd9\x7c\x24\x39\x1b\x98\x88\x8f\x0e\x55\x61\xb4\x15\x7a\x98\x6d\xab\xba\x58\x27\xb5\x93\x7b\x44\x17\xa5\x17\x16\xb7\x3f\x08\xe0\xb2\x0e\x39\xee\xa5\x29\x74\x73\x73\x01\xb3\x2a\x2e\x80\x63\xb5\x1f\xf9\xcd\xcd\x4d\x9d\x86\x9e\x60\x6d\x81\xe9\x95\x6e\x6e\xce\x07\x4f\x52\x38\x8d\x3c\x16\x20\x17\xdd\x5a\xb1\x0e\xdc\xd8\xc7\x91\x4c\x28\x9d\xcf\x29\xef\x91\xa1\x09\xa0\xe2\xf2\x1c\x5d\x29\x76\x4d\x6a\x01\x2d\x39\x85\x15\x93\x24\x5a\x56\xe7\x6c\x5e\x44\x7f\x13\x69\x5a\x6a\x82\xfa\x79\x72\xf9\xb1\xb1\xe9\xd6\x96\x85\x91\x5d\xfc\x24\xf7\x76\xcc\xd9\x83\xe7\x5d\x7b\x96\x3b\xe6\x2a\xb7\x67\x6b\xca\x07\x84\xcc\x57\x69\x3c\xc2\x24\xaa\x6c\x05\xd0\x00\xb0\x44\x61\x9d\xa5\xee\x50\x86\xd8\xe9\x89\x8b\x3e\x31\xd6\x65\x7b\x94\xb6\x2e\x5c\x82\x87\xd3\x0c\x5e\x25\x5f\x95\xb6\x8a\xd8\xe1\x17\x18\xc7\x40\x3c\x86\x42\x85\xea\xdd\x72\x5b\xce\x7b\xeb\x45\x21\xf8\x58\x4a\x31\x68\x43\xe5\x85\x47\xaf\x43\x59\x62\x16\xd0\x2a\x3a\x59\xd4\x34\x10\x2b\xa0\x55\x97\x02\x2c\x84\x9a\x86\x34\x74\xa6\x30\xbb\x2a\x85\xca\xd6\xd2\x09\xe8\x20\x27\x2c\xaf\x9a\xd4\xa2\x5e\xe8\x4f\xac\xe4\xcf\x9f\x5c\x48\x49\x7a\x5a\x85\xd4\xde\xe5\xec\x00\xa4\xd6\x4f\x58\xa6\x4d\xb7\x8b\xca\x77\x79\x22\x7e\x0b\xce\xd1\xdd\x34\x2d\xfa\xf3\xef\x97\x5d\x5f\x7d\xcf\xa2\xf6\x3d\x13\x76\xcd\xef\xca\x0e\x7b\x6f\x0a\x64\x66\x24\xa8\xf4\xf0\x09\x37\xee\xc7\x25\xc5\xc6\x30\x65\x8f\x2c\x61\xf3\xc9\xf8\x10\x8f\xdb\x0c\xf5\xd8\x8a\x5d\x39\xc0\xd7\xb7\x03\x67\x04\x18\xb5\x99\x28\xa3\x26\xc8\x6e\x61\xaf\x44\x14\x1a\xa0\x89\xf7\xbb\x6d\x46\x25\x75\x6e\xf5\x5e\x4d\xa5\xb8\x33\x8c\x48\xb2\xc9\xeb\x92\x42\xd3\xc4\xa8\xbb\x8a\x4a\x62\xc8\x28\xd0\x87\x53\x89\x95\x98\x20\x15\xb5\x6f\x1c\xcc\x1c\xff\x48\x75\x9e\x90\x01\x48\x83\x43\x8a\xd0\xf3\x41\x4b\xda\x28\x81\xdc\x27\x0b\x09\x0b\xb1\xbb\xc9\xc7\x60\xc8\x61\x5b\x16\xf6\xc6\x5c\x29\x3d\xca\xe4\x59\x69\xa3\x7b\xb9\x89\x91\x40\x83\x7f\x9a\x7d\xd1\x2e\x1d\xa8\x4c\x4a\x49\x5a\xcf\xf9\xeb\xec\xaa\xc3\x0b\x63\x76\xa1\xda\x49\xd2\x83\x79\x56\x21\x2f\x3a\x47\x11\xc7\xc0\x96\x85\x5a\x0b\x4c\xe1\xa9\x17\x0c\x83\x6a\x2b\xa4\x5f\x9d\x4d\x84\x19\x45\xe7\x81\xb0\xea\x60\x6c\xd0\x34\x22\x56\xea\x7d\xc9\x36\xfb\x66\x52\xcf\x5b\xb3\xf2\x22\x82\x3c\xa3\xe2\xb8\x7c\x21\x87\x36\x6c\x71\xfb\x2e\x8a\x55\x2b\xa6\xa1\x30\x0b\xeb\x12\x20\x45\x29\xdf\x3b\x32\x25\xfa\x71\xd9\x1d\xfd\xa0\xcd\x73\x69\x7a\xd8\x06\x2d\xaa\x45\xd0\x22\x57\x3f\x71\xee\xa4\xb8\xfa\x2e\xda\x91\x65\x76\x91\x6a\x75\x34\x99\xf6\x4d\xd6\x98\xd3\x40\x57\x76\x98\x12\xbc\x6e\xb9\xa6\x9c\x7d\x91\x0e\xf0\xdd\xd0\xc4\xbf\x61\x38\x84\xb4\xac\x5c\x27\x57\x80\x61\x8b\x5e\x68\xcb\x80\x32\x7c\x48\xde\x95\x50\xcd\x4a\x5f\x9b\x95\xf0\x83\x0e\x93\x53\x51\xb6\xc3\x34\xc9\xd7\xb6\x3b\x86\xcc\x56\x1c\x97\xbc\x31\x9f\x46\xa9\xfe\x08\x28\x7f\x4e\x11\xb4\xd3\x95\x4b\xc6\x98\xbc\xb5\xe5\xd6\x44\x04\xda\xde\xf6\x1a\x49\xe5\x26\x38\x41\x26\x58\x2f\xdf\x99\x3c\x9a\x55\xd5\xf3\x79\xc5\xc1\x4f\xc9\x49\x22\xd3\x71\x7e\xa2\x54\xff\xc0\xec\x3e\x47\x93\x02\xfe\x89\xe5\xab\x07\xe8\x00\xe8\x02\x07\xe9\x0f\x85\x56\x8b\xa5\x7f\x5c\x25\x29\xfd\x89\x0a\x8e\x4b\x7b\xee\x66\xe9\x3c\xbe\xb5\x7c\xb1\x94\xdc\xf9\xe8\xc4\x0b\x34\x54\x1d\x7b\x86\x28\x53\x03\xf5\xef\x50\x94\xd6\xbe\xb2\x89\x82\x30\xfb\xa6\xe9\x99\x50\xfd\xd7\x24\x02\x07\xee\xff\xc2\x0e\xee\x9c\x8a\x15\xa6\xd8\x5c\xcd\x8a\x92\xcc\xc2\x70\x71\x1f\xd9\x62\x45\xe8\x4c\xc7\x4a\xd4\x18\xd7\x0f\x80\xe3\x1e\xd5\x47\xfc\xb6\xd8\x7a\x6f\x3f\x17\xa6\x91\xdd\x04\x58\x9b\xfa\xd2\x2e\x38\xc6\xd2\xe6\x16\xd3\x4c\x41\x5e\xdd\x72\x7c\x4c\x4c\x94\xae\x6f\xad\x16\xbc\xd5\x31\x76\x02\xf2\x5d\xab\x86\x5d\x28\x47\x9a\x99\x37\x0d\x1f\x2a\x5b\xc0\xbc\x08\xcd\xe6\xaf\x00\x8b\x7d\xb7\x55\x65\xa6\x2a\xed\x54\xe0\x78\x88\xcc\x3a\x18\xb0\xb1\x01\xba\x6b\x8e\x4e\xd6\xe9\x47\xf5\x04\x31\xfa\x40\x10\xf4\xce\x17\xc8\xa3\xee\xe0\xd0\x7d\x1c\x94\x51\xa0\x0f\x08\x30\x28\x9e\x6a\xf9\x55\x29\xec\xbd\xa5\xb8\x7c\x74\x91\xcd\x31\xe7\xb1\xf7\x6e\xa9\xb2\xb3\x65\x4d\x16\xba\xdd\x3c\x44\xb0\x2a\xd2\x79\x10\x89\xe4\x23\x2e\x18\x9d\x8f\x0c\xa8\xf9\x4e\xea\x80\x3b\x09\xc2\xb1\x1f\xa1\x8e\x3a\xac\x3a\xda\x88\xf2\xa6\x87\x40\x47\x51\xdd\x68\x44\xbe\x02\x7b\x49\x34\x33\x7c\x62\x83\x22\xcf\xc2\xfb\x89\xc6\x4a\x7b\xe6\x4d\x89\x73\x83\xe8\xdb\xd2\xaf\xf5\x5c\xd6\xea\xec\x71\x4f\xc5\x6f\xf7\x29\x84\x48\x40\x39\x46\x92\xaf\x4e\xcb\x9a\xbd\xa1\x05\xdc\xb5\x27\xf4\x7b\x38\x80\x6b\x94\x6a\x78\xf1\x66\xbf\xd7\xe3\xe9\xb0\x22\x71\x8e\xb7\x14\xa3\x4e\xdb\x8a\x8a\xba\x2f\xdb\xe2\xda\xfd\x72\xda\xcc\x93\xd4\x66\xd5\x73\x06\x18\x11\x3a\xda\xe7\x80\xee\xa2\x76\x86\x99\x12\x40\x5c\x87\x40\xad\x6c\xc0\x72\x72\x24\xf7\x02\xfe\x56\xc2\xf4\x8c\xd2\x1e\x38\x48\xcb\x18\x11\xa9\x9f\x89\x14\xae\xc1\x73\xac\x46\x73\x2b\xab\xf7\x3a\x54\x46\x82\x27\xbb\x94\xb5\x1b\xd2\x16\xd1\xbf\x2e\x77\xb2\xc8\x51\x24\x50\x97\x69\x5e\x99\x94\x10\x98\x3c\xc0\x7e\xd5\xac\x3a\x5c\x4b\x57\x06\xf9\x75\xab\x38\x31\xeb\xb8\x93\x24\xe4\x07\x4f\x5d\x20\x2c\xe6\xf0\xac\xa8\xb3\xd1\x32\x9b\xcf\x95\x4b\x5e\x75\xfc\xd3\xb9\x60\x81\xff\xf0\x0b\x49\xea\xf2\xcb\xc6\x27\xc1\x85\xfc\x5e\xfa\x16\x5e\x7e\x6c\x9d\xe4\x36\x46\xb3\xad\xc4\x62\xdd\xb9\x85\xd6\x9c\x45\xc3\x41\x8a\x12\x10\x02\x1c\x81\x7e\x89\x21\xf6\xf4\x89\x39\x38\xf8\x8c\x31\x47\x24\x28\x7e\xb7\x66\x9c\x05\x16\xd3\xaa\xa4\x79\x7a\x46\x51\xf1\xf0\x8a\x91\xa3\x3c\xe2\xe8\x9d\x5d\x71\xc1\x59\x9e\x2c\x64\x62\x17\x3e\xa5\xc4\xfc\x4e\x6d\xbe\x53\xb7\xd9\x26\x7b\x93\x37\x66\x79\xa3\xf4\xf8\xae\x91\x27\xa9\x3a\xa6\x8b\x8c\x48\x4b\x83\x68\x57\xa2\x65\x23\x62\x3e\x89\x1e\xbe\xeb\xda\x03\x19\x44\xca\xed\xc7\xcf\xa5\x56\x5a\x0b\x4f\x0b\x72\xfa\xb2\xac\x67\xdd\xcd\xff\x31\x39\x24\xec\xfd\x1b\x73\x01\x76\x26\x3d\xd4\xeb\x7f\x08\x85\x83\x5b\x5a\xd9\x97\x27\xf6\x9a\x4c\x0b\x76\x81\x0d\xaf\xde\xc3\x2c\x7f\x97\xa9\x90\x8b\x05\x66\x8f\x6d\xec\xd5\x6b\x82\x77\x4c\xb3\x19\xe1\x5e\xba\xed\x32\xab\x87\x49\x93\x1c\x23\xb6\xa9\x0d\xe9\x12\x97\xbb\x5d\x8b\xf6\xbc\x3d\xd2\x8c\xd6\x89\x39\x37\xe8\xdb\x89\x87\xbb\xb5\xe1\xc8\x00\x99\x33\xdb\xee\xcb\x44\x86\x02\x4a\x16\x67\x42\x4c\xc3\x51\x11\x64\x1b\x0e\xf6\x59\x29\x82\x30\xc2\xd5\x9e\xf6\xcf\x52\x69\xad\xb2\xa7\xa3\xd5\x99\x24\xef\x9b\x31\xa8\xfc\xc6\x24\xe5\xaa\x0f\xca\xa7\x2c\x7e\xf6\xb6\x26\x24\x25\x2d\x87\x55\x61\xd5\xaf\x23\x69\xe9\x61\x09\xb5\xbd\x78\xb4\xc5\xba\x24\x5b\x86\xd0\x96\x15\xfe\x34\xad\x77\xdb\x06\xe3\xfe\x76\x33\x5a\x5f\x9b\xab\x18\x64\xeb\x33\x7f\x0b\x5e\xe9\x4f\x71\x9b\x95\x9a\x3c\xb1\xdf\x7c\xb3\x75\xfc\x78\x73\xe3\xab\xa1\x6d\x25\x37\x73\x9d\x10\x4f\xe4\x8e\xa1\xd7\x7c\x97\xba\x07\x3a\x68\xd5\x7e\x67\x24\x21\x12\x1a\x68\x36\xbd\x45\xf6\xfa\xcf\xa3\x45\x4e\xde\x3f\xfb\xe4\x51\x99\x28\x0a\xff\xa3\x34\x1b\x60\xbe\x7c\x8b\x89\x84\x06\x48\x7c\x61\x04\x20\x01\xea\xab\xa7\x39\x89\x22\x4c\x62\x0b\xc6\x0e\x03\x0e\x0d\x32\x3f\xd3\xae\x3b\xf6\x13\xd5\x87\x81\x98\xe0\x2c\xef\x6d\x43\x48\xbb\x7f\x3a\xf0\x61\x6e\xe8\x2b\x1e\x09\xf3\x7a\x02\xef\xc8\xe3\x96\x6e\xeb\x62\x01\x48\xb7\xba\x75\xea\x36\x1f\x18\xd5\xa1\xf2\xa6\xf5\x17\xa5\xf4\xbd\xf1\xdd\xd5\x7e\x2e\x3b\x03\xf8\x2a\x0a\xf2\xdf\xb8\x81\x97\xeb\x15\x90\x1a\x18\xe4\x3d\x26\x73\x2f\x67\x52\x92\xa5\x23\x78\xc1\xd9\xbf\xb5\xe8\x63\x82\xa7\x0f\xa8\xe2\x9c\xb5\x6d\x1c\xd7\xcb\x14\x46\x7b\xb4\x58\x0f\x55\x5a\xab\x69\x93\x0e\xc0\xf4\xde\x6b\x61\x0e\xdb\x56\x4d\x0a\xf1\x54\xfe\x09\xd1\x90\x10\x07\x86\xda\x2e\x7f\x4f\x41\xdb\x0e\x0d\xd1\x08\x14\x0f\x0e\x2a\x94\xb6\x5f\xb3\x43\x78\xde\x36\xf3\x27\x3b\x6d\x4b\x90\x77\x58\xf0\x93\xb6\xb0\xf9\x19\xeb\x09\x6b\xdc\x3d\x92\x51\x42\xc5\xf3\xa2\xc7\x7e\xf3\x00\x85\x04\x63\x17\x35\x47\xc3\x09\xa7\xd0\x72\xf3\x18\x23\xcf\x97\xf0\x13\xb8\x7a\xf2\x2f\xe3\x10\xd4\xa2\x32\x87\x59\x73\xcf\xc8\xdf\x87\xda\xc3\xdc\xa4\x01\x56\xd6\x87\x52\x1a\xf8\x76\xea\x89\x6f\x5d\x7a\x36\xa2\xe9\x9b\xe5\xf5\x02\x60\x7d\x72\xf7\x72\x29\xbd\x94\x5b\xbf\x57\xfe\xab\x37\x6c\xcf\x7e\xe1\x12\x75\x0b\x44\x7f\x6a\xee\x81\xc8\xdb\x23\x10\x3d\xe9\x1e\x29\x92\x19\x29\x80\xb5\x84\x58\x69\xcf\xa7\x83\x03\x67\x7b\x94\x1b\x57\x3e\x34\x94\x6a\x38\xbb\xe9\x30\xff\x64\x60\xea\xb9\xbc\xb1\x8f\x5f\xff\x41\xcb\xb0\xe7\x1b\xa9\xac\x95\x4e\x9c\xd6\xeb\x15\x0d\x2d\x95\xe7\x8c\xfc\x63\x79\x67\x07\x9b\x0e\x54\x6c\xb0\xa1\x5f\xe6\xee\x48\xee\xe7\x7d\x78\xec\xbd\x07\x9b\x3a\x68\xa8\x9f\xef\x44\x25\xfd\x2c\x4d\x53\x2b\xff\x58\xda\x40\xdb\x16\xbe\x52\x94\x6d\x47\x6d\x77\x0e\x6d\xa2\x31\x8f\x0e\x44\x5d\x13\xf0\xe5\xc7\x1f\x96\x2a\x7f\x8b\xbe\x87\x72\xe8\xfb\x57\xd5\x85\x86\x89\x8c\x1d\xc5\xa9\x8d\xf6\x3d\x68\x2c\xef\x5d\xc8\x0d\xb6\x16\xb8\x45\xb4\xe7\xa9\xa7\xa7\x5d\x21\x62\x14\x86\x88\xf9\xd3\xbb\xf8\x75\x1b\x3f\x0b\x1c\xf8\xc4\xbc\xed\x96\x54\xd1\x48\xf7\x22\x5c\x92\xed\x59\x43\x1a\x0f\xe1\xd2\x27\xd2\x68\x75\x23\xdc\xec\x2e\x08\x37\xb3\x08\xd7\x4c\x5b\x40\xbc\x83\x03\x55\x19\x72\xaa\x43\xfc\xfc\xbc\x6c\xc6\xc0\x50\x55\x3b\x30\x13\xf9\x7d\xb9\x36\x3d\x60\xa3\xfc\x6f\xc6\x19\xac\xd2\x31\x3f\x22\xdc\x64\x66\x2d\xb5\x6b\x8f\xb0\x53\xae\xa4\xbd\xd4\xe5\x19\x5b\x49\xa9\x10\x23\x68\x0a\xff\x74\x1c\x31\x4a\x45\xb9\x57\x1b\x4a\x10\x50\xb5\xb4\x5b\xae\xfc\x6c\x2f\x64\x00\x53\xea\x97\x94\x54\xdc\x0b\xa1\x92\x89\x24\xbc\x0a\xb5\xfd\x94\x13\x9a\x72\x67\x00\xa9\x95\x7c\x3c\xc3\xf4\x91\x9c\xde\xd0\xe6\xfd\xcd\xba\x72\xf4\x8a\x64\xc3\x44\x1f\x60\x36\x76\x32\xed\x02\x0a\x0c\xd3\xa2\x0b\x33\x69\x7f\x84\x26\xff\x3b\x74\x50\x61\x96\x46\x9d\x16\x88\xe2\xbb\xb8\xfc\x6b\x9c\x99\xf2\xca\x66\xce\xaf\x58\x0a\xe9\xfc\x25\x51\xa0\x23\xba\x8d\xf6\xe4\x61\xcc\xb4\x85\x3f\xd4\xc2\xb0\xcc\xa8\xab\x52\xb2\x6a\x53\x7f\x45\x76\xb0\x99\x49\x31\x92\xce\x31\xbb\x37\xe6\xc4\x64\x21\x91\xb4\x3e\x5a\x0f\x5e\xa5\x30\x2d\x9b\xda\x5f\xfc\x72\x42\x99\x1d\x8d\xb8\x0f\xeb\x97\x55\xb4\x7c\x73\x9b\x77\xb3\x70\x0b\x5c\x76\x2d\x70\x05\x0b\x5c\x8c\x36\x64\x81\xfb\x46\x8f\xb6\x37\xb8\xbe\x7e\xf8\x1f\x15\xf0\xa7\x26\x87\xaa\xb1\x35\xcc\x81\x0e\xa0\xfc\xab\xab\x2c\x57\x3d\xb7\x68\xca\x1f\x0c\x76\x9c\x26\xd9\xa4\x8a\x2b\xe8\x01\x17\x34\xf5\x37\x2f\x29\x46\x36\xb9\x10\x1a\xc1\x95\x24\x99\xca\x90\xb0\x3e\x9f\xc0\xd6\xc6\x32\x8d\x65\x55\x35\xf2\xe9\xec\x5f\xd5\x49\x1d\x17\x98\xd8\xd4\x26\x50\xc5\x1b\x22\x8d\xc3\x1b\x4d\x4d\xee\xd2\xd8\xc9\x1b\x93\xaf\xb7\x85\x4e\xd3\xca\xe6\xa5\xd6\xff\x5a\x85\x1a\x86\xea\xb1\x01\x44\x37\xfe\x93\x48\x58\xd4\x78\xcd\x2e\x79\xcd\x94\x4f\xcd\x37\xfa\x30\x9b\xac\x55\x34\x88\xee\x22\x62\x19\xb7\x95\x17\xa3\x08\x4f\xbd\xb5\x2e\x15\xe7\x3e\x23\x6a\x38\x73\xf9\xa1\xe8\xdc\xf7\x73\xb3\x0c\x2e\x2f\x29\x9f\xf8\x8c\xef\x94\x3d\xf4\xb2\x66\xc7\xb1\xcf\x13\x44\x51\xfd\xbc\xf3\x90\xdb\x9a\x81\xf7\xdb\x3b\x5b\x2d\xe9\x2c\x47\x5d\xa7\xd9\xe5\xa4\x2c\xc2\xa3\x54\x46\x8d\x19\x00\x9d\xdc\xce\x35\xb6\xac\x7c\x59\xb6\xcd\xa3\x65\x71\xb0\xcd\xfc\xa9\x2b\xff\x76\xc6\xb6\x3c\x5e\x71\x40\x0b\xc2\x74\xba\x6a\x86\x7b\x68\xb7\xaa\x73\x3b\x08\x4b\xea\xc6\xb9\xdc\x5f\xad\x9d\xa1\x61\xa1\xeb\x2a\x43\xa6\x23\xfa\xb3\xac\x61\xab\x15\xcb\xa6\xee\x69\x7d\x6f\x7a\x86\x79\xd5\xb0\x09\xef\xb7\x68\x9e\x56\xa3\x7b\x5e\xb7\x52\x33\x88\xbc\x92\xa6\xb0\xb0\xc6\xae\x1a\x26\x39\x7f\x22\xe4\xd4\xba\x59\xd7\x8f\x73\xd2\x35\xb2\x7d\x09\x28\xcf\x2d\x82\x71\x58\xd1\xe0\x72\x0a\x51\x65\x6c\x10\x80\x05\x14\x61\xcd\x49\xdf\x60\x0d\x0e\xa5\x2e\x43\xc6\x3a\xb7\x6a\x93\x0c\x11\x88\xd3\x7f\xac\xd3\xf2\xa3\x96\x0b\x28\x1d\x62\xd6\x8b\xac\x6d\x33\x1c\x51\x9c\xd0\x25\x87\x2f\x83\xcb\x7f\xfa\x1b\x39\x18\xa2\x07\x23\xb2\x13\x7d\x8a\xdc\x6a\x93\x58\x71\x28\x02\xc6\xf3\xe4\x4b\x66\x1c\x82\x5b\xe6\xb7\x8f\x19\xfe\x63\x9a\xec\x75\x7a\x96\xcd\x7a\x38\xac\xde\x45\x56\x2f\x7b\xc6\x3b\xb2\x17\xdc\x1b\x56\xc3\x7b\x41\xef\xb0\x57\x10\xc3\x6e\x0c\xce\x65\x8f\xf7\xc2\x5d\x4e\xfa\xe9\x1c\x31\x10\xea\xdf\x51\x8e\x1b\x46\xdb\xa4\xb8\xb9\x69\xd3\x5c\x6a\x17\x2d\x13\x29\xde\x4a\x0f\x0e\x52\xb2\x16\x49\x8a\x49\x81\x44\x75\xb4\xb4\xd4\xed\x0f\x14\xf6\x49\x10\x9b\x59\xb8\xeb\xf8\x9c\x00\x78\x8d\x58\xa4\x30\xe2\xbc\x69\x2e\x65\x17\xac\xe0\x35\x8c\x2b\xde\x46\x30\xaa\x78\x19\xa9\x7c\xbb\x56\x26\xdf\x57\x3b\xff\x97\x30\x7e\xad\x5a\x81\xdf\x9c\x8a\xd8\x64\xd7\x16\xf1\xea\xcc\x3b\x13\x9c\x2d\xa3\x90\x75\x3a\x72\xb2\x0b\x04\x67\x8a\x21\xb9\x57\x9a\x30\x66\x70\xb0\x34\x3d\xac\x15\xa8\x06\x86\x6a\x75\xad\x0d\x7f\x85\xd4\x11\x3e\x0c\x64\x69\x0c\xaf\x14\x9d\x12\x45\xa5\x13\x3f\x98\xf3\x2a\xcd\x66\x1b\x38\xe2\x7a\xa7\x13\x1f\x5a\x43\x15\x35\x4c\x82\x51\xc0\x21\xf8\xf6\x24\x40\xcb\x29\x48\xda\xb1\x1a\x52\xce\xf3\x7c\x2a\xec\x99\x9d\x15\xab\x23\x83\xed\xd7\x2b\xb6\xd8\x04\x8a\x77\x52\xeb\x44\xec\x11\xbd\x74\x27\xc1\x45\xc6\x35\xf9\x06\x5b\xd8\x00\x3d\x18\xae\x77\x51\xc9\xfd\xa3\x72\x2b\x0e\x0a\x23\x48\xb2\x85\xd4\x04\xa6\x9a\x47\x08\x79\x0c\x52\x0f\xb9\x33\x61\x4f\xea\x93\xea\xc9\xf1\xcf\xe9\x54\x07\x52\xf5\xe3\x1f\xe8\xfd\xc5\xac\x2b\xda\x19\x90\xaf\x5c\x56\xbd\x45\xc9\x4b\xc8\xe9\x40\x2c\x07\x85\xeb\xf3\x6c\x80\x59\x0e\x31\x7e\xb0\xa9\xd2\x5e\xa3\xcb\x06\xcd\xfd\x94\x37\x07\xe9\x6d\xcc\x06\x38\x32\x4e\x1f\x2f\xd3\x1a\x38\x18\x00\x74\x98\x10\xa0\x23\x89\xd3\x47\x42\x8d\x3a\x25\x9a\xb3\xc7\xac\xda\x4a\x53\x32\xc4\xc6\xd8\x22\xec\xb1\x3c\x57\x9b\xea\x65\xba\x81\x11\xd6\x70\xfe\xf2\xd7\xa7\x95\x2a\xd1\xea\x11\x69\x57\xe0\x83\x91\x2e\x28\x29\xf1\x72\x47\x00\x57\xf4\x13\xe0\xe4\x25\xa3\x55\x5a\xd5\x2f\xd4\x55\x18\xd1\x04\x80\x42\xd6\x5d\x57\x9e\x5d\xb3\x9e\xdd\x75\xdd\xea\x6e\x6f\xae\xb7\x33\x75\xc9\x91\x8f\x68\xd4\xa4\xfc\xd7\xee\xa6\xca\x6b\xfa\x04\x91\x80\x63\xf2\xcd\xae\x66\x0c\x8d\xc7\x42\xdf\x0c\xfb\x54\x4c\xd0\x3b\x0d\x3d\x18\x1f\x63\x0a\xd9\x01\xa7\x48\xa4\x9c\x6e\x64\x58\x4d\xe6\x73\x40\x65\x98\xdc\x20\x64\x03\x1e\xd7\x4d\x98\x2d\xe3\x5a\x28\xe1\x6a\x00\xc4\x76\x5e\x3c\x4e\xab\x59\x3a\x57\xad\x8b\x43\x5d\xc1\xee\x52\xc6\x37\x5c\x7d\xa2\x61\xb6\x40\x72\x1f\xe7\x26\x90\x9d\x5b\x6e\x00\x87\x94\xe3\xd4\x6c\x53\xbb\x6c\x26\x82\xc4\x31\xae\xd0\x30\x8a\x35\x75\x6c\xcf\x41\xb9\x55\x5d\x66\x4c\x16\x99\x90\x17\x2a\x1a\xd6\xd8\x04\x19\x26\x42\xa8\x8c\x3b\x07\x6c\xcf\x95\x4e\x44\xb1\x27\x7b\x7a\x36\xa6\xc3\x2f\xc5\x07\x3a\x33\xab\x3f\x3d\xd5\x1e\x72\xed\xd4\x5c\x3c\x1a\x6b\xf3\xd5\x3a\x20\x2e\x68\xea\x6d\xba\x91\xea\x0a\x78\x91\x9a\x22\x6e\x93\xbd\xd6\xa7\x4a\x3a\x86\x9f\xc6\x5a\x98\xee\x3e\xd5\xcf\x23\xe4\x79\x48\xd2\x52\x47\x81\xad\x85\xd9\x9b\xa3\xc7\x95\x36\x58\xf2\x3e\x88\x5e\x8e\x03\xad\xea\x08\xc8\xfc\x13\xa7\xfc\xe9\xbe\x4a\xec\xc8\xd4\xa3\x3c\x3e\x76\x45\xde\xe9\x0e\x31\x53\xa0\xec\xdd\x96\xde\xb7\x12\xe9\x71\xcd\x26\x5b\x95\xce\x63\x67\x36\x23\x5b\xa3\x44\x28\xab\x1f\xeb\x4d\x31\xe9\x90\xed\xa9\xc6\x37\x69\x9b\x55\x7a\x54\xb5\x62\x9b\x8f\x61\x82\xaf\x19\x38\x65\x64\x19\x51\xb1\x0c\x18\x8f\x54\xb7\xc9\xad\xe2\x10\x7c\x88\xbc\x49\x93\x34\x51\xc6\x8e\x34\x16\xb9\x06\x2b\x1f\xd2\xbd\xb3\x90\xae\x23\xfd\x8a\x30\x7c\xcd\x30\x96\x7a\x99\x3c\xa0\xa8\x05\x1a\x3f\x17\x98\x45\xb6\x7a\x58\x8e\x79\xd0\x40\xb7\x1c\x97\xc4\xa4\xa5\xe4\x2a\x6e\x8c\xfd\xaf\x33\x27\xa9\x4f\x4d\x36\x11\xe7\x43\x60\x1c\x83\x64\x9d\x83\x83\x82\xc7\x9e\xc2\x64\x87\xc3\x5d\x86\x6c\x9e\x48\x6a\xe8\x7b\x80\x84\x51\x61\xf7\x54\xc9\x15\x18\x14\x1e\xa8\x7b\xbc\x87\x48\x75\x29\xb0\xca\x50\xe4\xc3\x92\xa4\x72\x69\xef\x2c\x6f\x0a\x65\xe3\x80\x51\xa5\x3a\x4b\xa9\xf5\x60\x89\xf0\xa0\x62\xaa\x52\xc1\xa2\x9b\xba\xe5\x98\xe8\x4f\x0d\x92\x00\xf9\x8b\x9b\x0d\x5d\x0b\x4e\x5c\x66\x03\xf5\xe7\x3a\x56\x7c\xe1\x6e\x55\x73\x8a\xbc\x88\x3b\x06\x6d\x29\x6e\x55\x46\xc9\xb2\x6c\xd5\x42\xda\x39\x37\x04\x44\x3c\x4a\x18\xa2\x45\xbd\x93\x4c\x8f\xb6\x41\xf9\xbf\xa9\xac\x95\x3d\x90\x65\xdb\x52\x7d\x55\x16\xdb\x8d\xb6\x63\xf4\x9c\xc8\xdf\x57\xad\xf4\xc4\x8a\x79\x00\x9f\x40\x23\x40\xe7\xec\xf3\xfb\x68\xd1\x69\x24\xba\x82\x8c\x63\x6b\x01\xdd\x33\x20\x23\x7c\xa7\xe5\x5a\x06\x50\xe3\x6b\x5c\x69\x68\x93\x22\x12\xe4\x2e\x6c\x27\x7d\x77\x88\x2c\xe7\xd8\x9d\xd2\x40\xd9\x13\x10\x2a\xe6\x7d\x00\x53\x9b\x98\x07\x9a\xc2\x1b\xa1\x8d\xc5\xcd\x8d\x7e\x60\x90\x9d\x92\xf4\xc8\xbe\x5c\xc3\x90\x51\x7e\x2c\xcd\x96\xbd\xc6\x91\xa5\x58\x5c\xd1\x1b\x3f\x5b\x3b\x0a\xc3\xd1\xdb\x85\xb4\xab\x42\x28\x2e\xbb\x31\xa0\x48\x70\xee\xbf\xb5\xe9\x6e\xca\x12\xee\x16\x05\x93\xd3\x61\x35\xd6\x2d\x61\xd4\xb6\x2b\x74\xde\xe1\x0d\x24\x58\xf3\x64\xd0\xde\x53\x02\x43\xff\xae\x44\x40\x74\x0c\x93\xa1\x61\x4c\x18\xbd\x4e\x5d\x00\x06\x3b\xe9\xa8\xb6\x2b\x67\x49\x72\xaf\xc0\xb1\x2b\x30\x65\x68\xaa\x65\x2d\x22\xd3\x69\xe8\x47\x1c\xf6\xfc\x11\xd3\xe3\xed\x74\x32\x30\xa7\xc8\x5b\x99\x2d\x1e\xa5\x7e\x36\x3a\x85\xab\x53\x55\x13\x92\xdc\x50\x94\xdb\xc8\xf1\x11\x83\x2d\x27\xa5\x29\xfd\x53\x08\x55\x27\x79\x52\x5a\x37\x05\xa6\x56\x27\x82\x77\xc1\xac\x02\x8c\x99\xe0\x00\x84\xe6\xc8\xd1\x43\x9c\x27\xaf\x2a\x93\x2f\x2a\xc2\x01\x26\x98\xb4\x9b\x86\x8a\xd1\xa6\xf2\xe2\x43\x8e\x1d\x39\xdb\x4f\xd1\x2d\x09\x6c\x4a\x1b\x8d\x57\xf7\x8c\x80\x6d\x01\x07\x1e\x7f\x7f\x05\xbc\x21\x59\x9c\x5a\xc7\x33\x7b\xc2\xf2\xe3\x42\xc7\xe2\xb1\xcb\x5b\x4c\x45\x04\x49\x47\xc9\x24\xc9\xe6\xca\xe4\xf1\x10\x47\xe7\x55\x07\xcb\xe6\xa8\x1f\x0d\x8b\x8c\x07\x03\xa1\x24\x33\x74\xf3\xda\xc6\x14\xce\x35\x76\x44\x2c\x4a\xb1\x66\xd0\x20\x54\x3a\x8e\x10\xf7\x6f\xef\xa8\x5f\x7f\x72\xc8\xf1\xc8\x2d\x65\xa4\x89\xd8\x8c\x8f\x1b\x3f\xc6\xfb\xaa\x27\x1a\x54\x45\x86\xb9\xb1\x5e\x47\x68\xc4\x68\x68\x6e\x5c\x3e\xe2\x88\xf2\x63\xfb\x0e\xf3\x21\xf2\xf6\x52\x0b\x51\xa9\xb3\x14\x31\x84\xe1\x56\x93\x32\x72\x7d\x9b\x77\x9b\xab\x36\x56\x7f\x62\x25\x68\x9c\x03\xc0\xee\x97\x3c\xa5\x61\xc7\x8d\x9e\x8a\x1b\x2f\xac\x52\x3d\xa0\xd1\xbc\x44\x0c\x04\x00\x92\x9c\xfa\x90\x84\xa1\xc7\xf7\x86\x00\xd7\x60\x44\x6c\xf9\xb7\x0d\xb6\x96\x01\x03\xb9\x9e\x5a\x7a\xc6\x80\xdf\x06\x7d\xa3\xb3\xd0\x44\xca\x11\x38\xce\x32\x45\xbf\xd0\x65\xe0\xb4\xe4\x1d\x57\x34\xc3\xa3\x92\xfb\xec\xc3\xa1\x4d\x69\xab\x69\x5b\x01\x70\x8c\x61\xb8\xfe\xa0\x61\x8f\xd6\x96\x20\xc5\xf3\x51\xa9\xcd\x53\x74\x7f\x86\x65\xd1\xc2\x8d\xb8\x7f\x34\x6e\xc2\x7b\x67\xd7\xa9\x81\x2c\xab\xc4\xf2\x59\x2b\xc2\xcc\xf7\x6d\xd2\x8c\x3c\x4c\x8c\x20\x83\x18\x9a\x9a\x42\x75\xb0\x54\x15\x8e\x61\x78\x5d\x24\x1f\xaa\x81\x7b\x1e\xd7\xe5\xd5\x75\xa9\x43\xa9\x63\xfe\x1e\x8c\x1a\x40\x3f\x77\x33\x64\x50\x07\xdb\xf0\x7a\x3d\xb8\xb7\x56\x25\x76\x1e\xf7\x82\xff\xa8\x82\x1e\x57\xce\x2a\x14\x63\xa6\xd9\x4a\xcd\x47\xbd\x67\x00\x5f\x31\xce\xc7\x69\x3a\xfb\x88\x3e\xdf\xd9\x1c\x8e\x7c\x56\x5f\x71\x68\x0f\xf8\x72\x0f\x21\x0e\x74\x84\x3d\x68\xb3\x08\xfd\x34\x4e\x93\x4b\x12\xe6\x98\xdc\xc9\x30\xf0\x5a\x7b\x3c\x21\x91\x99\x5a\xe7\x62\xbc\x9d\x86\x28\x13\x76\xc4\x52\xc2\xf9\xcd\xbb\xd7\xaf\x46\x2c\x5d\xc8\x10\x73\x49\x73\xd7\xca\x38\xf6\x90\xdf\x85\x01\xdd\x2f\x49\xf4\xd3\x92\x48\x18\x53\x85\xde\x53\x2d\xe0\x78\x7a\xd5\x74\x49\xb5\xd1\x84\xeb\x29\x90\xd9\xf4\x9d\x9a\x6a\x2b\x9b\x6a\x27\x89\x69\x45\x83\x7f\xbf\x54\xbd\x5f\xf5\x76\xfc\xda\x63\xc5\x74\x6f\xbd\xad\xea\xde\xa9\xea\xa5\x3d\xd3\x18\xc5\x4a\x49\x7b\x3c\xcc\x5e\xa9\x36\x68\x53\x9d\xe3\x61\xa2\xd8\xf1\xb4\xdc\x8b\x2b\x7c\x5c\x64\x6a\x35\xef\x0d\xa0\xf8\xaf\x75\xb9\x85\x36\x61\x3b\x50\x12\xe8\x2f\x4d\x28\xa3\xdd\xfc\xd0\x06\xae\x89\xc3\x63\xe5\xd9\x96\xdd\xdf\x3f\x9f\x4e\x2c\x0b\x12\xcb\xd7\xe8\x87\xab\xc3\xf5\x22\x1f\xdc\x86\x01\x16\x91\x02\xf2\x92\x69\xb4\x51\x9c\x10\x1b\x14\x49\x84\x5d\x65\x3d\x53\xf4\xa3\x4e\xf5\x95\x69\x48\x82\x74\x99\x01\x2a\xe8\x97\xa2\xb3\x0c\x29\xc1\x8d\x21\xd4\xf5\x9b\xe1\x37\xd4\x92\xc1\x5b\x37\x37\x7f\x18\x4c\x18\xf9\x2e\xaf\x7f\x38\x62\xdc\xb0\x18\x84\x6d\xfa\xca\x8a\x86\x32\x8f\x58\xa6\xd1\x79\x60\x9e\x06\xd9\x00\xfc\xe8\x43\xc3\x82\x1a\x67\x1a\x53\x92\xb1\x4e\xc5\x3e\x83\xfc\x13\x18\x19\x3b\x25\x98\x9d\x7e\x3b\x4c\x30\x9f\xb9\x69\x51\x98\x5a\x37\x05\xe5\x0d\x52\x17\x49\x47\x61\x7d\x5d\xd9\xf8\xf4\x48\x0e\x18\x03\x7b\x01\x7d\xbc\x58\xc9\x9f\x75\x51\x6b\x7b\xce\xc5\xf5\xce\x3f\x10\x28\x7f\x44\x99\xc9\x4b\x3e\x03\x2e\x1c\x66\xe7\xa1\x08\x9d\x95\x9c\xa0\xb3\xba\x28\x27\x8d\x85\x29\x94\x03\x16\xc6\x0b\x60\xe3\x62\x72\xf4\x4a\x14\x37\x6b\x72\x6a\x97\x0e\x24\x19\xce\xa8\x57\x71\x9c\x54\x9f\x6a\xe7\x4f\x24\x17\x23\x67\x05\x22\x35\xf5\x6f\x68\x92\x48\xfd\xa8\xa2\xa8\x07\x86\x04\x02\xc8\x0a\x54\xe6\xcd\xcd\x77\xf6\x1c\x29\xe4\xf7\x2a\x00\x88\x46\x85\x22\x56\xb6\xd6\x84\x81\x80\xcc\xd2\x26\xbb\x2d\xd6\x8b\x8a\x71\x95\x78\xa6\x8e\x30\x94\x89\x9e\xa3\xa1\x0a\x30\x41\x1a\x65\x3e\x61\xea\xb1\x40\x46\x01\xf6\x48\x8a\xe6\x69\xb2\x3f\x9d\x49\x89\x5e\xad\x1b\xe1\xd3\x04\x9d\x5d\xf3\xa0\x59\xd3\x8f\x42\x58\x4b\x75\x00\xb0\x26\xcc\xdb\x49\x4c\x45\xd9\xa4\x1a\x30\x89\xda\x24\xc0\xf6\xd6\x09\xa9\x4a\xe1\x71\x34\x3f\x77\xcd\x9e\x5d\x03\x48\xb8\x6e\xf9\xfa\x0e\xa1\x07\x55\x23\xc4\xac\x90\x37\xe7\xa5\x65\x19\x66\x1e\x46\xef\xaf\xac\x04\x44\x9f\x2c\x6f\xae\x91\x07\x7a\x9c\x10\xe7\xb8\x16\x82\x2d\x16\xe3\x7f\x5d\x85\x9f\x60\x6d\x29\xb1\xb5\x1a\xb0\xc8\x36\x2a\xfb\x22\xee\xfc\xb5\x0d\x2b\x2c\xc7\x67\x54\x39\x61\xf4\xe6\x8a\x31\x6f\x5d\x6c\xd1\xaa\xe5\x59\x59\xac\xc7\xd5\x17\xa6\xba\x4e\xa9\xd4\xac\x8e\x36\x00\x1c\xb3\x44\xb9\x6c\x8e\xd5\xde\xc2\xb4\x16\xc2\xb0\x52\x60\xcd\x2e\x8c\x65\xe6\x23\xec\x14\xab\x46\x08\x0b\xea\x5d\xab\x66\x3e\xaa\xab\x4a\xdc\xb5\x5b\xd7\x4a\x79\xf7\x02\x16\xcb\xc4\x39\x45\x6b\x28\x72\xd1\x9f\x3f\xd7\xe4\xdc\x13\x85\xd6\xdc\x2a\x9f\x65\x26\x89\x05\x26\xb0\x72\x2c\xb8\xb0\x4d\xfc\x9f\xa2\x30\xd2\x98\x58\x34\x46\x10\xe6\x36\x90\x65\x21\x16\x0e\xda\xc3\x67\x02\x74\x69\x14\x66\xc1\x8b\xe4\x1b\x34\x39\x59\x7e\x32\x3f\x0c\x9a\x09\x01\xe6\x9f\xd3\x52\xa4\x79\xed\x72\xc4\xe4\x45\x6f\x55\x00\x55\x08\xb4\x9f\xcd\x19\xd9\xef\xbd\x87\x9e\x7b\xd5\xb2\xd8\x02\x15\x80\x0a\xbd\x25\x99\xc4\x8f\x7a\xcf\x17\xbd\xab\x62\xdb\x83\xab\xd8\xab\x94\x42\x4a\x01\xc7\xd8\x3b\xdd\x9e\x21\xf9\x3f\x57\xe7\x6a\x55\x6c\x70\x39\x7a\x1b\xd8\x1e\xa4\xcf\xd2\x0c\x83\xe5\xa2\x19\x51\xb5\xc5\xb0\x6d\xbd\x65\x5d\x6f\xaa\xf8\xfe\xfd\xb3\xac\x5e\x6e\x4f\x11\x32\xdf\xd7\x51\x86\xbf\x79\x67\x7e\xdd\xa7\xd2\x55\xef\x10\xc7\x99\x7f\xac\x82\x10\x01\xb3\x48\x9c\xe9\x30\xf0\x4f\x02\x03\x8b\xa3\xf9\x53\x17\x02\xfe\x33\xe8\x57\xa3\x4d\x66\x80\x0e\x01\x75\xca\x67\x80\x87\xcf\x07\x68\x5f\xe2\xbd\xd5\x67\x25\xec\xc4\xb2\xdf\x38\xbc\xb9\x2c\x8a\x8f\x1c\x8e\x6d\x3b\xa8\xad\xb2\xfe\x4d\x59\xcc\x54\xe5\xa4\xaa\x14\xb7\x07\x9e\x84\x35\x66\xc3\x54\x00\x06\x7c\x72\xb6\xcd\xe6\x44\xa6\xd8\x07\x3f\xb6\x61\x5d\x48\xb6\x0a\x5b\xe0\x86\x11\x3d\x12\x40\xa1\xd1\x98\x28\x25\x52\x86\x84\x15\x73\x93\xf2\x2d\x1c\x9b\x70\xe9\x5c\xdd\xf6\x26\x54\x1d\x45\x4b\x81\x68\x55\x85\x2a\xcc\x30\x8e\x40\x5e\xb0\x3a\x6a\x8f\x66\x90\x3e\x4b\x10\x3e\xde\x0b\x5a\x72\xb6\xbc\x2a\x48\x91\x17\x46\x9d\x96\x21\x59\x92\x15\xda\x32\xa4\xad\x06\x24\x9f\x97\x66\x25\x44\x40\x13\x53\x0d\x1f\xd0\x1d\xa2\xdd\xb7\x2e\x86\xbd\x93\x4a\x1b\x8a\xad\x06\xc1\xaf\xff\x51\xfd\xda\x13\x74\x06\xd3\xdf\x14\xc8\x30\xed\xfd\x4a\xb8\xf5\x57\x1d\xb2\x0a\x1d\xb0\xba\xec\xc9\x88\x74\x21\xb9\x09\xb5\x5d\x51\xdb\x30\x7c\x54\x50\xc3\xc5\x8c\x33\xd4\x51\xe3\x0f\x69\x23\x95\x15\x0d\xa0\xe5\x44\x1a\x81\xfe\x35\xc0\x13\x6a\x62\xe9\x7f\x7b\x25\x82\x99\x89\xe3\x84\x72\xec\xe8\xde\x89\x35\x1c\xc5\x11\x07\xf7\x86\x8a\xe2\x69\xed\xc2\x21\xdc\xc1\x20\x62\x13\xa1\x00\xfb\xb2\x25\x7b\x09\x01\x81\x71\x2f\x18\xa2\xf4\x9e\x4e\xf6\x33\xa7\x84\x04\x04\xaf\x2d\xe4\x60\x9d\x4a\x21\x74\x2e\x64\xf7\xf7\xb1\x89\xfb\x3a\x9b\x89\x0c\x3d\x3c\xe1\x30\x16\x70\x38\xbd\xd8\xa4\x4e\x88\xbc\x0f\x4a\x1f\x35\x08\xca\xa3\xe9\x9f\x21\x3c\xbf\x3b\x1d\x3d\x79\xfa\xe5\x87\xaf\x0e\x0e\x1e\xa7\x40\x14\x6d\xa1\xbf\xa8\x20\xcf\x30\x15\x91\xe7\x59\xc0\x66\x03\xcb\x02\xf0\xf4\xf7\x57\xfa\x02\x21\xff\xf9\xc7\x55\xb7\xfe\x02\x39\xbf\x6c\x70\x91\x72\x5e\x59\x63\xa3\x46\x7c\x21\x0b\x7b\x51\x34\x42\x58\x9f\x56\xf0\xd5\xd5\xe0\x9a\x64\x35\x71\x81\x7d\x2b\x16\xdc\x60\x07\xd8\x7b\xfc\xc3\x92\xa5\x28\xca\x37\x78\xc3\xef\x46\xb3\x16\x3f\xa7\x30\x60\xad\x2e\xd1\x34\xa4\x2e\xf0\xc8\x59\x25\x1c\xfa\x8b\xda\xdf\x61\x64\x90\x55\x5c\x38\x49\xa7\x31\xc4\x82\x65\xd0\x72\x84\xb8\x6d\xbc\xd1\x08\x1a\x1f\xa2\x9f\x9b\x08\x72\x86\xb2\x25\xed\x4c\x13\x9d\xce\xf7\x4e\xe0\xa5\x59\xce\x30\xfa\x70\x35\x3a\x05\xb4\x94\x13\x45\x6e\xed\xe5\x8c\x46\xc6\x04\x02\x2c\xb4\x97\x1f\x8a\xbf\xe8\x17\x4a\xe8\xd2\xc4\xbc\x9e\x98\x1f\x46\x91\x19\x1f\x4f\x23\x5d\xd2\x2a\x39\x4c\x4d\xec\x67\x7f\x18\x07\x37\x06\xf6\xe4\x53\xda\x1d\x57\x99\xe0\x0d\xe9\x2e\xdc\xc1\xf1\x81\x71\x2b\x3a\xb4\x28\x3a\xa5\x48\xfb\xda\x78\x6e\x8b\xe1\x33\xd8\x5c\x3b\x8f\x8c\xa7\x59\x38\x36\xc7\xed\xe4\xcd\xdb\xd7\x2f\x9f\xbf\x7b\xfa\xee\xe0\x60\x7b\x1c\x90\xf4\x24\x98\xfa\xda\x6f\xc2\xf4\xe7\x83\x00\xf0\xc5\x1a\x71\xeb\x5c\x01\xf6\x3d\x43\x54\x0c\x88\x58\xb1\x7d\x74\x84\x58\x7f\xa9\x56\x9b\x1e\x91\x42\x3d\x85\x44\x41\xc5\x48\x9f\x51\x79\x2f\xad\xae\x60\x1f\xcb\x22\x2f\xb6\xd5\xea\x6a\xd4\x7b\x57\xac\x55\xef\x14\xda\xae\x14\x94\xbc\xc8\x56\x2b\xa0\x0b\xce\x7a\xdb\x9c\x83\x13\xcf\xd1\xe8\x87\xfa\x2b\x15\x82\x52\x64\xa4\x22\x0a\xff\xb5\xcc\x66\xcb\x1e\xa5\x6e\x47\xca\x00\x83\xb7\x56\xe9\x42\xad\xae\x7a\x3a\x50\x8b\xad\x68\x07\x1a\xff\x92\xf7\x7a\xfa\x90\x34\xa6\x0d\xb0\x64\x91\xae\x2a\x35\x06\x82\x16\x03\xc3\xe6\x3c\x74\x3d\x68\x18\xc5\x7c\xab\x65\x17\xb8\x84\x08\xbd\x9d\x89\xa0\xda\x71\x00\xfb\x74\xf6\xf1\xe0\x80\xfc\x3c\xf0\x27\x5a\xe8\x49\xc2\xa0\x28\x3a\xd4\x64\x16\x0c\xed\x09\x52\x77\x36\x08\x8c\x5d\x0e\x9b\x4b\x62\xe4\xeb\x95\x8e\x6c\xa6\xbf\x60\x9e\x97\x48\x39\xb3\x2c\xad\x7d\x20\x49\x18\x9a\xb7\x26\xaf\x30\x58\x26\x96\x0d\xdd\xbb\x14\xef\x36\x65\x74\x0c\xc8\xc8\x2a\x20\xa9\x2d\x8a\xcf\xe0\x21\xae\xf9\x2f\xc0\xad\x00\xee\x4f\xb6\xb8\x7a\x54\x96\xe9\x55\x65\x0b\x89\x77\x58\x56\x3c\x46\x6c\xc3\xdc\xcf\xd3\x36\x69\xf8\x15\xe1\x90\x0a\x88\xb2\x79\xaf\xd2\xbf\x07\xce\xc4\x2b\x24\xea\x0e\x89\xbe\x6a\xbb\xd9\x14\x25\x5b\xfd\x6a\x3a\x8f\xcf\x07\xa0\x82\x02\xb9\x5c\xbe\x38\x57\x56\x80\x52\x5f\xd9\x1c\x31\xee\xfb\x8b\x33\xf8\x2e\xec\x66\x8b\x76\xcc\x21\x7d\x2d\xc9\x21\x46\x5a\xc0\xff\x5b\x18\x0f\xa0\x30\x15\x6d\xd5\xb9\x4f\x7c\x1a\xb7\xad\x3a\xb7\x85\xb1\x24\x21\xea\x04\x10\xe0\x61\x30\xfc\xe1\x6a\x38\x44\x57\x6d\x8c\x33\x98\x58\x47\x5c\x78\xc1\xc6\x5d\x44\x70\x35\xbd\x21\xbc\x72\x69\x9e\xad\x59\x74\x40\x76\x08\x3a\x83\x1d\x57\xf3\x5d\xa0\xcc\x1b\x6b\xe0\xe8\x7f\xa2\x1c\xc4\x06\x35\x9a\x97\xd6\x1a\x20\x61\xa8\x64\xaa\x4e\x6c\x34\x25\x4b\x4d\x46\x64\xde\x8c\xe6\xd8\x0a\xc5\xad\xf6\x37\x63\x90\xa8\x36\xe1\x68\x35\x19\xcb\xb1\x0e\x5c\x8b\x89\x68\x5d\xf6\x64\x6d\x21\x29\x13\xd5\x09\x9b\x78\x1b\x8b\x70\x0a\x50\x2b\x5f\x90\xeb\x38\x77\x64\x93\x01\x26\x72\x60\xb4\x6e\xc2\xd2\xb6\x18\x18\xa8\x25\x0e\xe1\x87\x0a\xaf\xf1\xaf\x86\xd8\x40\x9c\xf6\x2b\x5b\x0f\x1a\x76\xc4\x9d\xbe\x43\x82\x2c\x44\x4e\xa1\x0c\xb2\x46\x61\xa7\xa4\x52\x80\xae\x7a\xf4\xe6\x79\x0f\x67\xa1\xd2\xb9\x94\x3e\xcd\xec\x65\xef\xb0\x1e\xdd\x13\x9c\x17\xb5\x7c\x28\x81\x36\x69\x25\x6e\x41\x06\x3a\xa8\xea\xbe\x7c\x67\xed\x00\xf8\x2b\x9f\xc2\xb5\xae\x57\xa3\x12\xe8\xc9\xef\x28\xe6\xcc\x08\xed\x35\x00\xcf\x5c\x4e\xd8\xab\xe5\x2b\x7c\xdb\x1a\x29\x7c\xe7\x62\xe5\xa5\xf5\x7f\xa9\x37\x5c\xff\x92\x48\x5e\x41\x57\x2f\x0a\xc1\x38\xb3\x41\x9a\x31\x28\xa8\x29\x8b\x1d\xa5\xb0\x13\x56\xb7\x92\xa2\x94\x44\x7c\xdb\x6c\x09\x10\xed\xba\x18\xb8\x13\xa4\xcf\xa0\x21\xf2\x85\x35\x6e\xe1\x14\x0d\x5d\xc1\x70\x81\x80\x76\xe6\xc7\xbd\xd9\xb6\xc4\xe9\x02\x12\x29\x30\x14\xb2\x3e\x0a\xbd\x0a\x35\x34\x80\xcd\x38\x12\xf2\xa1\x41\x38\x08\xab\xb2\x7c\xb6\xda\xce\x81\xd4\x36\x74\x1c\x62\xb4\xb2\xbc\xea\x93\x5f\x26\xd9\x0f\x0a\x72\x85\x25\x10\x36\x36\xbc\xab\x64\xd6\xc5\x91\x83\x18\x8c\x4d\x3e\xd9\xcc\x83\x09\x41\x5a\x41\x4c\x11\xab\xc4\x4a\x62\x5b\x01\xd5\x83\xed\x82\x08\xee\x9a\x05\x31\xe0\x29\x50\x6b\x8d\xc8\xa6\x39\x9c\x25\x8c\x90\x27\x6c\x91\x8b\x96\x9d\x41\x5f\xb5\x83\xe7\xda\xc5\xec\x99\xe8\xb8\x68\xab\x8d\xb6\xba\xb8\x5a\x0b\x04\x44\x2e\x48\xee\xa7\xee\x45\xa6\x23\x5e\xca\x58\x2a\xda\xfd\x18\x83\x90\x55\x33\x11\xea\xcb\xc5\x15\x1b\xa9\xbd\x31\xc2\xe8\xe7\x5b\xb5\xb0\xe0\xd6\x30\xbd\x57\xa8\x77\x40\x58\x68\x5e\x94\x32\x2c\xf4\x67\xec\x3c\x87\xf6\xac\xae\x97\x54\x3e\x2c\xe4\xc3\x26\x8c\xbe\xbb\x32\xf9\x13\xed\xdb\x73\x8a\x9e\x6f\x1f\x31\x2e\x94\x7c\x3c\xf2\x1f\x3f\xf7\x1e\x0b\x3c\xef\x83\x7b\xa8\x6e\x09\xc8\xa5\x3b\x88\x7a\x01\xb9\x9a\x05\x84\x4e\x03\xeb\xbf\x16\x00\xf9\x53\x2a\x82\x51\x15\x33\x81\x00\xd9\xd4\x62\x81\x46\xd2\x04\x98\xcc\x59\xbf\xd7\x88\xb0\x62\x8d\xe0\xc3\xe8\xe7\xab\xa6\x92\xf4\xac\xb8\x63\xc8\xaa\xb1\xbf\x78\x22\x46\xd4\xd8\x20\xde\xd2\x2a\x6c\xcd\xa2\x87\xde\xd3\x71\x39\xa5\xc3\xaf\x43\x44\xf9\xaf\x43\xeb\x20\xc9\xbe\x84\x5d\x48\x8f\x24\x23\xf6\x60\xb0\x20\x54\x86\x89\x12\xb6\xc7\x45\xb7\x33\xc6\xb8\x19\xda\xa0\xcb\x07\x40\xa4\x95\xf7\xcc\xfc\x29\xf1\x60\xdb\x9d\x43\x18\x22\x17\x83\x4f\xb9\x5d\x88\xdc\x77\xce\x36\x78\xdf\x60\xf7\x2c\xf9\xcf\xc0\x35\x16\xc6\xbe\x45\xa0\x7b\x5b\xe0\x45\x28\xc7\xe9\x9c\x65\x85\x5d\xa9\x97\x09\xa2\x11\x8c\x82\xbc\x21\xd1\xf9\xdd\x32\xe4\x27\x66\x48\xd5\xc9\x09\x87\x2a\xdc\xf7\x15\xf5\xa9\x3f\x6a\x6e\x4b\xaa\x39\x2f\x0b\x61\xff\x2c\x1d\xc9\x9d\x13\x79\xf7\x6e\xdb\xad\xce\x9d\x73\xe2\xad\xee\xe0\x1f\x0b\x2b\x16\x63\x71\xe3\x13\x05\xd8\xa1\xb8\x4a\xea\x86\x13\x4b\x57\x5e\x80\xa7\x85\x1f\x7f\x9a\xb7\x80\x10\x71\xa2\xdd\xa0\xfc\xe0\x86\xf6\x73\x27\xe6\x6f\x07\xf3\x7f\x6a\x33\xb4\xfa\x91\x10\x4d\x20\xe0\xda\xfc\xba\x6b\x34\x44\x27\x63\x7d\xae\x41\xa0\xb3\x6d\x65\x0e\x32\x09\xf8\x2f\x12\xf9\xe8\xa4\xa6\xf9\x4a\x31\x8d\x89\xf8\x3d\x12\x31\x03\xf5\x18\x30\x95\xa3\x84\xc2\x7e\xe0\x73\xc4\xbf\xfa\xcc\x9f\x14\x83\x6b\x7f\x19\x80\xa2\x71\x11\x09\xc5\x94\x6d\x10\x43\x1d\xd3\x1a\x0d\x00\xdb\x39\x5d\x53\x1d\x7d\x2a\x71\x83\xa1\x10\xaf\x18\x95\x2a\x71\x8f\x1c\x07\xc4\x64\x05\x25\x92\xd8\x8b\xfc\x74\x52\x68\x15\x7b\x5b\x9d\xee\x04\x00\x86\xf4\x47\x41\x09\xa5\x9e\xae\x2e\x32\xb4\x34\x90\xa0\x3b\xbc\x26\xae\xb4\x98\xc5\xa2\x74\x71\x8a\xa5\xe9\xc3\xd7\x4b\xef\xc3\xc6\x7e\xf8\xca\xfb\xf0\xce\x7d\x78\xe1\x7d\xf8\xdd\x7d\xf8\x71\x19\xf3\x29\xd4\x12\x09\x2e\x05\x27\xfe\xd9\xd9\xa0\x89\x4d\x0d\x59\xee\xd0\x24\x06\xc7\x40\x69\xf0\x29\x01\xf0\x98\xcc\x5c\xce\x6c\xdb\xb5\x37\xfe\xf7\xee\x83\xf2\x3e\x94\x6e\x62\x5b\xef\xc3\x96\x3e\x68\x7d\x45\xdc\x22\xc4\x91\xef\x87\x9d\x00\x62\x1c\x80\xd2\x15\xda\x06\xa0\x6c\xc7\x32\xdc\xa3\xde\x1b\x16\xe4\x63\xf6\x93\x4f\xc9\xf1\x35\x84\xf9\xad\x32\xbf\xb4\x1c\x7f\xd4\x7b\x4f\x62\x7c\x20\xc6\x04\x27\xfe\x48\x23\x33\x1d\xbd\x9c\xee\xa5\x8e\xe9\xcc\x77\x14\x88\xcd\xc7\x7c\xbd\x28\x60\x20\x21\xf2\x40\x5f\x38\xcd\x86\xbb\x23\xdf\xe9\xff\x60\x30\x63\x33\xa8\xb6\x34\x11\xb6\xe0\x7c\x2c\x29\x64\xd7\xae\x36\x83\xaf\xc5\x3b\x24\x03\x0d\x34\x02\x6e\xe8\xae\x09\xf4\xf7\x8c\x93\xf8\x55\x19\x72\x48\xb9\x30\x43\xbb\x4e\xac\x26\x8c\x7e\x8b\x96\xcb\x21\x76\x32\x11\xfd\xe1\xfd\xaf\x27\x6f\x8a\xf8\x75\x61\x82\x5c\x05\x7e\xca\xf9\xd7\x5e\x23\x9d\x48\xef\xcd\x9e\x22\x68\x51\xef\x4c\x60\x0b\x63\x2c\x4b\xb7\xda\x9a\x55\x79\xd3\x7e\x56\x84\xde\xcd\x97\x01\x06\x9f\x31\x32\xd0\x38\x90\x9c\x3a\x34\x36\x14\xc6\xa5\x06\x3c\xf8\xc9\xcb\x3f\xa1\x01\x33\xd8\x03\x8d\x61\xf4\xae\x48\x15\x58\xdd\x33\xed\x04\x3a\x7a\xe5\xfe\xbd\xb2\x3c\x9b\xc5\x48\xce\x42\x5e\x24\xe2\x17\x06\xab\x45\x3b\x36\x0b\x59\x10\xd5\xe8\x3d\xdf\xb6\xe2\xab\xdb\x73\x41\x82\x93\xcc\x08\x4c\xce\x8b\x1a\xc8\xa8\xaa\xa7\xe0\xea\xc1\x9c\x80\xb2\xb4\x7c\x04\x9b\xf8\xe4\xc6\x1f\x4f\x73\xe5\x78\xbd\x29\x44\x02\x0a\xae\x99\x04\x34\x8a\x49\x47\x64\x18\x55\x7b\x10\x30\x02\xa7\x0e\x27\xfa\x2f\x4c\x21\x61\x53\xe5\x46\x2d\xf3\x35\xde\xf3\x1e\x98\x87\xd3\xb9\x79\x22\x22\x08\x35\x98\x46\xd7\xac\x83\xaa\x5c\x9c\x5a\x41\x4f\x86\xb9\x67\x1f\x1e\x1e\xa1\x1f\x91\x35\xac\x67\xa7\x7c\x76\x6a\xd7\x20\xb6\xd1\x1d\xe9\xa9\x7f\x4e\x81\xb6\x2f\x9c\xc5\x07\xd1\x27\x28\x0b\x2e\x1a\xc4\x85\xf5\x91\x15\xb1\x33\x8a\xae\x72\xad\x58\x66\x06\x6a\x1b\x0f\x58\xe7\xf3\xdd\x88\x14\xe6\x07\x3d\xb9\x25\x7b\xba\x6c\xc5\x65\x69\x69\x24\x7e\x31\xa9\x54\x04\x19\xac\x34\x8e\x05\xea\x68\x59\x94\x21\x9b\xc3\xa9\xc4\xba\x93\x52\x2b\x76\xa2\x1d\x2b\x65\x1c\x4b\x0d\xdc\xbb\x3c\x75\x0e\xce\x48\x18\x0a\xb5\xfd\x2b\x8f\x1e\x5c\x6a\xd1\xbd\xc5\xf3\x91\x98\xce\xce\x0e\xb6\x1d\xb6\xa3\x19\xb2\xca\xbc\x47\xbb\x18\x69\x40\x69\xf7\x2d\xbf\xc3\xbe\xe5\x5d\xe5\x3a\x62\xd0\xfd\x75\xdb\x11\x7d\x6a\x2f\xf6\xce\x53\x2c\xe9\x93\x36\x1f\x9f\x75\x6c\x1e\x19\x9f\x5f\xc3\xda\x38\x23\xdb\x3c\xcc\x9b\x72\x18\x5a\x2c\x4c\xf8\x5b\x4f\xa3\xe7\x85\x33\x25\xd2\xa7\x42\xbe\x1a\x3b\x55\x0d\xbb\x15\x0b\x41\xd1\x73\x2b\x9e\x52\xc9\xc9\xe0\x31\x59\x8f\x29\x0a\x2c\x41\x5e\x57\x93\x47\x34\xe4\x3d\x18\xac\x91\xff\x37\x42\xef\x8c\xb8\xf3\xb5\x33\xce\xf5\x54\x80\xbd\xaf\x53\x33\x4c\x17\xf7\x69\x72\x78\x14\x1f\x4a\x3f\xd5\xef\x5b\xf8\xfa\xb6\x8d\xee\x66\xa1\xf0\xcb\x1e\x46\xcc\x6b\x4b\x30\x58\xbc\xcb\xb7\xf0\x58\xb7\x15\x88\x34\xf7\xd7\xe6\x72\x10\x9b\x27\xdd\x9d\x4e\x1a\x07\x3d\x2e\xb6\x36\xf7\x4c\x18\x3d\x13\x97\xd5\xcc\xc8\x17\x2f\xdb\x69\xbd\x2c\xbc\x28\x05\x2f\xe9\x2e\x5b\x75\xfe\x02\x0e\x0d\x39\x8b\x0b\x55\xfe\x87\x26\x5f\x25\xa8\xf1\x4e\x34\xf5\x1e\x5e\x31\xaa\xfa\x2d\x65\x7b\xe5\xdc\x27\x85\xef\xff\xf2\x77\xa3\xdc\x0d\xd1\x55\x01\xf0\xde\x23\xde\x6c\x3c\x67\xa8\xe4\xee\x54\x9a\xe6\xc6\x4a\xb3\xdf\x4c\x95\x54\x7b\x36\x59\xb9\x49\x8f\xcb\x06\x5a\x4c\x1a\x70\x31\x69\xa1\xda\x64\xf8\x3b\x82\x57\x4a\x7a\xe6\x8f\x42\xa4\x51\xb6\x14\x47\x6b\xa3\x6c\xc6\xec\x41\x60\xa4\xd2\x6e\xd7\x88\xc0\x40\x51\x1f\xd0\x21\xa8\xfd\x30\x7a\xaf\x9e\x39\x1e\xfb\xc8\x90\x48\x6e\x37\x5f\xfe\x0e\xf0\x00\x8c\x94\x0f\x35\x35\x01\xed\x64\xfb\xde\x79\xd3\x5f\xbd\x77\x8c\x49\x85\x92\x03\x83\x04\x85\x1d\x2f\xa1\x6f\x32\x9e\xda\x73\xed\x6c\x78\xd1\xe8\x6e\xb7\xe1\x89\x77\x82\x5d\x94\x03\x61\x98\x5a\xb4\x3c\xc5\x6b\x80\x4b\xe8\x98\xf3\x73\x1a\xdd\x15\xd5\xd5\xa2\xf1\xef\xf7\xa3\xb8\xdf\x5b\x66\x14\x9d\x87\xfe\xe6\x06\xe1\x15\xfa\x9b\x51\xf8\x26\x2b\x43\x6b\xe8\x8f\x42\x38\x2c\x37\x37\x27\x83\x2c\x1c\xa9\xdf\xb7\xe9\xaa\x7a\x5d\xbe\xc3\xa1\x56\x3f\x00\xc9\xc6\x49\x18\x59\x3d\xa6\xa3\xfe\x6a\x39\x45\x33\x26\x57\x36\x0d\x8d\x99\x7b\xe7\xf7\xda\x85\x73\x4a\x3e\xd3\x40\xbe\xf4\xee\x85\xb0\xc4\xf5\x2c\x81\xae\xd1\x24\xf3\x78\xea\x34\x10\xb7\xa5\x39\xa1\xb3\x3f\xa2\xb8\x74\xaf\x28\xe6\x67\xdd\x9d\x3a\x9e\x0c\xe3\x47\x26\x05\xe8\x2b\x91\xe6\x82\xc2\x0e\xa0\xcd\xb5\x9f\x3e\xa0\xd6\xbe\x0e\xb0\x37\x68\xee\xac\xb5\x88\x2e\x91\x52\xb3\x46\x82\x41\xa1\xbc\x70\x7a\x36\x80\x5e\x2d\x1c\x81\xa2\xdf\x48\xab\x7a\x73\x93\xea\xbf\x83\xbc\xd9\x50\x49\xb6\x09\x99\x15\xf8\x77\xbb\xda\xea\x05\x20\xff\xf8\xcc\x59\xe0\xa9\x56\xff\xa8\x26\xf3\xfa\x47\x57\x04\xdd\x2f\xfb\xd2\x92\xe7\xb1\x30\xd6\xf5\x50\x5f\x87\x2d\x10\x50\x94\xf7\x4f\xaa\x2d\xe0\x77\x0b\x38\x85\xb1\x6b\x61\xf2\x10\x33\x90\xbe\xde\x8d\xeb\x71\xf8\x35\xb7\x19\x7d\xa5\xff\xa2\x7c\x4a\x87\xee\x01\x72\xf5\xbb\xd3\x89\x7d\x8c\x6d\x76\xe3\x9e\xb8\x02\x5f\x9b\x13\x32\xef\x64\xa6\x5f\xa0\x4d\xd2\xb6\x52\x4f\x58\x94\x50\x4d\x6a\xa7\xd3\x88\x89\x6e\xe0\xcc\x26\x92\xbb\x7e\xd1\x34\xe9\x4a\x3c\x5b\x4f\x36\x04\x73\x4e\xdc\x18\xdc\x00\xdf\x71\x98\x03\x34\x19\x23\xc3\x7d\x63\x3f\xdb\xb6\x03\xed\x33\x49\x94\x41\xa7\xb7\x31\x6b\x18\xb0\x80\x02\x52\xc0\x5f\xcf\x41\x58\x2f\xd5\xb5\x37\x2a\x37\xad\xff\x8f\xbc\x3f\xef\x6e\x5b\x47\xf6\x05\xd0\xff\xef\xa7\x90\x79\xd2\x89\x18\x97\xc7\xcc\xd2\x46\x74\x9c\xc4\x99\x13\x67\x70\x46\xdb\xed\xa6\x45\x4a\x62\x42\x91\x0a\x07\xd9\x8e\xa5\xf3\xd9\x5f\x55\x61\x20\x48\x51\x76\x76\xf7\xb9\x6b\xdd\xf7\xde\x5e\x3b\x16\x08\x02\x20\xc6\x42\xa1\x50\xf5\xab\x86\x42\x63\x29\x9b\xd2\xda\x32\x0e\xe3\x82\x1a\xbb\x52\x93\x93\x8c\x4b\x49\xfb\x53\x5e\x9c\x5d\x34\x9b\x74\x50\xaa\xeb\xd7\x1b\x5e\x90\x45\x30\x39\xa6\x3e\x96\xc7\x3d\x8d\xbb\xa4\x0c\x40\x33\x11\xea\x37\xdd\x4c\x2a\xba\x99\x94\xd8\xc3\x5f\x4e\xda\x76\x66\x72\x2f\x90\x49\xa0\x2d\xe5\xd3\x33\x75\xf9\x76\x4e\xa4\xca\x86\x86\x1f\x4c\x11\x3d\x13\xea\x84\x73\x7b\x2c\xbf\xd1\x76\xa8\xe7\x5c\x2e\xea\x97\x66\x48\x90\xf9\xa2\x8b\x08\x6e\x2c\xf0\x80\xf6\x30\xee\xc6\xab\xab\x2e\x97\x5e\xde\xa0\xc5\x16\xcd\x51\xa2\x15\x92\x77\xf8\x45\x3f\x68\xbf\x94\x2e\x4b\xdc\xce\xcb\xa4\x7e\xf3\xf1\xb2\x72\x88\x4e\xff\x4c\xe1\x4b\x7f\xc9\x52\x79\x32\xfb\x2e\xf6\xf2\xfb\x13\xd6\xb5\xfb\x7d\xc2\x1e\x49\x02\xd1\xa4\x92\x8d\xb5\xb0\xb3\x04\xd2\xaa\xf0\x91\xbe\x56\x92\xd2\x3a\x16\x9e\x63\xa3\x4f\xbd\xca\xe4\x81\xd8\xd6\x6d\x12\x01\xfc\x24\x7a\x74\xa1\x44\x71\x59\x47\xc1\x89\xc7\x73\x08\xce\x72\xdc\xa0\x74\xc4\xb7\x04\x4e\x53\x09\x58\xdd\x84\x41\x03\x7a\x11\xab\xe4\x39\x51\x32\x3c\x63\xcb\x42\x58\xc3\x12\x47\xf8\xcb\xa8\x16\x91\x1a\x0d\x2f\x3e\x30\xe9\x07\xa9\x93\x96\x37\x2a\x85\xa5\xb6\x52\x58\x50\x26\x11\xb1\x52\x74\xf9\x8a\x03\x86\x3b\xb1\x07\xb1\x07\xa1\x07\xa9\x07\x99\x07\x89\x27\x6e\x81\xe7\x89\x8b\x20\x52\x46\x83\x20\xa5\x5b\x48\x75\x4a\x59\xf2\xc5\xb4\x93\x78\x90\x93\xdf\x55\x60\x3b\x24\xe4\xc5\xbf\x8c\xc2\x5c\xe2\xf7\x52\xd2\x0c\xb9\x8f\x3c\xfc\x2d\xc3\x48\x6e\x27\x8f\x93\xb1\x74\xe0\x8a\x9d\x82\xdb\x7b\x38\x0e\x49\x77\xa5\x73\xe0\x5c\x5c\x38\xe0\xcc\xe7\xce\x11\x50\xb2\x08\x29\x54\xe5\xa5\x7c\x4b\xaf\x4b\xa4\x54\x2e\x95\x35\xe9\x70\x27\x34\x1a\x73\x18\x96\x9a\x37\xf8\xb2\xa2\x5d\x83\x5f\x94\xca\x78\x84\xca\x90\x71\x01\xb2\x39\xbc\x1f\xd3\x6b\xf2\xaf\x43\x31\x71\xc2\x4e\x02\xf8\xe5\xc2\x01\x50\x0e\xa7\x84\x10\xd5\x7d\xd3\xcf\x94\xf8\x3e\x4e\x1e\x67\x19\x43\x54\x12\x9c\x24\x16\x30\x87\xc2\x13\x9e\x07\x23\xec\x4b\xd2\xc0\xf0\xd2\x4e\xd3\x14\xc5\xe9\xe3\x65\xc1\x8b\xb8\xe9\xe5\x1b\xda\xf0\x26\xc9\x29\x4e\x82\x5b\xae\x4c\xb8\x57\xe4\x97\xa7\x5c\xdb\xc2\xb4\xab\x5b\xba\xd8\xe6\xf4\xed\x7c\x43\xac\xdf\x71\xff\xda\xea\xad\xdf\xb9\x59\xf9\x4a\x07\x23\xda\x56\x69\xdb\x54\xda\x36\x6e\xb7\xdd\xaf\x89\x58\x50\xc4\xd5\xa2\x53\x9c\x47\xc2\xc1\xe3\x0b\xc3\x1b\xf8\x96\x49\xab\x37\x0d\x87\x12\x35\x74\xe3\x47\xf6\x24\x19\xab\xdd\xd0\xc4\x13\x03\xc5\x9c\x06\x56\xb7\xb1\x04\x5a\x82\x09\xc1\x30\x37\xd0\x59\xf5\x6e\xfd\xd4\x4b\xe3\x2b\x13\x48\x56\x8d\x8d\x1d\x9b\xb1\xa4\x48\x98\x47\x95\x33\x8e\x02\xc8\x3a\x24\xf6\xe8\x76\x43\xda\x41\xf6\x3d\x3c\xd2\x78\x24\x1c\x0c\x71\x48\x19\xcf\xda\x21\x31\x7a\x67\x63\xe3\xf4\xf4\x74\xfd\xf4\xd6\x7a\x92\x0e\x37\xb6\x1e\x3c\x78\xb0\x71\x46\x6f\x1d\x9c\x8d\xf9\x68\x69\xaa\xfb\x1b\xd4\xcd\xfc\xe7\xcd\x6b\x07\x08\x4c\xbb\x21\xe1\xf6\xe6\xe6\xe6\x06\x21\x6f\xc3\x19\x4e\xa2\x9f\xcb\xbf\x48\x6f\x31\x51\xf3\xe7\xbe\xbe\x79\x2d\x3f\x69\x20\xb6\x39\x69\x9c\x2d\xfd\x24\xbf\xdd\x70\xe6\x48\x15\xec\x71\x59\x18\xf9\xde\xca\x56\x47\x87\xaf\x5f\x37\xa2\x74\x56\xf5\xa0\x10\x9f\x3f\x48\xe0\xf1\x34\xf0\xc8\x2c\xad\xdd\xf0\xc1\xfd\x0f\x1b\x1f\x3f\x3f\xdb\xda\xda\x18\xc8\x34\xff\xf5\xc8\xcb\xc2\xfe\x47\xa6\xbc\xf8\x88\xeb\x7f\x6b\x7d\x0b\xcf\x54\x99\x27\x0e\x9c\x04\x1f\xc7\x19\xfd\x49\x7e\xe3\xdf\xd3\xe0\xe4\x67\x98\x3b\x47\x7c\x6c\x8d\x3c\x18\x78\x48\x17\x60\xe2\x61\xdf\xc3\xd4\x83\xa1\x07\xe7\x1e\x9c\xb0\x49\x6c\xe4\x89\xd4\xeb\x35\x6a\x4f\x13\xe8\x23\xb9\xfa\x91\x30\xe5\xbd\xda\x8d\x80\xba\x49\x7b\xfb\x91\x3d\xf9\x76\x9a\xdf\xd2\x26\xd2\xa9\x16\x4e\x2a\x84\x95\x82\xe5\x99\xdb\xd9\x1f\x95\xca\x78\xd8\x91\x41\x66\xeb\xeb\xb5\xcc\x10\x65\xad\x84\x05\xc4\x64\x44\xd1\xba\x6c\xa2\xd1\x05\x4d\xd0\x1a\x27\x59\xde\x8a\xc2\x9f\xa4\xbc\xd9\xf7\x48\xd5\x4a\x79\xb2\x54\x4a\x98\xa1\xd2\x20\x3d\x4f\x8a\x1b\x29\x1e\x5c\x53\x36\x15\x65\xfb\x12\x96\xad\xe3\x10\x10\xbb\x43\xd2\x67\x52\x15\xd0\x15\x5c\x6f\x7d\x0c\x02\xfd\x79\x6c\xba\xc1\x25\xfb\x91\x71\x35\x88\x98\x66\x39\x4d\xd1\x35\x2f\xf6\xd7\x38\xef\x9a\xd1\x44\x45\x5a\x88\x15\x4b\x69\xe3\x26\xb2\xc8\xb3\xc1\xd1\x1b\xfb\xf2\x8e\x84\xaf\x89\x64\x56\x7c\x8f\x9c\x8d\x38\x7e\x38\xc5\xf1\x9f\xd0\xf8\x8f\x25\x68\x16\x4d\x00\x19\xfa\xa8\x10\x75\x71\x83\x38\xf1\x9a\xb8\x80\xaa\xa1\x98\x65\xb2\x4b\x07\xac\x39\x4e\x11\x31\xf1\xb4\x48\x7b\xe8\xad\xad\x5d\xbf\xbe\x32\xf0\x58\xb0\x3d\xa6\x57\x07\x43\xef\x08\xe7\xd4\xc1\xd8\x3b\x72\x07\x9e\x38\xf1\x30\x5a\x3a\xda\x65\x5c\xf6\x73\x4f\x64\x26\xfb\xb9\xa7\x44\xe2\x53\x8a\xa5\x9c\xab\x63\x4f\xbb\x48\xde\x84\x2d\x77\x3d\x4f\x3e\x91\xdd\xf7\x63\x24\xce\x6d\xb7\x7c\x49\xd2\x8b\x2d\x52\x0e\x3f\x98\xe2\x67\x2e\xe4\x77\xa6\xf8\x1d\x29\x41\x1f\x90\xab\xc5\x81\xdd\xba\xea\x61\x57\x83\x11\xcb\xbb\x4a\x52\xa8\x20\xcb\x44\xec\xbd\xf5\x30\x8e\x83\xf4\xf9\xfe\x9b\xd7\x82\x40\xd9\x04\xae\x0d\xbe\xf7\x8e\x92\x38\x90\x7a\x17\xf8\xcd\xaa\xc7\x0c\x56\x7a\x0a\xc9\x50\x24\x3d\xd7\x7d\x4b\xe0\x95\x0c\x7d\x1b\xd7\x65\xff\x04\xab\x43\x37\x6e\x25\x48\xbb\xc6\x77\x9f\xab\xc3\x2f\x56\x9b\x36\x48\x5e\xa0\xa7\x1e\x9c\x79\xc8\x48\xc1\xae\x8a\xfc\x9b\xe4\xf8\x6b\x72\xfd\x7a\x73\xf2\x25\x73\x49\xcd\x9c\xc5\x92\xce\x3c\xd1\x58\x90\xa2\xf5\xc7\x48\xeb\xcf\xaa\xfd\xcd\x7a\x88\x0c\x48\x2a\x4f\x8d\xf3\x39\x55\x7e\x59\x03\xc2\x20\xa3\x26\x5c\xd0\x87\x3b\xe6\xbb\x73\xd7\x2a\x5f\x0a\xe0\x8e\xbd\xf9\xb2\x56\x51\x19\xe5\xec\x5f\x28\x0a\xfb\xb1\xb1\x0d\x98\xcd\xfa\xca\xcf\xc6\x59\x73\xa5\x5e\x20\x39\xd1\x31\x7a\x80\x73\xab\x95\xb2\x7f\x95\x7a\xed\xa9\xa9\x81\x8c\xb6\xbe\x7b\xea\x89\xba\xca\x9e\x1d\x61\x80\xcb\xaa\xab\xb3\xa2\x58\xa9\x40\xae\x03\x3a\x18\xd8\x3c\xae\x20\x94\x3b\xd6\x99\xa4\xeb\x83\x65\x3d\xc7\x38\x7d\x78\xb4\x9c\xb7\xa5\x56\xd5\x8e\x07\x1f\x3d\x78\xec\xc1\x9e\xa9\x74\xc9\x35\x57\x7b\x00\xde\x35\x24\xd1\x52\x46\xd8\xf7\xc4\xc6\x3f\x0f\x0f\xd4\x1d\x5d\xbb\xd7\x61\x2e\x73\xf6\x34\x8c\x02\x52\xa0\x70\x0f\x8f\xae\x6d\xc0\xd3\x4a\xf3\xe7\xf0\x81\xc1\xda\x02\xaf\xb7\xb2\xd0\x2b\x07\xce\x3f\xfa\x5a\x9f\xfe\x47\xd6\xfa\x47\x7f\x73\xfd\xde\xfa\x2d\xfc\x65\x9b\x3c\x32\xce\x23\x79\x18\x60\x04\x51\xd2\xf5\xf5\x75\x06\xd9\x8d\x92\xb4\xd3\x4a\x87\x27\xed\xad\xad\xdb\xd0\xda\xba\x73\x0f\x5a\x77\xb6\xdd\x2e\xd2\xa4\x38\x5f\x3b\x65\xdf\x74\x1d\xdc\x57\x90\xe6\x46\xdd\x6a\x86\xfb\x77\xa0\x25\xff\xfd\x5f\x49\xbe\x8d\xb5\xb9\xbd\x09\xad\xed\xed\xdb\x4b\x32\xb4\x48\x05\x60\x4d\x29\xdb\x61\x4f\x74\x5a\xc4\x60\xa4\xc4\x24\x77\x91\x8a\x07\xc2\xf9\x26\xf7\xa8\xb4\x88\xf9\xf2\x53\x4b\x5d\x65\xc7\x54\xba\xa5\xb5\xd6\xc2\xed\x32\xf3\x86\x81\x32\x7b\x38\x21\x93\x05\x3a\x29\xf0\xcd\x31\x69\x1b\x2b\x2e\xd0\x18\x55\x90\xde\xe9\x20\x3c\x23\xcb\x06\xe4\xea\xc7\x52\x95\x9e\x50\x17\xd8\xce\x01\xdf\xa6\x2d\xe2\x15\xc3\xbe\xe4\x5d\x0e\xe3\xc3\x78\x3f\x31\xf6\x10\xf6\x88\x78\xbe\x74\xe3\xd5\x62\x54\x6d\x2f\xe7\xcf\xb1\x50\x93\xf6\x5e\x5d\xd2\xa2\xc1\x84\xb1\x93\x58\x5a\x76\xeb\x74\x14\xc4\xa6\x04\xda\xbb\xc7\x61\x1c\x0e\x42\x32\x0e\x31\x5f\xcd\xe2\x10\x09\x77\xde\x58\xfe\x46\x11\xeb\x1c\x8a\xe7\xb6\xe6\xdd\xc6\xcd\xf2\xed\xcd\x8d\xb9\xcb\xf5\x78\x16\xe4\xb2\x7f\xd8\xb2\x40\x32\x23\x5c\xf4\xd2\xbd\xdf\x7a\xc9\xc6\x1a\xc9\x34\x48\x07\x51\x72\xca\xda\x24\xb8\x7f\x64\x7c\x78\xda\xc8\xbd\xe1\x10\x6b\x61\xb2\x5a\xd9\x86\x12\xa4\x60\x98\x24\xc3\x88\x8f\xae\x1b\x48\x97\x8a\xf1\xc6\x7f\xad\xc8\x5f\xed\x1f\xac\x92\x29\x3f\x0d\xe9\x42\xab\xaa\xb3\x42\x2d\x78\x4a\x37\x28\x2d\x8f\xec\x59\x7b\xd8\x21\xb6\xe9\xaa\x69\xc8\x1f\xea\xbc\x50\x71\x4e\xf7\xf1\x02\x0d\x8b\xc5\xca\x8a\x3e\x55\x70\xe5\x1f\x27\x51\xe4\x4d\xb2\xc0\xef\xaa\xe8\x83\xb8\xe7\x54\xdf\x38\x1d\x27\x4a\x86\xce\x91\x12\x16\xab\x74\x24\xc0\xd6\x25\xe1\x6b\x36\x36\xba\x7e\xbd\x52\xf6\x2e\x5b\xdd\x21\xc9\x12\x4f\xbd\x39\x12\x2f\xb1\xc0\x5e\xb2\x75\xdb\xc2\xa9\x8c\xec\x3b\xe5\x36\xbd\xb6\x75\x54\xa2\x84\xb1\xf1\x34\x51\x4e\xd2\x0a\x50\x4a\xab\xbc\x09\xd3\xe5\x65\x55\x48\xd5\xb5\x40\xb8\xa5\xd3\x59\xeb\x4e\x82\xc5\x84\xac\x43\xed\xfc\xe5\xac\xa6\xab\xce\xc3\x96\xb3\xaa\x80\x6e\x32\x63\xd5\x45\xc6\xec\x24\x5e\x26\x5f\x4c\x25\x84\x75\xd8\x74\x3d\x17\x4a\x5c\x6d\xe7\xa6\x43\x92\xf5\x40\xfb\xa1\x99\xcf\x17\xcf\x6f\xa6\xf7\xaa\x44\xb3\x83\xc4\x11\xeb\x70\x09\x5d\x5c\x4e\xd3\x1c\xe3\xa4\x8f\x6e\x5c\x61\xa7\x32\xe6\xd6\x08\xd5\x2a\x50\xea\x7e\xd3\x0e\xd3\xc1\xd1\xc1\x9c\x3c\x54\xdc\x11\x3f\xf0\xd8\xf4\xc8\xf3\x4b\xd5\x0e\x07\xde\x7a\xe2\xc6\x4e\xab\xd4\x44\xf5\x70\x05\x13\x86\x18\xad\x42\x66\x94\x19\x59\xe7\x1f\x19\xe0\x04\xce\x49\x2d\xe4\x1f\x19\x27\x92\xbb\x61\xe0\xdf\x80\x27\xf5\x29\x20\x5f\xdd\x78\x83\x93\x96\xe8\x24\x29\x8b\x93\x92\x38\xd9\x7d\x3a\xad\x49\x54\x0c\xc3\x78\xbd\xf5\x8d\xcc\x2f\xbc\xf3\x56\x1c\x48\x9a\x48\xf7\xa8\xe4\x95\x14\x57\x8a\x4c\xd2\x9a\x86\xde\xd5\x1c\xbe\x4c\x9b\xfd\x97\x43\x85\x67\x78\x32\x7c\x51\xe7\x8f\x48\x3b\x51\x81\xeb\x98\xab\x83\x93\xb6\x42\xf2\xd0\x13\x6c\xd8\x76\x6c\x8f\x37\xd8\x2f\x94\xaf\xea\xde\x81\x1b\x37\x9b\x71\x5e\xb6\xd3\x0a\xa1\x92\x89\x9c\x19\x1a\xc1\xc7\x7a\x5c\x8c\x4f\x02\x9d\x07\x9f\x3d\x29\xc7\xd4\x8f\x72\x75\x58\x45\xce\xe1\xb5\x27\x5e\x78\xf0\x19\xb7\x64\x99\xb7\xd3\xc4\x21\xa9\x0f\x14\x7c\x3d\x5f\x90\x5b\x01\x6c\xd9\x2a\x21\x1f\xac\x12\x20\x78\xb0\x86\xdc\x47\xaf\x41\x5a\x9d\xaf\x06\x37\xe3\x79\x83\x79\x65\x3e\x57\xc8\xe4\xc0\x35\x6c\xfa\x28\xeb\x17\xb1\xed\xd9\x4a\xc6\x37\x65\x2b\x04\xd0\xe8\x5a\xfc\x90\x64\xdc\x18\x5e\x94\xfe\x24\x22\x15\x2c\xad\x41\x72\xde\xce\x8d\x2c\x57\xa3\x4e\x74\x13\x66\xd3\x09\x79\xed\x35\x32\x74\xf8\x8b\x1c\x5d\x72\x24\x6f\x24\x31\x6f\x37\x31\x6e\x43\xba\x09\xa1\x46\x48\x90\x36\xfc\x63\xa5\x08\x16\x53\x50\x21\x0b\x0c\x5c\xe5\x4e\x23\x95\x08\xcb\x31\x31\xcc\x24\xbf\x27\x2d\x46\x63\xf1\x35\x07\x39\x28\x4b\xbb\x40\x19\xe0\xad\x8c\x64\x27\x8c\xea\x9d\xc0\x1d\xc0\x66\x06\xa9\xb6\x54\x97\x88\x73\xae\x01\xfa\x03\xd2\xf6\x6f\xeb\x47\x32\xff\x25\x2c\x5c\x26\x2d\x09\x19\xfe\xd7\xbb\xc4\xed\x64\xba\xed\x6e\x59\x60\xe0\xda\x25\xe0\xc9\xb0\x56\x7e\xa6\x7b\xa3\xb4\x88\x2f\xb1\x4a\x1a\x7b\x86\x1c\x83\xcb\x61\x21\x8d\x0f\xfc\xba\x74\xac\x51\xed\xa2\x6c\x8e\x7d\xf4\xc6\x13\x9f\x3d\xf8\xe4\x89\x53\xf8\x42\xdc\x23\xfc\x46\xc6\xf3\xf0\xe0\x30\xbb\xd9\x3e\xbc\x39\x3b\xd8\x5c\x7b\x70\x34\x3b\xd8\xc2\xbf\x1c\x5c\x75\xf1\xc5\xe1\xd1\xc6\x10\x1e\x51\xb2\x9b\x1b\xf0\x8b\x33\x5d\xab\x9e\x89\xb5\x07\x29\x82\x63\xc0\xe3\xd1\xba\xd6\x98\xc3\x33\xa8\x40\xfa\xf9\xdf\xec\x07\x87\x2d\x07\x94\xfa\x9f\xc6\x39\x34\x3a\xbf\x52\x67\x78\x97\x0f\x8a\xf2\x16\x59\x21\x62\x60\x3f\x6c\x1e\x69\xaf\xe5\x2a\x46\x6d\x3a\xaa\x28\xa5\x89\x22\x1e\x79\xfa\x52\xac\xa2\xe0\xed\xf0\xc7\x7b\xec\x52\xe0\xb8\x1d\x28\x75\xc7\x75\x83\xb3\x2b\xc1\x1f\xc4\x4a\x3e\xef\x5e\xf3\xac\xe3\xc0\xc5\xe2\x25\x6d\xa3\xa8\x55\x69\x86\xea\x6b\x74\xfb\x46\x77\x0e\xf4\xb1\xa6\x5c\xc7\x6d\xeb\xdb\x3d\xa5\x68\xa0\x15\xe6\xb0\xd7\x08\xf0\x7c\x95\x0a\x50\x16\xfa\x9d\x46\x01\x13\xa5\xe6\xc6\x05\x9d\xc5\x8f\xf7\xcc\x41\x27\xe8\xa8\xcf\x61\xc1\xc6\xe4\x3f\xd7\x9f\x81\x40\x87\x5c\x03\xe8\x07\xd9\xe5\x6d\xee\x69\xbf\x11\x54\xa2\x11\x44\x70\x91\x6a\x9a\xae\x6e\xb9\x6c\x54\xa6\x8a\x66\xc9\xb5\x3e\xeb\xd8\x54\x6c\x41\xad\x91\xb6\xb7\x7e\x12\xa4\x7d\x92\xe9\x60\x07\xf0\xc4\xd8\x1b\xfc\xad\x3c\x35\x28\xf4\x26\xaa\xc9\x37\xe7\xb5\x74\x1a\xd9\xab\x1e\x2f\x3e\x79\xa6\xff\x5c\x12\x5a\xd3\x74\x7f\xef\xc1\x77\x4f\x1c\x93\xe2\x2c\x3c\xc7\x83\x1d\xbc\xc2\xbd\x59\xf9\x47\x60\x5e\x3a\xc1\x9d\x30\x4e\xe2\x35\xf6\x1d\x10\xf6\x5b\xd2\xf9\x1c\x3c\xf3\xc4\xbb\x6e\x93\xec\xf3\x34\x24\x2f\x93\xbd\xf7\x52\x86\xd1\x69\xaf\xd4\xc5\x04\x1a\x00\x83\x6d\x78\x71\x1c\x99\x17\xde\xd1\xda\x18\x4f\x53\x62\xa0\x94\x47\x41\x64\xb5\xfe\x2a\x0d\xfa\x96\xa5\xee\xae\xae\x86\xee\x92\x77\xa4\x37\x76\x10\x1e\xad\x3a\x1f\x9a\xde\x3a\x47\xdd\x25\xf9\x18\x15\x8a\x61\x2a\xf6\xc3\x71\x90\x14\x04\x9a\xd1\xfc\x81\x3a\xfd\x20\x1a\x6d\x41\x6f\x3e\x21\x35\xf8\x38\x39\x65\xe6\x52\x6e\x46\xde\x19\x49\xbb\xee\xae\xb5\xe3\x35\xd2\x42\xcb\x44\x6a\x1f\x3f\xf2\x76\xbc\x4a\xae\x1e\x08\x84\x5d\x60\x10\x32\xb2\xf1\x6e\x67\x1e\x6c\x82\xec\x5b\x17\xb0\x73\x65\x70\x49\xf7\xf1\xc8\x7e\xf3\xe0\xa5\x27\xde\x7b\xdd\x6f\xcd\x97\x07\xb2\x84\xeb\xd7\x55\x49\x78\xb6\x67\x21\x64\xdc\x6f\xbe\x4f\x58\x4c\x46\xcd\xea\x2d\xce\xc9\xe6\x84\x6d\xb7\x69\xd7\x2f\x7b\x47\xce\xc6\xaf\x9e\xc0\x6a\xe7\x85\xb8\x30\xb7\x8b\x9d\x0b\x9f\xc0\xfc\xfa\x7c\x2d\xe5\x9c\x04\xe4\x45\xf3\x45\x1c\xe6\x8e\xa6\x29\x6c\xd2\xe1\x20\x93\xaa\x73\x90\x5c\x9e\xd9\xe7\x6a\xd6\x90\x33\xa9\xf3\x70\x47\x1b\xba\x61\x9c\x56\x3c\x1e\x21\x3b\x79\x12\x04\x74\x80\xd6\xb9\xf8\xb8\x27\x01\x03\xa4\xec\xf8\x24\x50\x88\x43\x6c\x38\x4e\xf0\x83\x24\x84\xc7\x38\xb6\x24\x90\xec\x24\xe1\x04\x28\xf5\x65\x6d\xd3\x8b\xd5\xab\x7c\x4a\x22\x0b\x70\xc1\xa4\xb4\x23\x4d\x41\x13\x52\x99\xc2\xe3\x39\x32\xbb\x79\x02\xfc\xe9\x34\x18\xe2\xfa\x8d\xb0\xce\x78\x66\xa6\xa8\x73\x69\x21\x8a\x14\xbd\x95\xd6\xb5\xad\x4e\x82\x12\x3e\x80\x8e\xc2\x24\xd6\x96\x98\x46\x9a\xb9\xf5\xfa\x04\xc3\xc3\x35\xd2\xc9\x5a\x4f\xf6\xde\x00\xd7\x13\xeb\x28\x23\x1d\x6d\x6e\xfc\x87\xd2\xed\x71\x38\x4c\xd9\x77\x4b\xa3\x40\x7b\xfd\xc6\xbc\xbc\x17\xac\x0c\x88\x8e\x6d\x18\x49\xf5\x02\x69\xd4\xbe\x25\x64\xa2\xae\xaa\xf1\xf8\x55\x6b\x41\x83\x1e\x14\x1f\xf5\xda\x41\x8f\x7e\x19\x5c\x85\x7e\x5d\xd2\xa2\xd2\x1b\x83\x32\xfc\xd3\x8a\x05\x2b\xf9\x81\xf5\x7c\x64\xbc\xec\xaa\xba\x1a\x43\x41\x13\x53\x86\x7c\xe3\xb3\xb0\x7c\xa9\xe6\x58\x6f\xbc\xe4\x85\xdb\x51\x86\x96\x6a\x36\xf0\xd1\xa6\x69\xf6\x85\x04\x20\x3a\xc5\x4e\xc5\xf5\xc7\x89\xfe\xee\x74\xfc\x5f\x19\x3f\x58\xda\xf6\xfa\x1b\x6b\x1c\xe9\x56\xbd\x97\x5b\x6e\xa5\x78\xde\x92\x0d\xd0\x42\xa4\x5a\xfb\x41\x01\x71\x21\xf6\x21\x2c\x98\x75\xe5\xbf\x19\xfd\xed\x36\x50\x23\x85\x10\xd2\x0b\x0a\xa1\x82\x9d\x36\x86\x17\x15\x9c\x6c\x18\x6d\x64\x8b\x0b\xfa\x33\x12\x61\xd1\x0d\x2f\xbd\x0d\xc1\x13\xd9\x88\x70\x8c\x0b\x56\x3e\x0e\x31\x0b\xee\x00\xe2\x4d\x9b\x22\xd3\xa2\xe7\x75\x0a\xd2\x42\xfa\x4c\xda\x99\x73\xe2\xb3\xc3\x76\x5a\x10\x29\x0f\xdb\x59\x21\x41\x63\x73\xd6\x93\xd2\xb7\xa3\xee\x45\x86\x7f\x0c\x82\x84\xc0\xcd\x3f\x88\x1b\x38\x7c\x29\xba\x0d\x8a\x72\x47\xa0\x52\x94\x79\x40\x7d\x27\x55\xc2\x80\x26\x78\xba\xca\xd9\x4b\x1e\xd9\xa8\x52\x8c\xd8\xe2\xc2\x27\x92\xff\x82\x55\xbf\x84\xea\x97\x60\x53\x3a\x31\x8e\x06\x29\x0e\x79\xa4\x57\x41\x08\x1e\x05\xc1\xd6\x10\xb4\x2c\xf5\xc5\x67\x06\xa6\x2f\xf1\xad\xf0\xac\x62\xa0\x5c\x96\xe9\x98\xac\x53\x53\x95\x42\x3d\xa1\x2f\xce\x21\x28\xd6\xf1\x94\xd0\x94\xbe\xde\xf8\x05\xd0\xc3\x83\x23\xa5\xb1\xa7\xce\x70\x96\x0e\x5e\x8b\xbc\x06\x28\xc4\x3f\xab\xa7\x52\xdc\x52\x1b\x37\xb4\x9c\x2b\xd6\x93\x3f\x15\xfd\xab\x84\xae\x6d\x72\x58\x5b\x0b\x67\x33\x76\x45\xc0\x30\x92\xf5\x58\xda\xa3\x45\x5a\x3a\xcd\xe1\x4b\x1f\x52\xfc\x22\x3d\x3a\xd2\x14\xa3\x76\x2a\x35\xd7\x3f\x6a\x2b\x0e\x28\xeb\xfe\xaa\x8c\x74\x06\xfc\xe3\x3e\x8a\x65\x4e\xb9\xf3\x27\x85\xc0\xc5\xe4\x55\x97\x83\x8f\x47\x36\x8d\xbe\x4e\x16\x1e\xda\xbc\xb3\x84\x68\x57\x11\xca\xa4\xb5\xb4\x95\x37\xe3\xfd\xdd\x9b\x43\x51\x88\x2f\x30\x2a\x6a\x64\x58\x2a\xe6\x6a\x74\x89\xbc\x72\x70\x09\x2c\x37\xe1\xa5\x45\x28\x5b\x96\x5b\x06\xa2\x74\xbd\x86\xc9\x4d\x04\x92\x7c\x2f\x7a\xac\x63\xcb\x2c\x26\x6a\x53\x13\x1f\xb2\x7d\x7e\xcf\x80\x72\x3a\xaf\xb1\x54\xaf\x46\xcb\x4f\x3e\x56\x9b\x0b\x19\x66\xb0\x3b\x43\xe4\x72\x4b\x77\xf8\x38\xfb\x47\x85\x7d\x7a\xd2\x6f\x2b\xe7\x87\x4a\x95\x35\x96\x75\x43\xbd\x09\x96\x76\xb1\xea\x18\x3b\x07\xac\x63\xca\x84\x72\x8f\x6b\x55\x3d\x17\xd4\x73\x10\x46\xdf\x4b\x55\xbf\x32\xe7\x3e\x7d\x6a\x21\x63\xa5\x02\x95\x8c\xd8\x94\x4e\xd3\x69\x37\xcc\x58\xad\xa7\x67\x0d\x53\xc7\xea\xb0\xae\xf1\x46\xc2\x45\x18\xaf\xb6\x8b\x3d\x52\xed\xf7\x32\x97\xdc\xa2\xfe\xad\x6f\xbf\x60\xad\x82\xb2\x0d\xc4\x45\x2d\x34\x99\x91\xc0\xe9\x08\x6e\xf5\x11\xcd\x0a\xba\xc3\x5d\xec\xd9\x5a\x2d\xb5\x16\xe4\x7b\x77\x61\x42\x99\x77\xcf\xdc\xea\x2c\x35\x2f\xbe\xe9\x63\x54\xbf\x80\xa8\x80\x41\x21\x46\x05\xf8\xbc\xc9\x4c\x0a\x8d\x83\xe8\x48\x45\x60\xc7\xed\x46\xb8\x9d\xf1\x91\xb4\x59\xb6\xd3\x2d\x15\x2e\x94\xa3\xfd\xa4\x68\x02\x08\x0d\x45\x4e\x86\x53\x7d\x2c\x0d\x77\xde\x69\x98\x14\xd9\x23\xa2\xc1\x1d\xac\x45\xa9\x98\xf5\xc6\x8b\x91\xd7\x48\x59\x8b\x6f\x50\x20\xc5\xef\x23\x01\xef\x17\xeb\x0b\x29\x5c\x20\xb5\xf2\x8c\xf4\xbf\x72\x2f\xfb\xc9\x01\xc5\x28\x70\x58\x33\x98\x9d\x1c\xcf\xbe\x5c\x84\x7e\x6b\xa6\x3e\xe9\xf9\xc5\xbe\xdd\xd5\x5f\xdb\x54\xc1\xc5\x8f\x69\x8c\xb4\x95\x3e\x2d\x33\xab\xf2\x5c\xb0\xfe\x14\x76\x80\xf5\x64\xa9\xbd\x97\x4e\x93\xb8\xfd\xf5\x42\x78\x86\x2a\xc9\x77\x65\xbe\x51\xdb\x9f\xb6\xad\xaa\x83\x9c\x98\x75\xe7\xc9\xd5\x49\x4d\x98\x0e\x5c\x6b\xd1\xd4\x94\xe6\xf6\x61\x05\xda\x76\xd9\xcd\xeb\x65\x59\x56\xcb\x67\xb4\x5c\x6e\x84\xff\x5f\xcf\xc9\xc3\x55\x59\x94\x9f\x0c\x32\x6c\x25\xad\x5f\xd4\x17\xe1\x92\x84\x2f\xda\x7e\xc1\x3d\x52\x73\xe8\x7d\x75\x9d\xed\xe5\x62\xbe\x65\xfb\x9e\x6e\x14\x1f\xe3\x4e\xd4\x2f\xe4\x29\x3f\xc6\xbe\x25\xa7\x05\x71\x75\x24\xbb\x2e\x7b\x91\xab\x44\xc5\xeb\x3c\x3f\xcd\x77\x58\x83\x82\x60\xac\xe5\x1a\x1c\x17\x02\xd7\xe0\x94\x57\xdf\x10\xd7\x46\x1e\xf6\x7f\x76\xea\x17\xca\xc4\x39\x29\x31\xf1\x57\xba\x1f\x32\x86\x16\xa4\x53\xbe\xd9\xcd\xff\x9a\x16\x7a\x43\xcf\x49\xa2\x1b\x88\x69\x41\xa6\x67\xc1\x3a\x15\x87\xec\xcf\x6c\x86\x29\x14\x5e\x6b\xbe\xb6\x06\x5b\x6e\xd7\xd8\x60\x98\xbc\xbd\x97\x5e\x7b\x58\x70\x16\xb7\x83\x01\x75\x57\x4b\xea\x71\x8b\x64\xd8\x0c\x12\x94\x29\x67\xb3\xb6\x9d\x0d\x49\x5b\x59\x20\x0d\xf9\x49\xb2\x40\x44\x0c\xea\x18\xb2\x25\x65\x23\x18\x6c\x2c\xa6\x46\x90\x8f\x6d\x89\x03\xc1\x58\xd0\xb1\x36\x1a\x20\xf9\x16\x3d\x4a\x13\x18\x64\xe9\xce\x0b\x31\x2c\xe0\xa4\x81\x9b\xee\x96\xe2\x39\x12\x8b\x58\xc2\x0d\xe9\x41\x70\x89\xeb\xc4\x40\x9f\x91\xa4\xaa\x7a\x70\xa4\x0d\x91\xad\x5b\x0b\x96\x37\x4b\xb3\x9b\x64\xac\xb7\xd2\x12\xbb\x02\x16\x32\x68\x53\xc5\xb2\x8b\x64\x26\x1a\x25\xdc\xc5\x4f\x2a\xbb\xf8\xd2\xa9\x50\xf5\x94\xa2\xb0\x1c\x8c\x33\x53\xeb\x03\xbd\x76\x68\xcb\x72\x72\x11\xae\x55\x3b\x03\xf2\x87\x0a\x09\xa2\x90\x17\xf7\xbd\xb6\xf2\x9e\x42\x1a\xea\x15\x7b\x1e\x6e\x91\xed\x05\xbe\x6a\x48\x98\xe9\xe6\x97\xd6\x3d\xda\xee\x25\x0f\x26\x46\x62\x19\x4c\xda\x5b\x65\x4a\x63\x1a\xc5\x2e\xfc\x4d\x74\x6a\x23\xd9\x94\x26\x4f\xa5\x63\x0e\xb6\x50\x92\x48\xcb\xec\x2d\xdb\x48\x92\x8c\xdd\x16\x03\x2a\x39\x76\x75\xed\x82\x2a\xc8\xc5\x95\x01\xd9\x22\x0f\x2c\x1d\xed\xe1\x1f\xcf\xa3\xd4\x8b\x56\xb8\x9d\x6f\x54\xfa\x0b\x4f\x86\xd5\x08\xf8\x3f\x56\x07\xc6\x62\x61\x06\xd0\x49\xe6\xef\xf5\x6b\x7c\x65\x8f\x12\x33\x4d\x82\x02\x97\x25\xbd\xb4\x24\x16\xa6\x4d\xb7\xde\xcc\xfc\xcf\xfb\x38\xff\x4f\xfa\x38\xc7\x3e\x56\xa4\xee\xb4\x10\x27\x05\x9c\x21\x8b\x91\xc3\x4f\x66\x2a\xb0\xa2\x74\x0f\xbe\x5b\x88\x34\x87\xe3\x92\xf7\x90\xf4\x19\x8b\xde\x29\x44\x96\xc3\xc7\x42\xe0\x62\x7a\x5c\x2c\xa8\x1f\x31\x5d\x54\x2d\x39\xa6\x1a\x48\x89\xc8\x71\x98\x55\xd0\x70\xce\x7b\x4e\x13\x42\x0e\xdd\xe2\x97\x68\x81\xac\xa6\xa2\x0c\xb5\x94\x43\xff\xd8\xf2\x14\xca\xd0\xde\xd2\xab\x50\x3b\xd7\x8d\x8b\x79\x02\xb1\x06\x19\x63\xec\xc1\x5e\xfd\x8c\x51\x73\x8a\x45\x8e\x81\xa1\x0f\xfa\xce\xaf\xc8\xdb\x79\xc5\x54\xd0\x78\xca\x25\x3a\x70\x76\x4e\xe2\xd7\x42\x42\xb2\x9a\x34\xc1\x42\x9a\x54\x3c\x21\x58\x5f\xf2\xa2\x15\xe2\xb1\xe7\xfa\xf5\x94\xfc\x08\x23\x01\xd5\xfa\x01\xea\x37\x21\xb8\xd7\x4a\x5a\x06\xe2\x4f\xed\xd2\x09\xbb\xb7\x62\xbd\x98\x41\x5f\x2c\xa0\xf3\x44\xa2\x8e\xfa\x84\xa9\x84\x30\x9e\xb3\x3c\xd1\x57\x80\x0d\xba\xfb\x52\xf2\x2f\x1c\xd5\x22\x71\x82\x79\x6b\x05\x81\x48\x2a\xdd\x06\x83\x43\x80\x15\x1b\x89\xc4\x68\x16\x64\x65\xc1\x23\x93\xbb\x4f\x45\x96\x8f\xd1\xd2\xc2\x16\x91\x28\xe2\x56\x11\x07\x67\x13\x1c\xe5\x80\x2e\x2f\x62\x3f\x34\x13\x7b\x1c\xe4\x2d\x3e\x4b\x6a\x87\x38\x0c\xa9\x1e\xb4\x26\x89\x64\x21\x58\x05\xf7\x34\xb1\x00\xc3\xfe\x16\x22\xcb\x95\xc8\xea\x04\xc9\x02\xef\x1a\xa7\x51\xd7\x3a\xf4\xb2\x67\x19\x8d\x6b\xf2\x4e\xd5\x8d\x0c\x79\x97\xbe\xa4\x19\xbc\x7d\x3d\xee\x6d\x75\xd6\x90\xc2\xed\x15\x12\x78\x05\xf6\x2b\xab\x4a\xce\xff\x44\x93\xa6\xc5\x85\xb4\x57\x74\xde\x15\xda\xbe\x99\xe1\x83\x98\x3d\x78\xba\xb8\x36\x2d\x00\x28\x99\xd0\xdc\xf0\xa8\x6c\xd2\xd3\xb6\xc5\x74\x55\x2e\x14\xd6\x8f\xb9\x1a\xec\x3e\x12\x3e\x34\x6c\xeb\x9a\xb4\x96\xe4\xaa\xa1\xba\x25\x10\x62\x27\x77\xbb\xbc\x80\x03\x4d\x39\xe5\x12\x0e\x88\x3e\xc1\x0f\x9c\x49\x39\xbc\x45\xde\x3c\x87\x27\x38\x51\x73\x78\x81\x9f\xcc\xe1\x75\x21\xfc\x1c\x3e\x17\x62\x92\xc3\x1b\x24\x59\x41\xcc\x68\xeb\x35\x26\x26\x97\x22\x41\xa6\x0c\xc7\x1c\x94\x67\xf8\xca\xd3\x6c\x26\xe1\x45\xcb\x18\x6d\x5d\x29\xe5\x89\x84\x24\xc6\x21\x11\x10\x47\x5b\xff\x0e\x77\x49\x99\x97\xb0\x58\xec\xa2\x34\x28\x8e\x2a\xa2\xf6\x1d\x5a\xfe\x1d\x63\x61\xce\x6f\x90\x65\xfa\x54\x88\x37\x05\x7c\x29\xc4\x38\x87\xdf\x85\x38\xcf\xe1\x11\x52\xe8\x1c\x7e\xd1\x4d\x86\x74\x39\x43\xf6\x31\xda\x7f\x1c\x85\x2d\xa7\x70\x6c\xf9\x74\xad\x10\xa7\x39\xbc\x2f\x29\x37\x76\x37\x72\x4f\x48\xb9\xbf\x17\xe2\x67\x0e\xcf\x8b\x06\x85\x12\xb3\x5f\x68\x99\x8b\xe6\xe9\x82\x66\xc0\x4f\x3f\x18\x04\xa4\xaf\xc4\xbf\x55\xbc\x2f\xe5\x06\x8b\x1f\x7a\x26\x84\x67\xbe\xee\xf3\x0a\x2b\x55\x3d\x81\xeb\xa3\xbf\x85\x95\xc5\xdb\xd7\x50\x41\xa2\xd8\x96\xa9\x38\x2c\xec\x27\x46\x59\xff\x2b\x04\x51\x75\xdc\x8f\xfc\xcf\x65\x11\x52\x85\x18\xb4\x37\xcc\x26\xc9\x01\xad\x08\xd2\xc2\x2d\xd3\xbb\x7a\x55\xa8\xba\x58\x2d\x56\xf3\x94\xe5\x04\xbd\x4b\x96\x4a\x89\x7f\xa3\xe1\x4d\x55\xf5\xf4\x33\xce\x6f\x19\x5c\x90\x2a\x58\xbe\xf1\x2b\xcf\x86\x31\xd5\x43\x61\xb9\x5a\xac\x00\xac\x49\x63\xdb\x4a\x67\x40\x43\x0f\x2e\xe9\x2c\xa8\x7d\xd4\x70\x0a\xaf\x0a\x78\x56\x88\xe7\x05\x7c\x2b\xc4\x6e\x0e\x2f\x8b\x66\xc8\xce\xee\xab\x3f\x9a\x5e\x7f\x30\x9f\x6a\x33\x90\xfd\x59\xf3\x9c\xfe\x10\x0c\x77\xcf\x26\x6d\xe7\x9f\xca\xa7\x84\xb9\xc2\xdf\x38\x5c\xdf\x18\x82\x73\x78\xb8\xee\xb8\x56\xe4\x4d\x8a\x6c\x1f\xfc\x13\xe3\x8f\x56\x5d\xc7\x5d\x75\xae\x39\x36\x32\x5b\x09\xa4\xa9\xc6\x58\x41\x28\xe2\x4e\x1e\x2a\x09\xc1\x9f\x4d\x70\x78\x75\xf9\x04\xd7\xd8\x30\x0c\x7a\xfd\xad\x68\xd7\x0e\x25\xc6\xa2\x5b\xce\x6e\xf7\x2a\xe5\x71\x7b\x42\x1d\xd3\x05\x82\x34\x97\xb0\x1b\x16\x2c\x4e\xb3\xb2\x1e\x06\xb6\x22\x2f\x95\x46\x08\xd5\xa4\x5a\x33\xb7\x04\x55\x69\xa8\x47\xbe\x50\x8f\x5c\x03\xaa\x94\xf0\x53\x55\x0e\xba\xee\x41\x50\xb9\x00\xb2\x05\xfd\x8b\x6b\x8d\xc5\xcd\x57\x2f\x38\xf6\x35\xf1\x8e\x58\x30\xac\x87\xbd\xf8\x38\xbf\x2e\x2f\x8a\xce\xb5\xdb\x3b\x96\x67\x94\x11\x4d\xdd\x64\xbb\x8b\x08\xa4\x06\x87\xf6\xf5\x59\x6b\x99\x04\xd3\xa8\xae\x20\xae\xb9\x45\x4e\xf8\xe8\x4a\xb7\x00\x0b\x8b\xdb\x6b\xc7\x50\x4d\xe7\xda\x5d\xc0\xe6\xde\x2f\x0b\x6b\xd5\x4b\x0f\xef\xc1\x59\xd0\x27\x3e\x62\x8b\x98\xce\x83\x7a\x11\x10\x18\xbd\xcb\xd0\x85\x7a\x35\x6a\x04\x45\xaa\x5e\x56\x28\x0a\x19\xfd\x96\x2d\xb0\xc9\x02\xf6\x9c\xee\xeb\x65\x64\xd5\x1e\x52\xb5\x9a\x4a\xe7\x72\x0d\xb1\xe2\x62\x91\x26\x96\xe8\xcf\xb5\x71\xd3\xd0\x26\xd5\x22\x14\xb1\xfa\x5a\x00\x29\xe8\x8d\x20\x1e\x41\x38\x82\x74\x04\xd9\x48\x20\x05\x4b\x46\xe2\x38\x07\x6f\x44\x2b\xbe\x18\x89\x9d\x1c\x46\x23\xf1\x11\x39\xe5\x51\xe3\xa5\xd3\x7a\x9e\x86\x63\x9c\x3f\x10\x35\xbd\x77\xc8\xae\x1d\x57\xfd\x60\x24\x1e\xe7\xe0\x8f\xc4\x5e\x0e\x93\x91\x78\x97\xc3\x78\x24\xf6\x73\x98\x8e\xea\x24\x12\x86\x8d\xdf\xb1\x6f\x6e\x4a\xc8\xf7\x05\xdb\xf1\x50\xd9\x8e\xc7\x0f\xb7\x7a\xf1\xda\x56\x67\x93\x46\x7c\xab\x1b\x3f\xc4\xe9\xb9\xba\xea\x86\x07\xe9\xda\x96\x6d\x45\x9e\x4a\x73\x3d\x73\xc4\x51\x77\x5e\x25\x6c\x51\x52\x47\x37\x1b\x4a\xb7\x6e\xe4\x15\x42\x7d\x73\x85\x6e\xf3\x16\xb1\xd6\x1e\x4b\xb0\x16\x7d\xe8\x71\x56\xf3\x55\xa7\x7d\x43\xd1\xe3\x55\xe7\x86\x2b\xb5\x6b\xad\x98\xd2\x02\x2f\xa5\xc5\xcd\x4a\x04\x04\x2a\x8d\x0d\x72\xcc\xbd\x50\x5f\x8c\x47\xed\x04\x72\xd2\x59\x19\x89\xe9\x88\xfc\xa0\xca\x49\x99\x50\x54\xb1\x88\x66\x52\xbb\x73\x33\x5d\x3a\xc2\xe9\xd1\xef\xd5\x9a\x67\x3b\xbc\x0c\xf0\xe8\xd7\x5f\x00\x64\x63\x30\x94\xc0\x02\x43\x29\x48\x8c\x35\x12\xc3\x51\xdb\x41\x8e\x0d\xb7\x8d\x13\xf5\x80\x6c\x22\x3e\x9d\x8e\x84\xb3\x71\xb3\x65\xd9\x95\x98\x33\x48\x2b\xcb\xcf\x23\x6c\xf2\xcd\x8d\xc3\xd8\x81\xb3\x11\x09\x13\x7f\x8e\x70\xe5\x74\xbf\x26\xbd\x76\x30\xaa\x03\x22\x1a\x5b\x2a\xce\x87\x65\x07\x23\x89\x1c\xed\xd0\x42\xdc\xe8\x67\xa4\xc3\x6b\xe5\xc2\xb1\x52\x59\xb2\x47\xe7\xfb\xde\x90\xec\x7b\xdb\xce\x88\xc1\xdd\x49\x43\x30\xa5\x6f\xe1\xe4\x17\x01\xed\xf8\x58\xe4\xc7\x51\x40\xbe\x72\x46\x8b\xa7\x82\xd3\xd1\xea\xd9\xa8\x8a\x81\x5a\x4e\xf2\xc3\x18\x1b\x78\x41\xb0\xb5\xa1\xbf\xea\xcc\x65\x7b\x56\x73\x59\x66\x36\xd7\x70\x89\x18\x89\x67\xd7\x51\x2f\x1c\x11\xdc\xea\x3e\x03\xbf\x76\x82\x91\x65\x19\x87\xc4\x64\x44\x68\x1f\xa3\x2a\x8e\x23\xee\x77\x54\xd5\x4d\x32\x47\x2c\xf8\x9a\xac\x42\x4e\xb0\x62\x5a\xa8\x49\xbd\x87\xfc\x2d\x4f\x08\x9b\x44\xfc\x1c\xf1\x96\xdd\x96\x29\x88\x61\x71\x3b\xba\xa8\xa7\x9e\x4a\xff\xd4\x93\x94\x61\x77\x04\xc7\x23\xd8\x19\x09\x24\x11\x1f\x47\xe2\x43\x0e\x8f\x47\x86\x53\x56\x6a\x2b\x2e\xec\x95\x71\x46\x87\xc4\x85\x77\x48\x37\x14\x0a\xc1\xe2\x0d\x3a\x9b\xbe\x8b\xb7\x24\x19\x90\x5e\x68\x4a\x57\x16\x0d\xd7\x4d\x38\xad\xf6\x91\x54\x8c\xe0\xe9\x48\x3c\xc9\xe1\xc3\x48\x6c\xb4\x7b\x9d\x7f\xce\x0e\xe7\x6e\x8f\x54\x4f\x91\x5b\xb9\x38\x9c\x2b\x75\xd3\x0b\x64\x60\x7e\x60\x8a\x43\xe4\x65\xd6\x6f\xf6\x0e\x6f\x1e\xe2\xf9\x15\xde\x52\x1e\xcc\x84\xff\x1f\x1e\x60\xfa\xa3\xd5\xa3\xc3\x23\x77\x86\x8f\xf8\x90\x1d\xae\x1e\x3e\x3c\xfc\x9f\xce\x91\xeb\xae\xba\x94\xca\x8a\x3c\x6c\x1f\xad\x52\x26\xfa\x88\x7b\xb4\x7a\xe8\xba\x3d\xfe\xe8\x81\x4e\x70\xd4\xa3\xcf\xe2\x27\x9e\xe0\x27\xfe\xf9\xdf\xe3\xc0\x0f\xbd\x0d\x78\x41\x35\x38\x20\x38\x80\x35\x6d\x30\x82\x23\xfd\x3f\xc2\x39\xbc\x38\xf0\xd6\x7e\x6f\xae\x3d\x58\xc3\xc2\xe6\x0e\x29\xc7\xbe\x1e\x89\x2d\xf8\x8c\xdd\x45\x56\x0c\x1d\x87\x27\xef\x92\x9e\x23\xeb\x46\x9a\x32\x5a\x6d\xe1\xf5\x68\x75\x95\x4d\x20\xab\xa6\xfe\x3d\x4e\xd4\x79\x3a\x92\xa9\x49\x99\x5d\x79\xa3\x11\x21\x0e\x27\xdf\x96\x5c\x84\x3e\xb9\x7f\xe2\x89\xd9\x49\x49\xdb\xad\xa9\xdf\xdf\x8c\xc4\xe7\x11\x7c\x32\x95\x93\x8e\x8a\x96\xd4\x4e\xd6\x49\xa1\x10\x94\x76\x1b\x54\x67\xca\x77\xfd\xfa\x48\x85\x5c\xd7\x60\x1d\xa9\x08\x6a\x03\x05\x48\x5e\x8e\xcc\xcc\x82\xfd\x08\x31\x9a\xa3\x36\x21\xef\x64\x84\xad\xc1\x02\xbd\x77\x9e\x34\x31\xf0\x5a\xff\x92\xde\x1e\x94\x9b\xda\xd3\x30\x1f\x69\xb8\x4a\x52\xdd\x61\xba\x49\xa6\x55\xca\x0c\x8f\xc8\xa9\x26\x42\x0a\xd1\xc2\x25\x73\x26\x3f\xcc\xfa\x49\x91\x7a\x43\xb6\x68\xca\x90\x83\xca\xa5\x82\x0e\x92\xcd\x71\x8b\x4d\xaa\x58\xfd\x07\xb3\x4b\x63\xe6\x93\x62\x98\xad\xb7\x1e\x27\x71\x16\xfa\xac\x76\x26\x6b\x43\x95\x29\x6d\x2a\x94\x56\x57\x87\xcc\x77\x5a\xad\x8d\x0d\xb9\xc3\xaf\xaf\xd3\x13\xc3\x45\x94\x49\xb1\x1a\x17\x14\xdd\x52\xf6\x15\xfa\xa9\xd5\x1a\x9f\x4b\xdb\xbf\x4e\xeb\x62\x2e\xe3\xe6\x5d\xfa\x9d\xbb\xa6\x58\xf5\x1d\x6d\x61\xdd\x29\xcb\x57\xa5\xd4\xcb\x98\x3b\x34\x2b\xd8\xe5\xea\x1b\x5a\x88\x14\x02\x35\x1c\xf5\xb9\x50\x19\xe0\x37\x79\x05\xdd\xc3\xce\xb7\xdc\xbf\x5a\xa8\x60\x65\xa4\xe2\xba\x74\x53\x4a\x13\x8c\x71\x3c\x97\xf0\x44\xca\xad\x77\xc5\x9f\x53\x6e\xc3\x98\x97\xd0\x61\x0b\x38\xa1\x01\x09\x94\xb1\xfc\x2f\x23\xf1\x69\x04\xbf\x69\x6d\x1e\x66\xab\x1b\xdd\xe3\x2a\x53\xc1\x9f\x61\x37\x03\x38\x9d\xd2\x4c\x62\xce\x29\x4f\xf5\x4a\x6f\x4c\xe4\x52\xb3\xa8\xb6\xad\xe7\x5a\x8b\x47\x2b\x62\xb0\xbd\x19\xfb\xd2\xc5\x5f\xfc\xf2\xf1\xc8\x3a\xdf\x70\x1e\x8b\xb7\xd9\x1d\x2d\x93\xb3\x8a\xcd\xae\xf1\xcb\x29\x35\xe0\xe5\xe9\x93\xe7\x36\x1e\x52\x18\xe2\x55\x32\x73\x49\xa6\xf5\x31\xc8\xd8\x4f\x5d\xa3\xb0\x52\xb5\xd4\xa4\xa7\x6d\xa6\x7c\xbf\x1b\xfb\x2a\x09\xa7\x5e\xd8\xc2\x94\x91\xc4\x6a\x6e\x94\xb0\x0d\xda\x0d\x1e\x2b\xf1\x70\xb4\x69\x3c\x0b\x86\x5a\x83\x5b\x0e\x10\xb0\x57\x2a\x64\x98\x74\xb5\xfe\x32\x35\x31\x40\x4c\x1a\xdc\x1f\xa7\x28\x39\xff\x73\x95\x02\x47\xa8\x2e\xa5\xa2\x60\x90\x93\xad\x9e\x4e\x35\x96\x18\xb3\x6d\x03\x8d\x99\x15\x91\x92\x93\x63\xf9\xb9\x72\x6d\xd8\xab\x47\xb0\x9f\x53\xc2\xbf\xd9\xb5\x3b\xff\x82\xbe\xba\xf4\xb4\xc2\xbd\x4d\x3c\xb6\x86\x0b\x8d\xb1\x1e\x04\xf5\x62\x70\x47\xf1\x03\xec\x6e\x56\xfb\x7b\x25\x0d\x69\xc6\x22\x12\x5b\xae\xf6\x6b\x4e\x83\xc1\xa8\x61\xa4\xf5\xc8\x77\x0f\xc6\x74\xc8\xb6\x1d\xe6\x73\xc0\x6b\xb2\x0a\xb6\xb5\x4f\x2a\xc6\x18\xa4\x64\x13\x32\xdc\x91\xa8\x8c\x1d\x01\x1d\xb9\xe1\x42\x1c\x50\x35\xac\x23\xd7\x5a\x08\x07\xf1\xea\x16\x04\xf8\x2f\x3f\x9a\x03\x23\x2d\x2c\x5b\x60\x65\x65\x4c\x2b\x68\x28\xd9\x6a\x82\xf0\xb2\xb6\x8e\xf4\x5d\x16\xcf\x99\x03\x7a\x81\x7c\x33\xd0\xc4\x4b\x84\x2d\x4c\xc8\x71\x23\xb3\x66\x74\xa9\x16\x1a\xff\x45\xc5\x90\x5d\x0a\x75\x17\x38\xad\x96\x43\xbe\xff\x88\x2f\xa2\xc5\x24\xb9\x74\x4a\xb2\x9a\x69\xf6\xa8\x45\x62\x87\x7f\xae\xe1\x7f\x0e\xb2\xd7\x94\xea\x18\xb9\x48\xe6\x9b\x49\x2a\xcd\x96\xad\x98\x1b\x9f\xfa\x23\x8f\xb6\x57\x24\xbe\xce\x6a\xb8\xea\x74\xa8\xd4\xc4\x55\x02\x73\x8f\x2b\x2d\x1b\xe3\xad\x9b\xa4\xb2\x59\x1e\x61\x16\xa6\xf9\x1b\xbd\xc6\xc1\x23\xa4\x1e\x5c\xcf\x75\x2b\x83\x9a\x5a\x9d\x65\xb3\x60\xe6\x86\xd1\x8b\x63\x73\x0a\x13\xbf\x5a\x4e\x99\xbc\x34\x8e\xe0\x8f\x28\x01\xc2\xe2\xb0\x94\x40\xef\xb9\x3c\xac\xd6\xd7\x83\xeb\xda\x1f\xa0\xb6\x19\x0b\x2b\x6c\xd6\x6c\x46\x31\xe5\xc7\x90\xd8\x26\xa7\x16\x08\x52\x5d\x7e\x66\x57\xa6\xfd\x7b\x24\x15\x16\xe4\xa7\x96\xd9\x3d\x94\x1d\xc0\xb7\x89\x7a\xd6\xcc\x21\xc6\xbd\xf4\xf1\xc8\x86\x14\x5a\xcc\x48\x83\xb0\x93\x5b\xb9\x78\xa5\xca\x4d\xb8\x59\x8d\x54\x1e\x8e\xeb\x0a\xc8\x98\xc9\x1c\xed\x41\xde\x03\xd8\x68\x52\xa7\x1e\x41\xff\x19\xd8\xac\x12\x59\xc0\x36\xc6\x8a\x19\xe2\xb6\x02\xb0\x26\x72\xdb\x91\xa8\xe6\x0e\x84\xa9\x22\x52\x41\xe6\x8b\x1f\x8d\xe0\xd7\x08\xae\x8d\xe0\xfd\x08\x5f\xc2\x77\x62\xe0\x9e\x8f\xc4\x36\xbc\x1a\x89\x5b\xf0\x6c\x24\x6e\xc3\xb7\x91\xb8\x03\x2f\x47\xe2\x2e\x7c\x1d\x89\x7b\x90\xf7\xc5\x7d\x08\xfa\xe2\x01\xc4\x7d\xb1\x85\x0b\x1c\xff\xde\x82\x14\xff\xde\x86\x0c\xff\xde\x81\x04\xff\xde\x05\x0f\xff\xde\x83\x02\xff\xde\x87\x51\x5f\x6c\x6f\x42\x1f\xff\x6e\x41\x84\x7f\xb7\x61\x80\x7f\x6f\x81\x8f\x7f\x6f\xc3\x04\xff\xde\x81\x31\xfe\xbd\x0b\x53\xfc\x7b\x0f\x86\x7d\x71\x6b\x13\xce\xf1\xef\x16\x9c\xe0\xdf\x6d\x38\xc5\xbf\xb7\xe0\x0c\xff\xde\x86\x9f\xf8\xf7\x0e\xec\xe2\xdf\xbb\x70\xdc\x17\xb7\x37\x61\xa7\x2f\xee\x6c\xc2\x47\xfc\xbb\x05\x8f\xf1\xef\x36\xec\xe1\xdf\x5b\xf0\x0e\xff\xde\x86\xfd\xbe\xb8\xbb\x09\x4f\xf1\xef\x16\x7c\xe8\x8b\xdf\x39\xfc\xe8\xe3\x26\x4a\x9c\xb1\x38\x5a\xdd\x80\xb7\x7d\xb5\xa5\xc2\x93\xbe\x78\x94\xc3\x0b\x7a\x6e\x1f\x6e\x48\x96\x3a\x3e\x4c\x0f\x8b\xed\xcd\xed\xfb\xfc\xf7\x01\x32\xe3\x87\x07\x47\xb3\xc3\xc3\xf5\xd9\xe1\x41\x73\x8a\xa3\xc3\x43\x4e\xe0\xde\x3c\x72\x57\xb9\x98\xf6\xc1\x30\x1c\x17\xe7\x47\x6e\xbb\xb7\x42\x4c\xf3\xd1\xcd\xc3\x6d\xd7\xbd\xa9\x9e\x76\xd6\xbe\x1f\x5f\x23\x2b\x33\xd7\xdd\x80\xd7\x7d\xf1\x2b\x87\xcf\x7d\x71\x91\x77\xe2\x3e\xf2\xa7\xec\xbb\x89\x25\xfc\x6f\xfa\xc2\xd9\xd5\x57\x65\x5e\xeb\xa5\x37\xf5\x3e\xf6\xd3\x70\x92\xb7\x82\x33\x82\x09\xcb\x88\x4d\x81\x4f\x76\xaa\x7e\x94\x30\x2b\xc7\x37\x84\x0e\x7c\xe9\x8b\xf7\x39\xfc\xe6\xf6\x61\xd5\x57\xd7\x90\xe5\xdf\xbc\x29\x0f\x13\x14\x41\xf6\x6e\x87\xfe\x4d\x3c\x16\xac\x1f\xfa\xab\x7c\xa8\xc0\xff\x37\x67\xe6\x05\x36\x6a\x56\x8d\x71\x29\x5f\xb0\x7b\xc4\x85\x51\xa6\xde\x06\x3c\xea\x8b\xef\x79\xf7\xd1\x88\x3f\x23\xd6\xdd\x83\x7f\x3a\x37\xb0\x4f\x56\x7b\x5c\xde\x0a\x97\x21\x0e\x38\x8e\x9a\xfc\x8b\x59\x9a\x43\x2a\xe8\x86\x73\x78\x78\x32\x88\xd3\xfc\x68\xb6\x49\xbd\xc3\xbd\x32\x3b\xa3\x5f\x6f\x6d\xb0\xb3\xf6\xf4\xe8\x62\x7b\x3e\x2b\xec\xe7\xdb\xf3\x99\xfa\x48\x71\xc6\xc9\x37\x70\x3a\xeb\x02\x71\x64\xe2\xd9\xc1\x61\xb1\xb9\xb9\xb9\xc3\x7f\x9f\xd8\x23\x85\x69\x79\x11\xfc\xea\xc3\xb5\x3e\xbc\xef\x5f\x25\xa4\xb1\x6e\x90\xd5\xad\x71\xa0\x76\xd4\x1b\xce\x0d\xdc\x5e\xf0\x90\xbf\x92\x76\xdd\x8c\x3d\x1b\x5a\xe4\xe8\xd1\xc8\xa5\xcb\xe2\x4a\xdc\x2f\x2b\xce\xd8\xbd\x41\xd6\x0b\x57\xa9\x30\xba\x35\xee\x61\xff\x38\x37\x3a\xd8\x23\x37\x1c\x7e\x76\x6e\x38\x9d\xac\xd3\x5e\x28\xfe\xda\x48\x65\xc4\xa4\x85\xb3\xda\x76\xb0\x9d\xce\x6a\xc6\x24\xea\x31\x32\x92\x48\xa6\x18\x1b\x47\x7d\x66\xeb\xae\xeb\x2a\xb7\x82\x6b\xb7\xdd\x4e\x4a\x87\x70\xa3\x91\xcd\x5f\x87\x97\x1f\xf7\xde\xd2\xad\x72\x16\xb4\x09\xe3\x03\xbe\xe3\xbc\xe9\xb7\x6f\x90\x80\xe7\x39\x07\xb1\x2a\x2e\xbc\xea\x37\x11\x3a\x5b\x66\x2a\xb7\x14\xbb\x8d\x54\x06\xdd\x8d\x3e\xef\xb3\xc8\x74\xf1\x1d\x4e\xfb\x7e\x1f\xa6\xc8\xf9\x30\x2a\x09\xf3\x22\x7c\xa3\xef\x76\xaa\xa9\xa9\x0a\x54\xd2\xf7\x86\x92\xf8\xdd\x25\x25\x49\xfe\xe5\x19\x53\x01\xbd\x00\x8f\x2a\x4b\xf1\xe6\x06\x7c\xeb\x8b\xe7\x39\xbc\xbc\x2c\xd1\xb5\x0d\xf8\xda\x17\xaf\x90\xb1\x8d\xc4\x33\x24\xde\xd1\x9f\x75\x48\x6d\x2f\x6b\x2f\x54\xff\x42\x36\x2d\x8f\x64\xd3\xae\x4c\x3f\x97\xcd\x1d\xf4\x61\xdc\xd4\xdc\xea\x33\xee\x6f\x91\xf8\x96\x43\xf8\xbf\x55\xdb\x03\x59\xdb\x38\x6a\x18\x88\x23\x59\xb3\xe8\x0f\x6b\x96\x46\xe2\x65\x0e\x59\x24\xbe\xe6\x90\x44\x92\x4c\xfd\xcf\xe1\x06\xd1\x1c\x24\x49\xeb\x87\x1b\xee\xea\x8c\x7e\xca\xe7\x9b\xf8\x8c\xcb\xf8\x97\xa2\x69\x12\x2d\x46\xc1\x08\xcc\x48\xe1\x6a\x26\xef\x8d\x66\xa4\xb6\xeb\x07\x9f\x3e\xbc\x30\xf7\xd2\x65\xd4\x2c\x88\x17\x5e\x9a\xa8\x59\x98\x3d\xa5\xd3\x41\x80\x81\xb7\xde\xdb\x19\xaf\x8b\xa7\x51\xe2\xe5\x32\xf8\x02\x13\xd3\x82\x99\x91\x75\xde\x8c\x52\x18\x63\x39\xe9\xbd\xf8\xf0\x04\x49\x92\xaa\x1f\x23\x4e\xcd\xc8\x15\xcd\x8c\xcf\x5c\x54\x55\x3c\x99\x17\xc1\x4c\xba\x80\x0d\xd2\x99\xc2\xc0\x9c\xc9\x4b\xe2\x99\x9f\xcc\xe8\x5e\x65\x86\x05\x92\x24\x7c\x86\x84\x67\xa6\xc7\x6d\x16\x0e\x66\x21\xfe\x35\x20\x9c\x33\x64\x31\x67\x72\x04\x67\xd2\xed\xcf\x8c\x39\xc8\x19\x9e\xfb\x66\xf2\xfc\x3a\xc3\x81\x9e\x11\x57\x35\x63\xdd\x8a\x19\xc9\x18\xdc\x6b\x92\x10\x7a\x11\x14\x11\x8c\xa2\x72\xca\x5f\x3b\x66\xf3\x67\x25\x6f\x5a\xaf\xc6\xf2\x5e\x70\x78\x20\x2d\xa4\x89\x96\xe3\xaa\xe9\x47\xd5\xf5\xb2\x56\x5b\x55\x51\x24\xf2\x00\x06\x8d\xd6\x1b\x69\xc4\x16\xe9\x99\xfc\x21\xd6\x86\x34\x4a\x23\x11\x04\x30\x69\x9c\xac\x1a\x8b\x3a\x16\x03\x9e\x7c\x2b\xf1\x82\x35\x7f\x37\x66\x24\x2d\x3d\x9d\x43\xe1\x53\x4a\x37\xa6\xfd\xf5\xa4\x0f\x67\x9d\x18\xd2\x4e\x38\xe7\x3b\x34\xe9\x3a\xa5\x3a\x81\xdb\x8e\xeb\xf2\xa0\x75\x9b\x56\x42\x6a\x66\xfd\x95\xab\xc4\x75\x5c\x06\x87\xe6\x23\xf7\xa7\x3e\x1d\x4e\xb0\x0a\xc7\x5c\x85\x39\x49\x8a\xda\xf1\x7a\x42\xd8\xcb\xc6\x90\xbf\x5b\x44\x8d\x88\x14\xe5\x9e\x54\xc3\x74\x22\x60\x60\xba\x10\xec\x85\x9d\xb8\xb6\xb3\x90\x79\xfe\x62\x15\x43\xb1\x17\xb1\x1b\x72\xd2\xd5\x91\x35\x7b\x83\x35\xbb\xc8\x3a\xb8\xfc\x3a\x21\xe4\x9d\xd3\xbe\xc6\x55\x98\x2f\x3a\x89\xb4\xcf\x9d\xa1\x70\x56\x5a\xff\xd3\x5a\x25\xed\x19\x9e\x67\x8e\x3e\xbb\xe3\x19\x08\xfb\x69\x12\x91\x7e\x2c\x9e\x43\x8d\x2e\x50\xf0\x30\x97\x2a\xb2\xb1\x28\x22\x02\xc0\x3e\x62\x28\x56\x11\x77\xbd\x48\xa4\xf3\xb6\xb4\x45\x19\x47\x30\x8d\x60\x18\x09\x2f\xea\x4e\xff\xb4\x3f\xb4\x1f\x99\x94\xfb\x03\x56\xd2\xc5\x69\xd1\x65\x11\x24\xe1\x09\xd0\xb4\x68\xea\x9c\x95\x7a\x1f\x9a\x42\x98\x66\x69\x18\xf4\x30\x76\xa4\x62\xde\x46\x65\x6f\xd0\x58\x9c\xf6\x89\x47\x1f\x1a\x36\xdd\xe6\xb2\x9a\x6a\x91\xa9\x26\x64\x8b\x39\x52\x9a\x40\xbb\x7d\x90\xa3\x75\x80\x6d\x3e\x9a\xcf\xff\x60\x9c\x6e\xb6\x36\x5a\xff\xe0\x91\xfa\xeb\xaf\xd6\xc3\x87\xf8\xff\xc3\xd6\x5f\xad\xbf\x44\x0b\x83\x82\x21\xa3\x4b\x50\x5f\x21\x5a\x2b\x02\xff\xd2\x8f\x68\x5d\x6f\xfd\xb3\x35\x6b\x5d\xbf\xde\x9a\xcd\x6a\xc3\x3b\xbc\x6c\x78\xa7\xd5\xe1\x1d\x5b\xc3\x7b\x1e\xc1\x49\x04\xa7\x11\x9c\x45\xf0\x33\x82\xdd\x08\x8e\x23\xd8\x89\xe0\x63\x24\x70\xe4\x1f\x47\x78\x66\x86\xbd\x48\x84\x01\xbc\xc3\x5c\x01\xec\x47\x22\x09\xe0\x29\x13\x19\xea\x66\xe6\x43\x69\x1f\xfe\x10\x89\x22\x80\x1f\x91\x18\x05\xf0\x36\x12\xfd\x00\x9e\x44\x22\x0a\xe0\x05\xce\x99\x00\x5e\x47\xc2\x0f\xe0\x73\x24\x26\x01\xbc\xa1\xcc\xec\xb2\x91\xa4\xdd\xf0\x09\xbf\x14\xc0\x97\x48\x4c\x03\xf8\x1d\xf1\x49\xe0\x26\x11\x03\x7e\xf9\x28\x12\xc3\x00\x7e\x59\xd1\xe1\x80\x4f\x0a\xd7\x22\x71\x11\x78\xfd\x51\xe7\x71\x1f\x9c\x70\xe0\x74\x76\xf8\x77\x8d\xa8\xa9\xd3\x79\x87\x0f\x32\xb4\xd7\x87\x22\x26\xfb\xdb\xce\xc7\xfe\x1c\xde\x47\xe2\x3c\x80\xef\xaa\xb8\x0e\x4b\xfc\x97\xb0\x16\xc8\xc6\x3e\x57\xe9\xe0\x8a\x74\xaf\xa2\xaa\x7e\x44\xdb\x59\xb5\x31\xbb\xaf\x45\x5a\x1a\x31\x23\x69\x84\x8b\x1c\x36\x0e\xd9\xb3\x48\xec\x06\xf0\x2d\x12\xce\x5f\x2b\x24\x9d\x78\x89\xa1\xb5\xb5\x87\x4e\xf7\x9c\xb7\x5d\x9e\x88\x4f\x71\xb5\x64\xfd\x34\x08\xe2\x19\xbb\x44\x9f\x79\x45\x9e\x0c\x92\x7e\x91\x71\x68\x12\xd1\x56\x2b\xf1\xed\x67\xac\xea\xd6\xcf\x79\x3f\x4b\x93\x28\xa3\xcd\x35\xc2\x53\x87\xb5\x9d\xf1\xef\x63\x95\x5e\x3d\xbe\x21\x6c\x61\xfd\x20\x01\x11\xe5\x33\x6d\x84\x12\xb8\xcb\x9f\x29\xcf\xed\xb4\xf5\x8d\xdf\x26\x9f\xbd\x28\xa4\xbb\xbc\xd9\x28\xf4\xfd\x80\x76\x3f\x1f\xf7\xc9\x74\x8c\xfb\x23\xed\xd1\x71\x90\xe6\xb8\x53\xbf\xf1\x26\x33\x52\x71\xfc\xd8\x4f\x26\xc1\x2c\x4a\x92\xc9\x6c\x8c\x9f\x20\x08\x64\x0c\xd0\x47\xe2\xe4\x79\x1a\xe0\x8e\x99\x7c\x08\xb2\xf0\x77\x80\x81\x8f\x23\xcf\xa7\x5f\xf3\x85\x38\xf9\x92\x62\x39\x58\x42\x8c\x7b\x7d\x91\x05\x7b\xf1\xee\x59\x98\xcf\x48\x0a\xb7\x17\xe3\x66\x4c\xe6\xf3\x61\x8a\x85\xa5\x01\xb9\x4b\xc1\x40\x46\xdf\xc3\x9f\xc0\x1b\xd3\xb0\xcf\x32\xdd\x26\xd2\xa1\xa3\x66\xcc\xd8\x06\x82\x4c\x67\x31\x54\x04\x1f\x27\x01\xbe\x24\x92\xf9\xa6\xc8\x72\x46\x5a\x98\x4d\xc3\x2c\xc4\x94\xb8\x2b\x87\xb8\x3a\x24\xe3\x80\x5d\xe9\xcd\x4e\x88\x6f\x38\x49\xb1\x93\x23\xea\xf0\xb1\x17\x63\x5f\x25\x7d\xca\x3d\x0b\xc6\x27\x58\xd0\x28\x9d\x85\xe3\x21\xf6\xc2\xa4\xc8\x67\x38\xfc\x43\xac\x39\x81\xd7\xce\xc6\x41\xee\x11\xbf\xe2\x8d\xb1\x26\x45\xda\xe7\x7a\xf4\x7f\xce\x4e\x4f\x52\xfe\xce\x29\x4e\xe8\x5f\x45\x92\x77\xf0\x80\xed\x8d\x27\x9d\x5b\xf7\xc1\x43\x42\xd3\xb9\xf5\x00\xa2\xbc\x83\xe7\xe7\x21\xfe\xdd\x86\xf8\x24\x9b\x74\xb6\xf0\x31\xa4\x93\x29\x86\xb6\xa0\x4f\x56\xd7\x5b\xf8\x6e\x42\x4a\xc8\x18\xba\x05\xd2\x47\x31\x06\x6f\xc3\x39\xff\xde\x81\x93\x74\x7a\xe2\xa5\x18\xbc\x0b\x19\x89\xee\xb7\xee\xde\x83\x62\x4c\x25\xdc\x07\xec\xb2\x73\x0c\x3c\x80\x24\xf5\x07\x9d\xad\x7b\x84\x18\x8d\x75\xc1\x10\x61\x46\x63\xda\x7b\xdb\x90\x8d\x30\xc9\xbd\x5b\x64\x16\x83\xbf\xb7\x61\xec\xf5\xb1\xb4\x7b\x77\xc0\xe7\x88\xbb\xc8\x21\x14\xd9\x18\xbf\x75\xef\x1e\x64\xc5\x64\x1b\x03\xf7\x29\x70\x0b\x03\x0f\xc0\xeb\xe3\x90\x77\xb6\xee\x6f\xc2\x38\xec\xa7\x58\xf2\xfd\x2d\xa0\xce\xc0\xc0\x36\x46\xf9\x3e\x7d\xe5\x3e\x56\x3c\xf0\x43\xac\xd3\xfd\xdb\x94\x75\x0b\x03\x77\xa8\x4e\x63\x0c\xdc\x85\x54\xd6\xe9\xfe\x3d\x18\x60\xcf\x6d\xdd\xc6\xe0\x7d\x19\xc4\x8f\xdd\x7f\xc0\xc1\x5b\x18\xfb\x00\x3b\x87\x41\x15\x30\xb8\x05\x3b\xc3\xd4\x9b\xe2\xa7\x1f\x6c\xc3\x8e\xaa\xc5\x83\x5b\xb0\xd3\x0f\xd3\x3e\x86\x6e\xc3\x4e\x1e\x46\x3e\x45\xde\x81\x1d\xee\x8f\x07\x77\x61\x87\xe5\x76\x5b\x0f\xee\xc1\xce\x6e\x14\x52\xe8\x3e\x3c\x56\x55\x7b\xf0\x00\x76\x65\x91\xdb\x9b\x9b\xb0\x2b\x8b\xdc\xde\xdc\x82\x5d\x2e\x12\x8f\xb5\xb0\x4b\xe5\x6c\x6f\xde\x82\x17\x3a\xe1\x6d\x78\xa1\x13\xde\x81\x17\x2a\xe1\x5d\x78\x21\x13\xde\x83\xdd\xfd\xe7\xf8\x7b\x1f\xde\xca\xca\x6c\x6f\x3e\x80\x3d\x95\x77\x6b\x13\xf6\x54\xde\xad\x2d\xd8\x93\x79\xb7\xb6\x61\x4f\x25\xdd\xba\x05\x7b\x5c\xcc\xd6\x6d\xc8\xc3\x71\x90\x61\xe8\x0e\xec\xe1\x04\xcf\x46\x18\xbc\x0b\x9f\x74\x41\xf7\xe0\x93\x2e\xe8\x3e\x7c\x52\x05\x3d\x80\x4f\x9c\x7b\x7b\x13\xbe\xa9\xb7\xdb\x5b\xb0\xff\x7c\xef\xc3\x5b\x0c\xe1\xb0\xff\xa6\x0e\xd8\xde\xbe\x05\x9e\x2a\x67\x1b\xe7\xa7\x4e\x79\x07\x07\x96\xcb\xd9\xbe\x0b\x9e\xaa\xd0\xf6\x3d\xf0\x64\x91\x38\x83\xb9\x23\xb7\xb7\x71\x02\x04\x5c\xce\x2d\x92\x42\x71\x47\x6e\xdf\xda\x82\x40\x15\x79\x6b\x1b\x02\x55\xe4\xad\x5b\x10\xc8\x22\x71\x1d\x04\x5c\xce\xad\x3b\x10\xea\x84\x77\x21\xd4\x09\xef\x41\xa8\x12\xde\x87\x50\x26\x7c\x00\x41\x8e\x8d\xbe\xbd\x09\xb1\xaa\xcc\xed\x2d\x48\x54\xde\xdb\xdb\x90\xa8\xbc\xb7\x6f\x41\x22\xf3\xde\xbe\x0d\x89\x4e\x8a\x53\x8d\x8b\xb9\x7d\x17\xfc\x70\x1a\x72\xdc\x3d\x48\x54\x4f\xde\xbe\x0f\x85\x2e\xe9\x01\x14\xaa\xa4\x3b\x9b\x50\xc8\x92\xee\x6c\x41\xc1\xd9\xef\x6c\xc3\xb9\x7e\x7b\x0b\xf2\x51\x92\xc6\x18\xc2\x85\x28\xdf\xe2\xd8\xf0\x9c\xba\x85\xd5\x4e\x02\x19\x7a\x00\x1f\xfb\x5e\x9a\xc4\x9d\x5b\x98\x39\xd3\xc1\x5b\xf0\x8d\xb2\xdc\xc2\xb5\x35\x88\x93\x41\xe7\x36\x4e\x2d\xfe\xd6\x3d\x9c\x13\xb2\xd2\xf7\xb0\xe7\x76\xa2\xc9\xc8\xeb\x3c\xc0\x79\xf0\x08\x49\x0c\x06\x6e\xc3\x33\x0f\x49\x13\x86\xee\xc0\x93\x20\xe2\xb8\xbb\xb0\x3b\xc9\xc2\x08\xcb\x7d\x80\xf3\xe0\xbb\x4c\x78\x1f\x76\xf9\xf7\x01\xec\x8f\x38\x06\x27\xc1\x8b\x84\x03\x5b\xf0\xca\x9b\x4c\x28\xb4\x0d\xaf\xbd\xf1\x89\x4f\xc1\x5b\xf0\xa6\xc0\x9f\xdb\xf0\x96\x7e\xee\xc0\xd7\x10\x7f\xee\xc2\x1e\x2f\x69\x2c\x19\x47\xfe\x1d\x45\xdd\x87\x0f\xa3\x04\x7f\xb1\x59\xe1\x90\x2a\x82\x43\xbd\xef\x61\x1e\xac\xed\x27\x5d\x0d\x1c\xe8\x77\x23\x4c\x8d\xc3\xfc\x98\x7f\xef\xc0\xbb\x8c\x7e\xa9\xc0\x60\x48\xb9\x70\x22\xc9\xb6\xe1\xd0\x9c\x70\x05\x71\x68\x86\xb2\x6d\x38\x32\xbe\x6c\x1b\x0e\x4c\xa0\x0b\xc5\x91\xf9\xcd\x09\x71\x5c\xe4\x2f\x59\xd8\xc8\xd0\x36\x84\xdc\x36\xec\xd7\x9f\xb2\x6d\x38\x28\x91\x6a\x1b\x0e\xcb\x18\x2b\x78\xe7\x2e\x1e\x84\xf1\xe7\x1e\x9c\x61\x55\xee\xe0\x08\xe9\xb6\xdd\x79\x00\x13\x8c\x42\xc2\x9b\x52\xdb\x90\xec\x66\xd4\xb6\x01\x06\xb7\x65\x10\x43\x38\xdc\xd4\x4c\xa4\xba\x85\xae\x11\x52\xde\x09\x35\x0f\xc9\x6e\x9f\x7f\xef\xc1\x84\x9a\x79\x97\xca\xe6\x66\x22\xd9\xe5\x2a\x66\xe7\xe3\xce\x03\x24\x9e\x94\x75\x84\xa1\xfb\xf8\xc1\x69\xe7\x01\xd2\xc7\x20\x46\x9a\x7f\x9f\x88\x55\x30\x96\xa1\x3b\x74\x97\xc5\xb1\x44\x79\x7e\x9f\xc6\x3f\x28\x74\x1b\x43\x1c\xb8\x03\x51\x3a\xa6\x00\x12\xcd\x88\x03\xf7\x20\xf6\x69\x1a\xdf\x27\x22\x32\xd6\xc1\x6d\x88\x32\xa2\xa9\xf7\x89\x4e\xa4\x3a\x88\xf4\xfb\x44\x05\xef\x43\xe4\xcb\x20\x4e\x8d\x54\x07\xb7\xe0\x44\x07\xb7\xc1\x27\xfc\xcb\x94\xc2\xb7\xe1\x89\x09\xe3\x80\x11\x78\xd0\x7d\x22\x0c\xa3\x20\x8a\x42\xaa\x2a\x2e\xfe\x09\xf1\x09\x14\x8f\x6b\x74\x92\x22\xb9\xa2\xe0\x36\xbc\xd3\xc1\x5b\x58\x23\x4f\x96\x8d\x63\x99\xea\x30\x0e\x67\x42\x37\x30\x14\xbc\x4d\x84\x3e\xa3\x32\x70\x30\x82\x02\x37\x93\xfb\xb7\xb0\xc7\xc3\x31\xa1\xc2\xdc\xbf\x8d\x1d\x7e\x1a\x84\x41\x8a\x1f\xbc\x8d\xdb\x16\xa9\xaf\x51\xe8\x2e\x19\xd6\xfa\x94\x00\x3b\xd4\x8b\x82\x01\x75\xf7\xfd\x3b\xd8\x7b\xc8\x24\x61\x95\xef\xe0\xfe\x50\xa8\x10\x6e\x72\x2a\x74\x1b\x9b\x27\x43\x77\x60\xa4\x42\x38\x90\xf2\xf5\x5d\x9c\xe0\xd1\x0e\x87\x70\xde\x14\x2a\x74\x0f\x52\x15\xba\x0f\xbe\x0a\x3d\x80\x91\x0c\x61\x8d\x91\x87\xf2\xa8\x67\xee\xe1\x70\x4d\xc8\x92\x18\x43\x77\x21\x38\x0b\x33\x0e\xde\x03\x72\x0c\x7a\x4e\xc1\x07\x40\x6c\x97\x87\x41\x1c\x33\xe4\x48\x62\x0a\x6d\xd3\x86\x2c\x83\xb7\x20\x0e\xe9\x17\x67\x58\x9a\xf8\x14\x7a\x80\xbb\x26\xb6\xea\x1e\x8e\x10\x72\x63\x45\x46\x41\x1c\xe3\xe4\xd4\xe3\xc2\x71\x7d\x92\x9f\xa4\x3e\x06\x69\x28\xd2\x64\x42\xa1\x5b\x10\xc6\x03\x2e\x91\x38\x0f\x24\xd7\x18\x40\x6a\x1e\x53\x89\x44\x28\x53\xfa\xbd\x0d\x7d\x8f\x52\xe3\x4a\xec\x17\x1c\xb8\xcb\xa0\x85\x0e\x05\xef\xd1\x04\x4e\x83\xdb\x18\xc6\x8e\xc8\x42\xaa\x02\x8e\x08\x72\xa2\x54\x18\xf2\x0c\x34\xb7\x29\x13\x8e\x02\x8d\xe1\x7d\xdc\x38\x89\x6f\x9b\x52\x10\x07\x80\xa3\x6e\xc3\x90\x7f\xef\x60\x1b\x4e\x30\x70\x8b\x59\x00\x0a\xdc\x81\x58\x45\xdd\xa5\x77\x94\xea\x16\xb3\x16\x1c\x42\xa6\x85\x38\x0f\x0c\xe2\xc2\x4e\xe4\x0e\x88\xe1\x3b\x34\xd5\x28\x3b\x2e\xaf\x8c\xb8\x8b\xfb\x0f\xe8\x53\xfd\x80\x66\x1f\xad\xbe\x54\x07\x71\x10\x07\xc8\x9e\x62\x33\x1f\x20\xe3\x93\x9a\x30\xcd\x0c\x6c\xc0\x83\x4d\xcc\x97\xaa\x10\x75\xe6\x6f\x5a\xc4\x58\xb9\x89\x47\xc6\xe9\xb8\x3e\xb1\xa5\x51\x71\xc2\xc1\x7b\x38\xd9\x09\xb0\x8c\xc2\x0f\x70\x17\xf1\xc6\x14\xbc\xb5\x39\xc7\xe3\x95\x38\xe0\x79\xba\x85\x2f\x78\x65\x11\x39\xe7\x15\xc4\x0b\x83\xd7\x0f\x2f\x1c\xa2\xee\xbc\x3e\x68\x33\xe0\x65\x40\x5b\xc5\x16\xee\x64\xb7\x90\x69\xda\xc2\x21\xd9\xba\x4d\x49\x71\xc9\xf2\x62\xe5\xb5\xc9\xab\x92\x57\x1b\xaf\x70\x5e\xdb\xb4\x39\xf0\x74\xa7\xad\x84\xd7\x10\xed\x34\x84\x19\x7a\x8b\x22\xef\xdd\x3d\xc2\x13\x5f\xe5\xe4\x72\xbd\xfd\x5f\x74\x45\x70\x76\x70\x78\x78\x7a\x78\xe8\x1f\xad\xce\xf0\xef\xea\xac\x7a\x9c\x39\xad\x1d\x67\xdc\x6e\xcf\x01\x67\x88\x47\x9a\x5d\x64\x95\xff\xda\x18\xe2\x09\x52\x6c\x3c\xc4\xdf\x1d\xfc\xbd\xbe\x31\x94\x9a\xa1\x78\x3e\x1d\x40\x30\x80\x78\x80\x7d\x0c\xd9\x00\x92\x01\x78\x03\x79\xc0\x3a\x4c\x7b\x87\xf1\x06\x14\xf8\xc8\x41\x8c\xc2\x13\xe5\x68\xb0\x1c\x0e\x46\x9a\xab\x8a\xad\x6e\x50\x42\x3c\x06\xf2\xb0\x4b\x36\x68\x74\xd9\x77\x10\xac\xc9\x3b\x67\x0c\x6c\x1f\xc1\xe3\x80\x95\xb7\xf7\x48\xa2\x7e\xfd\x3a\x3e\xa5\x04\x7c\x39\x90\x52\x02\x0a\x7b\x2a\xcc\x97\x79\x32\x93\x75\x2d\x5d\x0c\x40\x29\x41\x48\x90\x74\x1d\xef\x61\x3c\x41\xf8\xbd\x93\xc5\x3f\x96\xc5\xeb\x72\xd5\xa7\xe2\xf5\x01\xdd\xa5\x5a\xdf\xd0\x11\x6d\x59\x4d\x3c\xb5\x2f\x7c\x48\xa6\x11\xf2\x67\xe1\x7b\xaa\x39\xef\xe4\xf7\xda\x6c\xc4\xb6\x3e\xe0\xf8\xcc\xaa\x40\x56\x6f\x18\x26\x3a\xc0\x7f\x25\x82\xae\xc8\xfe\xb4\x8d\xa5\x8a\xcc\x1c\xfa\x83\x05\x30\x1b\x29\x08\x0b\xa4\x82\x06\x5d\x94\x2f\xf8\x48\xd7\x8a\x3b\xba\xdc\x80\x8a\x85\xb0\x47\xa9\x45\xd8\x21\x7f\x80\xe1\x20\xa7\x8b\x69\x76\x9c\x1a\x62\x9b\x72\xf7\xea\x62\x62\x53\x4c\x6e\x35\x8b\xca\x63\x63\x1f\x52\x03\x8c\x06\x62\x3f\x80\x01\xce\xaf\x83\xd6\x61\x7e\x38\xa0\x6b\xa9\xa3\x55\x9c\xa2\xfe\x40\x9e\xee\x26\x78\x50\xce\xf8\x2e\x6f\xc6\x7a\x6c\x33\x52\xfe\xa4\x23\x1f\x9f\xcd\x26\x94\xaa\x92\x13\xc6\xb5\xb2\x70\xbe\x4e\x55\x59\x7c\xe7\x75\x98\xce\x0e\x63\x77\x03\x86\x03\xd6\x30\xb4\xe2\x30\xe5\xf9\x40\x3c\x0d\xe0\x84\xd3\x2b\x69\xc2\xd1\xc5\x16\xcc\x3b\x3d\xf5\xb4\xb9\xf6\xe0\x70\xed\xe8\x26\x6b\x21\x3e\xdc\x80\xd3\xc1\xa5\xbe\x11\xe8\x1a\x4c\xad\x81\x98\xac\xc5\xa5\x60\xcd\xb2\xd7\xa4\xab\x68\x58\x09\xb5\x04\x6b\x53\x5a\x7f\x51\x3f\xae\xa4\xb3\x59\xfa\x90\xa7\x50\x2a\x42\x23\xfb\xc4\xd8\xb5\x2d\x24\x5a\x03\xf1\x21\x80\x9f\x03\x75\x59\xeb\xdc\x78\x78\xb8\xc1\x37\xb6\xbb\x65\x94\xf8\xeb\xe1\xbf\x30\xaa\x9b\x0f\xc4\x85\x43\x07\x6a\x87\x8c\xa3\x1c\x46\x00\x27\xb8\x45\x30\xc2\x78\xed\x54\xc8\x21\x21\xa0\xa3\xee\x80\x82\x41\x4d\x76\xd2\xeb\x54\xc9\x4d\x3e\xa8\x92\x1b\x5a\x19\x03\xfb\xfe\xd4\xdc\x9e\x5a\x97\xa2\xff\xe1\xfd\x69\x4a\x84\xe8\xda\xe1\x05\x69\x6a\xb2\x32\xe8\x1c\xa7\x4a\xc6\xc4\xaa\x1a\x8b\x44\x4c\x52\x30\xba\x88\x8a\xc4\x7b\xe3\xd7\xaa\x6e\x84\x62\x30\x76\xb4\x81\x88\x0a\x28\xd7\xbc\x75\xa9\xe3\x1c\x2c\x4d\xa4\x26\x05\x12\xb6\x45\x36\x2a\x51\x89\x5a\xe1\x15\xfd\x27\xb7\xa7\x7d\x70\x11\xfd\x98\xce\x55\x87\x97\x4a\x49\x9d\x83\x65\x3a\x23\xa4\x04\xc7\xd5\xeb\xb1\xdc\xbe\x72\xd9\x99\x21\x91\x61\x93\x3b\x99\x62\x11\x2a\x00\x3f\xdb\xc9\x4b\x6b\x87\xb9\x1a\x76\x7d\xe9\x77\xa9\x9e\x4a\xe5\x4b\xc1\xc0\x95\x85\x0d\xac\x62\xfe\x4e\x01\xb1\x2a\x60\x35\xb8\x24\x37\xc4\xe2\x55\xdf\xc6\xef\x65\xc0\x30\xdd\x00\xce\x1f\xaf\x4f\x0d\xb1\xc1\xbd\xab\x59\x37\x89\x0d\x75\x7a\xb4\xd4\x3a\xf1\xdc\x9d\xd3\x9d\xc2\x12\x1b\xa4\xfa\x0d\xc7\x85\x53\x85\xff\xe5\xdb\x12\xd2\xcc\xfb\xa3\x7b\x47\x95\x15\xab\x19\xcc\xd5\x85\x8b\xf8\x4c\x96\x1a\x92\x0e\x60\x85\x68\x1d\x19\xbf\x1e\xff\x4e\xa9\x0d\x75\x86\x6a\x9d\xe7\x15\xbd\xb4\x3f\x6c\xf7\x41\x53\xbb\xd9\x30\xf4\xca\x1a\x1e\x35\xb7\x3b\x57\x4a\x81\xaa\xe9\x52\x2f\x10\x1b\xfe\x47\xf7\x43\xf5\x32\xaf\x6e\x75\xd3\x8d\x54\xa5\x27\x8e\x14\x9e\xd7\xf1\x00\x76\x1a\x49\x38\xd3\xbe\xaf\xa4\x42\x24\xd7\x6a\x86\x1f\x2e\x67\xa2\xd2\x55\x9c\xc3\xc7\x81\x78\x13\xc0\x63\x7b\xbf\x58\x94\x43\x1f\xb6\x37\x60\x8f\x08\x97\xcb\xf4\xa8\x7b\x3c\xb0\xe9\x91\xbd\xec\xf7\x22\x5d\xb1\x77\x03\xd8\x1f\x94\xf7\x85\x8d\x9b\xd0\x06\x3c\xe5\x24\x87\xe4\x36\x60\xe3\xe1\xd1\x06\x7c\xa0\xe7\x04\x19\xb6\x1f\x32\xb0\xa6\x2b\x75\x78\x78\xf3\xf0\x70\xfd\xda\xf1\x51\xed\x59\x96\xb4\x4a\x5b\xdf\x5b\x45\xbb\x25\x0e\xd0\x8c\x35\x6c\x67\xda\xff\xf5\x4c\xda\x21\xcd\x8c\x96\xee\x4c\xfa\x17\x9c\x11\x35\x9d\x49\x2b\x81\x99\xf6\xe6\x3b\x93\xc8\x08\x33\x69\x66\x4b\x65\x3f\xa1\x2d\x88\x31\xa8\xd6\x18\x7d\xca\xe9\x38\xf9\xa6\x03\x1c\x83\xc1\x2d\x07\x38\x1a\x83\xdb\x0e\x18\x14\xa9\x8e\x93\x10\xdc\xfb\x80\x6c\x0c\x8c\xae\x4f\xf7\xdd\x80\xfc\xda\x75\x0e\x9c\x28\x74\x8e\xc0\xcf\x31\xe4\xe7\x38\x01\x7c\x9f\x1e\xfd\xca\xe3\xa4\xe3\x78\xbe\x4f\x0a\x40\x2d\x64\xfe\xc3\x3e\x99\xbe\x93\x1e\x77\xeb\x24\x4a\xfa\x3f\x49\xa8\x1b\xb4\xfc\x70\xda\xf2\x09\x2c\x34\x88\x7c\x6c\x72\x6b\x90\x24\xa4\x6b\x48\x12\xf5\xd6\x68\xab\x35\xda\x6e\x8d\x6e\xb5\x46\xb7\x5b\xa3\x3b\xad\xd1\xdd\x16\x99\x93\xe0\xdb\x11\x3b\x4a\x68\x8d\xd2\x16\x5d\x61\xb5\xc6\x41\x5c\x90\xd3\xb9\x56\x12\xb5\x26\x2d\x64\x59\x5a\x24\xc9\x25\x75\x6f\x16\x6c\xb7\x8a\xa8\x7a\x29\x44\x95\x4e\xa9\x96\xe9\x04\x6b\x89\xa7\x21\xfb\x31\x99\xe4\x5c\x3a\x46\xea\xa0\x8c\x25\x10\x25\x8e\x63\x6d\x26\xfb\x65\x4e\xd5\xc2\x77\xf9\x49\xe2\x9f\xe3\xab\x9c\x1a\x41\xf1\xf4\xdc\x14\x4f\xbf\x26\x9e\x1c\x20\xd2\x43\x4a\x29\x74\x0c\x17\xe7\x53\xcc\x88\xfe\xa4\xfc\x95\x85\x38\xa9\x2a\xf7\x7a\x00\x9f\x07\xe2\x53\x00\x6f\x06\xe2\x77\x00\x9f\x06\xe2\x51\x00\x5f\x88\x0f\x5b\x23\xa5\xb0\x7f\x5e\xbb\xb9\xda\x5b\x6f\xbb\xb3\x83\xc3\xa3\x8b\x39\x59\x43\xfc\x1e\x88\x5f\x01\x3c\xa2\x19\x47\x77\x2c\x7c\xa1\xf4\x0b\x9f\xb2\x9b\x0f\x6b\x37\x3a\x6b\xb5\xa5\x44\x29\xaf\xd1\x5a\xca\x6e\xae\xad\x21\x03\xf6\x7e\x20\xae\x05\xf0\x9d\x4a\xfa\x2f\x8c\xa3\x73\x7b\xe8\x45\x7c\x11\xf5\x1c\x97\x59\x00\xaf\x06\xe2\x7b\x00\xcf\x06\xe2\xe0\x6d\x04\x3f\x22\x78\x1f\xc1\xe7\x08\x5e\x47\xf0\x24\x3a\x82\x6f\x18\xfd\x01\x7f\x5f\xe2\xaf\x7c\xf7\x1a\x9f\xbe\x0e\xb4\x7d\x43\xee\x8b\x83\xd7\x7d\x78\x86\x79\x06\xd8\xb8\x23\x08\x30\xe2\xf7\x00\xbf\x7a\xd4\xfd\x3a\xb8\x82\xab\xd0\xfe\x2f\xea\xde\x25\x67\xb3\x9a\x7b\x49\xa9\x4f\x8e\xd3\xb3\x9a\xe6\xe0\x00\x93\x1c\x1d\x39\xd2\x77\xb0\x4c\xb1\xdf\x50\xd6\x81\x4a\x88\x29\x0d\x8a\x50\xec\xe3\xba\x2d\x93\x09\x82\x16\xad\x7e\xdf\xf8\xbe\xa4\x1c\xb9\x37\xcc\xc4\xc1\x45\x98\x7d\xe4\xaf\x10\x73\x18\x66\xf2\x5b\x14\xa6\x5b\x9e\xce\x92\xa2\xe9\x3c\x41\xba\x74\xc1\xd2\x04\x74\xd6\xe3\x55\x93\x75\x9e\x0d\xe6\xb0\xe4\x2b\x9b\xf2\x2b\x71\x59\x5e\x6c\xe7\xfc\x56\xcd\xb9\xb9\x58\xbf\xb0\xcc\x19\xda\x39\x5f\x2e\xcf\xa9\xbe\x99\x96\x39\xd3\xda\x37\x55\xff\xd0\x0d\x15\x5d\x42\x21\x55\x0b\xf6\xb1\xaf\xda\xc6\x5b\x36\x8f\xf7\x93\x60\x92\x8f\xb4\x9a\xbe\xf2\x71\xfd\x91\x2d\x05\x34\x40\xa2\xe5\x42\x54\x5c\xc8\xe3\x4c\x67\x25\xb0\xa3\x49\xe5\xcd\x7a\x5c\x97\x89\xd8\xee\x59\x1a\xf0\x5c\x95\x9e\xd2\x70\x72\x52\x93\xd5\x5e\x50\x91\x19\x5d\xd9\x64\x15\x58\x13\x73\xa1\x2a\x98\x21\x6d\x9c\x90\x21\x57\x8b\xee\xce\xf8\x0f\x39\x4d\x6a\x11\x01\x68\x0d\x08\xec\x5a\xfe\xcd\xd8\x3f\x8f\xe7\xb7\xc8\xe5\x5f\x8b\xa4\x5d\x78\x76\x21\xfd\xec\x9f\x04\x43\x42\x08\xe6\x32\x19\x06\x64\x9d\xb5\x59\x0e\x5f\xa9\xb5\x54\x1c\x57\xaf\x95\x87\x79\x14\x54\x48\x21\xc3\x3c\xec\x68\xbf\xd8\xe4\x32\xd5\x78\xe8\xb7\x9d\xb7\xf2\x02\xb0\x9e\x65\xbf\x28\x73\xd7\xba\x1b\x58\xd2\x3d\x5c\x88\x54\x85\xaa\x5e\xd0\x86\x82\xa2\xec\x18\x02\xac\xd4\x61\x3d\x88\x59\x2d\x57\xb5\xb2\x4b\x33\x57\x93\xe9\x19\xc0\x5b\x98\xd2\xc4\x0f\x95\xa1\x47\x63\xf4\x95\x67\x0c\x7d\xbe\x50\x5a\xe2\xf6\x2c\x7c\xb8\xa9\xac\x36\x02\x85\x22\x63\x76\x22\xc2\x8d\xa1\x13\x48\x8b\xe6\x3c\xf6\x7c\x34\x68\xf3\xf9\x23\x6f\xe8\xee\x65\x3d\x0b\x2b\x7f\xf7\x05\x89\x2c\x48\x59\xfd\x82\x9d\xe0\x92\xf7\xde\x79\xf5\xa4\xf3\x1c\xa9\x6a\x7d\x79\x2d\x22\x6f\x62\xa4\x44\x7c\x69\xd4\x72\x09\xd6\xa9\x4d\x5a\xc4\x90\xdb\x4f\x64\xf3\x26\x39\xaa\xd8\x87\xd0\x87\xd4\x87\x0c\x69\xb8\xb3\x58\x53\x24\x88\x7a\x04\x1d\x29\xda\x30\xdd\x41\xec\x84\xa1\x67\xbc\xf3\x55\xa9\xb0\x63\xbb\x89\x09\x68\xb3\xf6\xc5\x05\xa1\xad\xbd\xf0\x3b\xcf\x02\x24\x38\xcf\xbd\x6c\x14\xf8\xf8\xf4\x8d\x9e\xd8\x08\xc9\xef\xbc\x0c\xd8\x5e\x9c\x1e\xf6\xa4\xe1\x4f\xe7\x6b\x00\xd2\xf6\xf5\x79\x10\xe1\xa9\xad\xf3\x3c\x00\x56\xa4\xeb\xbc\x0a\x08\xdd\x4e\x24\x3e\x14\xbe\x36\xd3\xd3\x4e\x91\x2f\x37\xd5\xeb\x96\xe9\x42\x79\x86\x22\xcd\x1e\x1d\x47\xe0\x7b\x2a\x28\x9a\x0c\xba\x7a\x61\x87\x94\xa7\x20\x58\x34\xd9\xac\xfb\x1c\x10\xd5\x0f\xf5\xca\x8f\x74\x2a\xee\xec\xf4\x97\x97\xd8\x8f\xa5\x22\xa4\x1d\x31\x66\x1f\xfc\x58\xbd\x63\xc9\x68\x96\xd5\xbc\x18\x20\xc5\x06\xc9\x80\x77\x42\x72\xc7\x2f\x54\x15\xed\xc6\x84\x38\xaf\xc3\xf5\xc9\xf5\xeb\x59\x4c\xc7\x0d\xc9\x16\x64\x14\xe5\x2e\xb5\x47\x23\x10\x83\xd8\x3e\x70\xd2\x21\x9b\x4e\xab\xc0\x7a\x6c\xa6\x6c\xfc\x0c\x64\xe4\xf6\xc6\x14\x8b\x5f\x20\xdb\x6b\x1b\x07\x7d\x0e\x23\x5f\x14\x7e\x37\xa6\xd9\xc6\x56\xaf\xec\x74\xc8\x29\x21\x99\xd4\x03\xfb\x83\xa7\x09\xa6\xb8\x5e\x8a\x97\x30\x6e\x14\x98\xaa\x94\x35\xdf\x45\x8e\xfe\x34\xcf\x46\xe3\x30\x9a\xb8\x09\xbf\x09\x40\x98\xad\xdf\xd4\x4a\xb7\x9c\xf1\x13\x1c\x46\xe8\xdb\x56\x54\x96\x19\x5e\x07\x97\xcb\x92\x99\xa5\x8d\xa8\xd8\xc3\xb8\xc2\xc4\xab\xfa\xf8\x37\xee\xca\x17\x3f\xda\x0b\x3a\x41\xc5\xdc\x2f\x6e\x32\x07\x36\x0e\xcc\x83\x65\x53\x4e\x98\x96\x41\x26\xc8\x1f\x28\xce\x41\x72\x4a\x8e\x21\x69\x0d\xc2\xb6\xfe\x74\xfa\xcf\xa4\x2b\x22\x72\x9a\xd3\x0d\x08\x6b\x3a\xbc\xc4\x26\xf1\xc0\x14\x7b\x84\x13\x62\xc5\x98\x5d\xd9\xa2\xaf\x26\x17\xff\xb9\x36\x9d\x24\x17\xaf\xdd\x70\xfd\x78\xc0\x8b\x8d\x7e\xd7\x43\x92\xc4\x04\x69\x8f\x5e\x09\x8e\xd2\x40\x47\x14\x43\x9f\xd9\x74\xc9\xeb\x33\xd9\x4a\xfa\x22\xf6\x97\x98\x9e\xb3\x65\x22\x41\x4f\x58\x33\x87\x9d\xa0\x2a\x12\xd7\xf7\x21\xf2\x61\xe0\xe3\x31\x08\x26\x3e\x8c\x7d\x98\xfa\x02\x29\xde\x10\x29\x47\x0c\xe7\xbe\xe8\xc7\x5d\xdf\x27\x3b\x70\x72\x4a\xbe\x3f\x62\x2f\xe2\x6f\x46\xd2\xa3\xf9\x97\x51\xe9\x66\x7d\xe4\xcf\xb1\x18\x61\x37\xb9\xf0\x5c\x2c\x50\xf8\x71\x7b\xe0\xaf\x0f\xc2\x08\xa7\x63\x43\x0d\x57\x7c\x1f\x1b\x44\x10\xba\x13\x9d\x56\x81\x6d\x4c\x97\x36\x4a\x7a\x76\x9b\x93\x70\x39\xc2\xc5\x62\xe0\x39\x2e\xfd\xce\x94\xbe\x73\xfd\xba\xfe\x1e\x36\x14\x1b\x2d\xcd\x54\xf1\xd7\x10\x19\x0c\x93\x25\x35\xf4\xfd\xa5\x86\xea\xaa\x16\x51\xdc\x76\x64\x0a\x07\xe4\x9b\x25\x04\xcf\x4a\x2f\xfd\x5e\xe8\xd4\x8b\x33\x4a\x27\x5d\x6c\x48\x50\xc1\xf1\xc0\x7c\xc4\x40\x48\xa3\x56\x1a\xcd\xab\xba\x6a\x4e\xba\x37\xb8\x3f\x44\xbe\x3c\x80\x9d\xe0\xd0\xfa\x70\xea\x8b\x49\x0c\x67\xbe\x18\xc7\xf0\xd3\x17\xd3\x18\x76\x7d\x31\x8c\xe1\xd8\x17\xe7\x31\xec\xf8\xe2\x24\x86\x8f\xbe\x38\x8d\xe1\xb1\x2f\xce\x62\xd8\xf3\xc5\xcf\x18\xde\xf9\x62\x37\x86\x7d\x5f\x1c\xc7\xf0\xd4\x17\x3b\x31\x7c\xf0\x45\x03\xde\x88\x5c\xe5\xe4\x5a\x8f\xa0\xc8\xfc\x45\x0b\x5b\x26\x20\xdf\x15\xb6\x27\xe3\x02\x95\x34\x73\xde\xfd\x51\x21\x33\x52\x42\xd0\xf9\x80\xb3\x95\xbc\x38\x11\x9c\xf4\x32\x93\x32\xfa\xa2\x71\x3b\x72\x49\x1a\x8d\xa0\x42\xe1\x3a\x94\x04\xa1\x2f\xb0\xdb\x61\x53\x37\x8d\x52\x29\x4b\x5f\xf4\x3b\x64\xbb\x32\xfa\x68\xe7\xeb\x98\xe0\x1c\xb4\xc0\x63\x89\x07\x24\x09\xdc\x43\x0d\x6d\x97\xdb\x02\x8f\xd7\x5b\x5f\xfc\xf0\xe1\x89\x2f\x3e\xc6\xf0\xc2\x17\x8f\x63\x78\xed\x2f\xb9\xb6\x51\xb6\x81\x83\x12\xf5\x49\x82\x0c\x1b\xfe\x57\x82\x48\x59\xa8\x49\x55\xcc\x43\x83\x35\x6f\x40\x10\xf3\x1a\xdc\x54\x28\x8a\xa2\xad\x0a\x20\x29\x64\x35\xb9\x44\xbb\x21\x60\xfb\xb0\x67\x57\xa0\x1d\xba\x9d\x71\xb1\x5e\x41\x48\xd6\x38\xef\xaf\xfd\xaa\x61\x2e\xbf\x5d\x44\x36\x53\xf0\x4e\xb8\x8a\x09\xbc\xb3\xb1\xac\x7a\x9b\x37\xab\x48\x58\xb5\xc6\xb0\x56\x3c\x12\xe6\x7e\xf0\x81\x72\x14\x2c\x31\xa9\xf3\x92\xba\xfe\xc7\xda\xec\x72\xe0\xf2\xe2\x3d\x09\x6b\xc4\x41\x03\x1a\xea\xf6\x57\xeb\xac\x61\x53\x35\x86\x98\xf1\x9a\x45\x8c\x88\xf1\x05\x10\x1b\xc0\x22\xf9\xd1\x98\x71\xce\xe2\xea\xb7\xaa\x15\xf3\x67\xb3\x31\x39\x29\xa8\x62\x4a\xab\xae\x95\xd3\xe7\xb3\x2f\x5e\xfb\xf0\x66\x71\xca\xfc\xf9\xf8\x5b\x33\xaa\x8e\x3c\x26\xbb\x82\xae\x3a\x3e\x21\xd5\x74\xfe\x5b\xb5\xd0\xe9\x10\x24\xfa\x20\x3c\xeb\x38\x7d\x47\xea\x28\x1c\x38\x0a\x15\xc9\x39\x9a\x83\xf3\xdf\x7c\x32\xb4\x92\x85\x26\x99\x7c\xc3\x89\xb0\x34\x2b\xc9\x4f\x93\x84\xe2\xc1\x24\x9c\x77\xdf\xd4\xe6\x50\xbd\xcb\x94\xfe\xb6\xb6\x25\x1f\x68\x04\xaa\x5a\x63\x43\xf1\xc9\x3f\x08\xa4\x6b\x8b\x70\x01\xb6\xe7\xc6\xa7\xf8\x67\x9c\x9c\xc6\xd2\x21\x26\x79\xfe\x21\xf5\xda\x80\x5c\xfe\x38\xe4\xcc\xf2\x86\xd3\x5a\x23\xb7\x5c\xa1\x5f\xbe\x21\x51\x62\xd0\x92\x8d\x85\x16\xb5\x87\x01\x28\x74\x37\xdd\x60\xc0\x52\xd5\xb1\x84\xcd\x55\xc1\xf1\x32\x33\xf5\xb8\xed\xfc\xb7\xb3\x4a\x06\xac\xd4\x11\xab\x7b\xb1\x9d\x05\x42\xba\xd3\xc5\x62\xe4\x6d\x20\x75\xc4\xc4\xdc\x19\xaa\x3e\x72\xe9\x22\xb0\xe1\x2d\xf5\xa3\x84\xde\x20\xfb\x0e\x09\x26\x92\x10\xf3\xb1\x2e\x55\x4f\xf3\x8f\xc5\x09\xf7\xa9\x10\x8f\xfb\xd7\xaf\x9b\x99\xda\xce\xc5\x1e\xb1\xba\x61\xa9\x81\x6f\xd5\x08\xe7\x06\x09\xbd\x25\xee\xfa\x0b\xfa\xd6\x87\x60\x60\x2f\xd1\x65\x0d\x43\x42\xdb\x45\xf6\x26\x56\xe3\x42\x68\xd8\x5c\x1b\x13\xb0\x1d\xbe\x2e\x44\xd5\x46\x93\x1d\x95\xd5\x93\x18\x28\xfb\x30\xe3\xe3\x97\xdf\xbb\xaa\x98\x8e\xae\xcd\xdc\x78\xd3\xd7\x7d\xf5\x07\xdd\xb1\xa4\x9d\xd2\xf5\x5b\x97\xa1\xd5\x65\xe1\x4b\x17\xba\xec\x52\x45\x19\xe4\xc3\x3a\x6d\x25\x4d\xbd\xab\x56\xfc\x17\x5f\xbc\xf1\xe1\xf7\xff\xfe\x8a\x27\xae\x63\x60\x3b\xc7\x5d\x3e\xc4\x86\x2e\x74\x7f\xff\xcd\xb5\x49\xff\xba\xb9\xc0\xd5\x4e\x00\xe9\x1c\x93\xf7\xf0\x69\x35\x28\xbf\x8c\xf3\x96\x28\x86\x1d\x25\x97\x58\xe9\x57\xc5\xf4\x58\x65\x08\xf2\x4b\x49\xea\xa0\xda\xbe\x2b\xba\xf9\x91\x2f\x7e\xfb\xf0\xcb\x17\xef\xe2\xee\xbb\xb8\xd1\xc5\x4c\x69\xb4\xae\x1c\x80\x4a\x68\x76\xfa\x4c\xe6\xca\x8b\xae\x01\xe3\xcc\x85\x07\x31\x7f\x3b\x3e\x52\x0d\x8f\x55\xc3\xe3\x6a\xa3\xe3\x5a\x83\x4b\x17\x40\x5c\xa5\x6b\x3e\xbc\xf7\xc5\x7e\x0c\xdf\x7d\xf1\x34\x86\xe7\x3e\x5d\x40\xbe\xc2\xb0\xaa\x93\x75\xb4\xa6\x2e\xe8\x5e\xab\xcf\x11\xd0\x2e\x70\x21\x53\x20\x74\x15\x6e\x81\xd9\x87\xb4\x91\x73\xa8\xcf\x96\x50\xa1\x94\xd0\xe2\x12\xa5\xd8\x88\x9c\xf9\x55\xb6\xe5\xd2\xf7\x0c\xa1\x5a\x11\x3a\x1e\x36\xdd\xa0\x24\xa8\x71\xf9\x20\xfb\x36\xcd\xf8\x65\x95\xdf\xb5\x44\x3b\xef\x7d\x02\x6d\xaf\x5c\x5c\x96\xbb\x29\x39\x30\x21\xd7\x3c\x60\x60\x47\xce\x0d\x8b\x70\x52\x9c\x9c\x44\x7c\x61\x7f\xad\x32\x5b\x65\x7c\xb3\xa3\x13\x0d\x74\x59\xc4\xa4\xa6\x2e\x59\x42\xf1\x23\x36\x38\x2d\xd5\x56\xba\x55\x5e\xe4\x6d\xbc\x98\x59\x13\x47\x89\x67\x46\x17\x89\x8c\xae\x5e\xa7\x99\x76\x39\xcd\xb3\x59\x1f\x67\x73\x0d\x24\x6e\xf7\xa0\xd4\xa6\xe9\xba\x34\xbd\xf5\xd6\xbd\xc8\x74\x19\x59\x80\xae\x3f\x9d\x45\x83\x7a\x67\xd5\xaa\x7a\x09\xb2\xbf\x02\xcb\xac\xe1\xfa\x37\xc2\x40\xca\xc3\xaa\x84\x5c\x3f\x08\x19\x96\x10\x14\x7f\x5c\x4b\xc9\x70\x73\xc8\x59\xb6\x53\xf1\xdd\xaf\xf5\x7b\xa5\xc9\x0a\x8c\x43\x13\x19\xdd\xa6\x66\xc0\x1b\x8d\x58\x64\xfb\xd4\x14\x79\x83\x83\x45\xc5\xa4\x77\xf2\xd2\xff\x6c\xaf\xd4\x39\x80\x86\x53\xd1\xdc\xad\x77\x51\x20\x9a\x61\x30\xe1\xe2\x12\x8c\xe2\x4b\x40\x95\x02\x56\x7b\x78\x41\x02\x31\x05\xc3\x8f\xad\x26\xb0\xc7\x98\x8e\x51\x13\xe5\xd4\xc7\x74\x80\x3a\x69\x3e\x89\x5d\x92\x2e\xe6\xc1\xe2\x10\xb2\x91\xfc\xa4\xfd\x21\x36\x82\xae\x54\x61\x0a\x6a\x4f\x62\xf3\x4b\xc7\x31\x20\x17\x67\x4b\x79\xe6\xc5\xa1\x5a\x90\x94\x58\xba\x19\x7a\x53\x61\x78\x0f\x4d\x85\x9f\xf9\x02\xa9\xde\xb7\x25\x27\x22\x0b\xb2\x5b\x4d\xf2\xd4\x9c\x8d\x88\x96\x05\xcb\x8f\x42\x1a\x0b\x54\x37\x48\xa5\x2d\x23\x16\x55\xda\xd4\x34\x55\xc0\xc7\x38\x31\xc8\xc7\xc7\xb0\xdf\xab\x37\x53\xbc\xf7\x15\x50\xc9\x7a\x5c\xa1\x56\xa1\xa1\x56\x44\xab\xd8\x65\xd0\x33\x9f\x9c\x21\xd4\xa8\x5a\x35\xdd\xbc\xfb\xed\x6f\x9e\xa1\xaa\x2d\xb3\x36\xba\x76\x75\x81\x96\xfb\x79\xed\x10\x65\xe1\x4c\x37\x03\xb8\x4a\xd2\x52\x39\x91\xa5\x16\xe5\x68\xdc\x80\x6d\xc4\xae\xa5\xd5\xf9\xc3\x99\x64\x8e\x52\x26\xa6\x32\x7b\x9a\x31\xad\xed\xa6\x2d\xad\xfa\x12\xee\xe1\x3f\xe8\xdb\xcb\xab\x5d\x92\xe9\xab\x0f\xab\x97\x95\x53\xcb\x6d\xdc\xf5\xbc\xf4\xc5\x37\x1f\xbe\x2e\x59\x40\xc6\x69\x63\xb9\x90\x6a\xeb\x24\x59\xc6\x42\xd2\xfa\x0a\x2b\xec\xc3\x58\x5d\xdb\x08\x8b\xcb\xa4\xeb\xea\xb4\xf9\x6c\x59\x98\x03\x2d\xf1\x08\xed\x8c\x44\x0f\xec\x7b\xde\x75\xe5\x92\xa2\x6b\x48\xa1\x68\x1a\x85\xcd\xfa\xa2\x85\xf3\x59\xad\xb1\xb4\xb2\x72\x3c\x99\x29\x07\xaf\x96\x83\xa8\x99\x57\x0e\xb3\xab\x11\xdf\xc8\xf5\x3e\x43\x85\x5c\x26\x66\x7d\xe9\xb3\xab\xc6\xe4\x2a\xf6\xa2\xfb\xb5\xb2\x50\x71\xc1\xbc\x92\xd3\x61\x91\xee\xda\x9f\xe7\x4f\xbf\x36\x24\x78\x85\x01\xef\xd3\xf3\xf6\x67\x72\x83\xb4\xd0\x7a\xe9\x9b\xdc\x44\x4b\xb5\xcb\xdc\x72\x54\x8e\xcb\xef\x52\xbe\x66\x65\xa1\xc8\x3a\x33\xcd\x4f\x65\xed\xdb\x97\x70\xd5\xba\x09\x9a\xb0\x7f\xbf\x5c\x7e\xa2\x8f\xc3\xf4\x75\x9b\x86\xcb\xa6\xd8\x72\x93\x2e\x01\x89\xaf\x88\xf2\xad\x66\xc8\x78\x80\x43\x25\x27\x6f\xac\x83\xf9\x70\x28\x49\xa9\xa1\x0b\x2c\xa9\x51\xe2\x75\xd5\xe6\x72\xe1\x5f\xb5\xfc\xaa\x1d\x66\xd5\xc5\x12\x1e\xc1\x42\x4a\xb3\xbe\x17\x5f\x49\x17\x2c\xd0\xd8\x84\x37\xb1\x5b\x9b\x5b\x72\x35\xe7\x13\xf1\xd5\x87\x60\x22\x3e\xe1\x21\x7c\x22\xbe\x20\xff\x35\x11\xbf\x63\x48\x27\x3c\xdd\x24\xcd\x7b\xe1\x4b\x69\x75\x30\x31\xac\x5f\x3c\xd1\xa3\x12\x4e\x90\x3a\x4e\x96\x48\x6c\x9f\x8f\xb0\x24\x05\xf2\xc7\x1b\x18\xce\xe9\x6c\x62\xcf\xe9\x25\xb8\xfc\x24\x3a\x95\xd0\x92\x92\x6d\x12\x16\x68\x7e\xcf\x71\x3a\xe5\x53\xc9\x8e\xe2\x97\x54\x50\xd7\x8d\x63\x28\x04\xa5\x4c\x58\xcd\xbb\x27\xfe\xff\x96\xd8\x37\xb6\xf0\xc6\xdd\xaa\xe8\xb7\x51\x86\x4b\xe2\xf8\x8a\xbc\xdb\xf4\x33\x56\x57\x87\x2f\x71\xa2\xd0\xad\xee\x1f\xed\xa0\x91\x9d\x22\x5e\x70\x12\xf8\x07\x76\x62\x09\xfd\x4e\x73\x0d\x09\x14\xed\xbe\x58\xdb\x3f\x71\xc6\x50\x25\xd7\x66\x0a\x95\x2d\xd5\x12\x56\xf2\x7a\xa7\xb0\x0b\xe7\x7f\x2a\x7c\x6f\x14\x8f\x4b\x5e\xd7\x71\x56\x2b\x9d\xdb\xb5\xa5\xe6\x81\xdb\x09\xd4\x24\x4e\x26\x22\x9b\x80\x37\x11\x8f\x62\x28\x26\xe2\x57\x0c\xa3\x89\xb8\x16\x43\x7f\x22\xde\xc7\x10\x4d\xc4\x77\x64\x9b\x26\xe2\x79\x0c\xfe\x44\xbc\x8a\x61\x32\x11\xcf\x62\x18\x4f\xc4\xb7\x18\xa6\x93\x3a\xb8\x85\x9e\x33\x0b\x00\x71\x43\x5c\x2b\x31\x9c\x4f\x44\x1e\xc2\xc9\x44\x44\x21\x9c\x62\xe6\x10\xce\x26\xc2\x0f\xe1\xe7\x44\x4c\x43\xd8\x5d\xb6\x12\x9e\xa9\xd3\x69\xa6\x24\x64\x92\x40\x56\xa5\x66\xe5\x95\xc6\x7a\xac\x15\x3b\x58\x97\xc1\x17\x95\xbc\x42\x7c\xec\xab\x91\x51\xba\x26\xb4\xa7\xaa\xa0\x7c\x61\xfc\x06\x99\xe3\xb2\x89\xd9\x4f\x1e\xf3\x04\x6e\x7a\xf3\x81\xa7\x6b\xd3\x9b\x4f\x6a\x2a\x4b\x55\x5d\x53\xcf\x50\x53\xae\x50\x89\x3c\x32\xbb\x15\xa1\xd6\xc6\x01\xa7\x7e\xed\x54\x1e\xc3\x2f\x62\xba\xc7\xed\x6c\x92\xf8\x86\x04\x37\x24\xa9\x99\xcf\x5d\xb3\x33\x4a\x87\xe7\x4f\x17\x1a\x24\x4f\x69\x62\x73\x91\xc2\xec\x4e\x1a\x0e\xe5\xde\x44\x13\x81\x62\x82\x73\x13\x49\xc0\x48\xfe\xee\xe0\xee\xd7\x37\x41\x83\x44\x94\x75\x22\x19\x69\x62\x3a\x03\x19\xf1\x56\xad\xfc\x8e\x3f\xb1\x26\xf9\x64\x42\x0b\x59\x4b\x7e\x2a\xb3\x59\x6f\x52\xa6\x8f\x5c\x03\xd6\x29\xaa\x2f\x8c\x51\x13\x1b\x74\x48\x25\xbf\x6a\x0a\x25\x6f\x8e\xd7\x63\xbe\x9c\x35\xa8\x35\xcb\xc8\xc8\xa8\x18\x0c\xb0\xf5\x63\x43\xaf\xa7\x13\x4d\xf8\x86\x93\x26\xd2\x69\x08\xcf\xf9\xa4\x5c\x9b\x27\x13\x4d\x37\x4f\x27\x25\x55\x3b\x9b\x68\x7f\x17\x3f\x27\x72\x25\x1e\x4f\x60\x67\x02\x1f\x27\x62\x77\x02\x8f\x27\x62\x18\xc2\xde\x44\x9c\x87\xf0\x6e\x22\x4e\x42\xd8\x9f\x88\xd3\x10\x9e\x4e\x48\xba\x45\x88\xb8\x91\xd7\x76\x58\x87\x15\x67\x47\x89\x5c\xee\x0c\x92\xc4\x51\xd0\xb8\xc7\x9e\x7b\x71\x3c\x21\xc6\x68\x07\x73\xed\xef\x3c\x7a\xbd\xdb\x39\xb8\xf1\x97\x54\x7c\xed\x47\x5e\x96\x09\xe7\xcc\x79\x78\x03\x9c\xbf\x36\x38\xf2\xa1\x73\x04\xfb\xcf\x77\x77\x9e\x98\x64\x0f\xff\x62\x05\xd6\x85\xd4\x14\xf9\xd0\xce\xf5\x68\xef\xc9\x37\x3b\x17\xeb\xaa\xd5\x73\x51\x64\x25\xd7\x07\x3b\x4b\xba\x90\x3e\xb5\x13\x7f\xdc\x7d\xbd\xfb\x78\x9f\x32\x48\x78\x8a\x7a\x6a\x19\xfb\x90\xae\x28\xa8\x33\x3f\x4c\x2e\xe7\xb1\xd9\x2b\x3a\x4f\x06\xf6\xb7\x47\xee\xb2\xd9\x47\x82\x94\xe8\x1c\x4f\x58\xe1\x65\x67\x72\x10\x90\x8a\x13\x01\xd6\x1f\xb9\xe4\x0e\xf1\x2c\x6c\x3b\x4f\x5e\x7c\x26\xe3\x29\xab\xd7\x49\x4f\x71\x35\x5f\x65\x1d\x45\x32\xb3\xfa\x45\xde\xa3\x3e\x2a\x3f\x70\xc8\x07\x9e\x61\x7a\x47\x36\x80\x04\x1c\xa1\x2e\x93\xad\xd0\x42\x8d\x2e\x4c\x02\x18\x85\xbc\xc1\x2b\xe1\xc8\x45\x2a\xcd\xf7\xca\xac\x06\xf5\xe9\xc3\x0b\xca\xeb\xad\x67\xd3\xe1\xf2\xaa\x60\xf7\x4c\x87\x76\xdf\x10\x6e\x2b\xf6\xce\x74\xf8\xd0\x59\x56\x37\xb7\x23\x8b\x33\x6d\xad\x16\x99\xff\x7b\x75\xef\x26\xf8\x9a\x97\x38\xc3\xe7\x77\x5d\xe5\xf3\x33\x21\x3b\x3d\x1b\x57\x3f\xe1\x1b\x15\xeb\x1b\x65\x3d\x68\x2c\x3c\x61\x1c\x7b\x7a\xb4\xb2\x8b\x03\xef\x48\x39\x48\xa4\xa0\xf9\x2e\xe3\xe9\xab\x25\x5d\xcc\xe1\xc7\x44\xfc\x0c\xe1\xed\x44\x1c\x87\xf0\x64\x22\x76\x42\x78\x31\x11\x1f\x43\x78\x3d\x11\x8f\x43\xf8\x3c\x11\x7b\x21\xbc\x59\xb6\xe1\xbc\x6a\x60\xbd\xde\x4c\x1a\xee\xc9\x1f\x2b\x72\xb8\x57\x92\xc3\x77\x36\x61\xdb\x9f\x34\x93\x96\x05\x1e\x4b\x51\x85\xb7\x97\x93\x95\x27\x16\x59\x79\x31\xb1\xd8\x31\x43\x56\x5e\x1b\xb2\xf2\x59\x91\x95\x4f\x13\xf8\x32\x81\xdf\x13\x78\x34\x81\x5f\x13\xf1\x66\x02\xd7\x26\x0b\x4e\xdb\x96\x70\x29\x73\x78\x3f\x11\xef\x42\xf8\x3e\x59\xea\xe9\x9e\x18\x91\xde\xc0\x2b\x99\x32\xec\xac\x9e\x79\xe8\x54\xb6\x42\xc5\xe6\x1b\xb1\x3a\x75\x59\x6f\x31\xaa\x6d\x00\x7b\x95\x01\xd4\xf3\x3a\x9f\x11\xac\x1f\x1b\xeb\x2d\xd2\xed\x22\x25\x0d\x72\xc6\xa8\x37\x54\xcb\x31\xa3\x66\xca\xac\x28\xf2\x93\xe4\xca\xc9\x18\xb8\x70\x65\x0d\x77\x18\xb0\x96\x98\x98\x57\xf5\x7a\xfc\x49\xde\x72\x53\x54\xa5\x3c\x5b\xde\x99\x3a\x6f\x43\xa7\x98\x62\xda\x16\x9c\xf1\xb7\x89\xd8\x0f\xe1\xe5\x44\x3c\x0d\xe1\xeb\x44\x7c\x08\x21\x1f\x8b\x8d\x7f\x92\x4d\xe1\x2c\x89\x67\xe7\xd8\xfc\x2d\xb2\xc6\x0c\xc6\x1a\x7c\x8a\xac\x2e\xe3\xf1\x52\xdb\xc8\x12\x73\x7c\xdd\x63\xac\x73\xba\x4d\xc1\xe3\x5d\xba\x9e\x9f\x26\xa7\xde\x79\xe5\x02\xbd\x1d\xaa\x58\x41\xfc\x47\x8c\xc7\xe3\xb1\x36\x9b\x75\x39\x4f\xe4\xfd\x5e\xc8\x41\x71\x42\x3d\x06\x26\x7d\x4f\xc3\x12\x62\xb8\xd3\x50\x5a\x88\x35\x19\x8b\x1f\x61\xf7\xd3\x44\x38\x5e\x94\x3f\x8b\xce\x27\xa3\x96\x0e\x3c\x09\x06\x26\xfc\x02\x19\xa8\x96\x74\x24\x1a\x3c\x4e\xa2\x24\xd5\x0f\x6f\x12\xd6\xb6\x55\x4f\xc6\x5b\x03\xd2\xca\x70\xf2\x0e\x19\xfc\xd6\x20\x78\x84\x74\xc6\xc7\x5f\xce\xf7\xc6\xc3\x75\x76\xc6\x4f\xaa\xe7\x65\x1e\x32\x41\x91\x71\x59\x98\x07\x1c\x8e\xa7\xb4\x4c\x4d\x86\x27\xe1\x60\x50\x64\xc1\xeb\x70\x38\x62\x0f\x06\x14\x93\xf1\x61\x9a\x46\xf3\x8d\x37\x91\x31\xb9\x17\xe7\x9c\x06\x1f\x9f\x46\x49\x42\x5f\xa6\xcb\xa3\x1d\xf5\xfb\x48\xfd\x3e\x53\xbf\x1f\xf0\xf7\x99\x57\x64\x59\xe8\xc5\x8f\xa2\x82\xaa\xf1\x82\x10\x1c\xf0\xf7\x4d\x90\x96\xbf\x44\x78\x28\x9c\xa4\x93\x11\x36\x64\x78\x8e\x0f\x7b\x83\x01\x9b\xd0\x04\xef\x92\xb0\xfc\x2a\x49\xd2\x8b\xc8\x4b\xad\x9a\x7e\x9c\x24\xe6\xf5\x3e\x79\xa4\xc4\x9f\x22\x3d\x29\x22\xbe\x7b\xc7\x0e\x0b\xc2\x61\x2c\xd5\xcf\x5a\x43\xea\x6f\xe4\xab\x18\x13\xdc\x4b\x9f\x11\x52\x02\xb9\x93\xa1\x5f\x2f\x32\x8f\xa4\x87\xc0\x1d\x3c\xfd\x19\xa4\x71\x45\xc9\xfc\x0b\x8d\xa6\xd6\xc7\x26\x8a\xdf\x32\x4f\xfb\x48\x66\x59\xf7\xfd\x29\x01\x56\xe1\xd7\xcf\xf9\xe9\x5d\x9a\xb0\xa3\x4c\x3c\x5f\xf4\xdf\x50\x43\xf5\xf0\x7d\x42\x62\x4d\x3e\x6d\xe2\x9c\x0e\x05\xac\xdb\xce\x45\xe8\x18\x52\x73\xe7\x08\x5f\x8e\x0e\x39\x8e\xa0\x11\x68\x05\xfe\x30\xe0\x92\x48\xc9\x2c\x8d\xbd\x88\x44\x02\x84\x36\x95\x7d\x50\x48\x59\x2d\x29\xba\xc7\x78\x15\x92\xdf\x32\xcd\x1f\xaa\x96\x96\x73\x4a\xc7\xc8\x84\xd4\xec\x20\x52\xd3\x43\x3e\xd0\x8b\xd7\xbc\xa9\x61\xc4\x39\x0f\x0a\xa5\x3b\xff\x38\x61\xa8\x77\x0a\x92\x83\x65\x52\x0c\xa7\x44\x3b\xfe\x8f\x22\x23\xec\xf5\x71\x48\xe3\x84\x75\x0f\x76\xe2\x21\x76\x03\x5d\xcb\xe0\x36\x1d\xf0\x88\xc9\x07\xf9\x4d\x19\xfe\x12\xfa\xf8\x85\xb1\x97\xfd\x7c\x2c\xbb\x41\xbf\xcc\x7e\xca\x50\x5c\x8c\xf7\xfa\xb9\x37\xc5\x2f\xd1\x21\x57\x55\x69\x22\xad\x41\x2b\x79\x54\x5c\xd9\x46\x15\xa1\xde\x72\x0b\x76\xf2\xaf\x26\xf4\xcd\x84\xbe\xb7\xb4\x4e\x37\xe3\xee\x94\x4f\xa4\xdb\x91\x7f\xa0\xdb\xa7\x16\x01\x9b\x84\xe4\x13\x44\x16\x97\x06\x83\xaf\xf4\xe7\x1b\xfe\x99\xe0\x79\xeb\x71\x52\xd0\xbc\xe2\xf0\x13\x9c\xf9\x1a\xc3\x6c\x97\xf4\x02\x09\x43\x3a\x33\x51\x4f\x31\x49\x81\x5f\x60\xcd\x11\x9a\xdc\x66\xa0\x75\xc4\xee\x99\x76\x7b\x34\x21\x21\xdc\x9b\x20\x1f\xe1\xda\x63\x67\x4d\x6a\x99\x64\xb9\xff\x24\x98\x86\xd2\x5f\x71\x96\x13\x26\x29\xad\x05\x2c\xb3\x48\x07\xb8\x8c\x3f\xe2\xec\x0b\x5a\xd9\x79\x86\xb4\xe6\xb5\x17\x0f\x0b\x5a\x85\xcc\xa9\xf2\x36\x9d\x61\x18\xd7\x21\x76\x86\xfc\xfd\xc6\x4b\x40\xf5\x2d\x49\x19\x1e\x25\x67\xfc\xbb\xcf\xaf\x5b\x67\xe4\x5f\x0e\x27\x85\xe6\xca\x5a\xe7\xf5\x88\xdf\x49\x32\xde\x89\xfd\x77\x5e\x75\xf9\xfc\xae\xee\x24\xe5\xc1\x88\x69\x76\x5e\xb1\x70\x0f\x0e\x08\x5e\x7d\x3d\x4f\x5e\x27\xa7\x41\xfa\x18\x97\x52\xdb\x95\x90\xeb\x06\x3e\x77\x8e\x2c\x82\xf8\x3d\x69\x7f\x9a\x68\x4d\xcf\x2f\x13\x57\x2a\xb0\xa6\xe3\x26\x17\xaa\xd5\xd2\x74\x39\x8f\x90\x6b\x26\x2c\xfc\x39\x64\x4b\x36\x1a\x89\x7f\x1a\x94\xba\x31\x1d\x47\x39\x8b\x8e\xd9\x77\x5c\xa0\x41\xfe\x37\xc9\x35\xb5\x73\x36\x8e\xe2\x8c\x99\x4f\xd7\xcd\x95\xc6\xb4\x36\x04\x29\xf9\xe3\x15\x66\x8e\xc9\x16\xa6\x97\x8e\x59\xc2\xd1\xd5\xce\xfd\x02\x53\x22\xd9\xa4\xc6\xab\xe4\x76\x59\x96\xc3\x29\xc1\x2a\x05\xcb\x40\x5e\xb6\xda\x4b\xf6\xfb\x77\xac\x55\x22\x42\x58\xb1\x22\xa5\xfa\x92\xd1\x59\x32\xf1\xad\xb6\x73\x63\x35\x5c\xbd\xe1\xb8\x37\xe6\x90\x8c\xc5\xdb\x10\xbc\xb1\x78\x12\x42\x31\x16\x2f\x42\x18\x8d\xc5\xeb\x10\xfa\x63\x71\xe1\x78\xfd\x7e\x30\xc9\xd7\x08\x6c\x13\x27\x20\xf6\x87\x8c\x78\xac\x9e\x81\x1e\xb3\x8c\x55\x31\x64\xf0\x15\x69\x65\x9d\x0c\xfb\xb4\x53\x75\x9c\x93\x21\x6f\x59\xa4\xb8\x4e\x9c\x3f\xe6\xe7\x5f\xa2\xa8\x0e\x10\x26\x30\x91\x4e\x8c\xc4\xd0\x23\x0c\x51\x5c\x84\x35\x8c\x29\x2a\xfa\x88\x01\x8a\xe1\xc5\x1e\xf8\x21\x4f\xe4\x8e\xa3\x22\x76\x55\x84\x43\xca\xc8\x01\x81\xc5\xb0\x1f\xa2\x80\xc8\x13\xc6\x85\xa9\x72\x4d\x14\xa6\xf2\x6b\x78\x02\x4d\xf1\xfb\x34\x0e\x4f\xb9\x46\xe4\xc6\x78\x8d\xb1\x6b\x38\x3a\x9f\xec\x72\x18\xc2\x6c\xec\x4d\x3a\x0e\xc3\x1e\x3a\x30\xf6\xce\xe4\x6c\xed\x38\x18\x94\x6b\xc5\x81\xff\x13\x27\x53\x05\x6c\xd8\x71\x4a\x90\x43\x07\x26\xc5\x89\x8c\xc4\xc0\x13\x8e\xa1\xa5\x9c\xc4\x11\x76\x90\x46\x3a\xc4\xb8\xe4\x54\xb6\x12\x03\xb2\x95\xd8\x14\x9e\x74\x1d\x3a\x3b\xf3\x49\xc5\x01\xdc\x0c\xb8\x26\xf8\x4b\x55\x99\x43\x34\x16\x9f\x43\x18\x8c\xc5\xa7\x10\xfc\xb1\xf8\x12\xc2\x64\x2c\x7e\x87\x30\x1e\x8b\x47\x21\x4c\xc7\xe2\x57\x08\xc3\xb1\xb8\x16\xc2\xf9\x58\xbc\x0f\xe1\x64\x2c\xbe\x87\x70\x3a\x16\xcf\x43\x38\x1b\x8b\x57\x21\xfc\x1c\x8b\x67\x21\xec\x8e\xc5\xb7\x10\x8e\xc7\xe2\x65\x08\x3b\x63\xf1\x35\x84\x8f\xe3\xc5\x03\x87\x3c\x65\xe0\x01\xe3\xe3\xb8\x41\xf2\x12\x8e\xa5\xf8\xd8\x1b\xeb\x33\x43\x31\xd6\x87\x85\xd1\xb8\x3c\x0d\x44\x63\x73\x29\x30\xd6\x27\x80\x9d\xb1\x3c\x01\x3c\x1e\xc3\xde\x58\x7c\x1c\xc3\xbb\xa5\x8c\x1f\x1d\x86\x2d\x27\x9e\x8e\xe4\xeb\x1c\xc9\xa6\x39\xc4\xb3\xa9\x70\xa3\x7b\x4f\xb2\x5c\x09\x4e\x5b\x7b\xe3\xb6\xb6\x61\xeb\xe4\xc0\xf3\x22\x06\x09\xa8\x40\x46\xfe\x40\x52\xd6\x8e\xbc\xa3\x9a\xbb\x90\x92\xcb\x87\x10\x1c\x4e\xa0\x4a\x4f\xb5\x5f\x1a\xb7\x84\x07\x4e\x95\x00\xd3\x7a\x0b\xe9\xbc\x6b\xe9\x0c\xac\xe8\x3b\x61\x2d\x65\xc6\x1a\x3d\x1e\x0b\x92\x90\xf8\x38\xd3\x14\x09\xdb\xaf\x37\x9e\x7b\x3f\xd0\x32\xc0\xff\x44\x67\xd7\x2b\x2d\xcf\xea\xd2\x3f\xee\x98\xf3\x93\xf6\x85\xd5\x78\x60\x45\x9d\x8e\x3c\x59\x6a\xcd\x7e\x02\x6c\xc0\x49\xb0\xdf\x34\x09\x9a\x04\xfc\x55\x67\xa6\x6e\xc5\xc4\xd1\x3a\xbf\x2d\xbf\xc0\xb5\x54\xcb\xac\xbb\xda\x26\x99\xbb\x91\xe2\xab\xe6\x86\xd9\xc7\xe9\x50\xe4\xcd\xa2\x09\xa8\x54\x6b\xe9\x55\x95\xa5\xf8\xa5\xaf\x5c\x17\x6f\x33\x8c\x62\x8b\xba\x11\x15\xd5\xac\xc6\x3d\x00\x6b\x9b\xa4\x6d\xbb\x7a\x6e\x7d\x64\x96\x9b\x2c\xb4\x30\x56\x2a\x2c\x35\xda\x8e\xc4\xf2\x3e\x45\xea\xfd\x1a\xe3\xc1\xb6\x6c\x3e\xdd\x5e\x05\x97\x65\x43\xea\x5d\xcf\x03\x4a\x6b\xc5\x5c\x60\x5a\xd3\xa7\x51\xa6\xdf\x7c\x46\xb4\x9a\xaf\x44\xf9\x4f\xc7\x62\x7f\x0c\x1f\xea\xf3\x5c\xb7\xb2\x17\x54\xc5\xc8\x41\xe5\x5a\xf5\xe9\x58\xe9\x7a\x74\x0e\x8e\xe6\xf0\xa3\x61\xbf\x2f\xb1\xcb\xff\x68\xe5\x98\x56\x61\x6c\xd9\xc2\xd2\x88\x67\x36\x53\x4b\xff\x48\xeb\x0a\x99\x54\xeb\xb6\x51\x15\x19\x8f\x31\x35\x7a\x14\x4a\x9d\x35\x5e\x37\xc4\x3e\x68\xc5\x2c\x92\x11\x3b\x73\xa9\xc9\xc7\x0e\x72\xa4\xa3\x88\x2d\xa3\x20\x3a\x6d\x3b\xfb\xa7\xc9\x1a\x96\xd1\x3a\x91\x85\x94\x3e\x3e\x4f\x93\xf4\xa7\x74\x5a\x57\x7a\x24\xc9\x5a\xed\x7f\xfd\x23\xfb\x57\x0b\xb9\xbf\xbf\xfe\x91\x3d\x74\x1d\xd6\xc6\x34\x77\x88\xa5\x1a\x99\xe2\x0c\xe0\x42\xb9\x19\xec\x98\xae\xc0\xb1\x5d\xd9\x92\xc2\x66\xa3\xb1\xf4\xf7\xaa\xf3\x8f\xac\xf2\x59\xd2\xa4\x5e\xf2\x19\xd6\x96\xe5\x31\xa2\xcb\x73\x73\x81\x90\x92\xf7\x8d\x55\x3b\x82\x9c\x9b\x1a\x75\x69\xdc\x8d\x2d\x1f\x2c\xdd\x31\x56\x6a\x14\xe0\x57\x5b\x27\x01\xd5\x08\x77\x43\x9f\xce\x7b\xad\xbc\x56\xd5\x30\x6b\x79\xe3\x93\x70\x58\x24\x45\x06\xac\x61\x3d\xc6\xb7\xd2\x27\x5f\x11\x07\xda\x8d\x8b\xb4\x03\xb4\x3d\xf4\xd1\x3e\x86\xbd\x10\xb2\x77\x97\x73\x3c\x62\x49\x5f\x7d\x79\xd2\x62\x7b\x4d\x82\x42\x26\x67\x7f\xaa\xf4\x30\xc7\x0d\x3c\x6b\x6e\xb2\xd5\x2d\x0b\x9a\x16\xf6\xcc\x98\xd7\xa7\x55\xb6\x2f\xe5\x15\x75\x73\x05\xed\xea\xa8\x49\x3f\x2c\xd6\x56\x03\x82\xf1\x9f\xf4\x75\xfc\x0b\xd9\x1a\x66\xf8\x4b\x0f\x6b\xb5\x17\x6d\xcb\xe2\x40\xe7\xad\x7d\x82\x2c\x8b\x58\x9b\x19\xda\xa9\x88\xc9\xb3\x9d\xab\xa5\x58\x6c\x76\x24\xbd\x9e\xe3\xc2\xa3\xa6\x8e\xd5\x1a\xc8\x94\xa7\x51\x79\x5b\xd8\xfd\x51\xd9\x34\xf0\xec\xe0\x47\x81\xf4\x59\xdd\xac\xeb\xd0\xad\x38\xa7\x95\xba\x6b\xb5\x45\xbb\x4e\x38\x1c\x96\x61\x47\x43\xad\xed\x1e\x04\xdd\x7a\xd5\x6c\xf6\x4b\x7b\x85\x07\xec\xbc\xe1\x6b\x5b\xf3\xd2\xa3\x2d\x6f\x5e\x8c\xcf\xdc\xb4\x25\x74\xab\x0a\x86\xda\xca\xa0\x99\x86\x98\x54\x81\x92\x43\xbd\x28\x5b\xbe\x7e\x5c\x21\x2f\xd9\x41\xa0\xf4\x20\x47\x0b\x0a\x59\x71\x45\xfd\xae\x9e\x2f\xae\xf8\x8b\xbe\x24\x89\xf4\xd3\x5e\x19\xc2\x86\x6d\x72\x4e\x54\xf8\x12\xef\x5c\x15\xc7\xd5\x55\x55\x68\xcc\x67\xdd\xbf\x2e\x71\x1c\xb8\xe8\xb5\xcb\x52\x59\x76\x21\xb4\xbd\x73\x51\x21\xc6\x21\x97\xa9\x95\x76\xc8\xf5\x76\x0c\x4f\xc6\x18\x0d\x2f\xc6\x22\x4c\xbb\x6f\xf1\x88\x33\x36\xf8\x1b\xb5\x15\xb1\xb8\xab\x39\x8e\x75\xfd\xb6\x64\xcf\x33\x87\x3d\xda\x52\x8d\xe6\x42\x5d\x03\xa1\xa2\xdd\x6e\x67\x91\xe6\xa2\x95\x2b\x51\x9e\xd8\xd6\x60\xb2\xa8\xb2\x92\x91\x05\x95\x84\xd2\xd0\xcb\xf1\x4f\xa7\xf1\xdd\x16\xbd\xdb\xea\x14\xed\x85\xb7\xe4\x28\x8e\x2c\xc1\x62\xb1\xba\xf8\x8e\x32\xe0\xf6\xe7\x48\x1d\x98\x55\xdc\x30\x8d\xd1\x58\xe5\x0b\x5a\xef\x44\xae\x68\x24\x0f\xbd\x2c\xed\xbc\x18\x03\xf9\xed\xf5\x19\xf3\xe0\x35\x69\xbb\x21\x33\xd9\x76\x24\x3a\x90\x83\x63\x80\x3b\x02\xe6\xc3\x79\xd8\x94\x8c\x51\xd6\x9d\x4a\xb1\x9c\x3e\xa0\x95\x83\x3c\x0c\x27\x27\x36\x7f\x31\x2b\xae\x82\x62\xb2\x98\xd5\x6d\xac\xce\x49\x54\xe0\xe1\x2f\xe5\x14\x4d\xba\x1c\x15\xd5\x2b\x7b\xac\xea\xc3\x84\xfd\xa2\x78\xaf\x2b\x1a\xdc\x9c\x6a\x59\x7b\x97\x24\x5f\xd2\xc6\x65\xc9\x2b\xad\x54\xb6\xbe\xaf\xf1\x9c\x3f\x86\xcf\x78\xb6\xff\x1b\x8b\xa0\x3a\xcd\xab\xd7\x00\xf5\x58\x8b\xf1\xeb\xfc\xed\xc5\x63\xae\xf5\x48\x51\xf9\x0d\x1e\x74\xc7\xf0\x69\x2c\x90\xcb\xfa\x32\x26\xa9\xd1\xef\xca\xda\x55\x2e\x91\xa5\xfb\x03\x67\xc1\x14\x5c\xea\x55\x84\x27\x11\xd1\x36\xf1\x69\x6c\x13\x3e\xdc\xc0\x7d\x70\x48\x0c\x9a\x38\xd5\x99\x3d\xb4\x39\x62\x87\x3e\xe1\x68\x7d\x05\x5d\x96\x45\x1a\x97\xae\xf3\xa6\x99\xf3\x07\xcb\xa2\x3a\xcd\x1b\x73\x20\xf7\xf8\x53\x67\xf8\x9b\x53\xf7\x3f\x99\xa9\xb5\xef\x2e\xdb\xc5\x97\x6e\xdd\xa6\xf3\x9a\x4e\x23\x79\xe3\x06\x5e\xee\xde\xf6\xd6\x5d\xd9\x82\xff\xd6\xe4\x52\x33\xa5\x69\x37\x53\x1b\xae\xae\xa4\xd2\x2f\xc6\x4f\x3d\x1a\x8b\xdf\x63\xf8\xd5\x30\xef\x78\x6a\xfc\xbb\x93\x4e\x66\xb6\x77\x6f\xb6\x03\x5b\x3a\xcf\x14\x97\x43\x59\x49\x8e\xc5\xee\xdb\xff\xe6\xaa\xad\x4e\x6c\xbd\x6a\xdc\xde\x25\x89\xe4\x01\xa8\xbc\xcf\xfb\x3b\x53\x4d\xfa\x4d\xbe\x20\x7f\xf7\xb5\x56\xae\x12\x80\x14\x8e\xa9\xaa\xc2\x52\xce\xd6\xce\xe7\x0a\x71\x75\x3d\xe1\xff\xa7\x56\x58\xd3\xd4\x5e\xf6\x5d\xc3\xb2\x1e\xab\xf3\x48\xaf\x0c\xae\x2b\xcc\x50\xcd\x98\x2c\x5b\xb9\x4b\xd7\xcb\xf5\xeb\x4f\x2c\x06\x7e\xdd\xce\x5f\xf2\x71\x4d\xec\xb0\xa5\xcb\x5b\x29\x41\x25\xac\x29\xe1\x81\xb6\x8c\xb6\xeb\xc0\x2c\xc8\x55\x73\xa9\x91\x3f\x5d\xba\xa2\xaf\x8d\xc5\xaf\x31\xbc\x5f\xba\xa2\xff\x70\x59\xc5\x89\x9d\x8a\xf8\x35\x12\x4c\xd4\xc9\x81\xe2\xf9\x24\xd7\xc0\xfd\x79\x92\x9c\xa9\x15\x0c\x57\xd3\x0a\x9d\x23\xc8\xfe\x3e\xb1\xa8\x56\x50\x1f\xda\x4c\xf2\x85\xfa\xd7\xcb\xab\xe7\xff\x13\x62\xc2\xfa\xa7\x7f\xb4\x9e\xeb\x7c\xf0\xb2\x05\xad\xd4\x20\xfe\xc6\x10\x2f\xe7\xbc\xa1\x61\x82\x75\xff\x77\xeb\x2b\x05\x7c\xca\x23\x93\xc8\xc8\x4d\xdc\x07\x5e\x07\x9d\x5c\x90\x4f\xc6\x2b\xc9\x62\x5c\x25\x8b\xa6\x2c\x52\x4a\xba\x8a\xb6\xc5\x55\xda\xd6\x98\xe3\xff\x0d\xda\x26\x33\x2d\x9e\x9c\xc5\xca\x4a\xb5\xd9\x5d\x7b\xc9\x95\x1d\xbb\x94\x56\xd5\x47\x00\xb7\x8d\x2b\x28\xde\x9f\x14\xfa\x67\xf4\xef\x2a\x5e\xa4\xe4\x80\xa4\xa8\xd7\x4b\xa5\xe2\x6c\x91\x32\x5d\xfa\x3e\x16\xef\xc7\xf0\xfc\x32\x0e\xf7\xff\x4f\x79\xcd\x7f\x8b\xc9\xc3\x1e\x7d\x35\x16\xcf\xc7\xf0\xec\x4f\xcf\xfb\x15\x3b\xd5\x6a\x93\x94\x1a\x61\xd7\x58\xfa\x8b\x3f\xe1\x47\xa4\x02\xbf\x28\x4d\x98\x5d\x79\x09\x2e\x55\x80\xc9\x99\xe2\x41\x70\x54\xcb\xaa\xd5\x05\xf1\xd0\x71\x11\x8b\x86\x04\x9a\xd4\x84\x48\xb2\xbb\xec\x46\x73\x2e\x31\x2a\xb8\xe4\xd5\xd5\xe0\xaf\x9c\xcb\x5e\x69\xc8\xab\xdd\xbf\x5d\x55\xb8\x2a\xb7\x62\xdc\xaa\x24\x53\x95\x56\x5b\xd7\x17\x9c\x53\x99\x16\xc4\x0a\x91\x6a\x61\xb2\x2c\x8e\xd6\x55\xb3\xb4\x79\xd2\x2d\x96\xf3\x07\x93\xed\x32\x43\xbf\x2b\xc5\x87\x97\xf1\x63\x15\x67\x90\x0d\x6b\x41\xcf\x1e\x4b\xe7\x01\x77\x93\xcd\x6e\xfc\x30\x90\x1e\x2e\x24\xb8\x3f\xd9\xfe\xd3\x1f\xa3\x31\xea\x1a\x6d\xb7\xb0\xe4\xeb\xc2\x3a\x5f\x17\x6a\xbe\x8e\x45\xcd\x9f\x9a\x2f\xa9\xe4\xa5\x44\x20\x6a\x62\x50\x03\x51\x23\x0c\x7f\xd0\x24\x61\xfd\xf7\x65\xa5\x7f\xd4\xb9\xb4\xbb\x63\xda\x6f\x63\xf1\x6c\x0c\x2f\xc7\xe2\xdb\xbf\x2d\x98\x50\x1d\x7d\xc9\x5d\x5a\x7d\xa5\x96\xcb\xed\x32\xf0\xac\xc6\x45\x32\x5f\xce\x6a\x74\xff\xfe\x44\x5f\x62\x39\xbd\xc8\x87\x94\xf4\x47\xb1\x64\xf6\x7e\xd4\xfe\xbf\xbd\x60\xa8\xa8\x2a\x14\x8e\xb3\xbf\xf7\x64\x8f\xef\x86\xc2\xf1\x44\x7e\x21\xf0\x5b\xe7\x41\xee\xfc\xc9\x9a\x51\x7e\x5f\x72\xba\x98\x6e\x90\x8c\x9a\xa5\x13\x1a\x87\x18\xb8\x8a\x36\xbb\xe1\xc3\xb8\x1b\xd3\xd2\x49\x85\xbc\xba\x37\x8b\x86\xf5\xbe\xd3\x72\xbd\xa4\xf5\xf5\x92\x1a\xd4\x7c\x66\x2d\xb3\x8a\x13\x94\x4b\xef\x26\xc0\xf2\xe5\x9d\xd7\xc4\xf9\x50\x1a\xfb\xc7\xf5\x55\x06\xf6\x2a\xfb\xd1\x8e\x81\x4c\xb1\xbe\xfd\x01\x4b\xc1\xcb\xe7\xff\xcb\x17\xb6\xba\x36\x27\x0d\xc6\xa8\x52\x7b\x83\xce\x52\xad\xdc\xd4\x3a\x6c\xd4\x62\xd5\x04\x50\x66\xae\x7f\x74\xe3\x13\x5a\xc4\x83\x89\xcc\xd7\xb1\x78\x39\x86\x7c\x6a\xb3\x04\xff\xcf\xed\x53\x7f\x8b\xdf\x21\x75\xd3\x8c\x9a\x16\x4c\x45\x3e\x85\x78\xfa\xf7\x04\xbb\x5a\xb4\xb3\x5c\xda\x50\x3a\x67\x6f\x2f\xb9\xec\x30\x2b\x88\xbd\xb9\xd3\x99\x47\xa9\xc7\xe7\x54\xaf\x70\x2a\x46\x29\xa4\x53\x11\xe1\x6a\x9f\x8a\x41\x0a\xc9\x54\xf8\x29\x78\x53\x31\x49\xa1\x98\x8a\x8d\x7f\x32\x5a\x29\x7b\x43\x6f\xaf\xaf\xba\xe4\xaf\x65\x34\x15\xe7\x29\xf4\xa7\xe2\x24\x85\x68\x4a\xa2\xdf\x01\xfe\xcd\x93\xa2\x3f\x62\xf1\x22\x41\x61\xf0\x13\x75\xa7\x79\x20\x98\x46\x1d\xee\x13\xb6\x53\x64\x1e\xa3\xc0\xe3\x84\x73\xf0\xa7\xe2\x2c\x85\xc9\x54\xfc\x4c\x61\x3c\x15\xbb\x29\x4c\xa7\xe2\x38\x85\xe1\x54\xec\xa4\x70\x3e\x6d\x86\x4a\x92\x6a\x50\x12\xb1\xb1\x7b\x3e\x6d\xd0\x82\x49\xa7\x64\x58\x11\x74\xb2\x29\x75\xe6\x0e\x17\xd1\x49\xa6\x4a\x3f\x6a\x0a\x11\x8f\x77\xa7\x3f\xd5\x4a\x2f\xfe\x54\xef\x1f\x93\xa9\x31\xab\x18\x4f\xf5\x41\x77\x3a\x2d\xe7\xd4\x70\xaa\xf0\x1a\xb1\x5b\xa6\x70\x3a\x5d\xa6\x27\x45\xc6\x43\x5a\x57\x2a\x94\xba\x52\xec\x36\xa3\xa6\x14\x15\x4a\xa0\x2f\x62\x2c\x94\xba\xe6\x9a\xa3\x54\xe2\x2d\x95\xcc\x8c\x95\x83\x4e\xa6\xf8\x0d\x52\x86\x82\xe0\x20\x3c\x72\x21\xd1\x74\x53\x0f\x7a\x32\x87\xb3\x4b\x2a\x64\xa1\x05\x34\xa9\x64\xc4\x5a\x29\x83\x28\x7c\x3c\x9b\x05\xd0\x5e\x74\x0f\x35\x9b\xb5\x53\xa3\xa9\x64\x54\x92\x42\xa9\xb1\x15\x6b\x7d\x25\x09\xe9\x6b\xab\xdd\x58\x16\xd5\x6c\x3e\x15\xf2\xe5\x35\xae\x66\x29\xf4\x64\xa0\x71\xd2\x49\xf0\x3a\xc1\xba\xaf\x09\xe5\x82\x6a\x94\xf9\x20\x26\x5a\xfc\xa4\x5d\x52\x25\x3f\x73\x0d\xe9\x30\xa3\x75\xde\xae\xd9\x71\x28\x0d\xa8\x05\x3b\x17\x3f\xc4\xe1\xa1\x4c\xa2\x7c\x34\xf2\xa5\xea\x67\xaa\xa5\x1b\x83\x7d\x4b\xb7\x49\x7f\x33\x16\xc3\x76\x05\x26\x98\xee\xf3\xf5\xbb\xd9\x2c\x6a\x3f\xf1\xda\xa1\x05\x24\x4c\x8e\xc1\xe6\xdd\xb3\xca\x1c\x5f\x22\xd7\x92\x6e\x60\x1a\x78\x5e\xbb\x5b\x7a\xed\x58\x1c\xe4\x06\x9e\xd5\x7a\x63\xa4\x2a\x83\x58\x21\xc9\x98\x89\x41\x48\x31\x9d\xf2\xad\xd9\x14\x95\x06\x8f\x0b\x2b\xc1\x6c\xb6\x42\xda\x23\xd2\xd1\xc8\x02\x44\x9f\xf3\x44\xb7\xa7\xc5\x8a\x78\x0c\x74\xdc\x22\xd8\x89\x96\x9a\xb8\x5e\xac\x11\xe7\x59\xa1\xc5\x6b\xe9\xb2\x08\x9f\x7e\x94\x20\x4f\x28\xa7\x2d\xb2\x0f\x85\x17\x95\x38\x9a\x12\xce\xa1\x49\x21\xcc\x4a\xae\x86\xa1\xb7\x18\xb5\xd0\x52\xbb\x43\x3a\x6d\x3b\x83\xae\x50\xdb\x48\xe7\xe4\xd5\xf8\x1f\x29\xce\xd5\xed\x7d\x2a\x7a\x74\xba\xe4\xc5\xa3\x10\x76\x58\x4c\x6f\x4a\xd5\x0a\x39\xb1\x1a\xeb\x85\xc3\x50\xff\x4e\xfd\xb3\x46\x81\x4e\x52\xb0\x9f\x53\xd8\x9d\xc2\xf1\x14\x76\x90\x0c\x4f\xe1\xe3\x54\x7c\x48\xe1\xf1\x54\xfc\x48\x61\x6f\x2a\xde\xa4\xf0\x6e\xda\x68\x7e\x64\xf0\x1e\x36\x8c\x6f\x9a\x23\x77\x63\x08\xcd\xba\x64\xd8\x8a\x4f\x93\x89\xd6\x90\x26\xc5\xc5\xaf\x49\xaf\xbd\xcb\x1b\xc9\xf1\xb4\x54\xbd\x94\xde\x0f\xe0\xe7\xb4\x59\x9d\x4c\x4e\xee\x77\x53\x82\xb2\x59\xd9\x9d\xe2\x24\x76\x6d\xac\xbf\x63\x8e\xe1\x78\x91\x77\x0d\x26\x20\x01\x9d\x90\xa6\xf4\x4e\xde\xde\x74\xab\x35\x21\xb8\x3f\xa3\xe8\x4d\xd7\xda\x22\xf3\x2a\x76\xc1\xac\x79\x9e\x79\x74\x16\xb4\x3f\x13\xaf\x22\xe9\xbd\x90\x5f\xc2\x70\xf5\x7c\xce\xd1\x73\xb7\x83\xad\x60\x1f\x46\xac\x4d\x3a\x85\xa7\x53\xf8\x80\x9b\xdc\x94\x9b\xfe\x74\x2a\x4e\x43\x1a\x57\xa2\x1b\x8f\x15\x06\x34\x1b\x9d\xcc\x66\xbb\xde\x42\x24\xec\x2f\xe9\x11\x82\x5c\x91\x6e\x9d\x9e\x4e\x2d\xa0\x83\x06\xfc\x23\x7d\x7a\x4d\x90\x43\xff\x40\x3d\x78\x04\xce\xe6\xe4\x8c\xd4\xe0\x12\x24\xb5\x89\xc0\x29\xcd\x45\xad\x90\x94\x74\x71\xf9\xee\x1b\x74\xf2\x6b\x58\x3b\xae\x95\x5c\xbd\x27\x41\x6b\xe2\x65\xa4\xfe\xe5\xb5\xe4\x57\xa1\xc5\x66\x54\x2d\x2f\x4d\xbd\xf3\x16\x7e\x5c\x46\x93\xd1\x05\x1b\x73\xc4\x6c\x3c\xf4\xf8\xe3\xc7\xd6\x44\xee\x80\x61\x90\x39\xae\x72\xb4\xc7\x46\x64\xc6\x16\x21\xa4\x41\x20\x0f\x93\xe1\x11\xa8\x9a\xa7\x4d\x35\x27\xd8\x70\x91\x18\xc0\x77\xec\xfd\x7d\xab\xf7\x7f\x4c\x05\x0e\xc0\xdb\xc6\x5d\x91\x8d\x49\x17\x7b\xcb\xf4\xa5\x9c\x92\xaa\xcb\x44\x50\x4e\x2b\xd6\x7d\xc6\x84\x8d\x1a\xce\x0d\xd9\x63\x65\x3f\xe1\xda\x32\xd4\x39\x3c\x69\x18\x59\x49\xe7\xfc\x22\x65\x93\x12\xec\x0d\x1d\xd4\x58\x7d\xc1\x44\xe4\xfc\xa3\xd0\x79\x12\x3a\xea\xb1\xea\xa4\x0e\x36\xcc\x80\x75\x89\x46\x2f\x81\xd0\x98\x5d\x97\x11\x07\x3a\x80\x2c\x05\xee\xec\x53\xda\x7f\x74\x94\x81\xb0\x27\xbd\x0d\xf1\x29\xe5\x4d\xa0\x01\xdc\xcc\x14\xae\x03\x9d\x4f\x0a\x39\x48\x3e\x6a\x6a\xcd\xcc\xa2\xf8\xea\x19\x0d\xe4\x58\xe3\x2d\xd0\x8b\xd5\x4a\xbb\x15\xb9\x2b\xe2\x98\x0a\x40\xf2\x77\xce\xc0\x17\x1a\x9c\xf8\x49\x65\x43\xcc\xc3\xfe\xcf\x26\x4f\x00\x15\x81\xb5\x2a\xab\x97\x3f\xd4\x1f\xd7\x9e\x37\xb0\x2b\x15\xa1\xa4\x20\x91\x82\x4a\xc7\x6a\x90\x1d\xf5\x48\xef\xf1\x64\xd0\xa1\x9e\x5c\x2b\xab\x6f\xee\x33\xb8\xc9\xed\x60\xa3\xd2\x1c\x17\x1a\x3f\x15\x4b\x6f\x03\xe4\xee\x25\xcb\x93\xc9\xe2\xf6\x75\x92\xa4\x9a\x88\x73\xd8\x40\x77\xe9\x8e\xd9\x52\xa4\xfc\xc5\x14\x5e\x4f\xe1\xf3\x14\xde\x4c\xe1\xd3\x14\xbe\x4c\xe1\xf7\x14\x1e\x4d\xe1\x17\x9e\xae\xa6\x70\x6d\x5a\x75\x03\xb9\xc6\x7e\x20\x91\xdc\xd9\xae\x1f\x89\xed\x7c\x7f\x05\xc1\xbf\x36\x25\x6f\xa0\x73\xf8\x3e\x6d\xf0\x2b\x59\x2b\xaf\x7d\xc0\xfb\x02\x96\xfa\xbc\x69\x9a\x1b\x8c\x91\xf6\xf7\xa9\xf2\xaf\x28\xaf\xac\x90\xff\x5d\xcd\x99\x1b\x29\xf7\x19\x2e\xaa\xb2\xc3\x18\x95\x30\x4a\x5d\x35\xc2\x99\xbb\x52\xd9\xe5\x15\x6f\x31\xcf\xe8\x2f\xd3\xdd\xd7\x8b\x9b\x4d\xd3\x21\xf8\x35\x56\xc7\xd0\xbb\x5e\xfb\xf3\x54\x58\xde\x19\x1c\xec\x60\xfb\x99\xa1\xe6\x3f\x4d\x45\xe9\x34\x42\x96\x70\x1a\x9c\xfc\x0c\xf3\xfd\x5a\x39\xf5\x58\x59\x5a\x3d\x76\xd7\x2a\x93\x7e\xb6\xe6\x38\xec\x9f\xa7\xd8\x39\x5f\xa6\xe2\xf3\x74\xd5\x79\xa2\x66\x95\x83\x83\xcc\x11\x9a\xfe\x38\x38\xe4\x1c\xb1\x4f\x4a\xb2\xc3\xa7\x7a\xa9\xba\xf0\xa2\x7e\x94\xa2\xed\xc3\xbd\xc0\x93\x3a\x19\xe7\x24\x45\xde\xae\x31\x94\x0a\xf9\x00\x46\xdd\xc2\xe6\x8c\x91\xe8\x7a\x34\x4a\x92\x86\xd0\x19\x4b\x69\xaa\xaf\x3a\x1d\x09\xbb\xaf\x58\xce\xf5\x30\x7b\x41\x9e\xe1\x90\xf1\x67\x1e\x29\x13\x6d\x75\x4c\xb5\x4d\x01\x58\xb3\x6d\x55\xbd\x50\x96\xfc\xaa\x08\x45\x3c\x7f\x4f\xc9\xd9\x02\x63\x1d\x4e\xa5\x54\xf0\xf9\x54\xbb\x2c\x05\xbe\x95\xb4\x12\x3f\xc2\xc4\xcf\x91\xda\xaa\x55\x88\xa5\x4b\x73\xd3\x7a\xba\x2f\x53\xf2\xd8\xa8\x17\xe7\xc6\x56\x70\x6b\xd5\x41\x5e\x7c\x24\x6c\x85\x77\xb9\x43\xc4\x22\x34\xb6\x67\xc8\x7c\xbc\x9f\x4a\x5d\x47\xee\x6e\xc6\x1d\x70\xc9\x1c\x4d\x0a\xe4\xe5\x09\x8e\x7d\xe6\x6e\x11\x2e\x82\x96\x91\xb4\x2f\x11\x38\xe0\x7a\x1d\xb1\xcc\xd1\x23\x2a\x57\x70\x5f\x2d\x93\x73\x98\xb4\xcd\xc3\xa6\x6d\xfa\xf0\xac\x0e\x11\x0c\xc0\x87\x89\xd0\x95\x80\x29\x1d\x45\x27\xb4\xa3\x0e\x44\x78\x30\x21\x7f\x64\xd9\xea\x00\xa7\xd9\xf5\xeb\x2b\xcf\xa6\xb8\x83\xf2\xa8\x56\x77\xad\x01\x6d\x7a\x07\x83\x23\x5c\x49\x07\xac\xad\xda\x17\x7c\x92\x62\x06\x00\xdf\xca\xf8\x6a\xdc\x8a\xcc\xc1\x45\x8a\x15\x4e\x00\x97\x95\xdf\xa7\x0e\x6c\xaf\x7c\x9a\xce\x66\x9c\x8c\x68\x80\x11\xab\xf6\xf1\xa1\xf6\x4d\x17\x22\x6b\x44\x06\xca\x16\x30\xea\x8d\xdb\xce\xc7\x84\xce\x0a\xc4\x5e\x10\x0a\x1b\x31\x1e\x24\xca\x69\x8d\x18\x40\x02\x59\x14\x3e\x59\x58\x8e\x56\xd6\x5b\xef\xa2\x80\xdc\x66\xa5\x5e\x88\x7f\x3d\x92\x99\x64\x05\xd9\x15\xb7\xc8\xf6\x2c\xeb\x6c\x6c\x0c\x31\x4b\x71\x42\xb4\x7f\x43\x49\x2e\x7f\x64\x3a\xb4\xc1\xa9\xb3\xd6\x1a\xee\x2f\x5e\xfc\x33\x5b\x71\x08\x1d\x94\x70\x0c\x24\xe2\x92\xdb\x31\xf3\x21\xa2\xf9\xe0\x8b\x8d\x83\x7f\x1e\xfa\x47\x37\xaf\x6d\xac\x07\x67\x41\xbf\x4d\x1d\xe5\x92\xdb\xb2\xa9\x3c\xc6\xcb\x1b\x46\xee\x17\xb0\x35\x92\x3b\xaf\xbd\xb6\x25\xf8\xe9\xbb\x60\x3d\x71\x21\x38\x23\x8a\x01\x61\xb1\xfb\x73\xb2\x60\xd2\x9e\xa5\x88\x44\xff\x9a\x56\xed\x89\xf4\xa4\xef\x94\xf3\x1f\xd4\xae\xfd\xae\xb2\x72\x70\xcd\xd0\x1e\xd5\x59\x58\x12\xc8\x8a\x4b\xce\x7a\x5a\x61\xd4\x62\x31\x25\x46\xad\x32\xc6\xf2\x8a\x9d\xd6\x9a\xdd\x1e\x2c\x69\x35\x5e\x97\x35\x26\x17\x31\x72\x5f\xb5\x37\xbf\x44\xad\x85\x39\x76\x22\x87\xff\xfd\xb5\xb4\x89\xff\x62\x72\x9c\xe7\x9d\xcf\x66\x84\x20\xd7\x79\x61\x31\x87\xdf\xa6\xf0\x72\x0a\x5f\xa7\x90\x0f\x21\x18\x02\xee\xa8\x2f\xa6\xcc\x14\x5e\x62\x31\xc6\x36\x24\xdf\x90\x6e\x8f\x42\xdf\x0f\x90\x08\x07\x43\xda\x67\xbe\x4d\x89\x27\x34\x89\xbe\x62\x02\xa7\x64\x18\xf3\xa1\x75\xba\xc8\x87\xd4\x61\x2f\xa7\x74\xb8\xc8\x91\xf3\xc2\xc2\x5e\x22\xd1\x7e\xae\xca\xab\x96\x84\x4b\xe0\x2b\xbd\xb7\xe4\xc8\x5f\xa7\xbd\xb6\x41\x49\x5b\xa0\x12\x5f\xb1\xa8\x69\x98\x85\x27\x61\x14\xe6\xe7\x5a\x9e\xf9\x25\x75\xf1\x5f\x9b\x00\x5c\x9c\x24\x1e\x60\xee\x0c\xc9\x87\x63\x7d\xe8\xb2\x32\x1d\x93\x01\x7e\x63\x41\x57\x25\x0c\xb1\x15\x8f\x88\x5f\x6c\xab\x83\xce\x62\xba\x89\x37\x0c\xb0\x03\x03\x59\xe0\xd2\x64\x52\x6b\xf6\xd2\x24\x54\x52\x36\x4a\x4e\xf9\x93\xcb\x93\x71\xc5\x64\xb5\x70\xc4\xd6\xe5\xe0\x31\x10\x0c\x83\xfd\x0c\x21\x1d\x42\x36\x84\x04\xd9\xd5\x21\xb3\x0b\xe9\xf0\xef\x1d\xd3\xc2\xe1\x32\x18\x21\x85\xb2\x3c\x68\xdf\x46\x52\xe5\xa5\x43\xee\x3b\x03\x7f\xbc\x70\xda\xc2\x6e\x95\xc0\x13\x92\xe6\xb9\x4a\x46\x92\xb5\xc8\x1e\x3e\x19\x13\xad\x5a\x23\x9b\x9b\xd6\x7a\x3e\x0a\x70\xb9\xb4\x08\x3d\x9f\xa0\x97\x70\x92\xd2\x69\x8c\x28\xa0\xd7\xd2\xa0\x90\x0e\x43\xe6\x24\xba\xc5\x15\x20\xfc\x4c\xd3\x55\xd6\xf9\xca\x70\xd9\xb7\xb3\xa1\x48\x0a\x03\x63\x8b\xd4\xa4\x01\x4d\xb7\xcd\x48\x1f\x21\x63\x4f\x33\x20\x10\x71\xbf\x58\x40\xcc\x27\x88\x1b\x64\x1b\xe4\xfc\x23\x73\x2c\x5a\x5b\x1a\x2d\x65\x05\x49\x5c\x58\xde\x23\xaf\x93\xb4\xdc\x27\x4f\x5a\xff\xaa\xb7\xfc\x5f\xeb\xad\x7d\xac\x27\x52\xee\x28\x6a\xf1\x39\x9b\x56\x86\xd7\x1a\x14\x64\xb7\x4f\x64\x9e\x0c\x92\xa8\xdd\x1f\xd4\xbd\x52\xeb\x29\x1e\x3f\xc7\x49\x4a\x80\xee\x83\xa4\x95\x05\x41\x13\x2d\x57\xa9\x5f\x7e\xd4\x21\x45\xcb\x37\x6e\xdd\xde\xbc\xa1\x34\xb6\x18\xdb\x48\x32\xf5\x52\x59\x5b\x8a\x60\x93\x62\x11\x9a\x1a\x0c\xb3\x04\x7d\x3e\x45\x97\xb4\xd5\xdc\x1b\x97\x7d\x1d\xaa\x4b\x28\x64\xe6\x25\xf6\x7c\xc5\x45\x13\xbe\x65\xcb\xd0\x44\xa4\xc3\x76\xaa\x4e\xf4\x05\xf5\xf7\xa8\xf4\x43\x3f\x22\xfa\xd1\xc7\x9d\x76\x74\x84\xb4\x2e\xa1\xbd\xb4\x6f\x9d\x8c\x89\x43\xf3\xe8\x64\xec\xad\xe0\x5e\xdf\xa7\xad\x57\xa9\xa4\xf5\x49\x0e\x5b\xdd\x84\x31\xa3\xf0\xcc\xb9\x54\xcf\x4a\x79\x6b\x80\x07\x99\x14\x19\xc5\x18\xc8\x34\xa4\xa3\x6b\x5d\xfa\x50\xce\x68\x93\x1b\x96\xe4\xd4\x1b\x0a\x5c\x48\xc5\xb0\xd1\xaa\xd0\x89\x0b\xc2\xca\xac\xc0\x32\x8b\x0b\xb3\x1f\xe5\xf3\x4e\x13\x70\xb3\x70\xb2\x08\xd7\x36\x63\x7d\x97\x89\xef\x6e\x6e\x62\xf2\x81\x97\xe5\xf5\x37\xdb\xf4\xa6\x7c\xbc\x4d\x8f\x64\xe0\x80\x9f\x22\x43\xe2\x36\x79\xfe\x66\xa9\xdb\x68\x28\x7e\x21\xa7\xb4\xb8\x68\x17\xaf\x18\xfa\xc3\x05\xf1\xeb\xde\x14\xe4\xdd\xc7\x68\x08\x9a\x3b\xe9\xfc\x98\x82\x1e\xe5\xce\xdb\x29\xd8\x73\xb9\xe3\x0d\xc9\x49\x0a\x99\xe3\xbf\x63\xe9\x62\xa7\x18\xca\x93\x5b\x84\xa7\x9a\x21\xf8\x43\xd1\xc7\x14\x43\xf1\x3e\xed\x46\x43\xd1\xac\xd4\xc3\x9c\x75\xb0\xcc\xb2\x15\x49\x19\x4d\x3e\x49\xb3\xae\x5f\xd7\x84\x10\x99\x1f\xe4\x16\xf6\x93\x47\xc1\x8b\xdd\xd7\xf8\xf9\xdd\x5f\x85\x17\xdd\x67\x6e\x8c\xdb\xe3\x10\xe0\xc4\x46\x3f\xcb\x98\x49\xe6\x69\xf1\x71\x14\x04\x24\xdc\x28\x1f\xc8\x01\x17\x01\x71\x2a\x19\x88\xd4\x9c\x61\xe9\x07\xe3\x72\x11\x8c\x4c\x26\xd1\xeb\xe5\xbe\x2c\xd1\xba\x72\x0b\xd3\xcb\x25\x35\x28\x0b\xc9\x6b\x19\xca\x27\x2b\x20\x60\x8f\x2c\x88\xe3\xe5\x11\x01\x6b\x7c\xfd\xba\xac\xf2\x0f\x6f\xea\x49\xc7\xa4\x8e\xbe\xba\x34\x69\x66\x33\xe2\x07\xa5\xd7\x52\x3c\x56\xb0\xdf\x4c\x29\xf1\xf5\x15\x49\x31\x04\x49\x5a\x23\x92\x31\x21\x41\x1e\x10\x19\x3a\x41\x8e\x30\x58\x0b\x24\x7c\x7e\xe0\x13\x5f\xd7\x64\x59\x68\x7d\x91\xfd\x64\x2d\x1d\x15\x39\xce\xe3\xc5\x51\x85\xa0\x22\xa1\x30\xc6\x97\xe7\x3d\xe7\xaf\x95\x27\x7b\x8f\xf7\xbf\xbd\xdb\x55\xba\x89\xe6\x9d\xef\xaf\x3a\x0f\x9d\x0e\x9d\x93\xff\xaa\xbf\xc3\xa3\xd3\x6a\xed\x0e\x57\x62\x14\xbf\x4c\xf5\x91\x89\x4e\x5b\x52\x9a\x11\xfb\x4c\x99\xbd\x68\x67\x79\x5a\xd0\x8e\xaa\xb5\x8a\x55\xcc\x68\x6e\xcf\x24\x66\x36\x1f\xd7\x57\x85\xd3\x2a\x15\x37\x40\x19\xd1\x54\xd3\x7f\xab\xa5\x37\x2a\xaa\x54\x5f\x6c\x0d\xf0\x80\x7a\x38\x11\x6a\x19\xab\x17\xd3\x8d\x9a\x1f\x3d\x2c\x42\xfb\xf6\x6a\x4c\x60\x9d\xcb\x1b\x12\xd5\xa0\x2c\x1c\x55\xc7\xe5\xc5\x49\xce\xb8\x26\x65\x47\x1a\xee\xd4\xa7\x22\x37\xc0\xe1\x25\x54\x89\x34\x63\xb4\x38\x57\x70\xee\xc3\x49\xb4\x6e\xe0\xca\xac\x91\x65\xc0\x58\xec\xac\xbf\x36\x16\x06\x9d\xe6\x83\xcb\x5a\x51\xd3\xa1\xf8\x9a\xc2\x70\x28\xf2\x0c\xce\x87\x97\xc0\x47\x9c\x0f\x1b\xee\x4c\xaf\x19\xe0\xce\xf7\x0a\xa9\xee\x7b\x89\x54\xf7\xbc\x09\xb8\xf3\x55\x1d\xb8\xf3\x59\x0d\xb8\xf3\x9b\x8d\x6f\xf7\x92\xf1\xed\x4c\x8f\x76\xbe\x4e\xe4\x75\xec\x47\x73\x09\xfb\xb8\xbc\x84\x1d\x96\xb6\xed\xe3\xa1\xb9\x85\x1d\x5a\xb7\xb0\x8a\x7c\x9e\x0c\xc5\xf9\x10\x4e\x87\x62\xe3\x9f\xec\x98\x1f\xce\x54\x70\x03\x7e\x0e\x97\x1a\xa6\x1b\x53\xc8\x5c\x5f\xba\x1e\xc6\x7c\xeb\x1a\xf0\x79\xcc\x52\xb2\x3b\x1d\x6a\x64\x31\x76\x0f\x3c\x0a\x07\x24\x84\x0b\xc5\x93\x76\x50\x35\xd8\xd5\x09\x43\x4e\xc8\xde\x3c\xd8\xe7\x77\x1a\xf8\x05\x89\x04\x32\x90\xb0\xd1\xa9\xc2\x05\x5e\xaa\xe0\xa4\x85\x5e\x29\x8b\xda\xf4\x62\xa4\xfa\xe1\x28\xcf\x61\x17\xf7\xd8\x0c\x8e\xeb\x7b\xac\x94\x56\x18\xfb\xfd\xb8\xda\xb2\x3a\x28\xac\xe5\xbd\xa1\x17\xac\xe6\x74\x79\x6a\x7d\x07\x1f\x61\x67\x28\x6e\x3c\x4e\x8a\xc8\x67\x22\x49\xa3\xda\xd2\x53\x8e\x4d\xbd\x95\xef\x52\x66\xf4\x6e\xc0\xc7\xc6\xae\xd6\x76\xb7\x7f\xe6\xda\xa8\xee\xf7\x41\x42\xf5\xf6\xf5\x5d\x1b\x1e\xf8\x85\x76\x27\x64\x16\x93\x0d\x82\x9b\x56\x81\x6c\x8d\x99\xa4\xb9\x43\xae\x3c\x19\x0c\xde\x5a\xac\xc1\xdf\x65\xe4\xf9\x1a\xb2\x63\x45\xef\x1f\x57\x64\x3b\x16\xbb\xc3\xfa\xed\x21\x2f\x72\x5c\xca\xbd\xf6\x13\xbf\xae\x40\xb4\x1e\x32\xd0\x4e\x69\x2b\x4d\x92\x1c\xd5\x00\x02\xaf\xeb\x4c\xdb\x3b\x43\x8b\x01\x25\xa8\x97\xa6\xb5\x5a\xdf\x1c\x97\x62\x32\xaa\x15\x7d\x15\x3e\x84\xf6\x70\x38\x97\x0b\xbf\x69\x5e\x56\xd2\x2b\xe8\xc5\xb9\xa1\x0f\x8d\x57\x7f\x8b\x79\x4a\x7c\xc4\x1a\xe2\xef\x9f\x7c\xd0\x86\x35\x9c\x37\x90\xa3\x3f\xaf\xc3\x02\xce\xe2\x95\x18\xda\x56\x09\x2a\xa5\xee\x2c\x43\xec\x96\xe4\xac\x0d\x8d\x9d\xc5\x52\x56\x7f\x27\x97\x13\x4d\x8e\x65\x05\xa9\xa9\xa3\xae\x03\x68\x7a\xf4\x4c\xa8\x53\xd5\x84\x96\xf0\xed\xe5\xdb\x32\xf2\x6a\x85\x26\x5b\x65\x42\x69\xb0\x5d\x76\xc3\xad\x6a\x35\x9b\x85\x93\xba\x0d\xd1\x9f\xdf\x80\x5f\x8e\x10\x8f\xac\x22\x75\x5f\x1d\x18\xfe\x89\x7a\xd4\x5d\xab\xef\x46\xaa\x30\x37\x15\x23\x6e\xab\xb4\x46\x38\xfb\x4b\xc0\xe0\x2d\x5d\xf3\xd9\x2c\x5f\xb1\x3b\xba\x14\x59\x4a\x07\x67\xc8\x0d\x54\xa8\x82\xe3\xac\x36\x22\x04\xb9\xa4\x38\x61\x0d\xe7\x62\x4e\x8b\x69\x68\xca\x8e\x19\x2e\x25\x31\x2e\xdd\xe9\x55\x29\xca\x25\xac\x6c\x05\x8a\xde\xa6\x4b\x58\x08\xa3\x10\x50\x7c\x15\x93\x5e\x77\x6e\x23\x2e\xbd\x55\xc4\xa2\x66\x83\xc5\x3b\x35\xab\x28\xd4\x3f\xd0\x5e\x46\xa7\x2b\xf1\xae\xbb\x04\x52\xa9\x74\x63\xae\xa0\xa5\x4c\x1f\x5b\x00\x4b\x1a\xda\xbd\xd3\xb4\x70\xf5\xcb\x79\xed\x13\xcd\x1b\xc9\x65\x78\xfb\x0b\x3c\x48\x33\x37\x98\xbb\xcd\xee\x30\xd7\x43\x6c\x8c\x02\xc7\xe1\xed\x70\x6d\xeb\x88\xdc\x62\xc4\xeb\x0a\xb4\xfe\xfb\xa8\xa7\xfc\xc3\x9f\x55\x99\x00\xc9\x94\xb4\x53\x66\x90\x56\xb5\x18\x3a\xc4\xad\xea\x78\x48\xae\xda\xd9\xc9\x3f\xe3\xfd\x2f\x83\x62\x32\xab\xbd\x61\xde\x5d\xa9\x74\xb2\xc4\x4d\xc3\x65\xe3\xbc\x58\x96\x4c\x69\xfc\x19\x59\xbb\xfd\x65\xb0\x50\xdd\x25\xb3\x67\xf9\xbc\x2a\x3f\x65\xf4\x7d\x96\x30\x09\x4b\xe6\x83\x2e\x5a\xd1\x9b\xca\xe1\xb7\x31\x83\x6a\x4b\xbb\x3e\x87\xcd\x04\xb3\x3e\x66\xf7\x57\xe3\x1c\x41\xe2\x2d\x37\x29\x68\xe4\xc1\x1a\xb6\x21\x60\x2f\x9c\x41\x9a\x3f\x0a\x08\xba\xb5\x52\x79\x69\x16\x20\x01\xe2\x86\xb0\x37\x84\x77\x43\xd8\x1f\x8a\x8f\x43\x78\x3a\x14\x45\x06\x1f\x86\x62\x94\xc1\x8f\x21\x2f\xb7\xb8\x20\x0d\x37\xe2\x27\x90\xa3\x7e\x3b\x14\xfd\x0c\x9e\x0c\x45\x94\xc1\x0b\x64\x12\x33\x78\x8d\x84\x3c\x83\xcf\x43\x31\xc9\xe0\xcd\x50\x8c\x33\xf8\x54\x97\xc1\x18\x6f\x87\x5a\x1f\x91\x9c\x1d\x28\xae\xab\x1b\xf6\x52\x5b\xd5\x78\x8c\x1b\xa7\xd4\xc7\x6e\x67\x98\x37\x74\x3b\x69\xdd\x15\x1d\x6d\xb1\x5f\x86\x24\x54\xfb\x8d\x7f\x1d\x5c\x05\xe4\x7b\xbf\xc8\x46\xf8\x93\x92\xc7\x1e\x42\x39\x74\x98\xb5\xa7\xdf\x24\xe5\x1f\xbe\xb9\xc1\x40\x11\xcb\x37\x47\xdd\xdf\xc3\xa5\x0e\xe3\x2b\xda\x83\x25\xb6\x66\x5d\xd6\x8b\x0b\x7a\x87\xf4\x62\xe8\xec\x10\x8a\xcd\x6e\xf0\x30\xec\x86\xab\xab\x6e\x7c\x10\x1e\x95\x89\xf1\xa1\xab\x9c\x3d\x92\x5c\xb1\x2b\xd5\x43\xc7\x23\xbd\xb5\xc6\x24\x22\xe4\x72\x4a\xce\x90\x74\xfa\x4a\x5d\x36\x30\x65\x49\x70\x1d\x06\x01\x50\x53\xc7\xa8\xe0\x23\x7d\xce\x95\x72\x85\x74\x23\x55\xbe\x92\x4e\x4d\x8c\x87\x3e\x09\xa6\x9e\x2c\x49\x74\xe0\x1d\x81\x24\xff\x4a\x98\xda\x4e\x14\xd6\xc0\xa7\x61\x3b\x01\x55\xe9\xd4\x08\x0d\x35\x60\xc0\xb2\xea\x6c\x41\x36\xef\x9e\x79\xed\x2f\xb8\x6d\xc1\x85\xc2\x04\x64\x0f\x91\x8f\xf9\xa2\xe5\xf1\x70\xfd\xf8\x98\x1b\x7e\x7c\xdc\x6b\xef\xd5\xce\xb9\xe5\x3b\xf1\x05\x37\xf9\x77\xcb\x5f\xd7\x7a\x70\xee\x76\xea\x85\xe9\x63\x8c\x94\xd2\xe2\xf0\xdb\xda\x61\x31\x46\x90\x6a\xd8\x19\x79\x76\x89\x75\x45\xbf\x0c\x49\x3b\xb7\x9f\xc4\x83\x70\x58\xa4\x8c\x8e\x49\x9e\x01\x17\x2a\x22\x99\x8a\xc6\x82\x71\xde\x06\x04\x6f\x75\xc0\xe5\x93\x5f\xfc\xbd\x21\x52\xa3\x09\x39\x6a\x10\xef\x86\xd2\xc7\xe9\x10\x7e\x0d\xe1\xda\x10\xde\x0f\xc5\xde\xb0\xfb\x08\x3b\x46\xda\xd9\x34\x71\xd2\x99\xd4\xac\x58\x29\xcd\xd0\x67\x33\xeb\x41\xf7\x3c\x56\x92\x1d\x1b\xd6\xe5\xa1\x16\xf0\xdb\xaf\xa1\x96\x89\xce\xf1\xfb\xcd\x92\x53\x09\xee\x66\xb3\x14\x41\x15\x19\xe9\x98\xd4\x5e\x02\xab\x2e\x6d\xec\xc2\x00\x1c\x1d\xe1\xe8\xbe\xbc\xd0\x33\xac\x83\x4b\x57\x7b\x08\xe6\x07\x55\x63\x56\x9c\x59\xe8\x6b\xec\x13\x96\xa4\x94\x9f\x30\xee\x85\x71\xd7\x94\xd6\xdc\x47\x0c\x79\x73\xc9\x7b\xb1\xd9\x98\x5f\x19\x76\x5f\x55\xfa\xaa\xd8\xb2\x13\x98\xe5\x64\x63\x95\xfc\x1a\xd6\x5c\x8e\x2d\x63\x7f\x15\xdf\xbe\xa8\x25\x5a\x37\x79\x2b\xb5\x7f\xa5\xf1\x0b\x9d\x8e\x75\x1d\x18\xea\x4c\xd7\x83\xf5\xbb\x4d\xad\xa1\x9c\x01\xca\x8f\x19\x83\xd1\xa5\x7c\x6b\x2b\xaf\xd9\xe5\x06\xc1\x37\xed\xe9\xc2\x7d\xd5\xb5\xa1\x2b\xc1\xeb\xd4\x65\x77\x0a\x6c\xfe\xac\x6e\xb7\xe8\xb2\x34\x3c\x30\x33\x43\x75\xd0\x1a\x76\xd0\xca\x62\x34\xa7\x4e\xad\xfb\x7d\x93\xe0\xf2\xaf\xdb\x9f\x9e\x4b\x87\xd0\x7a\x15\x95\x3d\xf0\xde\xac\x22\xdb\xd7\x11\xce\xe4\x6b\x43\x61\xe9\x0e\x9c\x22\xd9\x6c\x9d\xa6\x09\xc1\xd7\xd1\x5d\x53\x8a\x73\x96\x11\xe9\x70\x75\x05\x19\x6b\x2f\x12\xdc\x29\x2f\xc3\xef\x43\x78\x3e\x84\x57\x43\x81\xeb\xf1\x99\x92\x3b\x49\xe8\x7c\x29\x8b\xfa\x36\x6c\x52\xa7\x7a\x36\xd4\xfa\x4e\xbd\x83\xa3\xce\xc5\x5c\x3a\x69\x51\x97\x40\xf2\xda\xd9\x28\x14\x22\xbd\x73\x28\xb1\x59\x14\x34\xc1\xbf\x37\x2d\xf7\xca\x8d\x63\x29\x08\x6a\x93\x9f\x33\x02\x74\x20\x8e\x70\xd1\x6d\x55\xa0\x18\x03\xed\xb2\x6a\x85\x74\x6d\x86\x61\xbf\xb7\xb2\xd5\xe1\x29\x50\x35\x7f\xd3\xc9\x71\x07\x6a\xa7\x98\xbc\xf4\x14\x20\x9b\x14\xac\x47\x5e\x46\xee\xe7\x5c\x2a\x80\x78\x20\x47\x5e\xef\x95\x77\x3a\xe9\x6c\xd6\xa0\x43\x98\xba\xae\xd4\x83\xbb\x8a\x04\x3d\xb7\x48\xd0\xf3\xa5\x37\xae\x60\xd0\xce\x62\x49\x6f\x78\xc4\xb9\x65\xa5\x2e\xb5\x9c\x17\x9a\x56\x59\xc0\x6d\x0b\xa4\x8b\x14\x7e\xb0\x2f\x54\xdb\x20\x34\xfe\xc1\xe5\x6b\x6c\x22\xcd\xd9\x8c\x7c\xc1\xf6\x6c\x43\x28\x02\x2d\xec\xd4\x4d\x08\x09\x93\x56\x2e\xd3\x24\x0d\x87\x61\xec\x45\x4f\x02\x29\x3c\x4e\x52\x7d\x15\x88\xe9\x2c\xb5\xd2\xf2\x7d\x5b\x7f\xaf\xac\x17\x4b\x14\xf9\x7a\x51\x99\xdb\x61\xc8\x4c\xfa\x2f\x6a\xc5\x4b\x2b\xa0\x36\xaf\xa1\xac\xba\xac\xc9\x4c\xc1\x82\x97\x93\x92\x84\x69\x26\xf9\x08\x3c\x14\xb8\xd4\xcf\x7f\x8f\x54\x31\x18\xe1\x52\x99\x0e\x33\xe9\xa4\x89\xa9\x0c\x93\xda\x95\x58\x33\x3c\xd8\xc8\x03\xd3\xc8\x23\x1c\xa5\x2a\x8f\x61\x0f\xa1\x5b\x19\xd0\x3a\xfb\x67\x8f\x2e\x5c\xfc\x0c\x82\xc9\xee\x59\xc8\x0b\x59\xf5\x8e\xdc\x35\x6a\xb5\xd8\x22\xcd\x4c\x3e\xc4\x36\x49\x3d\x74\x3a\x8d\xe0\x57\xad\xac\x8e\xbd\xaa\x4d\xdf\x86\xe6\x1c\x63\x7d\xd8\x6d\x4a\x2b\xef\xda\xff\x64\x17\x68\xec\x6a\x5a\xcd\xf9\xbf\x35\xb9\x02\xb6\x6d\xa5\x49\xc5\xd2\xee\xb6\x04\xb0\x0c\x16\x66\x18\xd4\xc9\xb6\x52\x8b\x4b\x2d\xb5\xb8\xb4\xa6\x16\xa7\x8e\xb7\xd5\x96\x42\x33\x21\x5c\xac\x1b\x2c\x59\x43\xb3\x19\x32\x0e\x0a\x99\x1c\x3b\x3e\x88\x91\x13\xd6\xdc\x41\x03\xb7\xd0\x34\x30\xb1\x4b\x66\x37\x73\xcb\x39\xd6\x77\xd6\xb5\x65\xbf\xac\x43\xf8\x8a\x9c\xe9\xb9\xf8\x3e\xec\xe6\xe4\x6d\xfc\xe5\x72\x6a\xac\xc7\xe2\x5c\x5b\x41\xcb\xf8\xeb\xd7\x5f\x0d\x4d\xcc\x1f\xb0\x5d\x5f\x2d\x9a\xf7\x75\x09\xdb\x55\xa1\x57\x55\x42\xc7\x4f\x6a\x9c\x90\xde\x32\xed\x57\x79\x15\x92\x27\x71\xc2\x3a\x01\x56\xcd\x4a\x40\x1f\xfc\xcf\xf9\x94\x85\xaf\xd8\x56\x2b\x0b\x75\xb4\x5e\x5e\x45\x4b\x2a\xd9\x38\x29\x75\xa8\x42\xd7\x0b\xce\x05\x0e\x56\x7c\x2e\x86\xc8\xf1\x9c\xd3\xd1\x21\x3d\x17\xa7\x19\x64\xe7\xe2\x0c\x4f\x56\xe7\xe2\x18\x0f\x4a\xe7\xe2\x5d\x06\xc5\xb9\xd8\xcf\x60\x74\xde\x84\xfa\x6d\xf9\x3d\x37\x6c\xad\xf1\x3d\x6d\xbb\x9d\x56\x07\xf3\x41\xc5\x91\x75\x35\xbd\x26\x51\x4d\xe2\x3c\xcb\xe4\xbc\xaf\xc5\xd3\x36\x0c\xac\x89\xac\xe5\x9b\x77\x47\xe7\x7f\xe4\x65\xda\x6a\xc8\x7a\x96\x0c\xf2\x27\xc1\xc4\xf0\xb3\xb0\x90\xa2\x74\xf2\x4b\xe9\x0e\x24\x83\x20\x2f\x45\xea\x6d\x32\x7e\x94\x17\x4a\x01\x47\x79\x1b\x27\x9b\x7e\x29\x3f\xe8\x9f\x8b\xd1\x39\x44\x8d\x5d\x8d\x44\x5a\x77\xb1\x74\x7b\x2e\xdd\x67\x62\xc0\x08\x25\x65\x64\x66\x45\x8e\x70\xae\x50\x15\x31\x9a\x3c\xa9\x93\xac\x52\xa5\x56\x6d\x34\x38\xeb\xd5\x26\xd1\x7c\x90\x32\x8e\x60\x22\x5d\x71\x98\x98\x63\xb6\xf5\x53\xc7\x5d\x16\xf8\x7f\x28\xc8\x06\x6b\xde\x8d\x2a\x5d\xdd\x27\x77\x21\x69\x41\x6e\x37\x3a\xd1\x79\x0d\x6f\xaa\x8e\xbe\x5a\x9f\x39\x4a\x9e\x7a\x4e\x1a\x5b\xb4\x54\x03\xa9\xe7\x6a\xb6\x2b\x12\xc8\xf4\x23\x5c\x09\x8f\xc9\x35\xb3\x89\xb6\xd0\xa6\x74\xde\x2d\xbb\x77\xac\x4b\x73\x2d\x5b\xa6\x55\x11\xb8\xd5\xde\x32\x78\x05\xf2\x71\x51\xb0\x11\x54\x6e\x24\xd5\x08\xd3\x1e\x63\x0f\xa9\x4b\x20\x5b\xc6\xeb\x42\x7d\xc1\xab\x6e\x54\xf0\x78\xcb\x31\xa0\x08\x3e\x65\xcb\x60\x85\x0f\xd5\x59\x44\x49\x80\x18\x69\xba\x04\x68\x92\xe0\x4c\x2d\x6b\x8e\xb5\x08\x30\x37\xc5\x1d\x23\x3a\x67\x9d\xb7\xc0\x6f\x85\x79\x16\x44\x03\xa5\xe8\x81\xe3\x75\x82\xd4\xfe\x9c\xd3\x21\x41\x0f\x48\x8f\xee\xa4\x60\xee\x5e\x6a\x7d\x94\x93\xbe\xf5\x22\x27\xcc\x6a\x8c\x1a\x93\x7f\x08\xa9\x1b\xe2\xb7\x4e\xce\x5b\xff\x62\xea\xb5\x4e\xc2\xa1\xf6\xfa\xfa\xba\xfb\xaf\xd6\x5a\x2b\x1c\x90\xe6\x6f\x7e\x23\x93\xe5\x78\x59\x00\xad\x7e\x84\x6b\x54\x42\x53\xb3\x01\x14\xcf\x1e\xa9\x79\xac\x8b\xe0\xad\xd0\xb5\x8a\x72\xf4\x9e\x3d\x69\xa7\xb6\x2c\x7e\x5e\xeb\x12\x43\xd0\x65\xc7\x96\xfe\x2e\xcd\x1c\xa5\x73\x7d\x65\x58\xe5\x56\xab\x5d\x47\x9b\x55\xaf\x74\xbc\x08\xb6\xa6\x73\x50\xc9\x51\x5d\x3a\x47\xcb\x05\x5e\xcc\x63\x97\x60\x37\x59\x69\xb6\x95\x29\xdb\x39\x9c\xd0\x07\x19\xe9\x99\x2f\x1c\x22\x5c\x58\xf1\x90\x19\x88\xcb\xa5\xa7\x80\x94\x0d\x0a\xfb\xe2\x2b\x91\x4a\xfe\x23\x54\x7e\xa0\xc3\x05\xe7\xf4\x7d\x6f\x42\x5a\x81\x6d\x97\x8f\x53\xd6\xa6\x68\x11\x14\xdc\x51\xe4\x9e\x9e\xb9\x17\xe3\xf6\x8d\xa7\x5e\x48\xf3\x25\x4f\xd4\x2c\x08\xd4\x75\x76\x75\xb1\x0d\xda\x6c\xe3\xd3\xff\x39\x9b\x65\x95\xbb\x12\x65\xaf\xbf\x48\xe5\x03\xd6\x2b\x2f\x85\xaf\x52\x2e\x8d\x3d\x4a\x62\xdb\xb8\x1f\x06\x24\x92\x01\x5c\x9f\x7f\xbf\xf3\xf3\x25\x1e\x04\xca\xfe\xd2\xa4\xba\x8e\x5c\x42\xcb\x55\x39\xde\xd6\x2b\x73\x6b\x6e\x6f\xa6\x7a\x9a\xd5\xe9\xde\x96\x3d\x27\xa1\xbe\x1d\xeb\x49\x58\x95\x21\x30\x96\x41\xc5\xc5\x31\x0b\x09\x56\x2c\x4a\xb5\xa8\xf8\xaa\xf5\x69\x2d\xd3\x40\x5e\x3a\x49\x91\xb7\x64\x1e\x5c\xbb\x29\x69\x67\x79\xfe\x1a\x2d\xd0\xf5\x56\x9b\xd7\x38\xa3\xca\xb3\xa2\xf3\x15\xfa\xa1\x2b\xae\x36\x24\x96\xe5\x31\xfb\xb5\x38\x3a\xcb\xee\x6c\x14\x42\x8a\xe2\x5b\xf5\x40\xe1\x28\xc7\x55\xc3\x51\x11\x93\x64\x90\x4f\x5b\xb9\x61\x8a\x49\x31\x04\x8f\xd4\x7a\x11\x97\xa3\x43\xfb\x92\x22\xb0\xa1\x14\x97\x5a\x34\x56\xeb\x88\xe6\xb5\x2f\xe4\xf2\x0b\xa4\xa4\x62\x7d\x41\xe1\x71\x94\x54\x9e\x53\x54\x69\x82\x9d\x5a\xd6\xe7\x69\xd6\xae\x56\x09\xa8\xae\x2b\x0d\x9b\x26\xf9\xee\xc4\xc9\xd5\x6b\x4b\x65\xd8\xbe\x12\x37\x87\x6a\x57\xd2\x8c\x7e\x00\xfa\x14\xd3\x98\x5d\x64\xea\x30\xf7\xc9\xbc\x25\xec\x97\xce\x32\xc6\xa2\xa9\x57\xb4\x5e\xad\xc1\x52\xd4\xbb\x7d\xae\x29\x2c\xb9\x7e\x91\x3c\xc7\xe0\x5c\xe0\xf6\xec\x9f\x8b\x0f\x19\x4c\x90\x11\x7c\xba\xf3\xe2\xf5\xee\x93\xe3\xd7\x7b\x7b\xaf\x3e\xbd\x63\x1c\x8a\xf1\xb9\xf8\x91\xc1\x94\x99\xc4\xe1\xb9\x78\x92\xc1\xf9\xb9\x78\x91\xc1\x49\x23\x9f\x12\x25\xb8\xd1\xbc\x2a\x99\x15\x2d\x3a\x08\xaa\x80\x27\xf2\x68\x82\xb1\x32\x60\xb8\x8d\x26\x8e\xc4\x44\x99\x08\x5c\x9c\xdd\x93\x0a\xbb\x71\x95\xa3\x7b\x5d\x8d\x4a\x1d\x0d\x6f\x50\xfc\x7f\xd8\xbb\xba\xaf\xb6\x71\x25\xfe\xbe\x7f\x45\x36\xb7\x0b\xf1\x41\x85\xf6\xdc\x87\x7b\x8e\xb2\x2a\x87\xaf\x76\x69\x29\x50\x28\x74\x5b\xca\x65\xad\xd8\x24\x86\x24\x0a\x71\x0c\x55\x21\xff\xfb\xd5\x8c\x3e\xfd\x11\xd8\xf6\xec\xe3\x7d\x49\x6c\x59\x92\x65\x7d\x8c\x66\x46\xf3\x9b\x99\x18\x1f\xbd\x8f\xd8\x49\xd8\x97\xaf\x07\xed\xf7\x4c\x09\xd8\x10\x01\xd9\xf2\x3e\x68\x9f\xb2\x25\x30\x2d\x6a\xae\xce\x7a\x51\x72\xb5\x01\xff\x74\x6a\x47\xbf\xce\xbf\xe2\x17\x05\xe7\xd3\xfa\x83\x46\x25\xd1\xa9\xe2\x4a\x85\x79\x4b\xe2\x5a\x73\x6c\x07\x51\x6f\x71\xd5\xd0\x71\xb6\x91\x20\x88\xe8\xd9\x58\x13\xd4\xdc\xb0\x1a\x14\x8f\x29\x42\x67\x44\x25\xd2\x94\xf4\xa7\xa2\x98\xd0\xb1\x73\xd4\x63\xfa\xd8\x70\x60\xa6\x57\x3c\x13\x1d\xf6\x34\x0a\x60\x4d\x0c\x7d\xd9\xa4\xd1\xc5\xa8\x37\x93\x0a\xcf\x5b\xdd\xb1\xa5\x9b\x16\x95\x9e\x5b\xa0\xda\xb0\xed\x33\xa4\xa4\x1e\x34\x23\x6c\x76\x5e\x9b\x1b\x58\x6f\x31\x59\x10\x4c\x62\x41\xbb\x3b\x95\xa9\xff\xc2\xaf\x15\xc7\xc2\x74\x7c\x52\x13\xb3\x6a\x62\x78\x63\x43\x7c\xb8\x17\xe4\x2a\x6b\x7d\x3c\x26\x28\x2b\xa8\x5f\x1c\x9b\x48\xdf\xae\x5a\xeb\x8f\xa8\x7c\xeb\x2b\xd0\x21\x3e\x22\x6d\x02\x0d\xbb\x99\xce\xe8\x59\xe0\xfa\x96\xb6\x31\x0e\x03\x9d\xa4\x90\xd8\x12\x3d\x8c\x47\x9f\xfc\xe3\xf8\x33\xd5\xb2\x4a\x83\x10\x42\x55\x1a\xb1\x9a\x4a\x2c\x8a\x16\x79\xe3\x73\xfb\xb5\xcd\x59\xfb\xba\xf7\x4a\xf0\x2e\x85\xa4\x19\xc4\xb7\x69\xcb\x12\x41\xc5\x12\x8f\x11\xf2\x91\xce\x5a\xda\x69\xd4\x3f\xff\xc1\x8d\x93\x31\xf4\x14\x58\x35\x64\xf0\x11\x09\x51\x28\x7b\x78\xb0\x07\x05\x6e\xed\xc3\x14\x9a\x60\x08\x8e\x12\x3d\x38\xaf\x2e\xdf\xc7\x26\xe4\x0c\xe2\x0e\x9b\x66\x05\xdb\xbb\xb6\xfa\x9c\x79\x07\x72\x33\x9c\x87\x33\x33\x0f\xed\x50\xcd\xd4\x07\x5f\x83\x28\x17\x7e\x5d\x50\x4d\xf9\x23\x83\x12\x91\x31\xb2\x58\x40\xa9\xec\xe1\x80\x65\xd9\xec\xb7\xe8\x23\x6b\x13\x96\x06\x3e\x08\x95\x79\x86\xdf\x98\x1a\x76\x3e\x3b\x9b\x9e\xc3\x43\x08\xba\xad\xe6\x46\xa8\xd3\x33\x2e\x30\x1e\x6b\x6d\x48\xd1\xcc\xae\x7c\x27\xd9\x5e\x4e\xbe\x55\x77\xd8\xc0\x5d\x91\xd7\xe1\xa3\x63\x88\x17\xa0\x37\xaf\x5a\xb2\x4e\x75\x09\xc4\xdf\x74\x63\x96\x91\xc2\xc3\x64\x12\x01\x73\x58\x04\xd1\x23\x67\x24\x36\x27\x49\xc2\x32\xaa\x28\x7c\x3f\x7f\xa9\xca\x8a\x95\x97\xf3\xbb\x81\x92\x08\x3a\xe3\x33\x71\xbe\xb4\x54\xbc\xf2\xb8\x18\xa1\x23\xfa\x74\x32\x70\x74\x47\x84\x56\x6c\x76\x72\x34\xb2\x82\xdc\x50\x89\x00\x79\xf8\x5a\xb2\xd3\x9c\xec\x20\x27\x71\x21\xd9\xa7\x9c\x6c\x48\xb6\x99\x93\x63\xc9\x6e\x72\xb2\x25\xd9\xb3\x9c\x1c\x48\xf6\x25\x27\x87\x2a\x0f\xb8\xe9\xcb\x7a\xd9\x0c\x99\x90\x8f\x2a\x61\x2c\xb6\xe2\xbc\x17\x27\xda\x3d\xd6\x6b\xc9\xde\xe5\xe4\x48\xb2\x37\x39\xb9\x92\xcd\xd6\xcc\x10\x32\x2b\x51\xa2\x0f\x76\x0e\x1c\x38\xa0\xb5\x84\x55\x34\x09\xe3\x16\x02\xd8\x26\x38\xe6\x77\x93\xdb\xc4\xce\x30\x67\x20\x46\xf4\xc0\x8a\xc4\x34\x67\x99\xa1\x19\x63\xcd\x55\xab\x72\xf6\xd2\xe8\x78\x40\x27\xe1\xf4\x25\x78\xa7\x68\x01\x06\x86\xf1\x46\x2a\xc8\xea\xdc\xdb\xb7\x53\xf7\xb0\x9d\xa4\x97\x71\x31\x9c\xb5\x5d\x92\xd7\xc4\x40\x84\xc7\x1f\x2a\x63\x42\xcb\x1e\x70\x8c\x13\x3b\xf5\xbc\x55\xae\x03\x69\xe5\x95\x46\x99\x83\xaf\x4a\x6a\xa0\x04\xa8\x16\x30\x62\xe5\x1b\x58\xa1\x4d\x9c\xdb\xae\x19\xc2\x50\x7e\x70\xd9\x74\x9f\xf9\x7b\x3b\xde\x5b\x26\xdd\xf6\xa0\x1b\x76\xaf\x95\x52\x23\xc9\xa6\x56\x39\xaa\xc7\xcc\xb5\x4c\xcb\x04\x28\xeb\x44\x6e\x79\xe1\x31\x1f\x78\xab\xc1\x5d\x6f\x1a\xb9\x3c\xd3\xc8\x4a\x2c\x8e\xb2\xa5\x70\xd6\xe7\x78\xa4\x71\x60\xeb\x09\x76\x64\x6e\xe7\x9b\x62\x6d\xae\x22\x11\xc5\x4b\x4b\xfa\xb5\x4b\x4b\x43\x25\xaf\x69\xfa\xae\x6a\x05\x11\x3a\xb6\x42\xb4\x93\xdf\x64\xab\xb3\xfc\x5b\xbe\x0c\x31\xd1\x4a\xfd\x9c\xea\xb6\x0a\xac\xdd\x50\x22\xe3\xc8\xa9\x7b\x55\x62\x79\x71\x42\xd2\xb1\x24\x68\xdb\x62\x7a\x8d\x0a\x49\xcc\xa8\xd0\x58\x5d\x3a\x25\x19\x2d\x24\x31\x6f\xa0\x89\x44\x5e\x77\x64\xd4\x72\x7d\x89\x9c\xa2\x84\xbf\xe9\x35\xbd\x53\xff\x10\xff\x9a\x5e\x4b\xcf\xd8\x5d\xc0\x35\x6e\x50\x74\x43\x1a\xc5\xf3\xb1\x44\x3e\x69\x4b\xfd\x79\x4b\x23\x7a\x20\xbd\x9a\xfb\xb5\x0c\x49\xee\x91\x34\x71\x8e\x94\x40\x21\xbb\x6f\xf3\x45\xea\xc2\xb7\x4a\xb6\x50\x65\x1a\x7c\x3e\x65\x70\x32\x03\x38\x31\x6f\x8b\x00\xa4\x20\x6d\xde\xc4\x8c\x32\xb8\x9b\xba\x70\x4d\xb6\x74\x6a\x4a\xaf\xff\x69\xa4\xbb\x9b\x22\x05\xa3\x80\xc8\xea\x7c\xe9\x4c\x38\xbb\xf6\x70\x13\xac\x37\xc0\x10\xec\x6d\xc9\x54\xb3\x77\x25\x4b\x05\xd9\x93\x6c\xed\xeb\xb3\xaf\xf7\x9d\xb3\xff\x7e\x9d\x9f\xaf\x44\x5f\xe7\x6b\x7d\x72\x2a\x9d\x01\x98\xfb\xd6\x76\x44\xde\x97\x92\x2f\xb3\xbe\x4a\x3b\xd1\x69\xdb\x12\xc2\xee\x64\x90\xeb\x93\x54\x04\xfe\xbb\x64\x67\x6d\x4b\x85\xda\x5a\xe2\xd3\x86\xda\x6d\x52\x72\x9b\x66\x5d\xd3\xa8\x8b\x5b\xf3\x38\x84\x98\xc3\xbd\x41\x28\xc0\x65\x00\xfa\x6f\x9f\x93\x4d\xc9\x72\x41\x6e\x24\xeb\x89\x6e\x4f\xfc\x0d\xeb\x7a\xd4\x96\xd8\x43\x3d\xab\xd4\x24\x0d\x26\xaf\x0b\xbc\x90\x59\x28\x6e\xc5\xd4\x35\x0c\x81\x16\x56\xfd\xf2\xc7\x43\x71\xfe\xed\xc8\x99\x7a\x24\x9f\xc9\xaa\xc5\xdd\x94\x08\x3d\xa7\x0c\x8c\x55\x3b\x90\x00\x6a\x34\x81\x1f\xf4\xa4\xd2\xb7\xde\x0c\x8b\x3a\x84\x63\x60\x4d\xf5\x04\x13\x0f\x0f\xb0\x5d\x77\x12\x55\x25\x11\xab\x06\x61\xc5\xa6\xa8\x99\x4f\xce\xda\xed\x73\xe6\x52\x91\xf3\x46\xc2\x0e\x2e\xeb\x96\x96\x4c\x64\xc1\xdf\x7f\xcb\xd7\x5e\xb5\xdc\xe0\x2b\xb6\x12\x94\xb6\x26\x67\xeb\xaf\x74\xf8\x97\x23\x31\xdd\x56\xa6\x9f\xf3\x34\x1d\xb7\x92\x2c\x57\x4b\x51\xad\xcc\x54\xbb\x22\x41\x10\x6f\x8f\x15\xdd\x5e\x17\xf9\xd9\xde\x2a\x9a\xfb\x5a\x4b\x59\xd1\x8b\xee\x87\xcc\x26\x42\x9b\xe2\x4c\x5d\x19\x7e\xa6\xa7\x9e\xe8\xcf\xb4\x6a\xb2\xf1\xd2\x52\x88\xd5\x1d\x37\x44\x01\x4d\xbd\x0d\x82\x40\x35\x50\xa3\x8f\x29\x11\x65\x6c\x43\xb1\x26\x11\x81\x00\xd8\x2c\x5b\x37\x9e\xa3\xa9\x70\x81\xa8\x5f\x20\x93\x82\x8f\x7f\x7d\xe1\x45\x8e\x5c\x15\xaa\x33\xe2\xe9\x74\xd4\xba\x2b\x66\xe8\x48\x4b\x65\x58\xef\xdc\x42\xb9\x7b\xcd\x8c\xd1\x6a\x44\x1f\x62\x45\x54\x17\x4b\x86\x0d\x15\x2d\x50\x04\xf9\xc5\x39\x29\x2b\x1b\x5d\x40\xca\xf5\xcb\xf5\x58\x9f\x24\xb8\x98\xa4\xb4\x83\xad\xb3\xbe\x76\x0d\x0d\x81\x37\x47\x14\x72\x87\xe7\xf0\x6e\xdf\x71\xd0\x72\x00\x14\xc3\xbd\x13\x4f\xc1\x6d\x8f\xf6\x2c\x79\x23\xa1\x31\xa5\x96\x04\x6f\x8f\xa8\x7e\xad\xe2\x96\xfb\x9a\x96\x69\x9f\xfd\x31\x6c\x8f\x69\x18\x97\x6e\x53\x76\x62\x72\x9f\x0e\x29\x1e\x5b\x69\x03\x5c\x38\x04\x46\xb3\x8c\x09\xb1\x04\x82\x0a\x82\xe7\x88\x74\xa0\xcf\x13\x1f\x1e\x82\x59\x89\x29\x44\x7d\x46\x76\x29\xd1\x62\x30\x87\x6c\xc1\x2d\xd1\x7b\xd4\x40\x33\x4f\x73\x72\xaf\xa7\x0c\x1d\x10\x37\x7f\xe9\x8c\xb8\xb9\x45\x87\xc4\xf6\x06\x05\x7f\xa4\xe0\xa0\xe6\xd0\x36\x24\x21\xbd\x3c\xdf\x4d\x72\x5a\xac\xea\x0b\xf5\x59\x97\x28\x0e\xbb\x28\x96\x39\xeb\x93\x78\x4e\x3e\x48\x36\x11\xe4\x4b\x23\x4f\xe8\x34\x86\x30\xea\xdd\xb4\x1b\x61\x00\xf5\x8b\xa1\x62\xf4\x1c\xf0\xe5\x43\x91\x4e\x15\xa3\x72\xd6\xbe\x00\xf7\x49\xe8\xa5\x03\xb7\x7e\xbd\x37\x38\xe3\x30\xd0\x60\x5b\x9b\x9f\x39\xf9\x43\xb2\x5b\x41\xde\x49\xd6\x17\xe4\x8d\x64\x52\x90\xcf\x92\x71\x41\xde\x4a\x76\x27\xc8\x9f\x9e\xcc\xdb\xcd\x11\x70\x89\x9c\x5d\x0b\x92\xf2\x26\x3d\x9a\x05\x59\x47\xf3\x6e\xca\x43\x3a\x6c\x50\x4b\x47\x7d\x0d\x47\xda\xef\x3b\x9c\xd1\x76\xbf\x01\xf8\xb3\xdb\xaf\xc0\x89\xf6\xfa\x65\x68\xce\x69\x3f\x40\xf8\xbc\xef\x6b\x96\xe0\x0f\x69\xc9\xec\x3b\x69\x11\x28\x6f\xa4\xb7\xdb\xff\x2c\x2d\x59\x7d\x2b\xbd\xe9\xfa\x8c\x6b\x12\x3a\xe6\x2c\xe5\x24\xe3\x75\x94\x25\x7e\x42\xda\x2b\x81\x2a\x3c\x4a\xad\x37\xef\x66\x8d\xdf\x9a\x3c\x0d\x42\x42\xb7\x32\x4f\x80\x65\x20\x8f\xdd\xaa\x30\x10\x73\x05\x35\xa3\x3a\x09\xc1\x32\xbe\x75\x6a\xf7\xf9\x25\xa8\x7e\x71\x4c\xe1\xf6\xef\xbf\x3e\x7f\x6e\x8e\xe2\xb0\xe4\x4a\xfb\xf9\xf3\x57\xed\x45\x76\xfd\x16\xd9\x02\x08\x69\x3d\x83\xf6\xbd\xe3\x96\xc0\x0e\x1e\x5d\x1c\x98\x8d\x69\xca\x59\xc6\x49\xce\x1b\xa7\xb5\xef\x5d\xd1\xb3\xec\xa4\x59\x55\x2c\xad\x6d\x49\x01\x82\xc6\x2d\x44\x06\x0b\xc1\xdd\xd5\xea\xa8\x24\x3c\x01\x58\xac\x1c\xdf\x8f\x2b\x09\xf8\x3d\x99\xc7\xfd\x86\x93\x60\x0c\x28\x62\x44\x87\x5e\x94\x29\x00\x98\x7e\xab\x8d\xb2\x33\xea\xfc\x3d\xf0\xe5\xf2\x4a\xb6\xb2\xdc\x5e\x0e\x50\x3d\x2e\x64\x3a\x73\x98\x9d\x1a\x18\x26\x80\xbc\x20\x18\x26\x75\xd6\x6c\x16\x25\x33\xad\x60\x61\x6a\x7d\x12\xc2\x61\xd4\x46\xb8\x2a\xb3\x74\x98\x80\x31\x78\x76\x1e\xad\x97\x6e\x03\xe3\x32\x5a\x7a\xc0\x50\xbf\x72\xfe\x98\xe3\x73\x34\x2b\x0d\xeb\xd2\x82\xfd\xab\x97\x0d\x8a\xb6\x80\x5d\x70\x7d\xd5\x03\x37\x23\x70\x90\x8b\x7a\x29\x38\x99\xbd\xbf\xc7\xea\xda\x2b\x9d\x6c\xbd\xdd\x6a\xaf\x64\x14\x20\xf1\xed\xf9\x5c\x71\x17\xbd\x61\xac\xbd\x46\x80\x42\x2a\x6e\xcd\xb2\x91\x8e\x2c\xd0\xcd\x9b\x16\xec\xff\x21\x95\x4f\x41\x2a\x9f\xc6\x46\xd6\xa7\x55\x13\x3c\xf2\x27\xc1\x99\xf5\xc3\x89\x45\x3d\xe1\x18\xf1\xd9\x93\x58\xc4\x80\xfd\xd6\x60\x9d\x1f\x8a\x5a\xbf\x10\xff\xd4\x08\x70\x32\x21\xb5\xbc\xe1\x8f\x5b\x09\x8e\xa8\x98\xd0\xc3\x3f\x2e\x30\xfc\x7c\xe4\x78\xc1\x59\xce\x49\xdc\xb0\xf1\x05\x2b\x28\x24\x76\xf1\xbc\x1b\xf3\x9a\x03\x95\xd7\xb1\xed\x69\x75\xe5\x3a\x46\x5d\x7b\xc1\x3a\x7e\x7c\x2f\x2a\x3b\xc8\x08\x5e\x0e\x0e\x11\x4c\x63\x0b\xce\x62\x4e\x06\x9c\xed\x08\xd2\xe3\x6c\x43\x90\x21\x67\xc7\x8a\xb5\xe4\x6c\x4b\x90\x84\xb3\x8f\x82\x4c\x38\x3b\x52\x1c\x60\xc3\x07\x39\x7f\x09\x23\xde\x20\x25\xde\x25\x16\x5d\xfd\x2d\xd1\x6b\xfc\x3a\x71\x8b\x77\x27\x69\x58\x4d\x17\x49\x65\x8d\x6e\x24\xe5\x65\x72\x1c\x32\x01\x5b\x09\x09\xfc\x8a\xd3\x43\xbc\xc5\x27\x1f\x13\x3f\xb9\x5f\x27\x9a\x9b\x19\x70\x3b\x07\x7a\xdc\xa9\x34\x76\x41\xed\x79\x94\x5e\x2e\x50\x7f\xbb\x13\x91\x34\xb1\x39\xf3\x2e\x6a\x47\x03\x8d\xa9\x76\x67\xa0\x55\x06\x6e\x75\x0c\x79\x30\x36\xdc\x2e\x81\x84\x07\xaa\x90\x9f\x79\x77\xea\x0e\x90\x83\xf7\x93\x52\x44\x87\x89\xe1\xbf\x6e\x39\xe9\x73\x22\x39\x1b\x71\xc2\x39\xbb\x12\xe4\x8e\xb3\xb3\xb6\x9d\x75\x65\xf9\xff\x11\x35\x82\xd6\x1e\x9c\x93\x6f\xdc\x71\xaf\xa8\xf9\x51\xac\xeb\x75\x95\x69\xb5\x77\x2d\x25\xe6\x69\xff\x99\x68\x7a\x6d\x5f\x74\x36\x3b\x07\xd1\xa1\x49\x24\x4c\xad\xc4\x39\xeb\x41\x77\x96\x01\xdf\x9d\x48\x47\x90\xc9\x6c\x80\x3d\x25\x15\x7b\x00\xa4\x2a\xef\x57\x23\x42\x3f\xf1\xd5\x6a\xd7\xf5\xe9\xb9\xda\x73\xc3\x5b\xcc\x41\x21\x07\x16\xd0\x4f\x7d\x59\xea\x9a\x93\x63\x73\x2c\xb7\xaf\x5f\x66\xef\xea\x6f\x4d\x43\xb9\xc0\x56\xf1\x27\x9c\xcd\xc1\xab\xbc\xcf\x99\x48\x57\xe4\x13\x7c\x8b\x1b\xfd\xce\x98\xfc\x8d\xcf\xb0\x28\xec\xc2\xd6\x97\xda\xd9\xb9\x3b\x16\xa8\xe8\x53\x74\x33\xa7\x3e\x0c\x80\x1d\x15\xc5\xd9\x65\xac\x14\x0c\x17\x8c\xbb\x75\x98\xfc\x86\xa1\x0a\x8d\x7b\x34\x38\xc6\xed\x0e\x3e\xdc\x2d\xc9\xe6\x64\x87\xb3\x7d\x41\x2e\x38\xeb\x0f\x3a\x0e\x49\x18\x91\x0d\xce\xb6\x05\x39\xd6\xc9\x1a\x3c\x18\x91\x2d\x73\x0b\xe8\xc2\x88\x1c\x98\x3b\x0d\x32\x8c\xc8\x21\x67\x7b\x82\x7c\xe4\xec\x54\x90\xd7\xbc\x49\x8e\x3a\xe2\xec\x44\x90\x2b\xce\x3e\x09\xb2\xcf\xd9\x77\x41\xb6\x7d\x3e\xbb\x3a\xc0\xd1\xab\xae\xd9\xa2\x16\x23\xb2\xc7\xd9\xa6\x20\xa7\x41\x66\x54\x52\x81\x5e\x8e\xb3\x1b\x41\x4e\x38\xa8\x59\x13\xfa\x26\xb6\x0e\xab\xe8\xb7\xc2\x52\xb6\x9d\x42\x53\xb6\x8d\xc2\x51\xb6\xfd\xa2\x81\xb2\x6d\x17\x15\xca\xb6\x6b\x13\xac\xd8\xbb\xa7\x13\x0e\xb5\x70\x7c\x5a\xe8\xf8\x1c\xdf\x0b\xd4\xd2\x6e\x16\x44\x63\x4f\xe9\xb3\xc2\x28\x66\xbf\x14\x44\x68\x9d\x3e\x2d\x06\xf6\xf2\x40\x4d\x3d\x3a\x50\xb7\x97\x8a\xac\xa8\xbf\x31\x4d\xe0\x57\x25\x4e\x06\x64\x22\x26\x54\xaa\x3f\xb5\x86\x28\x1f\x58\x4a\x75\x3c\x30\x9a\x5c\xce\xf5\x85\x59\x78\xf4\xda\xdc\x3b\x8c\xfa\x0e\x24\xe0\x18\xd2\x0b\x8e\x5a\xdf\x0d\xf5\x07\xbd\x48\x8f\xd5\x85\x1a\x38\xba\xa5\xfe\x71\xc8\xe8\x81\xba\x2a\x38\x1c\xbb\x29\xca\xcc\xfd\xa6\xf5\x11\x08\x63\xbf\xaf\xb6\x87\x23\xb8\x82\xe8\xd9\xf4\x0a\xaf\x66\xa3\x21\x5c\x39\x42\xb6\x0f\xd7\xba\xfa\x5d\x6e\x35\x87\x7b\xbc\x14\xa3\xe7\x3d\x9f\x93\x4f\xbc\x66\xf0\x6d\xe7\xff\xc3\xc3\x07\x81\x94\x69\xbd\x72\xda\x0e\xc7\x42\xed\x8b\xbc\x98\xa8\x29\x91\xe9\x1d\xdd\x62\x4e\x74\x6a\x09\x25\xa0\x93\x18\x9a\x63\x2e\x00\x91\x66\xf6\xb8\xd3\xe4\x9d\x42\x54\x39\x70\x13\xf3\x9d\xb3\x2f\x82\x6c\x72\xf6\x59\x90\x9b\x46\xb1\x8d\x80\xb3\x40\xf7\x3e\x1d\x86\xcf\xa1\x07\xa2\xf5\x4e\xe6\x0e\xa1\x8c\xf3\x97\xac\x6e\x4f\x03\x56\x43\xc4\xc0\x0b\x40\x13\xd9\x19\xb3\x9b\x44\xeb\x7a\xbe\xc9\x26\xb7\x08\x36\xef\x4d\xe2\xf4\x4d\xe3\x08\x8c\x06\x69\xa6\xb6\x0f\xde\xe8\xe4\x5a\x7d\x5e\xcb\xd2\x37\x71\xa9\x36\x18\x0d\x50\xd9\x94\x56\x4b\x4e\x61\x05\xdd\x72\xdc\x07\xfb\x6a\xd5\x6c\xef\x6c\x9e\xbc\xa1\x25\xb0\x81\x56\xe2\x81\xbe\x1e\x1f\x5e\x1c\x1e\x1d\xbc\xdf\x3d\xde\x39\x5e\x94\x4b\xc7\x06\xa2\x06\xdb\xb5\xc9\xe7\x76\x8f\xdf\x1d\x5f\x0a\x9b\x7c\xa3\x92\xd1\xe3\xab\x4d\x78\x77\x39\x27\x87\xda\x13\xa4\x4d\x12\xc5\x9c\xe4\xb7\x7d\x7b\x3b\x8d\xe7\x46\xa7\x65\x12\xc6\x2a\xe1\x74\xe7\xe8\x78\xf7\x60\xdf\x26\xb5\x5f\xac\xfe\x67\xf5\xdf\x4a\x7c\xb7\xfa\xf6\xa6\x46\xde\xcf\xe7\x5e\x8f\xb5\x30\x87\xdf\x52\x17\xe5\x30\x2e\x66\x1b\x9e\x0e\x54\xcb\xf4\x2e\xbc\xa8\x6c\x49\xb1\xdf\x94\xe9\x7d\x3c\xf7\xca\xbc\x05\x95\x04\x47\x00\x0b\xb2\xcc\xc9\x75\xdc\x41\xb6\x22\x22\xb7\x21\xc3\x97\x75\x4e\xd4\xb2\x8c\xcb\xa9\xab\xc1\x69\x0e\x53\xa5\xd4\x33\xab\x33\x64\x61\x3e\xad\x6f\xe7\x81\xdf\x7c\x3c\x7e\xd7\x6a\xe0\x6d\x54\x06\x88\xbb\x5f\x19\x7b\xc6\x1f\x1e\x4c\xaa\xe6\xab\x82\x57\xa9\xfb\x51\x39\x4b\xa0\x85\x2e\x3f\xa8\x80\x9a\x2d\x1b\xf5\x78\x26\xb3\x0d\x3e\x9e\x69\x14\x4f\x9e\xa8\x05\x01\x2d\x26\x4f\x93\x03\x5d\xeb\x99\xb0\x74\x5b\xf3\xd4\x8a\x15\xd4\xe5\xfb\xdd\x59\x6b\x28\xc4\x75\xde\x1a\x66\xd7\x69\x4b\x8a\x62\x79\x0a\x86\x23\xc0\xef\x21\x26\x73\x26\xd0\x25\xaa\xf5\x03\x7a\x95\xe3\x31\xa5\x12\xff\x41\x62\x6a\x71\x55\x9b\xda\x41\x56\x5b\x9f\x55\xc1\xe1\x50\x55\xac\xcd\x7e\xa1\x08\xa8\x05\xd0\x42\x2c\x6d\x2d\x0f\xd3\x7e\xdc\x93\x2d\x5e\x64\xc3\x24\x5f\xc6\x33\xcf\x29\x94\x47\x0b\x61\x08\x5d\x51\x80\xef\x55\xeb\x55\x94\xae\xad\x25\xa2\xe7\x4e\xd1\xaf\xc0\xfa\xa1\xbf\x06\x9b\x49\x3e\x5b\xd3\x55\x3d\xd7\x55\xa1\xd6\xc6\xb9\x25\x9d\x8e\xb4\xb9\x79\x5b\xe3\x54\x3e\x70\x35\x63\x2c\x85\xfc\xc0\xe7\x51\xf7\x97\xb5\xb5\x7f\xb5\x72\x51\x4c\x7b\xa9\x31\xb1\x39\x39\xda\x63\x16\xdf\x36\xca\xc6\xea\xfb\x60\x40\x7e\xf9\x5f\x00\x00\x00\xff\xff\x93\x40\xbf\x2b\x59\x85\x02\x00")
+var _webJsRactiveMinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\xbd\x79\x97\xdb\xb6\x92\x28\xfe\xff\xfb\x14\x12\x6f\x86\x26\x22\x34\xbb\x95\xcc\x7d\xbf\x37\x54\x60\x8e\xe3\x25\x71\xe2\xc4\x8e\x97\x38\x89\xac\xdb\x61\x8b\x50\x0b\xb1\x04\xca\x00\xd4\x4b\x24\xcd\x67\xff\x9d\x02\xb1\x71\x51\xdb\x99\xc9\x9c\xe3\xe3\x16\xb1\x16\x0a\x85\x42\x55\xa1\x50\x18\x2e\xb6\x7c\xae\x58\xc5\x13\x85\x29\xda\x45\xd5\xc5\x1f\x74\xae\x22\x42\xd4\xed\x86\x56\x8b\x01\xbd\xd9\x54\x42\xc9\x38\x8e\xb6\xbc\xa4\x0b\xc6\x69\x19\x0d\x6d\xe6\xba\x2a\xb7\x2b\x9a\xd7\x7f\x52\x53\x94\xd0\x04\x65\x91\x6d\xd6\xb7\x54\xd7\x8e\xe3\xfa\x6f\x5a\xac\xcb\xbc\xfe\x99\x50\x94\xa9\xf4\x65\x31\x57\xec\x8a\x42\xed\x43\xa2\x96\x4c\x62\x07\x19\xda\x45\x5b\x49\x07\x52\x09\x36\x57\xd1\xc4\xa6\x0f\x54\xa2\xd0\xee\xaa\x10\x03\x3a\x61\x8b\x44\xc5\x71\x74\x51\x55\x2b\x5a\x70\x0f\xa1\x42\x82\xaa\xad\xe0\x7d\xd0\x5f\x33\x5e\x56\xd7\x71\x5c\x56\xf3\xed\x9a\x72\x15\xc7\x2a\x57\x29\xaf\x4a\xfa\xfa\x76\x43\x73\x95\x45\xd0\x23\xbf\xf4\x43\x50\x71\x9c\x50\x62\xcb\xa7\x97\x54\x3d\x5e\x51\xf8\xf9\xf5\xed\xd3\x32\x51\x08\x0f\xa9\x6f\x2e\xfd\xb0\xa5\xe2\xf6\x15\x5d\xd1\xb9\xaa\x44\xb3\x66\x23\x2b\x51\x08\x61\x1a\xc7\xd4\xf5\x8d\x72\x9a\xa9\xe9\xd9\x2c\x8e\xe1\xff\x00\xa4\xe9\xd9\x2c\xe3\xdb\xd5\x4a\xff\x77\x70\x88\xa0\x80\x88\x7a\xa0\x03\x40\xc3\x96\xbf\xe7\xd5\x75\x88\x86\x74\x53\x08\xca\xd5\x8f\x55\x49\xe3\xf8\xf8\x57\x2a\xe8\xba\xba\xa2\x0f\x97\x6c\xa5\xc7\xa3\x7c\x1f\x3c\xe8\x03\x7a\x1f\x12\x40\x58\xaa\xaa\x57\x1a\x49\x80\xae\xc8\x97\x66\x50\x7a\x51\x89\x44\x4f\x0f\x29\xc4\xa5\x1e\xb8\x4c\x57\x94\x5f\xaa\x25\xe6\xe4\x81\x10\xc5\x6d\x42\xef\x8f\x73\x7a\x32\xce\xce\x10\x66\x64\x3c\xa1\xf7\xd9\x84\x8d\x46\x88\x4f\xd9\xc9\x78\xe6\xab\x4d\xd9\x6c\x62\x5b\x13\x58\x4e\x24\xe1\xa9\x5c\xb2\x85\x4a\xd0\x04\x41\x86\x18\x30\x3e\x90\xe8\x79\x91\xce\x8b\xd5\x2a\x91\x58\xa0\x38\x4e\xd4\x54\xcc\x88\x9c\x8a\x19\x9a\x58\xec\x78\x10\xc5\xdf\x0f\xa2\x45\x4f\xba\xa8\xc4\xe3\x62\xbe\x4c\x6c\x67\x09\xf5\x5d\x71\x00\x95\xa2\x21\x4d\x97\x85\x7c\x7e\xcd\x5f\x88\x6a\x43\x85\xba\x4d\x38\xda\xef\x75\xa6\xda\xef\x13\x35\xe5\x33\x42\xa7\x7c\x86\x0e\x8d\x69\x90\x7e\x1a\xa2\x69\xbd\x58\x07\x1a\x95\xb3\x88\x10\xf2\xc2\x8c\x5f\x21\x5f\xa3\x0a\x26\xee\x75\x91\x2a\x2a\x55\xe2\xcb\x05\x05\x8b\x9a\x05\xd8\x41\x6c\x57\x2b\x42\x60\x92\xcd\x2f\x9a\x0f\xcf\xb2\x0e\x7f\x50\xfb\x7d\x97\x67\xe4\xc3\x71\xa6\xa0\x8a\x6f\x7c\xeb\xa1\x18\x32\xf9\x63\xf1\x63\xb2\x29\x84\xa4\x4f\x56\x55\x01\xcb\x18\xc5\x31\x93\x4f\x18\x67\x0a\x68\xd9\x57\x5b\x06\xc0\x03\x65\xdb\x21\x3f\xd7\x7f\x8e\x8e\x79\xde\x18\x8a\x4a\x05\xdd\xac\x8a\x39\x4d\x4e\xff\x4d\x9e\x5e\x86\x8c\xc5\x94\xa0\x96\x9a\x0e\x41\x23\xab\xbf\x9f\x40\xd4\x52\x54\xd7\x03\x45\x00\x3e\x8e\x30\xa7\xd7\x83\xc7\x42\x54\xa2\x01\xfc\x22\x41\xbb\x9f\x2e\xd2\x47\x8f\xbf\x7e\xf3\x4d\x1c\x3f\x28\xd2\x62\xb3\x59\xdd\x26\x30\x0d\xd8\xb5\x16\x94\x2f\xff\x17\xe0\xb4\x10\xbe\x02\xa2\xe0\x41\x67\x9b\xff\xc5\xce\x5e\x16\x53\x35\xdb\xef\x13\xfd\x97\x0c\xcf\x6c\xef\x41\xf7\xeb\x06\x6e\xca\x8f\xa1\xe6\xaa\x51\x7c\xf3\xb1\xe2\x97\x40\x36\x98\xd7\x9b\x0a\x23\xb7\xe6\xd3\xae\x6b\x96\xb3\xa9\x9a\x4d\xf9\xac\xc5\x7e\x6d\x39\x3d\x09\x13\x3a\x41\x3b\xb6\x48\xf4\x4a\xa6\x53\x35\x33\x3b\x50\xbd\x49\xd1\x94\xc9\x6a\x55\x28\x5a\xda\x64\x68\x6a\x42\x09\x35\x5c\xf8\xe0\x9b\xbd\x08\x68\xbf\x4b\xb2\x61\xc9\x6b\xb7\x11\x62\x8e\x19\x16\x58\xe2\x4a\xf3\x4b\x4a\x54\x2a\x37\x2b\xa6\x92\x28\x8d\x10\x4e\x38\x79\x5b\x4c\xa9\x99\xab\x19\xda\xef\x13\x4e\x6e\x12\x9b\x80\x10\x96\x64\x3a\xc3\x8c\xb8\xee\x00\xfd\xae\xc7\x3c\xfa\x3c\xca\x80\x27\x1d\xb0\x20\xdc\x54\x9a\x88\x93\x93\x09\xaa\x08\x9f\x8a\x59\xba\x2e\x36\x09\x43\xe9\x1f\x15\xe3\x75\x8f\xb2\xcd\xe4\x2a\xe8\x54\xa6\x9b\xad\x5c\x26\x15\xc2\x72\x5a\xcd\xc8\xf0\xcc\xf1\x67\xe9\x07\x75\xd3\x33\x28\x5c\xe0\x2d\x5e\x92\x28\x02\x5c\x0e\xdf\x02\x9d\xd4\xd4\xc8\xc8\x74\x36\x59\x1a\x90\xbe\x52\x13\xb4\x1c\x91\xb1\x41\x81\x66\x34\x4f\xb9\x4a\x96\xf8\x0b\x84\x45\x30\x38\x3b\xb4\x68\x0c\x8c\x44\x1d\xb0\x24\x67\x13\x7a\x9f\xc8\x89\x1c\x91\x71\xdd\x32\x27\xd2\x6d\x72\xc9\x17\x68\x62\xc7\x0d\x9d\x70\x12\x9d\x45\x23\xae\xfb\xd9\x02\xe6\x0a\x87\x16\x5c\x91\xb3\x49\x71\xbf\x9a\x54\xa3\x11\xda\xd6\xe3\x15\x09\x9f\x56\x33\x84\x26\x6c\x2a\x67\x64\x7b\xd0\xf0\x13\x76\x30\x63\xd7\x9f\x7e\xfc\xef\x6b\xaa\xc2\xac\xc6\x82\x20\x6a\x4a\x67\x7a\xdc\x62\xbf\x1f\x8a\x94\x7e\xd8\x16\x2b\xf9\x5c\xbc\x52\x85\x50\xf2\x2d\x53\xcb\x84\xa1\x38\xee\xcd\xe0\xc8\x92\x1b\x34\x42\x44\x2e\x1c\x4b\xe4\x98\xa1\x8c\xe1\xe1\x99\xef\xf9\xb1\xc3\x3c\x90\xcf\x8a\xcd\x29\x0c\xdc\xa0\x8a\x69\x54\x4d\xc7\xb3\x38\xde\x26\x14\xe5\x23\x9a\x05\x6c\xfe\x3c\xa0\x59\xa0\x6c\x42\x54\xae\xb2\xe4\x43\xd1\xa6\x03\x05\x74\xf0\x01\x46\x4c\x80\x0b\x7e\x56\xc0\x26\x80\x75\x42\xb0\x20\x1f\x00\x0e\xd0\xce\x7d\xf3\xc4\x2f\x4f\x2c\x70\x65\xc9\x86\xa7\x4c\xbe\xac\x2a\x95\x57\x64\x3a\x4b\xe7\x15\x9f\x17\x2a\xa9\xf7\x88\xf4\x3d\xbd\x95\x89\x4a\xaf\x18\xbd\x5e\x57\x25\x5d\xa5\x65\xa1\x0a\x84\x8f\xe5\xae\x8b\xcd\x86\xf1\x4b\x79\xbc\xc4\xbc\x5a\x6f\xb6\xaa\x00\x80\x24\x42\x59\xc2\x48\x98\x7b\x2d\x8a\xcd\x86\x96\x53\x9e\x4a\x25\x66\x58\x10\x96\x33\x90\x14\x13\x94\x85\xc5\x20\x85\x23\x5c\x11\x91\x87\xfd\x08\xa4\x79\x0b\xc2\x55\x1c\x57\x5d\x31\x42\xa1\x5d\x74\x2e\x6a\x49\x59\xcf\x43\x1c\x43\x9d\xfd\x9e\xd6\x14\xc6\xeb\xa5\x07\xdb\x3a\xc2\xf4\xe0\xf1\x04\x14\xca\x08\x05\x98\x42\x86\x50\x91\xe9\x6f\xc5\x6c\x22\x08\xf3\xe2\x54\xf4\x39\xb4\x2c\xf2\x8a\x54\xa9\xa0\xe5\x56\xd3\xc8\x74\x86\xb2\x6a\x7a\x36\x23\x84\xfc\x56\xe4\xfa\xd7\x79\x22\x50\x06\x85\x60\xd1\xbf\x4a\x04\x72\x8b\xb8\xf2\x13\xf8\x2a\xa0\x07\x37\x0a\xbf\x33\x53\x0b\x6f\xc0\xcc\x1e\x06\x55\x40\x20\xb7\x84\xfa\x67\x81\xa3\xf4\xb3\x71\x84\x1a\x82\xe6\x73\xcb\x7b\xd9\x22\xb1\xf2\xba\x13\x7b\xe9\x7e\x3f\xdc\x26\x1c\xa1\x7a\xdf\xf5\xdb\x6d\xf4\x75\x51\x0e\xdc\x0e\x10\xa1\x89\xc6\x14\xb9\xaa\x58\x39\x38\xc3\xc2\xfc\x80\xc5\x76\xfa\xee\xf3\xd3\x5a\x66\xa2\x6e\x0d\x09\xb2\x3b\x60\x20\xcd\xf3\xe4\x61\x42\x11\x42\xbd\xf2\x5e\xdd\x64\x7b\xce\x29\x82\x56\x87\xdb\x84\x75\xa1\xfa\xbe\x40\x13\x31\xd5\x93\x34\x23\x6c\xc4\x41\xf2\x4b\x25\x55\x89\xd0\x95\xa0\xb1\xba\x09\x7c\x47\x7d\x83\x38\x5d\x8f\xe2\x11\x1b\x85\xbb\xf7\x8b\x86\x5c\xf4\x6d\x51\x2b\x5a\x0a\xd7\xe3\x05\x89\x2d\x1f\x67\x23\x1a\xd4\x78\x0d\xb3\x01\xa5\x52\x7a\x45\xb9\x22\x0a\xeb\x8f\x35\x55\xcb\xaa\x24\x51\xc5\xa3\x91\x49\x2a\xe9\x46\xd0\x79\xa1\x28\x51\xdb\x06\x23\x7b\x52\x77\x0a\xf8\xe0\x44\xa5\x8c\x97\xf4\xe6\xf9\x22\xa1\x68\x72\x32\x26\x84\x70\x50\x23\x34\xf5\x86\xfd\xbe\x34\x2b\xdf\x48\x1a\x9c\x9c\x61\x18\x7f\xcd\x7b\x27\xec\x3e\x9f\xf0\xd1\x08\x81\xda\x07\x82\x32\xa1\x66\x6e\x86\x67\x06\x03\xc3\xb1\x6f\xeb\x8f\x00\x00\x40\xe4\x10\x84\xe8\xfd\x7e\x28\xfd\x9c\x0e\xc7\x90\x61\xdb\x1f\x12\xe2\xf6\x44\x97\x0f\x4b\x88\x7b\x10\x38\x6c\x7b\xa6\x7f\x28\x0f\xa2\xba\x2b\x6b\x7e\x04\x3c\xf5\x47\x4f\xd5\x5d\xb5\x32\x9f\xaa\x59\xe6\xe6\x40\xe5\xd3\x59\x16\x88\xfc\x8f\xc2\x05\x31\xb5\x00\x9c\x8c\x03\x14\x3f\xbd\x03\xc5\x43\x83\x62\x58\xf6\x7a\x2d\x8f\x03\x34\x3f\x6b\x8a\x73\xd3\x19\x6e\x0f\x11\xf6\x7b\xa2\xa6\xdc\x69\x37\x01\xb7\xff\x19\x6a\x4b\xaa\x5e\xb3\x35\xad\xb6\x2a\x51\xf8\x2c\x68\xfb\x87\x06\xb1\xd9\xe4\x24\x98\xd4\x09\x74\xe6\x18\x0f\xac\x9a\x80\x0f\xbc\x69\xed\x7f\x30\x41\x14\xd0\x13\xd0\x3d\x28\xea\x66\x45\x3f\x18\x6c\x44\xb5\x66\x92\xde\x93\x83\xc5\x76\xb5\x60\xab\x15\x2c\xee\xc1\xb2\xe0\xe5\x8a\x8a\xc1\xbc\xe0\xbc\x52\x03\x03\x8d\x5a\xd2\x81\x2c\xd6\xd4\x56\x8a\xf4\x0a\xa3\x03\xc6\xa5\x2a\xf8\x5c\x33\x8f\x2d\xa2\xa9\x5a\x52\xae\xb7\xc8\x09\x5d\x49\x3a\x00\xe2\xa1\x5e\xe3\xf1\x8c\x26\x8e\xbd\xc5\xc3\xa7\xea\x21\xe9\x9a\x3b\x25\x6e\x77\x82\xd4\x0d\x1e\xe6\x85\x9a\x2f\x13\xe9\x70\xa3\xa7\x9e\x25\x12\x1d\x80\x8b\xb9\x86\x1c\x81\x88\x1a\x05\x5a\xfc\x99\x14\x5e\x80\xa1\x68\x57\xed\xf7\x49\x05\xb2\xb2\x47\x17\x3a\xe0\x6d\x43\xc8\x71\x65\x40\x2b\x47\x87\x89\x86\xa5\x56\x00\x29\x34\x89\x3c\x40\x30\xc0\xca\xd0\xb1\x86\x48\xb3\x06\xdd\x03\x3a\x1c\x60\x24\x83\xd6\x34\xbd\xb5\x0c\x18\x20\x64\x8e\x48\x08\x70\x46\x1c\xfd\xd7\x29\x6c\x26\x34\x95\xdb\x0b\xa9\x44\x72\x86\xbf\x40\x79\xc2\xc8\x79\x62\x93\x6a\xa9\x0a\xe1\x0f\x89\xc2\x2c\x5d\x30\x21\xd5\xf7\xf4\x16\x73\x84\xb2\x28\xd5\x75\xa7\x67\x33\xa8\xf2\x67\x52\x00\x2b\xc7\x14\x61\x16\xc7\x3d\xc5\x19\xf9\x5a\xb3\x64\x8a\x40\x71\x61\x1e\xc2\x3f\x5b\xcb\x1f\x46\x34\x38\xd3\xf6\x8b\xce\x8e\x01\x16\x1e\x05\x44\xa9\x04\xc2\x06\x00\x8b\x0e\x90\x6d\x80\x4a\x38\xc8\x34\xc1\x2e\x9a\x4d\x67\x38\x4a\xd3\xf6\x48\xbf\x44\x46\x21\xe8\xcd\xab\x95\x84\xa1\x15\x17\x3b\xbc\xfc\xde\xc3\x6a\xbb\x2a\x07\x35\xc9\xca\x6a\x75\x45\x07\x82\x2e\xa8\xa0\x7c\x4e\x07\x27\x03\x55\x55\x83\x75\xc1\x6f\x07\xba\xf1\xc1\x46\xd0\x05\xbb\xa1\xf2\x1e\xc8\xa7\x9b\x6a\x93\x20\x4c\x5d\x7f\x80\xe2\x2f\x91\x95\x32\xb9\xe5\xb5\xf8\xdc\x8a\x0b\x20\xa9\xbb\xfc\xf3\x44\xe5\x6a\x44\xdd\xb6\x7b\xfa\xaf\x77\xe9\xbb\xd3\x53\x40\x06\xca\xda\xc9\xf9\x29\x8e\xa2\x50\x3f\xfb\xba\xb5\x68\xad\xd0\x6e\x75\x1f\x10\xd2\x2c\x3e\xa9\x96\x9b\x25\xa1\x6e\x1e\x27\x5c\xb3\x54\x50\x2e\xe6\x15\x57\xf4\x46\x61\x4e\xb8\xd1\x8c\x30\xd8\xd0\x0a\x20\xf5\xaa\xb3\xab\x0a\x2d\x30\x25\x76\x5d\xba\x85\x53\xed\xf7\x3d\xcb\xa9\x42\x71\x2c\x41\x3d\xab\xfc\x96\x5e\x63\x42\xef\xbd\x6e\x13\xfd\x2c\x14\xfb\xb0\xd4\x56\x39\x03\x4c\x1c\x0f\x95\x53\xe5\x2c\x5c\xc0\xcd\x40\x38\xac\x38\xd8\xf8\xea\x82\x4f\x44\x71\x09\x7c\x08\x4b\x72\x0e\xcb\x69\x0b\x54\x6a\xf2\xb0\xc4\x1c\x0f\xcf\x10\xca\x1b\xf2\x25\xc8\x53\x12\xef\x2a\xc1\x2e\x19\x77\x3d\x06\x90\xbc\xa7\xb7\x9b\x42\x2d\xb3\xed\x01\x61\x8a\x32\xb6\xdf\x17\x79\x4d\xd4\x59\xa3\xa1\x5a\x04\xa8\x73\xa0\xa4\x9f\xa6\x0f\xe1\x92\x18\xda\x2e\xf6\x7b\x3f\xa6\xfd\xbe\x39\x7a\x0a\xf2\x3a\x85\x85\xab\xd5\xc8\x60\x10\x14\xdf\x31\x6a\x18\x5d\x1c\x07\x0d\x69\x15\x91\x7e\xca\xe8\xf8\x21\x24\xac\xcf\xc2\xcd\x24\xd2\xcb\x69\xbf\x07\x7e\x3d\x50\x5a\xa0\x77\x1f\xa1\x74\xee\x12\xad\x50\xef\xdb\xfb\x09\xb6\x2e\x95\x2a\x5a\x88\xb2\xba\xe6\x49\xd0\xd5\x6f\x75\xd6\x96\x5f\x30\x5e\x86\x19\xdf\xda\x0c\x41\x79\x49\x45\x98\xf5\x7d\x9d\x35\x87\xbd\x63\x15\x66\x7c\x53\x67\x94\x54\x81\x3d\x30\xc8\xf8\x35\xcc\x00\xdb\xab\x0c\x73\xbf\x83\xdc\x21\x08\xbf\x45\x79\x0b\xf3\x52\x6d\x95\xa8\xac\x95\xc5\x25\x68\x43\xad\xa0\x1c\x0c\x86\xa6\xc8\xc3\x6a\xbd\x59\x51\x45\x75\x52\x3d\x1b\xb9\xc3\x72\x49\xe7\x42\x1b\xac\x9f\xeb\xb2\x89\x42\x59\x0b\x04\xdc\x6e\x07\x38\x3f\x06\x91\xa2\xd5\xbf\xaa\x54\xb1\x0a\xfa\xef\x59\x67\x80\x8f\xd5\xea\xa2\x98\xbf\x8f\x63\xff\x3b\x41\xd8\xaf\xa2\x2e\x64\xaf\xa1\xdd\x04\x85\x73\xff\x4b\x20\x33\x68\xf6\x32\x99\x6f\x53\xa3\x05\x59\x90\x26\x88\x92\x30\xb5\x66\x82\x9c\xd0\x80\xf4\xb4\xf5\xe7\xe1\xb2\xe0\x97\x7a\xa4\x3c\x8e\x37\x5b\xe0\x3d\x54\x6b\x95\x9a\x1b\x29\x05\xe0\x91\xb3\x89\xfa\x6a\xbe\xd5\x55\x5d\x07\x0a\x4c\x02\x36\x71\xaa\x66\xe9\x76\x53\x16\x8a\x26\x75\xc5\x56\x69\x72\xe6\x5b\x51\x85\x7c\xdf\x69\x45\x27\x4e\xd5\x2c\x71\xdc\xa6\x55\x92\x9c\xe1\xee\x28\xf3\x5f\x12\x64\x04\x45\x8f\x1e\x80\x79\xe7\x70\x83\x99\x86\x47\x91\x72\x6b\x2a\x4d\x94\x96\xe3\x48\x09\x62\x39\xa6\xa9\x59\x60\x79\xb9\xb5\x32\xa1\xc2\x63\x94\x25\x9c\x6c\xb7\x09\x4d\x45\x55\x29\x4c\x53\x41\x17\x98\xb6\x96\x33\xac\xe5\x24\x61\xfb\xbd\xb6\xb7\x20\x54\x6f\x25\x3b\xa6\xe8\x3a\xa3\xe1\xc2\xc5\xcd\xb6\xd1\x84\xc5\x31\x73\x5a\x12\x0d\xad\x9d\x14\xcc\xbf\x3b\x95\x42\x23\xa9\xd9\xea\x12\x65\x81\x0c\x0a\x72\xd5\x90\x34\x42\x83\x10\x9e\xe3\x15\x5e\xe0\x12\x6f\xf0\x1a\x5f\xc1\x5e\xc3\xb4\x55\xa1\xda\x36\x54\x67\x83\x68\x41\xd4\x01\xe1\xce\x5e\xa1\xd0\x8e\x13\xba\xdf\xef\x40\x7c\xe2\x29\x2d\x24\xe3\x97\x78\x49\x78\x5a\x6e\x85\xe6\x27\xb8\x00\x1b\xcf\x1c\xce\x1e\x14\xdd\xe0\x95\xde\xa6\xea\x55\x82\x93\xf9\x7e\xbf\x02\xf4\x94\xa0\x1c\xd6\x25\x08\xa8\xf2\xd8\x17\xaa\xbf\x17\xa4\x07\x26\x97\xa4\x07\x58\x6a\xb3\xc8\xe1\x50\x93\x96\xde\xaa\x14\x52\x6d\x13\x8a\x84\xee\x5c\xbf\x1b\xb2\x80\xfd\x85\x93\x5d\x0d\x79\xb6\xc5\x16\xee\x6c\x79\xc0\xf3\x38\x4e\x0c\x54\x1b\x84\x42\xa0\x56\xf9\x26\x7b\x52\xe0\xa2\x9e\x4c\xa6\x6a\x9d\x50\x62\x35\x95\x33\x10\xac\x1c\x81\x5e\xdd\xd1\xf4\x55\xdd\x74\xcf\xc8\xc0\xb6\x5b\x82\x39\x62\x05\x24\x00\xe2\x6f\xdf\x9c\xac\x6c\xa9\x2b\x0f\x98\xc0\x6b\x62\xc1\xd1\x98\xd3\xff\x5d\x21\x0b\xea\x1a\x61\x96\x4a\x55\x6d\x48\x8f\x6e\xa1\x26\x8a\x14\x35\x17\x98\x20\x90\xe7\xf4\xaf\x75\x1c\xaf\xcd\xef\x03\x76\x86\x37\x4e\xb8\x9e\x76\x8f\x14\x07\xaa\x4f\x6a\xe0\x4c\xe0\xca\x81\x56\x13\x65\x0f\x28\xa6\xf5\x2a\xe8\xd0\xe6\x0e\x98\xea\x13\x94\xb6\x16\xd5\x54\x1f\x17\x5a\xf3\x82\x1e\x38\xe8\x98\x71\x9c\x6c\x7b\x2c\x0a\x08\xdf\x6e\xd3\xe2\xa2\x12\xb0\xdd\x2b\x84\x8b\x64\x8b\x39\xc8\xd9\x0e\xe0\x38\xf6\xbf\x13\x96\xaa\x0a\xe1\xf7\x5b\x30\x5d\x19\x32\x8f\xe3\x44\x90\x1e\x0e\x6e\xf3\x73\xfb\x23\x53\xe6\xc7\xd4\xa6\xcc\x70\x8f\xe6\x03\xa2\x9a\xd0\xe4\xae\x6d\xca\x4e\xb5\x65\x6e\x2d\xe5\xff\x7e\x76\x96\xf9\x4f\x5c\xe9\x05\x7b\xbd\x4d\x76\x96\x8d\x50\xbc\x10\xd5\x3a\xdb\x62\x55\x65\x1c\x03\x57\xca\x94\xa7\x3b\x89\x0d\x40\x02\x33\xae\xa8\xd8\x80\xd8\x52\x89\x8c\xa5\xe1\x27\x06\xaa\xcc\x60\x6a\xe8\x06\x5b\x04\x64\x1e\x17\x87\x1a\x75\x65\x09\xb6\x68\x95\x9e\x17\x9c\xad\x75\xfb\xde\x42\x5d\x05\x2c\x48\xa8\xc0\xfe\x0e\x66\x90\x7a\xeb\xa4\x65\xde\xf8\xca\x34\xcd\xa6\x74\x15\xc7\x4f\xed\xcf\xf4\xdc\xda\xed\xce\xad\x8e\x29\xcf\xcf\xb5\xe5\x04\x59\xfb\x49\xdd\x16\xd1\x5f\x0b\xc3\x72\x4d\x72\x82\xf0\xb9\xd9\xab\x7a\xaa\x04\x20\x4a\x15\xac\x2a\xd3\x77\xde\x6c\x71\x01\x62\x8d\x42\xad\x43\x8c\x4a\x85\x32\xa1\x69\x80\xd7\xc0\x9c\x33\xf9\xd0\x4a\x78\x3f\xc1\xd1\x75\x3e\xd4\xe9\xd2\x9c\x60\x83\x3c\xc2\x8b\xb5\xd6\xce\xc3\xf4\xac\x3e\x4e\xcf\x17\x45\x52\xff\xc2\x8d\x6a\x35\x08\x98\xe7\x35\x92\x34\xc6\xeb\x72\xd0\xa0\x45\x13\xc2\x74\xbf\xd7\x05\xce\xd7\xc5\x7b\xfa\x88\x01\xef\x43\x20\x37\x77\x76\xc2\x22\xf0\x08\xa8\x47\x90\x50\xd2\x96\x49\x51\x4e\xd3\xea\x9a\x53\x91\x05\x72\xab\x5e\x6f\xc1\xf7\xd1\x3a\xed\x2e\xb7\xbe\x4b\xcc\xcd\x81\x03\x6c\xb3\x9c\x68\x60\x40\xa3\xb1\xb6\x59\x60\xce\x05\x58\x7d\xdd\x2a\xf7\xcd\x2c\xdb\xfc\x00\xce\x33\x4c\xb1\xf7\x45\x22\xf0\xce\x22\x2d\xdb\x5d\x15\xab\x2d\x18\xdd\xf1\x8a\x5d\x51\xfb\xc9\x0f\xb8\x33\x4b\x36\x8f\x1d\xf0\x39\x98\x30\xed\xf7\xab\xed\xe1\x80\x00\xed\x75\xcb\xb5\xd0\x6a\x33\x1f\x6e\x0f\xf8\x5c\xaf\x36\x93\xa0\x0e\xf8\x5c\x56\xc2\x7d\xbf\x86\x02\x6e\x26\x6c\xea\x13\x48\xad\xbd\x07\x6c\xd2\x4b\x48\x2a\xc3\x42\xc3\x31\xbe\x16\x4c\x15\x17\x2b\x9a\x0d\xcf\x00\x08\x81\x32\xe1\xb1\x30\x0f\x69\x10\xb3\x49\x8b\x8c\x13\x6a\xf6\x67\x4b\x96\x80\x00\x18\x29\xa3\x12\x27\x8c\x70\x38\x3c\xc8\xb5\x27\x05\xe4\xe4\x0c\x56\xbe\x36\x7a\x01\xab\x23\x7f\x6c\x2d\xc3\x1e\x0e\xeb\x12\x78\x38\x86\x3d\x04\x7e\xea\xed\x51\xcf\x93\x42\x98\x4f\xa3\xf3\x68\xa4\x66\x84\x21\xdc\x5d\x3b\x0f\xe0\xb0\x19\x33\x84\x19\x42\xd9\x34\xb0\xc2\xad\xfa\xc1\xef\x83\xba\x31\x51\xff\x7d\xf0\xcf\xfe\x7b\xe0\xbb\xde\xa5\x1d\x88\x1f\xc4\xa2\xc3\x40\x1a\xb5\x5d\xd5\xc6\x91\x75\xd9\xa9\x04\xfa\x7b\xc1\x38\x15\x79\xf3\x33\x5c\x72\xc7\x72\x1c\x2f\x69\xd5\xcd\x5a\x15\x60\x34\x0f\xed\x57\x97\xa3\x6d\x3a\x40\x59\x75\xc8\xff\xee\x80\xd3\x4e\x6e\xc1\x52\x67\x67\x61\x51\x80\xe2\x85\x4e\xee\x82\xb0\x0e\x29\xc2\x6f\x83\xee\xe4\x61\xfa\xc5\x2c\xdf\x1d\xb2\xf0\x1b\xa4\x57\x8a\x76\xbc\xb6\xb7\xe7\xe6\x6f\x0d\x06\xcd\xcc\x27\xd9\xc1\x77\x46\xf1\x39\xaf\x1e\x88\x4b\x58\x4c\xe6\x08\x03\x14\xf4\xf4\x9a\xad\xca\x79\x21\xca\x1f\xc0\xac\x07\xba\xce\xe4\x0a\x00\x61\xd8\x54\xc7\x3c\x2d\xc4\xa5\x04\xfa\x09\x2c\x79\x57\x7d\x32\x49\x1f\xd0\xff\x3e\x03\x07\x8f\xf0\x1b\x83\x5d\x46\x73\xbf\x37\xdb\x94\xf2\x0f\x5b\xba\x05\xc9\x9f\xc3\xe6\x6f\x2d\xe9\x13\x79\x9f\x9c\x4d\xe4\xc9\x09\x12\x44\xa5\xe7\x60\x9b\x9a\xd2\xa9\x9c\xcd\x8c\x61\xe7\x12\xba\x17\xba\xfb\x38\x2e\xb4\x3d\xf6\xcd\x36\x2d\xa9\x69\x2c\xd4\x1a\x0b\x6d\x3c\xab\xe2\x38\x98\xa8\x1a\xe6\x6d\xc3\x04\x03\x48\x1a\x45\x69\x34\xa2\xee\xd0\xfb\x64\x3c\x9b\x80\x64\xb5\xed\xc1\x12\xe6\x9a\x0b\xb8\xfa\x44\xa1\x03\x20\xc5\xf4\x6b\x70\x13\x60\xec\xb2\x8d\x31\x2d\xf2\x60\x49\x86\xe3\x09\x8f\xe3\x21\x4f\xeb\xf9\x89\x63\x50\x98\xb8\x3b\x99\x64\xc8\xd8\xe7\xea\xd5\xed\x5c\x9b\x2a\x72\x86\x0b\x8f\xb0\xfa\xf8\x98\x8c\x11\x9d\x56\x33\xe3\xbb\x00\xeb\x95\x10\x32\x1c\xc7\x71\x22\xc3\x93\xf3\x66\x7f\xb2\x16\xc0\xd2\xda\xcc\x52\x68\x0d\x41\xa4\x1b\xa1\xa7\xff\x11\x5d\x14\xdb\x95\x8a\xe3\x76\x4a\x82\xb0\xd0\xd2\x2a\xf8\x24\x15\x97\x5a\x12\x8a\xe3\x4e\x52\x82\x10\x1e\x06\xb6\x94\x5b\xbf\x07\x92\x1d\x10\x56\xa6\x1d\x4e\xd2\x8d\xa8\x54\x05\x12\x61\x3d\x50\x6d\x0d\x48\x1c\xd9\xe0\x31\x3a\x4c\xde\x3a\xa6\x16\x9a\xa7\x2e\x7c\x83\x76\x78\x4a\x4b\xc3\x70\x46\x4c\x6b\xe6\xdf\x14\x82\x15\xfe\xb0\x45\xc1\x31\x56\x63\x35\x83\xb1\x0e\xaa\x78\x7b\xdd\xd6\x76\x0b\xf6\x3e\x6c\xd8\xca\x91\xad\xbf\x16\x0d\x7a\xba\x04\x58\x3c\xcc\xd7\x20\x7c\x73\x4d\x98\xc3\x26\xdb\xac\xad\x45\xb4\xec\xd8\x78\xa3\xd7\x4b\x3a\x78\xf0\xe2\xe9\x60\x59\xc8\xc1\x5c\xdb\x25\xca\xc1\xc9\xe0\xb6\xda\x0e\xd6\x5b\xa9\x06\x80\xaf\xc1\xef\x46\x6c\x4c\x8d\xd1\x49\x15\xe2\x92\xaa\x29\x1e\x14\x7c\xbe\xac\xc4\x0c\xfd\x3e\x50\xd5\xa0\xce\x84\xaa\x62\x60\x3c\x29\xdd\x59\x46\x3a\x78\x0e\x46\x63\x0b\x06\x14\x82\xd3\x90\x01\xf8\x54\xba\xd6\x19\x97\x14\xc4\xdb\xdf\x53\x73\x14\x42\xf4\x41\x23\x27\x20\xb0\xec\xf7\x9a\xae\x87\xb4\x3b\x84\x5f\x2d\xb0\x72\x43\xe7\x6c\x71\x3b\x28\x06\x57\xc5\x8a\x95\x83\x1a\x4e\x80\xad\x6e\x7a\xc0\xb8\xaa\x22\x34\xb1\x5d\x7d\x4d\x17\x95\x91\x66\xbd\x8c\xcb\xcd\x86\x45\x57\x84\xe2\x84\xf6\x0b\xcd\xfb\xfd\xb1\x9c\xc0\x28\xd1\x27\x58\xeb\x41\xdc\xd4\x6a\x5b\x40\x6d\x37\x9a\xda\x7e\xb2\xe2\x35\xc2\x7d\xfb\x64\xf4\x79\xd4\x73\xcc\xab\xd0\x0e\x6a\x7b\x59\x35\xf4\x1f\x7b\xdf\x36\x58\x74\x69\x99\xf5\x13\x16\x96\xda\x95\x03\xce\x27\xf3\x44\x90\xf5\x36\x95\xe0\xe4\xa1\x01\x07\xa6\x8d\x5b\xb5\xd6\x54\x5c\x02\xbf\x65\xc0\x8c\x10\x5e\x03\x0f\x2e\x61\x31\xa3\xcc\x08\xdc\x06\x94\x38\x0e\x14\x5a\x0f\xe9\xe3\x7e\xc1\x85\x13\x38\xe4\x86\x03\x97\xda\xa6\xd1\x19\x3e\x45\x3b\x66\xcf\xab\xcd\xd9\x34\x7c\xa0\x43\xe3\xfc\xe5\xbc\xcd\x25\xc1\x70\x33\x71\xea\x2d\x66\x04\x2c\xd8\x4b\x0c\xe7\x03\x2f\x0a\xa5\xa8\xe0\x30\x6a\xd0\x07\xe5\xd2\x55\xc5\xa1\xde\xbb\xa9\x8b\x3d\xbf\x90\x54\x5c\x51\x61\x74\x07\x01\xdb\x0d\xe8\x05\x75\xe5\x6f\xb6\xbe\xb2\x48\xc1\xb7\x30\x01\xed\x90\xa9\x66\x5b\x82\x5e\x32\xa9\xa8\x48\x28\x98\x94\xf2\xa8\xdd\x76\x04\x4e\x8f\xf6\x37\xb4\xa4\x6d\xb2\xb0\xdf\x01\xcb\x2f\x88\x95\x9f\x1d\x56\x0c\x1e\x27\x70\xec\x26\xf3\x84\x93\x3b\x21\xb7\x67\xb5\x02\xe1\x3b\xcb\x19\x73\x1a\xc7\xe3\x66\xc1\x2d\x6f\x0c\xa0\x0b\x3e\x42\xd9\x5d\xe5\xc3\xb1\x99\x63\x3e\x47\xa7\xe9\xb9\xcb\xad\x31\x5c\x20\x5c\xf8\x89\x7d\xd0\x26\x70\x3d\xb1\x6c\x91\x2c\xe1\x9c\x71\xc7\x09\xc5\x82\x28\x0c\xb6\x42\xbd\xc5\x29\x30\x64\x09\x24\xba\xbe\x40\x9a\xc7\x0a\xd0\x9b\x98\x5f\xbe\xa9\xe9\xdc\x74\xe2\x0c\x51\x3d\x08\x87\xd6\x27\xcc\xee\x9b\x88\xd5\x86\x1f\x6f\x8e\x3f\x1c\x39\x58\x75\x67\x51\x00\x2b\x05\xe7\x06\x12\x45\xb8\x5a\x9a\xdd\x41\xf7\x0b\xdc\x50\x7a\x3f\xba\x41\x84\x30\xb8\x2d\x58\x93\xac\x6d\xa1\x55\xc9\x3a\xa5\xe1\xca\x95\x9c\x54\x60\x87\x55\x04\x7c\xde\xb0\x02\x93\x8c\x1e\x6a\xb3\xe2\xff\x68\x90\xb6\xf0\xe0\x55\x73\x66\x48\x0b\x9d\x41\xa3\xd4\xca\x15\x00\x82\xdb\x9b\x41\xad\x70\xed\xe2\x1d\x2c\x9a\x6c\x38\xc6\x25\x9c\x40\x66\x9a\x8b\xe8\x9f\x07\x0b\xed\x20\x58\xee\x0f\x9b\xcc\x44\xf7\x0d\x38\x54\x88\x37\xb0\xa8\x4f\x82\xe6\x4b\x94\x2e\xd8\x0a\x96\xdf\x6a\x89\xfa\x78\x8c\x11\x04\x38\x16\x93\x84\x13\x66\x04\x47\x35\x03\x89\x86\x6a\x3e\x11\xb8\x3b\xe0\x93\xf1\x90\x10\x01\x00\x9a\xf5\x22\xf0\x18\xa1\xcc\x57\x83\x2d\xe2\x60\x4e\xf3\x1d\x4d\x02\x88\x75\x09\x54\x52\xe0\x8d\x41\xca\x54\x39\x45\x1c\x12\xfd\x38\x9f\x37\xc7\x09\xd4\xef\xc6\xda\x6f\x5c\x36\xcb\x80\x1d\xb1\xe7\x02\xcb\xb7\x4a\x1c\x74\x5f\xf1\x84\x61\x35\x65\xb3\x8f\x11\x85\xb5\x77\x9a\xa3\x5f\xb0\x77\x06\x44\x61\x80\x17\x1f\x45\xbe\xe8\x45\x7e\xe2\xf1\xb0\xdf\x07\x1f\xc1\x5e\x0b\xdb\x1e\xee\x81\xcd\xf4\x2c\xd3\x6a\xb1\xd0\xb8\x0a\x49\xf4\x45\x88\x3e\x43\xa0\xfc\xd3\x68\x93\xfb\xe1\x39\x02\xe4\xbe\xe5\xd7\x7d\x6c\xc9\x78\x8e\x4e\xb5\x07\x25\x23\x4f\x6c\x19\x3c\x64\x8e\x07\x80\x27\x2e\xd0\x84\x70\x2e\x30\xb8\x20\x76\xc1\x9d\x7c\x71\xc2\xa6\xe3\x19\x96\xe4\x87\x42\x2d\xd3\x35\xe3\x89\xc0\x6c\x7a\x36\x03\xb6\x29\x47\x3a\x6f\x0b\x0a\xce\xfd\xed\x64\x0b\x87\x3c\xcb\x7a\x2a\xb7\xb5\x11\x7f\x52\xb5\xd2\x4f\xc6\x26\x43\xb4\x2b\x8c\xbc\x1b\xd7\x32\x55\xd5\x16\x8c\x82\x4f\x44\xb5\x26\x67\x43\x42\x8a\x1c\xfa\xcc\x1c\x80\x4b\x3f\x6e\x37\xa6\x9d\xbc\x66\xe0\x60\x42\xd1\x6e\x5e\x48\x1a\xc1\xb4\xcf\x69\x94\x41\x77\xd6\xf9\x81\x70\x7d\xbf\x04\xfe\xff\xea\x0c\xd4\x1e\x70\xe9\xb3\xad\x8e\xea\x21\x16\x37\x3a\x19\x9f\xd8\x74\x14\xb8\xbf\x7e\x31\x41\x86\x58\x03\x1d\x64\x3a\x9e\x79\xf4\x70\x40\x89\xad\x7a\x02\x2d\x21\xcc\x27\x35\x44\x95\x50\x51\xa6\x7f\x82\x4a\x22\x24\x8d\x32\xdb\x06\xcc\x82\xce\xd9\x54\x1b\x97\x6a\x9b\xc9\x03\xff\x27\x3c\x9e\x65\xd3\x33\x7c\x36\x33\xc5\xb7\x72\x69\xcb\xbb\x52\xf8\xcc\x29\x5d\x1c\x99\xbe\xc1\xdf\xc8\x15\x3c\x73\x10\xe6\xe3\xcc\x36\xb5\xe5\xed\x42\x61\x33\x01\x11\xbf\x54\x49\x9b\xd0\x1c\x0f\x90\xfa\x57\xaa\x44\xc1\x25\x83\xd2\xf2\x31\x07\xcb\x57\x59\x4b\x6f\xbc\x7a\xca\x95\x00\xaf\x85\x23\xc5\x88\x36\x4e\xf5\x08\x7f\x90\x02\x34\xb1\x5d\xd1\xd7\x85\x7c\x9f\x74\x57\xdc\xc3\x65\x2d\xcb\x56\xe8\xe0\xc5\xc5\x4f\xd0\x47\x40\x98\x37\x7e\x52\x5a\xf7\x68\xa9\x1e\x68\x00\x36\x56\x3e\x28\x56\x5a\x04\xf2\x1a\x85\x15\x7f\x87\x83\x87\x61\x35\x7f\x50\x3e\xd0\x1e\x1e\xa1\x66\x61\x4c\xba\x74\xe5\x54\x0c\xfd\x5d\x2b\x35\x81\x12\x10\xa4\x12\x8e\x6b\xcd\x0a\x1c\x6c\x79\x19\xc7\x86\x7b\x14\xe4\x88\x3a\x30\x29\xe2\xb8\x30\xb3\x1b\xc7\x7f\xa8\xa4\x40\x20\x64\x72\x4e\xc5\xb7\xaf\x7f\x78\x46\xa2\xc8\x9e\x00\xe9\x66\xe7\x52\x3e\x2d\xe3\xf8\xc1\xd2\xb0\x1d\x84\xc1\xac\x96\x88\x63\xcd\xa3\x5c\x78\x6e\x8d\xb2\x23\xa5\xc8\x14\xb2\x67\x98\xe7\x7d\x9a\x4f\x6b\x52\xe0\x54\x1a\x5a\xaa\x47\x68\xae\x61\xf5\x96\x43\x81\x98\x7f\x8c\x80\x24\x6e\x1f\xb8\x39\x0a\x79\xee\x29\x24\xd0\x58\xfe\x30\x67\xb1\x66\xef\xf4\x6b\xc3\xab\x3e\x3f\x05\xc5\x7f\x34\x2c\xdc\xee\x41\xdc\xb9\x8b\x03\xe1\x3a\x33\x06\x03\xb1\xe7\xbf\x82\x6d\x1a\xb6\xb4\xfd\xde\x70\x0f\xfd\xe5\x18\x88\x6f\xfc\x51\xb8\x3f\xe0\x40\x7b\x62\xe4\xde\x14\x3c\x3e\x4e\x0c\xb2\x4f\xe6\x52\xfe\x17\x89\x76\xf7\x46\x74\x74\xef\x10\xcd\xee\xb5\x5c\xfc\xed\x15\x82\xd6\x36\x60\x4c\xf5\xb3\x09\x27\x3f\x2e\x53\x7a\x43\xe7\x60\xae\xb7\xc6\xfa\x9d\x54\x22\x03\x96\x85\x2f\x0a\x49\x33\xcd\xca\xd6\x55\xc9\x16\x8c\x0a\x99\xf1\xe9\x17\x33\x7b\x38\x4b\xa8\xde\x4b\x9f\x29\xf0\xed\x71\x63\xde\xc2\x98\x0b\x22\x1d\x3e\x04\xa1\xd3\xed\x0c\x17\xd3\xed\x8c\x88\x14\x1a\x1d\xb1\x91\x48\x5d\x9b\xfb\x3d\x08\x9e\x41\x85\x0a\x4a\xb2\x51\x34\x88\x46\xf0\x13\x9b\xdd\xa1\x30\x2e\x5b\x20\x86\x56\xfe\xb7\xc3\xe0\xd2\xa4\xe1\x41\x84\xc0\xe4\xfc\x74\x59\x7b\x2f\x2b\x14\xb8\x52\x3f\x5d\x82\xd3\xbf\xff\xfe\x63\x09\x6e\x5c\xee\xf3\xe5\xd2\xef\xc2\xe1\x1c\xd8\x3e\x1e\x99\x36\x29\x02\xdf\x7e\xf0\xb1\x30\x82\x05\x36\x82\xc5\x53\x05\x04\xc0\xf5\x6f\x61\xaf\x83\x00\x44\x30\x1c\xec\xfb\xa5\x60\x42\x0f\x09\xf0\x69\xc3\x64\x9b\x2a\xc1\xd6\x79\xfd\x27\x09\xe1\x3d\xfd\xd7\x3b\x39\x3a\x6d\xc0\x7c\xfa\x4e\x8e\x3e\xd3\x49\xbe\xb5\x67\xcd\xd6\xa4\x0a\x8e\x1a\x7e\xd6\x79\xfa\x0a\xe3\xbc\xe2\x52\x89\x2d\x1c\xae\x0c\x09\xa9\xbd\xf1\xe3\xb8\x57\x5d\xd8\xef\x9d\x68\xe7\x5d\x06\xf3\x55\x12\x01\x41\x0e\xaa\x0d\x14\xaf\xcd\x21\x17\x14\x38\xa5\xb9\xad\x56\x89\x41\xe1\x8e\xf8\xf1\xe0\xf7\x68\xa4\x46\xd1\xef\x03\x26\xb5\x7f\x9f\xb6\x97\x44\x28\x5b\x27\xd1\xd3\xc5\x40\x6e\x37\x9b\x15\xa3\x25\x36\xcd\xc9\x54\xb7\x2d\x97\xda\x1f\x10\x9a\x1d\x6c\x56\x05\xe3\x83\xef\x8a\xab\xe2\xd5\x5c\xb0\x8d\xb2\xdd\x9c\x0c\xb6\x70\x24\x3a\x28\x06\xbc\xe2\x27\x2f\x9e\x7f\xf7\x7c\x50\x48\xed\xea\x0a\xe7\x39\xb6\xd4\xba\xb8\x1d\x5c\x57\xe2\x3d\x1e\x5c\x6c\x15\xc0\x50\x32\x39\xaf\xb6\xa2\xb8\xa4\x65\xd4\xf0\xbb\xf9\xc1\x2c\xc1\x9f\x81\x61\x6b\xfb\x32\xef\x3b\x24\x06\x0d\xaf\x27\xd9\x99\xee\xe8\x7e\x0f\x0e\x4a\x94\xec\xe0\xd4\x69\xbf\x67\xb9\x2d\x6c\xfc\x56\x04\x61\xf9\x1b\xd8\x49\x0d\x23\xc5\x92\x70\x48\x50\x26\x41\xd9\x96\xde\xaa\x44\x60\x89\x0e\xd9\x5b\x5d\x3a\x00\xf5\x4d\x53\x9a\xac\x4d\x8d\xb5\xaf\x3d\x37\xf2\x5d\x67\xe2\x78\x1c\xaf\x92\xe8\x11\x60\xd7\xb5\xa3\xe7\xce\xf4\xe6\xe6\x2f\x02\xc5\xa4\x9f\x4a\xae\xda\x0d\xd4\x75\x69\x39\x90\x15\xf8\xc8\xc3\x74\x54\x6a\x49\xc5\x40\x2d\x0b\x7e\x7c\xee\xd2\xc1\xeb\x25\x93\x83\x35\xbb\x5c\xaa\xe6\xec\x48\x25\x2a\x7e\xb9\xba\x6d\x4e\x13\x0e\xe4\xdd\xb7\x66\xec\xa0\x63\xc5\x71\xc8\x8f\x41\xd1\xa0\xa8\xe7\x4e\xa9\x45\xa8\x72\x1b\xe0\x7e\x1f\xd8\x32\xff\xf4\xf6\x57\x1c\xac\x7b\x95\xae\xe1\x40\xc1\xdc\x85\x8a\x48\x84\x72\x30\x8d\xa6\x9b\x4a\x62\x95\x16\xab\x55\x75\xfd\x76\xc9\x14\x95\x1b\x58\x91\xda\x4f\xd0\x54\x31\x66\x9d\xe4\x8f\x39\x42\x79\x2b\xed\xc7\x39\xb8\x2a\xf4\x96\x4c\xfa\x5a\xed\x81\x62\xca\x31\x9b\x81\xef\xe3\xa6\x92\x84\x6a\x77\x09\x84\x5a\xdf\xfa\xac\xb9\x53\xa6\x79\x5c\xf3\x75\xc7\xee\x9c\xd0\x36\x60\x4f\x01\xb0\x9d\xca\x36\x73\x7c\x95\xd1\x43\xeb\xc0\xe7\x43\x88\x39\xa0\x3e\x15\xf8\x23\xd0\x29\x7c\x49\x56\x52\xb8\x47\x33\x1c\x77\x14\x0f\x4e\xce\x26\xfc\x2b\x95\xaa\xe2\xd2\x19\x0d\x38\xa8\x06\x70\x26\x44\x3e\x83\x79\xae\x33\xe1\xb2\x81\xad\x1d\xcc\xdb\x67\xe1\x2a\x80\x3d\x13\x6b\x49\x94\xbb\x39\x6a\x60\xee\xdd\xbb\x68\x44\xd3\x6a\x43\x39\xd2\x66\x6b\x30\x9c\xf3\xfd\x3e\x7a\xf7\x2e\x1a\x12\xd8\xca\x95\x98\xf2\x93\xb1\xbf\xc0\xa8\xcb\x1e\x9c\x23\x7c\xb3\x39\xdb\x92\x29\xac\xc7\xa4\x55\xad\x97\xb0\xb1\xda\xe4\x76\x9d\xf9\xaa\x92\x60\xe4\xac\x6b\x13\x06\x3b\xae\x49\x25\x5a\xa5\x52\x29\x9c\x3d\xff\xb0\x95\xda\x39\xe2\x75\x71\x09\xe7\x34\x3f\xcf\xeb\x83\x36\x18\xdc\x27\x90\xc8\x69\x84\xc0\xd5\x72\x53\xc9\x13\x32\xd6\x7c\x0c\x3c\x88\x37\x95\x9c\x3c\x02\xd8\xc0\x3d\xb2\x92\xa4\xb2\xc4\x51\x9d\x18\x08\xcc\x14\x60\x95\x52\x73\xc7\x40\x29\xba\xde\x28\x5a\x82\x59\x5b\x17\x19\x14\x03\x49\x8d\x1b\xde\xb2\x50\x83\xeb\x42\xf2\x7b\x6a\x00\x83\xd1\x7e\xd1\x5a\x42\x38\x9b\xc8\xaf\xa8\x76\xe5\xa4\xc2\xcd\xab\x34\xf3\x2a\x88\xcb\x02\x87\x2b\x46\x84\x9e\x43\x8b\x30\x30\x8a\xbe\x82\xcb\x66\xe0\xc0\xc5\x52\xe9\xdc\x31\xe7\xab\x6d\x49\x9f\x31\x4e\x5f\x54\x46\xec\xd3\x05\x36\xf5\x25\x21\x93\x01\x77\x9f\xc2\xf5\x0b\xc3\xe3\xb8\x49\xb2\x3f\x7d\x02\xd1\xff\x69\x88\x7e\xd9\x4b\xf4\xbf\xf9\x16\x08\x6c\xee\xeb\x82\x71\x40\xbc\x15\x4a\x22\x25\xb6\xb4\xe5\x01\xff\xef\x7a\x75\x6f\x2a\x39\x22\xff\x8e\x77\x2a\x2b\xa1\xe5\xba\xe0\x01\xc2\x47\x14\x2b\xd9\xae\xf2\x4f\x5f\xe5\x9f\xbe\x4a\x5d\xf2\xd0\x5e\xca\xdf\xf6\x8d\xea\x7b\x98\x6e\x94\x7f\x37\x37\xf2\x4b\x7a\x05\xce\x20\x57\xd9\xbd\xe8\xde\x88\xa6\x57\x5e\xbe\x88\x4e\x2f\xf1\xbd\x77\xef\xa2\x7b\x68\x74\x2f\xba\x97\x25\x94\xbc\xad\xab\x42\xe9\x2e\x7a\xbe\x01\xf4\xd0\x8e\x3b\xca\xf7\xfd\x6c\xf4\x2e\x96\xc9\xc9\xaf\xd0\x11\x36\x77\xee\x79\xde\x64\x58\xd9\xa7\x90\x7b\x16\x1d\xe1\x9c\x8c\x3c\x5f\x05\x8d\xb3\x76\xe3\x3b\x95\x5d\xcd\xf1\xfb\x8c\xe3\xab\x8c\x1d\xda\x0c\x34\xd8\x6d\xbf\x69\x0e\x0c\x8b\xf6\xd0\x18\xf9\x25\x1c\x06\xcb\xa1\x03\x10\x15\xa7\x6c\xd6\x06\x17\x03\xb8\x82\xe8\x36\xb1\xc8\xb9\x55\xb4\x45\x07\x80\x2c\xbc\xca\xf6\x2b\x94\xf7\x37\x43\xe1\x84\x78\x67\x8d\xc7\xe8\x10\x72\x40\x03\x9b\xe7\x82\x5d\xcc\x88\x26\x66\x84\x81\x97\x91\xa9\x98\xe1\x4f\x40\x39\x8e\xc0\x1c\x29\xc9\xaf\x2a\x68\x45\x82\x70\x59\x73\x8e\x1f\xe6\x70\x15\xda\xea\x50\x14\x35\x5d\x2e\xbe\x0b\xe5\x54\x4d\x68\xfb\xfd\xd7\xf5\x9f\xef\xeb\x3f\x74\xa5\xff\xb0\xfa\x8f\xe6\x5a\xbe\xfa\x2f\xed\xd9\x00\x45\xa7\x3b\x23\x2d\x9a\x3d\xfd\xd7\x7f\x26\x79\x66\x9c\xed\xf6\x5a\x37\xdb\xbf\xa7\xb7\xe8\x14\x61\x70\xf0\xed\x6c\xdd\xd5\x0a\x69\x1d\x85\x91\xa1\xbe\x4e\x26\xe8\xaa\xb8\xa1\xe5\x8f\xc5\x9a\xea\x81\x36\x0a\xcf\xa1\x70\x2b\x6d\xb9\xd2\x2d\x47\x29\xec\x2e\x20\x10\x72\xb0\xb5\x33\x02\x17\x4b\x10\x66\x39\xdf\xef\x9b\xad\xee\xf7\xc3\xcf\xcc\x4a\x65\x28\x87\x5e\x3f\xf8\xcf\x44\x90\x0f\xf3\x5a\x6d\x63\x08\x76\x0d\x43\x2a\x23\x61\xb9\xf6\x4e\x65\x6b\x60\x2a\x02\x28\x59\x92\x04\xb6\xb6\x08\x8d\x1e\x26\xac\x33\x7d\x89\x9e\x3e\x6d\xa9\x2f\xa4\x7a\x6a\xf4\x54\x00\xac\x36\x28\x57\x79\x22\x89\xf4\x9c\x48\x3b\x0b\xd6\xdd\x59\x7e\x0e\xea\x49\xbd\xc1\x20\xe0\x4d\x97\x73\xcc\x33\xe9\x19\xca\xbf\xd4\x92\xc9\x77\xe9\x29\x8e\xd2\xd3\x50\x6b\xd1\xe9\xa0\xb7\xa4\x11\xea\xac\xb8\x16\x4b\x53\x34\x98\xe7\xf6\xfc\x76\x47\xd4\xcf\x03\xb8\xa5\xf4\xfd\x3e\x24\xce\x4f\xa0\x71\x14\x21\x5f\xe7\xcd\x5c\x0f\xf3\x66\x8e\x6f\x32\xde\x61\xbe\x34\x84\x14\x33\x63\xf5\x39\xba\xfa\x5a\x1d\xa5\xb0\x5d\x1f\xd9\xd9\x3b\x64\x09\xac\xd7\x18\xc7\x55\x76\x0b\x58\xe7\x87\x89\x85\x32\x7a\x7c\xb3\xa1\x73\xd8\xaf\x0b\xb8\xe8\xa7\x4f\x9b\x06\xe0\x14\x12\xb9\xeb\x4e\xad\xbe\xa7\x1f\xe3\x9d\xec\x2f\xe3\x6d\x16\xe2\xcd\x43\x74\x6f\x76\x2f\xd2\x38\xbc\x05\x1c\xb2\x0e\x0e\x39\xed\xe7\xb1\x09\x27\xaf\x60\x06\x3f\x22\x7b\xb7\x80\xc8\xff\xfe\x71\x65\xe1\xb8\xee\xb9\x71\x45\x59\x74\x0f\xdd\xc9\x64\x45\xb3\xab\x9d\xca\xde\xcf\x71\x95\x81\x10\x8f\xc5\xac\xb1\x0a\x3a\x12\x3a\x0b\x43\x1d\x3d\x6c\x06\x7d\x11\xb4\xbb\x23\x18\x4b\xb8\x4a\x55\x6d\x0b\x1f\x94\x73\x6d\x76\x1e\xac\xcd\xdf\xa5\xf9\xbb\x99\x1b\x63\xef\x40\xa5\x57\xda\x0a\x3c\x98\xbb\xa4\xef\x5e\x3d\xff\x11\xc4\x61\xc6\x2f\xd9\xe2\x36\x31\x18\x50\xe9\x15\xaa\x6d\xca\x83\x95\x2d\x1a\x4d\xa3\x51\xa2\x52\xb0\x5a\xac\xb5\xf9\x83\x3a\xf3\x87\xbe\xb8\x8e\x46\xd1\x2c\xaa\xeb\x2c\x5c\x9d\xdd\x47\xeb\x1c\x4c\x9d\x2b\x5b\x67\xa0\xd2\xf7\xa3\x28\x8b\x46\x14\x6e\x69\x19\x28\xae\x6d\x6e\x12\xd5\x2a\x2e\x08\x50\x2a\x95\xb9\xf9\x1c\x44\x99\x4a\x25\xd2\x75\x2a\x53\xe7\xb1\x6b\x51\xa7\x82\x91\x7e\x94\x44\x8c\x9b\xaa\x9e\xe7\x7d\x81\x72\xb0\x45\xa9\x54\x8e\xa2\x46\x43\xd3\xf1\xcc\xb4\x75\xde\x68\xeb\x06\x8d\xa2\x44\x8f\xac\xca\x55\x5a\x1d\x19\x19\x32\x23\xbb\xb1\x75\xa1\x8e\xad\x6e\x33\x2f\xda\x0d\x43\x01\x61\x7a\xbd\x75\x99\x2a\xe5\x39\x78\x7b\xa9\x94\x67\xd1\xd4\x37\x63\x31\xfe\xbe\xd1\x0c\x04\x31\x40\xa3\x28\x8f\xfc\x30\x1c\x46\xab\xe9\x17\x76\x50\x97\xb6\x52\x74\x1e\x8d\xbc\x11\x53\xa5\x1c\x4d\xca\xda\x6d\x2a\xeb\x18\xd2\xdd\x6a\x58\xd1\xcb\x62\x15\xa8\xfb\x11\x3a\x1c\x1a\x1e\xd6\x12\xce\x63\xe1\x68\x10\xe1\x9d\xc8\x38\x96\x19\x6d\x86\x43\x90\xb4\xa1\xd3\x01\x2f\x55\x29\x04\x57\xba\x9c\xc7\xb1\xbe\x40\x4f\x1b\x40\x81\xfb\xa8\x39\xbf\x00\xa7\x6b\x58\xe1\x2a\xad\x60\xc9\xad\x41\xdd\x80\x20\x21\x48\xd2\x84\x61\x73\x33\x79\x00\xd6\x02\xee\x8e\xb9\xea\x7b\xed\x50\x60\xca\x67\x50\x46\xa5\x37\x71\x0c\x40\xa4\x37\x70\xf7\x56\xa5\xc2\x7c\x8a\xfa\xf3\xca\x7c\x5e\x35\x2e\x18\x56\x21\xd4\x1a\x66\x73\x7e\x5d\xc3\x7e\x33\x07\x91\xe1\x06\x4e\xc3\xe1\x8f\xc1\x85\x1d\x57\x4e\x53\x38\x82\x83\xbb\x8f\x90\xb0\x9c\xc7\xf1\x93\x95\x31\x63\xd6\x22\x3b\x64\x5f\x81\x3c\x59\x50\x23\x96\x8b\x1b\xc2\x33\x9a\xde\x90\x17\xc0\x14\x30\x0d\x30\xa8\xcb\x38\xa3\x08\xd5\xd8\x9e\xd4\x4d\x5f\x68\x38\x84\xfe\x7d\x3b\x87\x4b\x52\x2a\x15\x98\x3b\x04\xd2\xf4\x26\xa7\xe9\x8d\x87\xeb\x26\x7b\xb1\x4a\x68\x7a\x03\x46\x7a\x8e\x70\x1b\xfc\xa1\x06\x1f\x18\x79\xb6\x03\xff\x72\x8e\xd7\x19\x0f\x40\xd9\x36\x27\xb3\xde\x8b\x6d\x7d\x2d\x8f\x87\x2c\xd6\xa8\xa1\xbd\xdb\x07\xe4\x80\x71\xa9\xa4\x2b\xb6\x66\x8a\x8a\xc1\x3d\xd0\xe6\x21\x99\x8e\x22\xd8\x5a\x38\xd9\xa9\xec\xfb\xe5\x01\xbf\x5e\x25\x0c\xdc\x82\x78\x9b\x99\x2e\x9b\xd0\xf8\x71\x34\xf8\x7c\x7c\x6c\xeb\x48\x8c\x2c\x81\xfe\x5e\xb8\x59\x00\x37\xf8\x0c\x81\xa9\xba\x4f\x1c\x9a\x37\xa1\x6f\x8a\xbd\x47\x4c\x1e\xf7\x8f\x6f\x83\xb6\x7c\x28\x81\x92\xe1\x99\xdb\xb5\xda\x39\xe3\xde\x3d\x4e\xba\xd2\xdd\x3c\x91\x27\x15\x8c\x4d\xcd\xf5\xd8\x04\xae\xfa\xcb\x81\xef\xa6\x2e\xf8\xcd\x12\xf3\xec\xf9\x1c\x2f\xb2\x69\x35\xd3\x75\x24\xae\x10\xc2\x7f\x27\xb6\xab\x7e\xe4\xae\x42\xe4\x1e\x21\x8c\x21\x20\x93\x37\x35\x7e\xc7\x8a\x2c\x54\xb5\x00\x6d\xf5\xd8\x11\xe1\xb6\x7b\xc3\x6f\x40\x02\xa2\xf3\x83\xbd\xcb\xd1\x86\x63\xd1\x9e\xe4\xd0\x9e\x65\x65\x97\xde\x23\xfc\xb3\x89\xf8\xca\x1d\xc7\x08\x63\x6c\x69\xc2\xea\xf6\x36\x3a\x15\x33\x53\x14\x01\x43\x85\x88\x81\xa6\xc5\x60\x55\x80\x4c\xc2\xb1\x6c\x0a\x1d\x65\x1b\x40\x2c\x27\x06\x3e\x1d\x67\x81\x91\xa7\x70\x5b\x60\x6a\x46\x3d\xb3\xb1\x16\x2a\xb4\x93\xa4\x02\xb7\xa4\x21\xd3\xd2\x6e\x34\x34\x5b\xae\x12\xe9\x7c\x59\x88\x07\x70\xd4\x8c\x5a\x9d\x5b\xa3\x97\x34\x67\xb7\xf2\xd0\x67\x88\xd3\x3d\x81\x46\xf3\x97\x09\x62\x50\x2c\x20\xcd\x45\x1a\x88\x34\x72\x1b\xf0\x71\x7a\xa3\x1e\x2e\x0b\x91\x38\xe0\x34\x50\xdd\xae\xe8\xcd\x46\x50\x29\x01\x49\x95\x30\x1b\x61\xd0\xb0\x15\xc0\x05\x50\xfa\xb7\x96\x4d\x09\x84\x83\x43\x9a\x4d\x67\xf6\x9b\x94\x68\x95\x80\x1f\x56\x86\x12\x8f\x68\xba\xd3\xe2\xe4\xcf\x07\x27\xbf\x9d\x7f\x36\x73\xbf\xce\x4e\xfe\xe3\xdd\xc9\xec\xf3\xd3\xfe\x25\xd8\x8f\xd1\x60\x85\x51\x3b\xca\x7a\x5c\x9b\x42\x28\x56\xac\x8c\x6e\x81\xf5\x98\xaa\xf9\x01\x22\x55\x24\x22\xe5\x70\x11\xc3\xdc\x76\xf2\x83\x5b\xf7\x50\x8e\x19\x9e\x1d\x4a\x1b\x0c\x30\x2b\x1e\x61\x60\xcd\xa2\xd1\xe9\x5d\x8c\x2e\x58\x03\x10\x9f\xea\xc8\x9a\x15\xa0\xf4\xee\x54\xf6\xdd\x12\x8b\x8c\xf9\xc5\x22\x50\xe0\x47\x34\x3d\x9b\x1d\xb0\x59\xda\xe2\xe3\x68\xfb\x54\x3a\x8c\x10\x96\x4e\x0f\xe0\xd6\xfc\xd3\xfe\x6e\xe2\xf3\x2a\xc4\xa7\x59\x81\x4d\x72\x71\x70\xd5\x78\x6c\x91\xc9\x9f\xab\xbf\x79\x23\xdb\xa9\xec\xf5\xfc\xd0\x06\xbb\x05\xf5\x65\x08\xb5\xdd\x86\xfe\x12\xdc\x1f\x00\x6e\xab\xd6\xff\xcd\xf0\x3f\xb1\xfa\xbd\x19\x03\xeb\x1d\xc3\x6d\x73\x0c\x7d\x37\xb3\xef\x38\x86\xf8\x57\x84\x90\x30\xdb\xdd\x02\x62\xa9\xf0\xec\xd5\xfc\xa0\x65\xd3\x5d\x97\xbb\x45\xff\x88\x9a\x8c\x27\xac\x7a\x68\xb7\x6d\xd6\xa5\x36\xef\x98\x59\x38\x01\x16\x5b\xf0\xb2\x10\xe5\x23\x3b\x74\x09\x51\x76\xcd\x7e\xe4\x30\xf5\xc2\xac\x69\x1d\xa4\xb8\xb6\xb0\x6b\xb7\xf9\x8a\xaf\x6e\xf5\x39\xac\xd2\x47\xab\xaa\xda\x0c\x56\xf4\x8a\xae\x06\x70\x16\x0c\x09\x74\xbd\x81\xb0\x20\x78\x50\x89\x01\x5b\xaf\x69\xc9\x0a\x45\x57\xb7\xe0\x8f\xcf\x4a\x3a\x70\x17\x0d\xc0\x35\x17\xeb\x0b\xbd\x8d\xf9\xfc\x7e\xa5\xaf\xd9\x97\x64\x8b\x81\x75\x7c\xb6\x9a\x6e\x67\x3a\x30\xd0\x27\xaa\xef\x7d\x1c\x38\x42\x78\xd1\xee\xe7\xb7\x15\xaa\xc9\x6e\x63\xee\xf8\x4c\x44\xca\x48\xb2\x69\x97\xfb\x76\x85\x50\xbe\x18\x45\x38\x1a\x6d\xb2\xc5\xa1\x0f\x8a\x26\xd6\xff\x67\x54\xa7\x52\x73\xa0\xf2\x88\x6e\x14\x44\x71\x84\x18\x79\xe9\x02\xcf\x41\x6a\x2f\x2b\x08\xc1\x24\xc9\x1b\xd8\x53\x29\x42\x65\x1c\xcb\x14\xce\x63\xcb\x38\xee\x76\x66\x8f\xb7\x46\xd1\x69\x34\x2a\x6d\x37\xed\x2e\x4e\xc8\x18\xaf\xc0\x9d\xdb\x1e\x6c\x49\xf2\xb5\x69\x1e\xf0\x4f\xc8\xab\x79\xd0\xf8\x6e\x07\xc5\x0e\x07\x7d\x72\xaf\x31\x41\x4b\x38\x5f\xdd\xed\xfe\xb1\xe5\x2b\x2a\xe5\xe1\x10\x21\x5c\x04\x35\xd8\xaa\xde\x23\xea\x9a\x6c\x91\xa6\xe9\xe1\x60\x36\x5a\xdb\x5a\x84\xf0\x72\xbf\x4f\x96\xe4\x02\x34\x33\x91\xc2\xb1\xcd\x32\x5d\x18\x5f\x14\x23\x09\x3e\x80\xc5\xf8\x62\x95\xdc\xd0\x64\x6e\x8d\xe4\x32\xbd\x41\x08\xe1\x45\x06\x81\x0f\x0f\x08\xcf\xeb\x2a\xd7\xb4\xce\x09\xc6\xf4\xf6\xef\x1c\x13\x9c\x68\xd3\xc6\x6a\xa8\x38\x35\x23\x3c\x1c\x06\x17\xab\x6a\xfe\x1e\xdb\x15\x42\x79\x09\x6b\xc3\x9d\x94\x01\x7e\x40\xb2\x5e\xe6\x77\x0e\x31\x18\x56\x16\x62\x06\x57\x64\x99\x2e\x4c\x58\x2d\xe3\x9b\x0d\x07\x68\x89\x2a\xe1\x5a\x12\xba\x10\xb4\x78\x3f\xa9\x6a\x44\x48\x74\xb8\x5e\xb2\x15\x4d\x86\x2b\xa7\x67\x2d\xcd\xa6\x4c\xc8\xf3\xb9\xf9\xf9\x62\x0e\xee\xaf\x2b\xb2\x44\x5e\x0c\x49\x17\x86\x25\xec\xf7\xc6\x29\x58\xa4\x8b\x50\x3a\xb9\x08\xd9\x9e\x6d\x1c\xee\x0a\x3e\x9f\x03\x43\x0e\xc6\x54\xf3\xa6\xd7\xab\xe4\x1a\x54\x51\xf0\x65\xcf\x82\x02\xaf\x82\x02\x0a\x32\xc3\xc3\xfd\xeb\x46\x6c\x6f\xad\x88\x5e\xcf\xe3\xd8\x49\x8b\x60\x5a\xc9\x76\x2a\xbb\x9e\x63\x99\x45\xc3\x08\x57\xd9\xfb\x96\x05\xe1\x26\x6c\x02\x0c\x06\xce\xe3\x51\x81\x2b\xe9\x4e\x65\x8f\x75\xe5\x38\x86\xda\x53\xa8\x0e\xa6\x11\xfc\x9e\xc2\x3c\x58\x97\xae\x31\x42\x68\x16\xb4\xfa\x3e\x68\xd5\x5a\x82\xc3\x98\xb4\x8f\xdb\xf6\x4b\x2f\xde\x78\xbb\x65\xc8\x36\x7e\x5d\x19\x3f\x80\x63\xe2\xc9\x77\x2b\x90\x4c\x20\x36\x65\x40\x89\x4f\xcd\xea\x02\x87\xbe\xc1\xc9\x80\xb6\x19\xcd\xbc\x5a\x83\xdf\xdc\x40\xc2\x3d\x42\xb8\x06\x95\xdc\x3b\x39\xb9\x7f\x0f\x69\x05\xb9\x21\xd5\x38\x31\x66\xf4\x25\xd6\x82\x0f\x9d\xe3\x79\xc6\x0f\xc7\x4f\x5b\x65\xfb\xb4\x15\x0e\x76\x64\x7b\x77\x3c\x0f\xd1\xef\x7d\x98\xde\xaf\xfa\x9c\xac\x2c\x8a\x38\x89\xfe\x01\xe7\x24\x3a\xfa\xd9\xf5\x6a\x4a\x67\x59\x74\x03\x53\x4e\xa7\xe3\x59\xee\x22\xda\xda\xd3\x51\x40\xd6\x17\x08\x8f\xff\x2f\xca\x7a\xf3\xc6\x08\x8f\xcf\xe0\xca\x77\xbd\x8b\xa6\x10\x59\x01\x84\xf8\x87\x55\x49\x93\x07\x14\x34\x8d\x4c\x85\x8e\x58\x0f\x1a\x40\xe7\x63\xf0\x0d\x50\xf9\x97\x5f\x64\xe3\x2f\xfe\xdf\x7d\x95\xab\x6c\xfc\xcf\xff\xb8\x4f\x54\x7e\xb3\x9a\xaa\x93\xf1\x17\xff\x6f\x96\xfd\xf3\x9f\x5f\xfc\xc7\xff\xd5\x59\xff\xfc\xff\xbe\xfc\xf7\x2f\x21\xf3\xff\xfe\xf3\x9f\x5f\x7e\x99\xc1\xff\xff\x84\x4f\x95\xf9\x84\x2f\x7d\x57\xaf\xfa\xf1\xf3\x60\x85\xa3\xb8\x58\x6f\x26\xc1\x71\xc9\x63\x48\x5b\xa9\x30\xe9\x1c\x92\x2e\x21\xc9\xb7\xf8\x90\xde\x15\x6c\xd1\x97\x7b\xde\xec\x19\xd6\x16\x9d\xc3\xae\x05\xbf\xf8\xdc\x17\x7c\x11\x14\x34\xd6\xb7\x6f\x96\xb6\xe0\xaf\x4b\x04\xf4\xb8\xf0\xc5\x5f\x53\x7b\xf1\x08\x87\x91\xfb\x02\x69\x08\x8c\x51\x4b\x30\x8a\xe1\xca\xad\xc6\xfa\x7a\x48\x41\x14\x5c\x0f\x29\x52\x7a\xa3\x69\x2e\x77\xde\x98\x15\x04\xe9\xa1\xe0\xd1\x0a\xe0\xd1\x79\x10\xd3\x11\xb2\xb4\xda\x3b\x07\x3f\x7b\x96\x6f\x16\x9a\x06\xb1\xc8\xd7\x0b\x1b\x4f\xb6\xd5\x0f\x88\x56\xae\xaf\x85\x15\x04\xea\xb6\x7f\x59\xc6\x71\xb9\xa8\x0d\x6e\x45\x4a\xd1\x64\x0e\x01\x52\x36\x18\x8e\xe0\x36\x71\x3c\x5f\x24\x45\xba\xc0\x57\x0b\x7c\xb9\x40\x78\xbe\xdf\x83\x0c\x33\xad\x4e\xc6\x33\xbc\x84\x1f\xa3\xf1\x0c\x27\xc3\xed\x7e\xdf\xc1\xfd\x36\x8e\xd7\xa6\xdd\xad\x16\x72\x60\xdf\x45\x38\x19\x2e\x7b\x0a\x2f\xe3\x78\x63\x0a\x2f\x75\xe1\x05\x14\x46\xf8\x35\xd5\xdd\xd3\x1a\x99\x5a\x32\x2a\xd2\x55\x1c\x27\x3a\x63\xa5\xb3\xb8\xce\xc2\x1e\x41\xa3\x31\x3e\xc3\x45\xba\x42\xd8\xf0\x72\xfd\xbb\x48\x0b\x04\x68\xd3\x3b\x38\x7c\x14\x69\x27\x50\x71\x89\xe2\xd8\xc2\xe6\x5c\xc7\x8a\xb4\x98\x96\xb3\x38\xd6\x5d\xc2\x4f\xd7\x27\x08\xba\x45\xba\x36\x59\x6b\x0f\x4b\x91\x5e\x85\x7d\x5d\xa1\x22\xbd\xea\xeb\x2b\x91\x49\x91\x5e\x4d\xcb\x99\xb6\xe5\xea\x56\xea\x2f\xdf\x94\x2b\x51\x36\x4a\x94\xae\x44\xed\x78\xd2\x26\xad\x0e\x86\x61\xf6\xc1\xd1\xb1\x27\x7d\x04\xf1\x9d\x13\x28\x00\x33\x3a\x1b\x99\x69\x6d\x20\x74\x0c\x3b\xd5\x7e\xef\x4b\xb9\x45\xb9\x58\x60\xed\x94\x8a\x75\xa0\x36\xc8\x6a\x11\x6b\xb0\x58\x9f\x84\xfb\xc4\xdd\x3b\x44\xf4\xd5\xa9\x35\x39\x35\x24\xd4\x8b\x85\xf6\x2a\xab\xe5\xeb\x38\xe6\xda\x7b\xa9\xfe\xea\x71\x71\x10\x73\x0c\xe1\x32\x8c\x4a\x73\x42\xbe\xa8\xaf\x0d\x77\x76\x14\xbb\x83\xa8\xe2\x32\xea\x28\x9b\x2f\x9b\xbb\x9b\x85\xbb\x4f\x38\xee\xc2\xfb\x1e\xe0\x4d\xa8\xb9\xc4\xcf\x0f\x98\x91\x3f\xa8\x75\x1c\x18\x12\x30\x1a\xd0\x54\x47\xcc\x00\xc3\x01\x6d\xf7\xfd\xc7\x91\x93\x41\x87\xb5\xd3\x29\x79\x77\x7a\xff\x9d\x9c\x99\xc8\xc5\x0d\xa3\x4d\x9f\x54\xfe\x3b\xf9\x1d\x0f\x7e\x3f\x85\xff\xee\xff\x0e\x6a\xcb\xb5\x1b\x40\xf4\x29\x06\x92\x88\x1c\x33\x37\x58\x9d\x8f\x91\xa6\xd0\x8d\x05\x79\x0a\x5c\x12\x54\xfd\xfd\x5e\xff\xbc\x17\xdd\x43\xfb\xfd\x23\x87\x88\xd6\x46\xef\x80\xd5\xee\xb2\x83\x42\x29\xc1\x2e\xb6\x8a\xc2\x75\xe3\x2d\xed\x28\x0e\x43\x42\x98\x57\xfa\x02\x17\x2e\xde\xae\x6a\xee\x5a\xd7\x71\x24\xc0\x61\x56\xc7\xd5\x04\xbd\x01\x66\xdf\xb4\x39\x00\xaf\x3b\xc8\xb4\x54\x11\xa6\x03\xa5\x5b\xb7\x83\x1c\x04\x2c\xfb\x11\xc7\x9d\xb5\x25\x60\x43\x3f\xa7\x09\xfc\x45\x99\x80\x23\xc1\xac\x49\xa3\x7e\xa2\x7f\xfc\xb8\x08\xd5\x25\xae\xc7\x9a\xb8\x18\xe1\x70\xf7\x52\x3b\x0b\xea\x93\x37\xdb\x68\x63\xc7\x83\x41\x1e\x8c\xb9\x27\x91\xe4\x7a\x01\xca\x87\xe6\xb5\x10\x76\xd3\xc9\x46\xd2\xbb\x3b\xd8\xeb\x3f\x3d\xa7\x09\x8f\x42\x68\xf5\x6e\x04\x82\xd3\x03\x8b\x6c\x90\xf8\x4d\x80\xe1\x67\xe0\xbf\xb2\xdf\xeb\xe1\x4d\x4c\x6c\xab\x56\x9c\x9c\x46\x11\x3b\x66\xd3\x77\xde\x6e\x78\xdc\x5d\x23\x4f\xdb\x26\x89\xd0\x00\xd1\x36\x98\x36\x0d\x0a\x5d\xc8\xa9\xbe\xc2\x8a\x85\x85\xea\x59\xdd\xb8\x05\x5d\x4c\x90\x71\x3c\x12\xa8\x5b\xc8\x34\xdd\xee\xb2\x67\x10\xac\x6d\x2a\xf2\xc3\x79\xd6\x1e\x8e\xa7\x04\x3b\x28\xd1\x92\x9c\xe5\xa7\xce\xbe\xb9\x3c\xad\xaf\x79\x5f\x2f\xb4\x83\x75\x2d\x66\xb3\x60\xf5\xfd\xb4\xad\x14\xed\xac\xba\x7a\xe9\x2c\x8b\x2b\xf0\x4a\xb7\x2b\xe3\x03\x14\x85\x73\x1c\x67\xf3\x07\xbd\xcd\x11\x13\xeb\xf0\xd2\x9f\x9b\x64\xee\x31\xd6\x65\x27\x09\xad\xfd\xe3\x34\x85\x43\xa4\x30\x08\x83\xd4\x57\xb0\x89\xee\xe3\x2e\x71\xe6\xfc\xa2\x28\x13\xbd\x6a\x0c\xd7\xe5\xe9\x55\xf3\xd4\xa9\x0d\xf2\x0f\x77\x59\xbc\x26\x6c\xd1\xb3\xa7\x6a\x23\x3a\x23\x0f\x17\xf6\xae\x49\x3d\x9b\x2b\xa2\x9a\x4e\x47\x28\x72\x34\xf3\xdc\x88\x3f\x40\x4e\xd4\xce\xc4\x53\x5e\x33\x40\xc6\x37\x5b\x65\xcc\x08\xe0\x69\x5e\x95\x75\xbc\x88\xc0\xec\x7e\x0f\x8e\xc2\x6b\xed\x6d\x35\x1a\x23\x77\x2c\xb8\xce\xc0\xb5\xf6\x80\x25\x89\xa6\xbe\x08\x4f\xd7\x66\x81\x8d\xc6\x78\xa5\x1d\x15\xe0\x99\x0f\x7a\x3d\x38\x87\x03\x07\x88\x19\x03\x87\xab\x70\xff\x5d\x6e\x57\xa0\x3a\x22\xcc\x41\xfa\xd2\xc4\xe2\x83\x8e\x47\x99\xb7\xd0\xd9\xbb\x1b\x13\x45\x20\x24\xbc\x5e\x83\x3a\xbe\xe2\x74\xa6\x2f\x63\x62\x73\x30\x3b\x71\x92\x0a\x9c\x51\x57\x3e\x2a\x38\xee\x20\xb2\xd2\x88\x2c\x48\xd5\xe8\xb0\xe6\x61\x05\xda\x15\x71\x6c\x5e\xdb\xa8\x3c\xcd\x15\x08\x2e\xcb\xd4\x3d\xdc\x2f\x46\x10\x3b\x65\x09\xf7\x1d\x6d\x11\xa0\x91\x62\x04\x71\x2a\xb5\xf1\xe0\x60\x5b\x40\xb5\xb3\xb3\xfb\x9c\x2c\xc9\xd2\x5a\x5e\x94\x73\x21\xb2\x71\x36\x73\xfb\xfe\xc8\x7e\xdf\x11\x18\xb7\xda\x22\xc0\x33\x40\xd5\xf6\xf8\xee\xb0\x85\xdd\x01\xfe\xcb\xb6\x07\x7d\xcb\x7c\x79\xbc\x2c\x8c\x20\x4f\xe6\xe4\xc1\x22\x81\x69\x84\x4f\x98\x33\xb8\xab\x50\x90\x79\x3e\xaf\x69\x39\x83\x74\x33\x0b\x28\xe3\x69\x49\x96\x28\xe3\x64\x9b\x69\xef\xbb\xc0\xd0\xf0\xa6\xb9\x0a\xef\x0e\xaf\x69\xb7\x1f\x2b\x66\xed\xf7\x0d\x6e\xd6\xe0\xa7\x3d\x36\xdc\xaf\x5a\x36\x5c\x58\x30\xa7\x77\x1e\x2f\x59\xca\x39\xaa\x8b\xf3\x3e\x5d\xbc\xd5\xed\x30\xe8\x36\x55\x64\x3b\xc7\xad\xbd\xf3\xf4\x5f\x65\x35\x07\xf4\x9e\xb2\x5e\xeb\xe5\xa3\xe7\x0f\x5f\xff\xfa\xe2\xf1\xa0\xa4\xf3\x55\x51\x07\x2e\x34\xf8\xee\x34\x94\xa4\xa3\x1c\xdd\x3f\x85\x1b\xaf\x00\x7b\xaa\xc8\x2f\x4b\x08\xbf\xd4\xde\xae\x5f\x2f\x10\x1e\xf2\x94\x76\xb6\xa0\x27\x8b\xbb\x85\x37\x2b\xa6\xaa\xe2\xd2\x1e\x3b\x49\x7f\xef\x8d\xba\x60\x1b\x84\xa5\x7c\xbf\x67\x93\x1f\x4d\x7b\xc2\xd9\xc2\x4f\x9c\x9c\xe2\xc5\xa3\x87\xf5\xed\x4f\x08\x0d\x23\xa8\x0e\x17\x50\x0e\x74\x84\x20\xdd\x87\x1c\x24\x75\x98\x1a\xac\x73\x15\x1e\xd8\xf8\xc9\x78\x50\x47\xc3\xc5\x03\x77\x4f\x46\xff\x5c\xb0\x4b\x3c\x80\xf0\x01\xd8\xdc\x16\xc5\x03\x7b\x2b\x14\x0f\xea\xb0\x2f\x90\x0f\xd7\x22\x11\x88\x51\x3c\xbd\x82\x07\x6a\x58\x2f\x57\x9f\x54\x76\x73\xbd\x01\xdd\x79\x82\x2a\x1d\x16\x0b\xf6\x82\x47\x8b\x69\xfd\x31\x43\x39\x9f\xb2\x19\x79\xb5\x48\xaa\x7a\x05\x60\x85\xb2\x44\x90\x3f\x0c\xe3\xad\x8b\x01\xb7\xe7\xe9\x15\x78\xa1\xa6\x57\xfa\x62\x52\xd1\xac\x82\x65\x22\xa6\xe3\x19\x2e\xc0\x32\x92\xca\x82\x33\xc5\xfe\xa4\x8f\x01\x15\x8e\xcc\x65\x1c\xbf\x34\x58\x35\xad\xea\xf6\x8a\xfa\x7f\xdd\x2a\x4f\x0b\x0b\x18\x31\x8d\xc3\x1d\x32\xa0\x75\xf3\x99\x47\x51\x76\xa6\x0d\x82\xe9\x5a\x57\x5c\x6b\x67\x20\xe0\xc6\x86\xeb\xf4\x4a\xe0\x9f\x7e\xf5\x41\x07\x0d\x05\x35\xbb\xbd\x0a\xef\xb7\x56\x21\x6c\x47\x97\x84\xa7\x34\x55\xd5\xb3\xea\x9a\x8a\x87\x85\x84\x26\x6f\x61\xb1\x1b\x6a\xf0\x7d\x01\x00\xc3\x6d\x1c\x0f\x2f\x8c\x6b\x0e\x10\x31\x5c\xb3\xa0\xf5\x93\x88\xaf\x54\x31\x7f\x5f\x33\xce\x4b\x84\x93\x48\xd6\xfe\x4f\xe0\x48\xa3\xf9\xe3\xed\x4a\xfb\xf7\x5f\xd6\xe4\x58\xb3\x11\x72\x89\xea\x6d\x61\x4e\xae\x8b\x04\x80\x42\xc6\xe4\xbf\x31\x0c\x67\xdd\x92\x72\xde\xd2\xe4\x12\xaf\x11\xec\x1a\x57\xe4\x16\xa8\x02\xed\x4a\x1b\x9b\xe5\x82\x5c\xb5\x07\x03\x50\x5f\x80\x97\x8e\x5d\x04\x64\x83\x87\xff\xd5\x02\xda\x6e\x2c\x17\xc8\x6c\xd2\xd7\x24\x7a\xc3\x3b\xa6\x45\x50\x0c\x27\x76\xf4\x17\x30\x8e\xeb\x11\x89\x06\xc9\x57\xd1\xe8\x62\x14\xdd\x87\xcb\x5b\xc5\x40\xeb\x96\xa6\xf5\xc1\xc9\x80\x29\x77\xc1\xda\x28\x1c\x73\xb8\xe4\x2b\x28\x07\x8b\xa4\x5d\x86\xd7\xe6\xad\x85\x64\x51\x9f\x74\xec\x40\x54\xcb\x8e\x1c\x5c\x61\x10\xbc\x68\x7f\xee\x78\x76\x00\x4a\x07\x8c\x18\x11\x7e\x83\xb2\x64\x61\x65\x1e\x5a\x42\xee\x7c\xba\x48\xf9\x2c\x40\x88\xe3\x06\x8f\xb6\x60\x3e\x29\x14\x1d\x6c\x3a\x87\x61\xb0\x5a\x57\x8b\x64\x91\x2e\xc0\xcc\xa2\x04\xdb\x3c\xac\x4d\xad\x12\xdf\xe2\x21\xfc\x43\xb8\x6e\x99\x40\x19\x6d\xe9\x09\xba\x56\xd0\xb5\xb9\xd3\xba\x40\x59\xe9\x0e\x60\xf4\x2f\x63\xb3\x2f\x91\x7b\xd3\x4b\xb3\xf8\x05\x18\xe8\x57\x86\xdb\x6b\x2f\xd5\xc6\xbc\xe9\xe8\x17\x76\x57\x76\x34\x05\x64\x84\x83\x25\x5c\xd7\x90\xe0\x3c\xa7\x0d\x05\xed\x0c\x27\x57\x5c\xa2\xfc\xe9\x22\x0b\xb6\xc8\xb7\x4d\xa9\xcf\x8a\x69\x9c\x9c\xfe\xeb\xab\xc4\x78\x16\x58\x0f\x83\xb3\x93\xff\x98\x7d\x8e\x4e\x6b\x9e\xa3\x65\xeb\x17\x0b\x88\x6f\xc3\xe3\x98\xe5\xc3\xff\xf2\x56\x6d\x3e\x1d\xcf\x9a\x34\x8a\x20\x9c\x9f\xeb\xf4\xcf\xf6\xbe\xec\xbb\x6d\x2e\x05\xe1\x8d\x1e\x60\x28\x19\xd9\xaf\x2c\xfa\x2a\x72\x1b\x35\x84\x42\xeb\xbf\x91\x96\xd3\x20\x92\x8a\x30\xcf\x5a\x7d\x92\x02\xc1\x08\x73\x42\xd1\x5d\x35\xe0\x62\xd9\xc8\xd6\x42\xb8\x21\x31\xc0\x7d\xb8\xb3\xdc\xa8\x51\xf7\xa2\x7b\x38\xba\x17\xe1\x88\x44\x18\x80\x8f\xee\x47\x38\xfa\x3d\x82\x30\x2e\x26\x46\x6c\x50\x33\x6f\x7c\x65\x02\x02\xc4\x5d\x2f\xf4\x9b\x2d\x08\xd3\xbc\x96\x4e\xe1\x22\x3f\x75\x6f\xb3\x19\x75\x76\x44\xa8\xc3\x4b\x1e\xa8\xbb\x14\x65\xe7\x34\x69\x24\x74\x74\x96\xaf\x9b\xa6\x63\x6b\xf8\x7a\xbb\xc0\xd1\xbb\x77\x9f\xc5\xa1\x35\xfa\x83\x9f\x40\xc3\xc4\x38\xe9\x5d\xac\x67\x70\xfb\xeb\xc8\x32\x76\x6f\x52\xb9\x68\xca\xa1\x5c\x65\x85\x89\xaf\x17\x68\xbf\x6f\xb1\xf9\xb6\x13\x11\xc5\x8e\xd9\x57\x6d\x61\xe4\xc3\x42\xdf\x98\xbd\x02\x2d\x63\xc5\xb8\x5f\xf6\xe6\x14\x45\x0e\x0a\x41\x07\xee\xa1\xa7\x32\x7d\xc7\xdf\x48\x13\x2c\x27\x4d\xdf\xf1\xc1\x60\xb7\xfb\x87\xad\x13\x8d\xaa\x51\x74\x38\x0c\xd2\x34\x1d\xec\x76\xa7\x26\xf9\x70\x78\xc7\xdf\xf1\x34\x05\xbc\x2b\x5a\xe8\x93\x41\xa8\x9f\x41\xed\xaf\x86\x27\x27\x83\xdd\xee\xbe\xad\x7a\x72\x72\x5f\x57\x37\xe9\xa7\x41\x3a\xa8\x32\xad\x91\xb2\x60\xf0\x76\x44\x9f\x2d\xfa\x87\x2f\xe0\x34\x19\x70\x50\x68\x0d\xe7\x25\xbd\x7c\x7c\xb3\x49\xa2\x7f\x41\x4f\xef\xde\xc9\xcf\xa3\xd1\x1b\xb8\x39\x3c\x8a\xe0\xe3\xdd\xbb\x53\xf8\xa3\x7b\x77\x99\xcc\x64\x9e\x9c\xdc\x8f\xf4\x2e\xd5\xea\xb9\x40\xb9\x24\xc3\xb3\x2c\x79\x16\xf2\x3c\xfc\x6c\x11\xc8\x70\x47\x4f\xab\x92\x7b\x00\xc8\x20\x1a\xd5\x67\xd7\xd5\x08\x02\x01\xd8\x73\x2b\x13\x75\xe2\xd9\x02\x21\xcb\x28\xa5\xd5\x19\x77\x2a\x2b\xe0\x38\x51\x60\x9e\x55\xc1\x69\xdc\x67\x6d\x2e\x82\xe5\xc4\x90\xa3\x23\x85\x2e\xe1\x85\x34\x66\x90\xac\xbd\xa6\xdb\xa2\x79\x1b\xeb\xbf\x1d\xc1\x3a\xff\x8b\xae\x59\xc1\x25\x95\x8f\x78\x5c\xb5\x81\x1c\xcf\x7a\x05\xf8\x5e\xf7\x03\x28\x6d\x5d\x4f\xb5\x8f\x41\x22\xfe\x9e\x6d\x37\x32\x40\x82\x9c\x33\x14\xe0\x38\xdd\x85\x08\xa2\x3b\x80\xe2\x66\xd7\x07\x7c\x8f\x67\x36\x90\x5d\x22\x42\xda\x11\x7d\x43\x3a\xd6\x80\xe1\x95\xe2\x28\x91\xf0\x8c\xe3\x45\xc6\x02\x22\xf9\xa9\xe9\x28\x0d\x82\x18\x77\x82\x18\x66\xe0\xf3\x2a\xfa\x65\x41\x3d\xcb\x5f\x01\x2e\xac\x4a\x31\xa9\x25\x27\x69\x58\x5e\x65\xd9\x57\x61\xd9\x57\x52\xd8\xa1\xd5\x52\x08\x9f\x16\xa1\x14\x22\x7b\xa4\x90\xf2\xb8\x18\x52\xf4\x88\x21\x02\x0f\xe1\x1f\xc4\x43\x86\xa6\x09\x94\x61\xb5\x18\x52\x35\xc4\x10\x63\x78\xac\x50\xe6\xfa\x0c\xc4\x3d\xeb\xcf\xa3\x25\x36\xca\x55\x84\x26\xff\xe7\xff\x68\x27\xfe\x2d\xd9\x5d\x65\x55\x81\x55\x46\x0f\x06\xb5\x03\x30\x3a\x6f\xd3\x0d\xe1\x08\x6f\x3d\x66\x7f\x33\x92\x83\x29\x04\x2c\xe7\x17\x38\xaa\xd3\x81\xa1\x91\xb1\xa8\xf8\xe2\xdf\x06\x5b\xc6\x75\x91\x54\xa5\x9d\xb9\x81\x7e\x91\x43\xd2\x50\xd1\xf3\x0f\xb4\x7e\x0f\x11\x35\x60\xcd\xa0\x43\x18\x99\xf4\x7b\xd3\x39\x2c\xe6\xef\x17\xc6\x73\x34\xb8\xac\xf0\x03\x93\x9a\xf9\x98\xb8\xa1\x75\x0f\x83\x13\x2b\xa8\xd6\x9f\x16\x0b\xe9\xe0\x31\x03\xef\x0f\x78\x72\xaa\xce\xa9\x84\x8e\x24\x0a\x9e\x1e\x10\xc9\xc9\x5d\x85\x36\x8a\x7a\x1d\x80\x42\x17\x15\xde\xb4\xf5\xbd\x19\x3d\xb0\xfe\xd4\x04\xba\x08\xf6\xcc\x6f\xda\xd7\x07\x86\xbf\xd4\x76\x1f\xf3\x4c\xfa\x6b\x18\x83\x61\x31\x93\xce\x80\x8c\x12\x2b\xa8\x12\x8c\x5e\x79\xd8\x07\xff\xd0\x81\x37\xc0\x8c\x6f\xc6\x6a\x43\x70\x88\x2d\x07\x85\x42\x1f\xcd\x0d\x2e\x44\x75\x2d\xa9\x80\x1b\x79\x6c\x91\xfc\x0a\x73\x61\x5f\x05\x0b\x0e\xd4\x11\x1e\x26\xfc\x8e\xb7\xe0\xd1\x5f\x80\xd7\x3d\xf5\x05\x71\x47\x3d\xb8\x56\x6d\xb8\x66\x6a\x39\x60\xa5\x06\x5f\xc3\x14\xbd\x7a\xf8\xf2\xe9\x8b\xd7\xe0\x20\xc0\x41\xe2\x82\x4b\x98\xa9\xaa\xde\x6c\x36\x4e\x86\xfc\xe4\xce\x5f\xdf\xd5\xdb\x28\xc2\x3e\x9c\xc9\xe0\xab\x5a\x87\xbb\x6f\x8b\x3a\x27\xde\x08\x9e\xea\x7a\x68\x96\x07\xe3\x03\x9e\xf3\x34\x48\xca\xb8\x0f\xba\xe4\xe7\xf8\xd7\x86\x08\x15\xc7\xd1\x3f\x80\x4f\x82\x5d\xd1\x97\xf9\x2e\x28\x33\xec\x33\xaa\xfa\xa2\xbf\x34\x9a\x4b\xcd\xd5\x1c\x69\x66\xce\x7e\x22\x2c\x4b\xfb\xaa\x69\xef\x1a\x72\xcf\x1d\x62\x7a\xc0\xbb\xd0\x25\x42\x85\xd1\x84\x88\x4a\xcf\xe7\x15\x5f\xb0\xcb\xd4\x4e\x17\x90\xa9\x0e\x8d\xb8\xb0\x11\x47\x06\x94\x50\x70\xee\xe0\xe9\x02\x1e\xae\xd3\xb3\x55\x2f\x75\x30\x3e\xd4\xbf\x74\xdc\x49\x78\xa7\x00\x2b\xd4\x7d\xd0\x80\x86\xbe\x21\x84\xf3\xa4\x28\xc1\xc6\xf5\x02\x56\xd3\xf3\x7a\xd5\x00\xa9\x79\xee\x00\x26\xe0\xd6\x8b\xdd\xdc\xc3\x0d\xcc\xbc\xf8\x44\x66\x52\x94\x35\x23\xe8\x65\x29\xc2\xc0\xd5\x6f\xe9\xf6\x33\xa9\xb1\x5f\x94\xda\xdf\xa4\x5e\x15\x58\x11\xbd\xf6\x7b\x06\x42\x8d\xbf\xda\xce\xbd\xd1\xd7\x7c\xec\xd1\x12\x6c\xe0\x63\x69\x79\xd4\x05\x85\xab\x69\x10\xea\x5b\x0b\xb0\x11\xdf\xae\x2f\xa8\xf0\xe6\x57\xb8\x23\x77\x77\x43\x7a\xac\x02\xe2\x37\x0c\x36\x85\x94\xb4\x34\x51\x74\xea\xd1\xb9\x72\x75\xd0\x9c\xd0\xcd\x73\x50\x56\x54\xf2\x7b\xee\x18\xc4\xf0\xc0\x74\x30\xf8\xa1\x78\x4f\x07\x72\x2b\x28\x44\x5c\xbe\x27\xa0\x8f\x9a\xd1\x32\xde\x6c\x01\x02\x32\x43\xa8\x98\xf7\x50\x10\x44\x6c\x33\x0c\x95\x5e\x0d\x09\xa9\x8a\x2e\xe8\x3f\x30\xa9\xe5\x9d\x70\x7b\xb2\xcc\x37\x71\x3b\x57\x34\xaa\x0a\x58\xc1\x97\x95\x02\xf4\xa4\x57\xa3\x08\x0d\x5e\xac\x28\x5c\x6b\xa3\xdc\x42\x06\x1d\x9a\xc0\x41\x30\x2e\x18\x93\x54\xae\xb5\x6a\x61\x79\x65\xfa\x87\x7e\x88\x48\x87\x98\xbe\xd8\xb2\x55\x09\x57\x6a\xe7\x54\x4a\xe0\xa7\xd7\x74\xb5\x1a\x14\xbe\x40\xb1\xd9\x78\x17\xff\x60\x67\x93\xdc\xc6\x28\x04\xfe\x84\xac\xac\xa1\x43\x62\x52\x04\x77\xb4\x87\x3d\x71\x31\xc1\xb6\xa2\xc0\xaa\x47\xa7\x6c\x16\x90\x76\x15\xb6\x36\x3c\x3d\x97\xdb\x0d\x15\xe6\xd0\xdb\xeb\x3d\xdc\x84\xc6\x6f\x45\x1d\x52\x58\x90\x82\x27\x2c\x3d\xb7\xe1\xdd\x41\xea\x8a\xea\x46\x22\x13\x15\xd4\xc4\xf3\x4b\xeb\x54\xbb\x70\x82\x24\x22\x30\x04\xde\xec\x0f\x54\x29\xf3\xb0\x64\x95\xb5\x82\x8b\x6e\xa8\xc0\xca\x4b\x0e\x16\x10\x08\x99\x9b\x9e\x9b\x17\x76\x79\xf8\x6a\x50\xc1\x7b\x0d\x10\xf5\x23\x77\x70\xc2\x00\xaf\x7b\xf7\x47\x63\xe2\x39\xef\x09\xcd\x09\xae\xdd\x8c\x3c\x29\xc2\x4e\xb6\x0e\xa9\x86\xb9\xdb\x38\x54\x7a\x85\xe9\x58\xe5\x17\x94\xf2\x40\x0d\x84\xee\x17\xc5\x15\xcc\x3b\x1c\xd0\xb8\xe2\x14\x16\xe4\x28\xe1\x79\x34\x08\x22\x0b\xda\x40\xe1\x17\x95\x5a\xda\xc2\x78\xb0\xa9\xc9\x12\x24\x89\x56\x03\x59\x23\xa6\xd7\x32\x9c\xf2\x7a\xe0\xfe\xb9\xfd\x2e\xb7\xd8\x02\x67\xad\x1f\x59\x9c\xac\xcd\x17\x30\xa1\x9a\xcb\xd3\xd0\xa1\x71\xae\xf9\xa4\x6e\x3f\xba\xd0\xc1\xc9\x9f\x72\xa6\x22\x1c\x55\xdc\xd9\xb4\xc1\x4f\x57\x17\x60\x36\xab\xb6\x65\xbb\x74\x1b\x5c\xdb\x54\x33\x1f\x36\xd7\xc6\xda\x37\xb2\xab\x8c\x4c\x12\xc4\x5e\x86\x37\xc6\x8b\xb2\xd8\xa8\x4a\xc0\xc3\x5e\x75\x05\x9b\x10\x99\x9f\x21\x26\x56\x06\x13\x60\xe9\xbf\x2d\x13\xe6\x9f\x11\x10\x80\x0b\x06\x36\xd1\x75\xd9\x5e\x4c\xc2\x98\x34\x25\x61\x53\x31\x9b\x44\x74\x05\x52\x84\x88\xe3\x1e\x9a\x91\xf9\x3a\x89\xa2\x91\x18\x45\x20\x27\x15\x4e\x68\x32\x71\xce\xb4\x94\x07\xdc\x4f\x8b\x2e\x35\xd7\x09\xe2\x9c\x0d\x0a\x5e\x0e\xae\xd9\x6a\x05\xce\xf2\xec\x92\x57\x82\x96\x91\xc1\x1c\x6c\xf4\x39\xb7\x47\xab\x53\x31\x23\xf2\x70\x55\xba\x00\x19\xb6\x89\x44\xa0\x1d\x44\x85\x4e\xcc\xcb\x0b\x08\xbf\x5e\xfa\x4f\xbc\x2c\x83\x8f\x1f\xc2\x9c\x05\x3c\xe5\xe9\x9e\x1f\xd0\x69\x1e\x71\x0b\x47\x42\x0d\xf6\xc3\x01\x61\xc3\x4d\x39\x65\x10\x17\xb5\x87\x09\x99\xf3\x14\x3e\x65\xb3\x49\x0f\xb6\xe0\x15\x0b\x41\x2e\x4b\xf0\xc0\x03\x2f\x0a\x0c\xcc\x8a\x88\x80\xc2\xca\x60\x27\xde\xb9\x75\xaf\xba\xc3\xf6\x82\x0c\x85\x48\xbb\xc3\x33\x78\xe8\xd3\xb7\xb3\x81\x15\xac\x39\x8b\x7e\x5d\xe7\x67\x38\xe5\x20\xfe\xfb\x81\xb8\x04\xed\x51\x07\x4f\x4b\x2f\xaa\x2d\x2f\x7b\x67\x57\x67\xeb\x87\x90\xd2\x8b\xed\xc5\xc5\x8a\xc6\x71\x27\x29\x09\xe8\x6d\xed\x18\xa7\x85\x7c\x6c\xdf\x7b\x82\xb7\xfb\x6c\x20\x8c\xdc\xa7\xc0\xc9\xa3\x0d\xed\x9f\x25\xca\x0b\xcb\x73\x41\x0b\x45\x1f\x99\x4f\xfb\xd2\x82\x8d\x64\xa9\xeb\x76\x91\xe2\xc4\x20\xea\x1a\x85\x47\x36\x54\x23\x58\x26\x07\x22\x09\xc5\xc2\x2b\xde\x36\x9f\xc2\xc6\xea\xba\xa9\x8f\x9f\x79\x77\x18\x98\x42\x2c\xae\xfb\x74\x42\xcd\xd5\x41\x13\x44\x5b\xd7\xd2\x5c\x56\x3f\x87\xa3\xe7\x9c\xd9\x27\xb5\xdc\x96\xe3\x8d\xb4\x60\x35\xf4\xd0\x5c\x36\x19\x78\x07\x1a\x00\x86\xf5\x00\x03\x81\xc1\xea\x37\xcf\xc9\x18\x89\xa0\x00\x08\xaa\xc2\x3e\x57\x10\xc7\xee\x67\xc3\xf7\x24\x20\x9d\xdb\xff\x55\x00\xfc\x7b\x09\x71\xdc\x93\x78\x0c\xa8\x8b\x4f\x99\x23\x7a\x04\x2a\x6a\xa1\x6a\xcf\x11\xb7\x73\xe4\x00\x08\x26\xcb\xa5\x7d\xca\xac\x5d\x87\xf0\x59\x4f\x07\x37\x8e\x46\x9f\xa3\xf1\x2c\x5c\x00\x7c\x34\x9e\x41\xa0\x55\xa9\xe0\x79\xd5\xc4\x3c\xc7\xa0\x17\x9d\x5d\x3b\x10\x9a\x31\x0f\x16\x9e\xbb\x1e\xd4\x9b\xa8\x81\xff\x91\xde\xd8\xf6\x80\x83\x06\x8d\x36\xb3\xdb\xef\x5c\x04\x1b\xbf\x87\x31\x8e\x1b\x0b\xb6\xb5\x7c\x43\xd8\x9b\x16\xf0\xf7\xc1\xf6\x63\x1a\xd5\x0f\x39\x9c\x61\xd5\xb1\xff\xfb\x57\x1f\x6c\xf7\x5a\x3d\x84\xd0\x9e\xf4\x46\x65\xca\x85\xb7\x95\x79\xf0\xfb\xc8\x31\x82\xee\xb9\x86\xd7\xf4\x7f\xb0\x51\x25\xc0\x97\x4e\x10\x3e\x8a\x4e\xa2\x11\x1b\x8d\x40\x78\xb3\x0f\x89\x82\x63\x2d\x18\x7d\xaa\x2a\x7c\x0c\xe1\x5a\x00\xf3\x28\xa7\xae\x18\x58\xb0\x66\x28\x97\xc6\x79\x42\xeb\xf5\x9a\xbf\x26\x48\xdf\x12\x26\x15\x8e\x3e\xdb\xe9\x3d\xf1\x10\x85\x1d\x7b\xc4\x3c\xf6\x02\x66\xd3\x9f\xa9\xc1\xa0\xf5\x81\xc4\x73\x13\xdd\xb7\x1e\x8c\xd2\x0e\x0e\x96\x26\xd2\xf3\xcb\x2d\x2b\xc1\x5f\xc7\x38\x78\x50\xf0\xee\xc0\x75\x64\xd5\x9c\x1b\x00\x75\x70\xe1\x54\x55\xc6\xf0\x89\x66\x35\x0b\x85\x47\x78\x9e\x31\xa9\x08\xc3\xcd\x6e\x75\x50\xe9\x46\x11\x0f\xf8\xb9\x03\x5c\x53\xa6\x39\x79\x55\xe9\xc6\x18\x36\xec\x32\xf1\x29\xfa\xad\x3e\x63\x5e\x54\xee\xa1\x3d\xb7\xc4\x1d\x7d\x1b\x86\x4d\x4b\x63\xf0\xd1\xe3\xa3\x01\x31\x3d\xf8\x28\xce\xf4\x24\xfc\x35\xa4\xd1\x36\xb2\x5a\xb8\x32\xaf\xa8\x40\x56\x03\x4f\xba\x2b\x8f\x28\x8d\x68\x0f\xea\x2b\xb7\xf9\xda\x77\x34\xe0\x41\x21\x0f\x41\xef\x4b\x1b\x3a\xdb\x90\x58\xf8\xe6\xa3\x79\xef\x56\xc4\x71\xe3\xb3\xe7\xed\xe9\x87\xed\xc5\xdb\x02\xeb\xb9\x61\xec\x56\xa4\x81\xe8\x12\x67\x13\xf6\x95\x16\x83\x36\x86\x61\x4e\xd8\x68\x84\x9c\x76\x0d\x4e\x9f\x5a\x65\xd0\x45\xa6\x6c\x16\x18\xf6\x7d\xc3\x2f\xda\x3b\x46\xed\x17\x04\x68\xdf\xc2\x3b\x57\xd0\x21\x3c\x36\x5d\xc0\x8b\xc1\x92\x70\x70\x7b\x98\xa8\x89\x16\xca\x13\x58\x80\x9a\x31\x81\x06\xc7\xac\xcf\xd6\x4b\xba\x90\xce\x42\x95\x08\x52\xc1\x2a\x7b\x6a\x72\xc0\x1d\xd3\x82\xa1\xdb\x24\x3b\xcb\x11\x32\x85\x05\x5d\x64\xe2\x80\x0b\xd8\x21\x86\x14\xb9\xe7\x71\x19\x12\x84\x41\xfc\x44\x3e\x15\x29\x9f\xe9\x3b\x09\xc3\x33\xf3\xd5\xd3\x02\x3a\xb8\x57\xcf\x41\x84\xd0\x30\xba\x1f\x9e\xdd\xf6\x24\xb5\x9e\x87\xd2\x07\xaa\xed\x22\x36\xfc\xb7\x7b\x71\x2a\x4f\x0a\x9f\xfd\x35\x88\x64\x85\xb8\xd5\x32\x1a\xe9\xd6\x6e\x76\x80\x32\xbf\xb2\xac\x42\xbd\xcd\x8b\x8e\xa9\xe8\xb5\x93\x6a\x61\xfa\xed\x0a\x8a\xfe\x13\x4c\x31\xd4\x46\x14\x38\x43\x39\xe8\x46\x6f\x4b\x53\x16\x4e\x79\x3f\x94\x49\xbb\x4f\x38\xf1\xd4\x05\xbf\x86\x82\x10\x3c\x24\x83\xaf\x9f\x6d\x35\xdf\xeb\x93\x26\x75\xc0\xb4\x7c\x0b\x52\xb9\x9d\x41\xfd\xe1\x1e\x43\x99\x50\xb8\x87\xc0\xc0\xc3\x07\xa2\xe9\xd8\xc8\x8c\x03\xae\x0f\xe1\x9e\x98\x46\x13\x66\xb8\x2a\x8e\x10\x8e\x6c\x44\x23\x50\x3e\xe1\xf4\x4b\xb7\x08\x1e\xb2\x1e\x88\x97\xe1\xee\x00\x6f\x5f\xac\x1a\x4f\xb0\xff\x61\x60\xec\x9c\xd6\x9e\x9e\x27\x53\x38\xa7\x1f\xa1\xd3\xcb\xf0\x32\x96\xc5\xa1\x3b\x6b\x1f\xf1\xfb\x2e\xb4\x77\x0e\x90\x73\x40\x1c\x98\x0a\xfd\x93\x63\x2c\x8f\xb6\x5c\xdf\x15\xa6\x65\x04\xcf\xd0\xca\x57\xa0\xe8\x16\x2b\x08\x6e\xc8\x8c\x5f\x91\x35\x4c\x79\xa5\x21\x17\x3a\xfa\xa4\x18\x81\xfb\xbb\x7e\xa9\x56\x34\x22\x62\xff\x18\x8e\xed\x3c\xd1\x9b\x4f\x30\x82\xe9\xbb\xf4\xdd\xf4\xdd\x6c\x76\x7a\x89\xa3\x93\xe0\x5a\xd4\xe9\xbb\xcf\x4f\x71\xf4\x8f\x1f\xde\x3c\xfb\x07\x44\xdd\x3e\x84\x1b\xd4\xa3\xb0\x49\xc7\x0a\x54\x1c\x47\xff\x19\x0d\xdb\x16\xd8\xa7\xbd\x02\x63\x10\xea\x9e\x57\xd7\xa2\xd8\xd8\xf9\x56\x90\xcd\x48\xe4\xf3\xe1\x12\xae\xe6\xca\x20\x3a\x81\xf9\xc6\x94\xd4\x7e\x71\xa7\xc0\xf6\xdc\x2d\x05\xcf\x9a\x11\xda\xdd\x14\x9a\xf4\xcc\xcb\xa1\xdf\x97\xd6\xb4\x49\x11\xae\x0d\xaf\x5b\x61\xdf\x11\xad\x35\x5f\x71\xe4\x59\x15\x61\xad\x47\xb0\x7d\x2b\x08\xed\x61\xc9\x8e\xa6\xe7\x5a\x3d\xb2\x84\x67\x3c\xa1\x77\x0b\x9e\x29\x0c\x3c\x11\xa2\x60\xea\x17\x58\xec\xc2\xd3\x40\x05\x83\xab\x07\x1f\x59\x28\xe1\x5d\xf1\x2e\x6e\x3c\x32\x9f\x85\x98\x57\x6d\x16\xfe\x73\xdf\xbc\x04\xb6\xb3\x1f\x74\xbe\x56\x17\xe7\xf4\x25\x6c\x15\x5b\x48\x0f\x49\xfd\x8d\x99\x2d\x97\xa0\xd5\x26\x65\x37\x96\x24\x0c\xd1\xc4\xbc\x46\xe5\xc4\x9f\x09\x1d\x12\x78\x04\x31\xac\x61\x48\x1c\xac\xd7\xc0\x0e\x2d\x2b\x95\x8d\x8f\x5e\x1d\x16\x5a\xd1\xfb\x18\x87\xd8\x50\x08\x1d\xbc\x1c\x08\xcf\x42\xb6\x78\x4e\x45\xa8\xb3\xa5\x43\x8c\xf8\xaa\x52\xa4\x16\x85\xb0\x6a\x95\x25\x12\x7b\x21\x84\x48\xf7\x13\x2b\xd7\x42\xb3\x31\xed\x6c\x63\x03\x65\xd5\x32\xaa\x0d\xab\x1b\x14\x4c\xa1\x59\x58\x98\x61\x1a\x84\x5c\xf6\x3b\x3d\x9c\x81\xea\x1d\x4b\xa0\x10\x4d\x82\xfc\x04\xbc\x51\xe8\xdb\xbb\x41\xe5\x9b\x66\x21\xe0\x72\xdf\x40\x41\xd9\x28\x84\x59\xb3\x96\xe8\xa9\xa6\x36\x89\x6a\x96\xd1\xb5\xfc\x80\x53\xb8\xf6\xf4\x4a\x5f\x5f\x6c\x2d\x80\xc8\xdc\x90\x81\x2c\x69\xc5\x59\x13\x6e\xc7\x93\xc3\xdb\x50\xc9\x09\x24\x30\x98\xe6\x80\x97\x85\x92\x0c\x51\xe6\xea\x94\x79\x63\xae\x6e\xd9\xd0\x35\xb8\x34\x76\xc4\xa4\xe4\xbf\x2d\x27\x85\x13\x40\x89\x7b\xd4\xa4\x05\xc9\xd9\x90\x28\x70\x0f\x23\x7d\xfd\x98\xb7\xf5\xfa\xb2\x3c\x00\xc7\x7a\xb5\x1d\xba\x61\xf2\xfa\xb9\x49\x2d\xc2\x18\xbd\x53\x5d\x57\xd7\xc5\xed\xd7\x8c\x97\x8c\x83\x5f\x23\x03\xfa\x07\x06\x03\x0c\xcd\x63\xfa\x4f\xb3\x48\x1b\x0f\x80\x58\x3d\xec\x8e\x15\xe5\xcf\x66\xb8\x5d\x58\x4a\x2f\xac\xbb\x65\xc9\xb0\xa8\x83\xe1\x6b\x27\xc5\x36\x57\x56\x8f\xdd\xe7\x83\x93\xcd\x3b\x76\x1f\x0f\x6c\x68\xfb\x71\xa9\xff\x4d\xfb\xcf\x1d\x28\x00\xac\x35\xec\x3d\xde\x16\xd4\x36\xfc\x7c\xd6\xa2\xe7\xc0\xce\xe3\x3b\xb8\xdb\xd6\xe3\xca\x4d\xe9\xcc\x99\x78\x0c\x0e\xdc\x02\x69\x6a\xc5\x3f\x35\xb7\x4b\x23\xf9\xf4\xf7\xda\xb0\xa9\xb4\x3a\xe4\x33\x6b\x3e\x69\x4d\xdc\x6f\x7f\x7b\x07\xce\x14\x22\x5b\x5d\x7d\xfb\xbf\x80\xc1\x86\xdd\xe5\x6e\x54\x7e\xdf\xd8\x2c\x9b\xad\x19\x8e\xee\xac\x2d\x7d\x39\x5d\xbb\x4b\x8b\xd4\xef\xb2\x95\x7c\xd3\x50\x48\x41\x54\x51\xfd\x23\xd7\x2a\x08\xd8\x0a\x55\x30\xfa\x36\x96\xa6\xb4\x01\x8d\x17\x81\xc2\xd1\xfd\x05\xe8\x7e\xe5\x47\xee\x6a\xb8\xc7\xa7\xcc\xfb\xfc\xcb\xed\x62\xb1\x62\xfc\xd2\xbe\x80\xbb\xe5\xc6\x1e\xac\xb3\xe7\x5b\x01\x5d\xbe\xda\x5e\xe8\x4d\x8f\x90\x87\x73\xad\x95\x35\xeb\x82\x9a\xf2\xf1\x27\xa7\xb6\x61\x85\x31\xd8\xab\x49\xcf\xa8\xb0\x04\xa7\xa2\x3e\x8b\xb7\xa5\x69\xb8\xdb\x56\xe1\x02\x2f\x1d\x6e\x40\x7f\xc9\x35\xab\x85\x37\xe8\xc8\xb6\x81\x56\x04\x82\x78\x33\xc9\xec\x04\x70\x85\x5f\x6f\x05\xd4\x44\x8d\x50\x79\x12\x14\x7c\x5d\xbd\x31\xf7\x10\x6a\xa1\x8f\x19\x39\x87\x39\xdd\x5b\xdb\x92\xd4\x09\xc5\x15\xd9\xda\x7d\xa6\x56\x4c\x28\x04\x30\x69\xa5\x81\xa9\xc1\x88\x19\x0a\x27\x4b\xc2\x82\xdd\xc3\xaa\xb6\xfa\x5c\xc7\x8d\xfd\x3b\x0e\x55\x0c\x77\xae\x70\x61\xf6\x13\x3d\x48\x06\x1b\x86\x76\xe9\x3d\xb6\x8f\x05\x9b\x1f\xb2\x8b\xd0\x0f\xdc\x4f\x63\x9d\x05\x77\x52\x0d\xd5\x4d\x0c\x71\xd6\x19\xfa\xa2\x78\x58\xb0\x97\xc8\x89\x79\x91\xcb\x3e\xf8\x15\xc7\xeb\x6d\x5a\x94\xe5\xcf\x8c\x5e\xeb\x6e\x20\xbe\xc0\xce\x4a\x22\xf5\x62\x73\x72\xc9\x02\x03\xfc\x99\xdb\x76\xb1\xd6\x96\xf5\xf7\x01\xcc\x33\x13\x71\x9f\x4d\x18\xac\x9c\x82\x48\x88\x0f\x5f\xec\xf7\x4d\x28\x5e\x57\x0f\xf5\x86\xe1\x66\xaa\x99\x0d\xc7\x2c\x45\x60\xae\xfd\xae\x29\x7c\x46\x51\xf3\x55\xa0\x5f\xdc\xd2\xd6\x88\x68\xac\xc1\xb2\x9a\x03\xa9\xfe\x3d\x3b\x95\x6d\xd8\xb6\xda\xda\xb8\xdc\x4b\x5e\x7e\x03\x87\x04\x78\xeb\xcf\xb4\xda\x9e\x0c\xfb\x34\x54\x33\xf9\x75\xf5\x52\xb7\xa4\x57\x56\xd8\x8e\x3b\x03\x32\xfd\x7b\x14\x28\xd6\xe0\x1f\xba\x9f\x09\xfc\x97\xea\x5b\x3c\x8c\x5f\xc2\xb5\xfe\xf0\xdb\xb5\x65\x68\xce\x3f\x8a\x7d\xcc\xa2\x1a\x14\x06\xa5\x76\xa6\xe5\x65\x42\x35\xf5\x22\x84\x8f\xcc\xb0\x91\x23\x12\x7e\x9c\x9c\xf6\xfb\xe9\xac\x4d\x52\x56\x01\x30\x7c\xde\xaa\x03\x21\xa5\x1d\xe9\xb0\x33\x71\x16\x31\x13\x0e\x4e\xed\x60\xa6\x26\xac\xb3\xd4\x8d\xa7\x3c\x51\x98\x6a\xe9\xfc\xf6\x22\xd1\xab\x39\x68\xff\x65\xc8\x5c\x7c\xc7\xb0\xba\xbd\xcc\xd6\x85\xc7\x2c\xb8\x33\x94\x71\xa6\xa7\x00\xd7\xde\x67\x50\xda\xca\x66\x1f\x5b\x8c\xa6\x80\x16\xc3\x89\xc2\xad\x89\x1c\x07\x6b\x81\x32\x63\xd1\x01\x7e\x01\xa1\x8b\x1e\xea\xb0\x22\xce\x4a\xd7\xf8\x00\xcf\x5f\xfb\x9e\x6c\x23\x75\x92\xf0\x38\x8e\x18\x58\x9a\x58\xaa\xf6\x7b\x88\x13\x12\xbd\x37\x9f\x00\x3d\x44\xfb\xb7\x37\x3c\x09\x28\x5f\x3d\x6c\x98\x84\xda\x64\x4d\xe9\x67\xa8\xbf\x68\x77\xb5\x05\x82\x82\xb7\x9d\x42\x60\x2c\x96\x2a\xc2\xf3\xe8\x7d\x94\x45\x2c\x3a\xa8\xf6\x96\x17\x68\xdf\x9c\xf9\x4d\xa8\x41\x7d\xfd\xa4\xd7\xa6\xbb\xf6\xf6\xdd\xa6\xc1\x83\x96\x21\xc0\x36\xf1\xd0\x50\x15\xac\x28\xf0\x28\x04\x40\x90\x8b\x53\x6e\x13\xea\x68\xe5\x0f\x5c\xc4\xea\x66\xd5\x31\x2e\x34\xb8\x78\x38\xc6\xe6\x5d\xcb\xc1\xab\x8f\x96\x3d\x73\x65\x9f\xbb\xb2\x15\xe4\xd9\xe4\x17\x2e\x59\x5a\xc2\xa8\x33\x1e\xce\x33\xb6\x48\x96\xc1\x55\x79\x4d\x39\x0e\x5c\x70\xb0\xc0\xc2\xd6\xb1\xd6\x12\x95\x56\xc2\x70\xa2\x61\x05\xaf\xf3\xba\x84\x3c\xd1\xf5\x1f\xce\x31\x98\xd9\x99\xad\x88\x32\xe8\x62\xbf\xef\x39\xad\xa6\x79\xa0\xea\xb2\xa0\x7e\xd8\x31\xca\xea\xf4\xe7\x75\xbb\x66\x6c\x36\xf5\x41\x9d\x7a\x17\x26\x83\xa5\xe1\x80\x72\x62\x89\x16\xac\x84\x33\x04\x62\x11\x84\xd2\x32\x68\x19\x8e\x75\x99\xaf\x5c\xf2\x27\x90\xba\x7d\xa7\xd8\xd6\x39\x11\x1f\x23\xfb\xdf\x4c\xe4\x6e\xe8\xea\xbe\xeb\xca\x68\x02\xe6\xd3\xef\xaa\x9e\x8f\xa9\x26\x1f\x63\x9e\x8f\x31\x2c\x03\x3e\xa6\x8e\xf1\xb1\x20\x03\x36\x5d\xe7\x50\x3e\xb0\x40\x10\x81\x87\x81\x4d\x5a\x74\x71\x58\xcb\xa9\xe6\xe9\x44\x3d\x15\xdf\xd3\x5b\xcd\x1e\xea\x9f\x70\x7c\x80\x9b\x18\xb2\x03\x02\xdb\x71\x15\xe6\xc0\xe3\x2c\x15\x8c\x09\x2c\x7f\xfa\x0c\x02\xb6\xa9\xca\x71\x80\x23\x68\x34\xce\xde\xb8\x77\x16\xc6\x08\xcb\xa9\x6e\x74\x06\x47\x40\xee\x3d\xe0\x4f\x06\x47\x23\xb4\x76\xa9\x49\xcc\x17\x11\x10\xe6\xb1\x3a\x22\x11\x6e\xdd\xbc\xce\x19\xb8\x72\xf6\x75\xe6\x9e\x8d\xa6\x08\x44\x24\x3b\xd4\xbb\xa7\xf6\x3d\xbd\x25\xcc\x4d\xb1\xd0\xe7\xa1\x9f\x30\xc9\x2d\xcc\xd8\x34\xe8\x17\xcc\x20\x9d\x29\x0f\x0b\x9b\x95\x11\xbc\x11\xef\x18\x89\x65\xd0\x34\x37\xcb\x32\xdb\xb2\x46\x24\xe5\x2a\x64\xc0\xed\x5e\xb4\x02\x30\x38\xcb\x12\xda\x1d\x32\x36\x76\x3d\x72\x86\xb9\xa5\x62\xfa\x89\x54\x7c\x36\x03\x4f\x7d\x37\x96\x31\x30\x32\x0f\x92\x61\x0d\xdc\x5d\xa6\xc6\x12\xa8\xb7\x0e\xf1\x20\x09\xf0\xb4\x38\x06\xe3\xbf\xe3\x09\x05\x70\x14\xcd\xec\xe2\x18\x78\x19\xda\x15\x70\x9f\x11\xe6\x6f\xae\xa9\x54\x27\x8c\x4d\x9c\x01\xcb\x28\x05\xe1\xb9\xdc\xef\x8b\xfd\x7e\x48\x33\x1a\xc7\x43\x19\xc7\xc3\x02\x8b\xdc\x0d\xdf\xfe\xb8\x3f\xce\x93\x5e\xaa\x26\x3d\xd4\x3c\x46\xb8\xb7\x6c\xc0\x48\x60\xbc\xe6\x28\x29\x4b\xac\x02\x73\x86\xb7\x16\x8f\xec\xd3\xf1\xb8\x6d\xe3\xb1\x3d\xc1\x5b\xd6\xd8\xa7\xcd\xc8\x3e\x7d\x38\x0d\xc1\xc1\xf4\xe4\x1e\x30\x5f\xb2\x4f\x18\xac\x83\xaf\x6f\x4c\x26\xeb\x2c\x40\x89\x87\x7d\xd9\x84\xdd\xca\x5e\xbe\xc0\x9c\xdd\xa9\x74\xac\x9a\x12\xb7\x66\x2b\x54\x47\x60\x20\x67\x46\x00\xef\xb5\xda\xd0\x51\x4b\x09\x00\xc3\x8d\x3b\x27\x09\x62\xe3\xf8\xae\x16\xac\xa9\xdf\x34\xeb\x37\xd0\xd1\xc8\x71\x88\xb0\x25\x7a\x74\x99\xa7\xe1\x6c\x05\xb6\xc7\x4e\x23\xa0\x8c\x3c\xb2\x67\x36\xde\xa2\xea\x70\x1c\x5a\x23\x48\xb8\x65\x94\x1a\x51\x47\x60\x56\xf9\x86\x65\x6b\xd6\x12\x80\xbd\xbe\xd4\xd5\x80\xc6\xbe\xe5\x8d\x99\x21\x1b\x7f\x20\x69\x2c\xf5\x75\x27\x37\x94\x93\xaf\x1c\x4e\x6d\x5c\x3f\xb0\xb8\x84\x16\xb1\x0e\x30\x93\xb6\xc1\x28\x20\x49\xf7\x4c\x7f\x08\x0b\xe6\x96\xd0\x0d\xcb\x7e\x0e\xd7\xd9\x6d\x64\xbf\x9e\xe6\xcc\x74\x99\x48\x29\x13\x64\xc9\x32\xa9\x6b\x34\xc0\xd2\xae\x4f\x7d\x66\xa6\x4b\x6a\x8d\x52\xb8\x6a\xf5\x60\x09\x52\x92\xb3\x49\x75\x5f\xd6\x2f\x16\xb6\xc1\x80\x33\xf7\xf0\xe6\xb0\x82\xf0\x41\x74\x48\x88\xcc\x93\x50\xf9\x6c\x28\xbf\xca\x5b\x6d\xeb\xc0\x2d\x34\x8e\xdd\xe8\x29\x1e\x07\xb8\x90\xf8\x4c\xdf\x8c\x68\xb4\xa5\xef\xcc\x03\xd8\x96\xa2\x6a\x1b\x7c\x68\x63\xc3\xa2\xe7\xb5\x6d\x53\x1f\x4e\x4e\x26\x2d\xf4\x7c\x4a\xfb\x9f\x64\xa5\xfb\x58\xc7\xc7\x68\xb7\x5f\xd7\xf7\x24\x78\x19\x90\xa0\xf3\x8f\x33\xed\xd6\x1e\x72\x66\x6a\x78\x00\x37\x6e\x58\x26\x8f\xcf\x87\xab\x07\x26\x35\xb7\xf7\x06\xc5\x03\xf3\xca\x6d\x93\x95\x61\x11\x2e\x83\x4e\xe7\x3d\x46\x61\xdb\x91\x0e\x70\xc3\xf4\xe7\x6b\xad\xf2\xb0\x45\xb2\x28\x12\x86\x43\xd3\x30\xc8\xb8\x84\xa5\x1f\xb6\x54\xdc\xbe\xa2\x2b\x3a\x57\x60\xb0\x72\x05\xc4\xa1\xd7\x76\x7c\x11\xca\x12\xde\x40\x1a\x5a\xcb\x1b\x90\x36\x78\xae\xee\x32\x80\x94\xd7\x90\x0a\x07\x29\xc4\xa2\x2c\x12\xa1\x35\x73\x73\x77\x50\xc0\xdd\x01\xd1\x04\x53\x1b\xef\x11\xd2\x16\xe2\x8a\x78\xe1\x48\x2f\xa7\x62\x52\xc0\x94\x98\xea\x72\x5a\x84\xf7\x1a\xae\x59\xcb\xd9\xa7\x45\xa7\x00\x88\x77\xd6\xb3\x5f\x7f\xd9\xc8\x7d\x13\x6e\x6a\x4f\x36\x53\x05\x7e\x33\xfa\x2f\x59\x15\x80\x64\x5f\xf4\x7d\x6b\xff\x02\x6f\x01\xa9\xa7\xc3\x84\xed\x29\xd6\xe0\x3f\x9c\x82\x31\x53\xdb\x9b\x13\x4a\x9e\x41\xec\x9a\xaa\xa4\xee\x7e\x9f\xdd\xab\x1f\x03\x07\x07\x43\x90\xbb\xb6\x9f\x44\xeb\xed\x4a\xb1\xcd\x8a\x46\x28\x87\xa7\xe3\x8f\xb8\xfe\xd9\x33\x73\x08\x97\xa2\x63\xe3\x82\x75\x01\x5e\x1a\xd7\xc9\xc1\x51\x35\x0b\x80\xf1\x67\x76\xba\x5f\x6b\x45\x09\x46\xf7\xb8\xb9\xbb\xd7\x03\x0b\x77\xf7\x73\x96\x78\x93\xaa\x9d\x8c\xee\xb5\x9c\xc6\xfb\xb0\x75\x31\x78\x05\x1c\xdc\xfb\xdc\xf3\xb0\x83\x62\x05\x37\x4f\x6f\x4d\x3e\x3c\xe6\xfc\x3f\x31\x41\xea\xc9\x27\x2f\x37\x89\x37\xf9\xe0\x3b\xf9\x7c\xc3\x24\x88\xf0\x1f\xa6\xa6\xb5\x57\x20\xdc\x18\xe3\x71\x1b\xe2\x03\x4f\x11\x93\xff\xa9\x2d\x70\x68\xce\x13\x35\xf8\xd6\xda\xd5\x30\x5f\xb5\x46\xf3\xc9\x96\x30\x0f\xef\xab\x60\x4d\xb9\xb3\x62\xd7\x4f\x7e\x4e\xe1\xaa\x83\xef\x18\x9e\x27\xf3\x95\x1f\x7a\xa9\xc4\x77\xa6\x2c\xa4\x7a\x0e\x9c\x90\x42\x3b\x28\x0c\x25\x89\xe7\x7d\x6c\xdc\x16\xb5\xc1\xd0\x5c\xa3\x71\xdc\xe1\x55\xfa\xcd\x2c\x9f\xa8\x45\x09\xe7\x20\x01\x53\x0c\x41\x41\xaa\x2b\x6a\x38\x3b\x9a\x50\xd2\x87\xc1\x16\x3d\xf4\x91\x11\x6d\x91\x0a\xbd\x63\x63\xfb\x34\xd6\xd3\x43\x6e\xc1\xc6\xf2\x22\x40\x8d\x1f\xa2\x5d\x1c\x34\x4f\x12\xe5\xbc\x46\xa0\x4d\x1d\x76\x39\x1c\x2c\xd5\xc1\x52\x3b\x8e\x71\xc1\xc4\x37\x77\x89\x27\x41\x8e\xeb\xcf\x67\xbf\x6c\x70\x05\xc8\x77\xe1\x0f\xed\x69\xbd\x4f\x98\xaa\x59\xde\x4d\x32\x8e\xa7\x6d\x90\xfe\x70\x23\xad\xc7\xb9\x95\xd4\x7a\x6b\xc0\x05\xb8\x90\x24\x9a\xe7\x9b\x81\x3b\x72\xd6\xcc\xf0\xbe\x53\x93\xc2\x78\x31\x18\x42\x86\x78\x53\xac\x8c\xec\x99\x3d\xc4\xa9\x8a\xe3\x70\xb9\x85\xb7\xd2\xf4\x0a\x06\xb4\xcb\xa9\x2f\x32\xc3\x8d\x05\x69\xfc\x49\x7a\x1a\x84\x8a\xe1\xa2\x48\xad\x27\x94\xad\xfb\x57\x16\xec\x8f\x9e\xc1\x04\xda\x60\xed\xf6\x0b\x02\xd0\x38\xd0\xbb\xad\x38\x00\x5b\x42\x6a\x0e\x33\xbf\x5d\xe6\xf0\xd9\x51\xe5\x1e\x75\xa6\x55\x57\x60\xf3\x1a\x36\x73\xb7\x99\xb8\xd8\x42\x06\x64\x70\x47\x32\x56\x5f\xb9\x36\x96\x79\x3d\x76\x33\x26\x26\xbf\xae\xaa\x15\x2d\x38\xb9\x35\x21\xa1\x1c\x76\x60\xd7\x31\xb8\xee\x04\x06\x34\x39\x79\xd2\xb3\x82\x48\x08\x50\x2b\xaf\xa9\x77\x59\x75\x3d\x34\x5b\xeb\x76\xdb\x07\x25\xc1\x89\x08\x6a\xcc\xea\x11\x2a\x33\x63\x73\x01\x83\x2a\x41\xaa\x75\xa8\xd1\x31\x09\xfe\x2e\xe0\x89\x47\x86\xc3\x4e\xe1\x38\xee\xa6\x39\xcf\x2b\xbd\x5b\x5b\x06\x58\x94\xe0\x0f\x6b\xae\x40\x37\x36\x80\x06\x72\xf3\xe1\x99\x1d\x9a\x7e\xce\xd7\x4f\xeb\x53\x23\xf2\x35\x46\x12\xc7\x8d\x4f\xab\x9d\x37\xfd\x1c\x9e\x05\x3b\x99\x23\x5d\x38\x37\xd1\x93\xa7\x03\x5c\xbc\x79\xf9\x34\x8e\x9b\xdf\x20\x64\x14\xe9\x52\xad\x57\xfb\x7d\x42\xc9\x7c\x3d\x75\xf3\x3d\x33\x07\x98\xf0\xdb\x8b\x24\x70\xdd\xd0\x2e\x0e\xfb\x18\x2d\x5c\xdc\x27\x14\xe1\xa4\x39\x4a\x53\x9f\xc9\xd7\xda\xa3\xc8\x1d\x00\x05\x7c\x02\x90\x15\xac\x44\xf0\x42\x52\x9d\xe5\xe6\xb0\x88\x8e\x6d\xeb\xfa\x7c\xaf\xe1\xee\xf3\x73\x93\x37\xc1\x39\xbe\xc6\x03\xb8\x6f\x7a\x04\xbc\x10\x74\xc1\x6e\xc0\x72\x61\xe9\x8c\x84\x71\xa5\x2a\x81\x65\xb0\x68\x61\xb9\x26\x06\x58\x78\xd4\x00\x02\xc0\x69\x19\x2b\x1a\x92\x26\x91\x43\x07\x71\xac\xe3\x09\x14\x82\x16\xbd\xf9\x80\x8e\x46\x63\xee\xdc\xbb\x51\xb4\x29\x5f\x9a\xe0\x1d\xb4\x64\x0a\x88\x35\x82\xd0\x6e\xfa\xa6\x38\xc4\x8f\x01\x4e\x02\x27\x58\x10\x52\x35\x6a\xb7\x53\x83\x64\x8f\xcf\x23\xf8\x24\x3b\x78\xb2\x55\x84\x32\xcd\x7e\x0f\x9e\x56\x0b\xf0\xb5\x3d\x44\x6e\x63\x77\x33\x6a\x19\x14\xcb\x69\x16\xe9\x7c\xa9\xfb\x07\x26\x66\xae\x32\x18\x56\x16\xc7\xb0\x49\xb9\x54\x60\x68\x7a\xac\xb6\xff\x68\xc2\x48\x78\x8b\xc1\x29\x40\x5a\xe6\xe6\xf5\x13\xa6\x10\x7d\x97\x8e\xee\x91\xe8\xde\xe8\x07\x96\xb0\xfa\x15\xfb\xf0\x4d\xcc\x1f\x1a\x4c\xd0\xbb\x0d\xc7\xa7\x97\xdd\x67\x17\xf4\x73\xf8\x51\x0c\x81\x7d\x1b\xc9\xf7\x74\xf2\x3f\xbe\xfc\x8f\xc6\xeb\x0b\x6f\x58\xf2\x91\x55\xe8\xec\xf3\xdd\x7d\xe9\xe3\x7b\x91\xef\xe8\x6d\xc7\x28\x13\x50\xbc\x77\x82\x81\xb7\x68\x68\x89\x9c\x49\xa4\x6f\x63\x82\x4b\xdc\x3e\xd3\xe8\x2b\x38\x38\x4d\x61\x60\xed\xd7\x67\xa4\xe0\x0e\x8c\x39\xf1\x4d\xe4\xed\xd6\x32\xf3\x17\x73\xf0\xba\xd8\x51\xa7\x4f\x80\x25\xd8\x18\x7e\xdd\x20\xfe\xbc\x73\x10\x00\xb4\x4c\x04\xda\xef\x13\x41\xc0\x75\xb9\x17\x4e\xea\xe1\xd4\x0e\xf6\xee\x1e\x38\xf7\x40\xf2\x36\x90\x46\x67\xc2\xdc\x83\xf7\x32\x11\x8d\x8b\xb2\x5f\xf7\xb1\x82\xaa\xac\x59\x81\xae\x3d\x81\x9b\x05\x14\xf0\x4b\x38\x09\x70\xd2\x76\x6d\xfe\x70\x64\x94\x30\x0c\x98\x29\x45\x84\x6d\x08\x8b\x90\x7b\xf5\x2f\x66\x1b\xf2\xdd\x55\xfa\x84\xc2\x84\xdc\x55\xc8\x86\x91\x8a\xe3\xa1\x6b\x34\x8e\x1b\x15\xbc\x6a\xcb\x49\x5f\x46\x2a\xd9\x05\xb8\x3f\x41\xb0\x7b\x17\x69\xce\x48\xf4\xcc\x3e\xb9\x41\xf5\xf5\x61\x3c\xa4\xae\x36\xa0\xc0\xac\x6d\xc0\x44\x33\xc3\x82\x62\x0a\x0c\x6a\xfd\xc6\xdc\xc3\x4e\xa8\xde\xd3\x41\x30\x5f\x16\xbc\x5c\xd1\x87\x3a\x36\x6b\x82\x0e\x7d\xca\x98\xa4\x2a\xa1\x96\x63\x99\x5d\x29\x7c\x25\xe8\xb3\xc6\x14\xe9\x11\xea\x81\x18\x70\x20\x14\x74\x3d\xf9\x95\x55\xb7\x71\x41\x98\x1d\x3c\x80\xae\x08\xeb\x47\x3d\x96\x10\xc5\xd4\xdc\xe2\xb4\x71\x98\xcd\x5d\x10\xa8\x46\x2a\x30\x3b\x99\x21\x02\x64\x49\x91\x32\xf9\xd0\x4c\xad\x9f\x64\x38\x7d\x3a\x92\x33\xd6\xf1\x32\x07\xfd\xb9\x15\x09\xdd\xe8\x7f\x72\xfc\x09\x86\x93\xce\x57\x85\x94\x7a\x1f\xe6\x81\xd8\x11\xac\x82\xdf\x3e\xbe\x0a\xda\xec\x8a\xcf\x20\xac\x4b\xca\x4a\x12\xdc\x52\xf9\x36\x40\xb0\xb5\xe6\x42\x71\xab\xec\xe8\xa6\xcc\xc4\x98\x1d\x1d\x4e\x08\x40\x78\x94\xea\x76\x05\x6c\x24\xc4\xed\x5c\xca\xd7\xf4\x46\x45\x0d\x51\xe6\xfb\x26\xac\x06\x3c\xbf\x3f\x82\x94\x50\xb7\xe9\x39\xa3\x75\xf5\x01\x58\x7c\x9c\x1e\x12\x9e\xdc\x7c\xf3\x71\x14\xb4\xd7\x3e\xe1\xad\x3e\x6c\xba\x05\x86\x43\xe0\xdb\xf0\x2a\xd1\xaf\x6e\x5e\xba\x70\x4d\x3b\x52\xd3\x2c\x18\x60\xd0\xc8\x77\x77\x41\xea\xa4\x17\xcd\xd9\xe1\x4b\x8b\x2d\xba\x8d\xa6\xbc\xc2\xf3\x26\xba\x7f\x7c\x05\x01\x25\x71\x22\xf7\x7b\x81\x82\xcd\x17\x65\xcd\x6d\x3e\x17\xad\x8a\x09\x83\x73\xa0\xcc\x2a\xe5\x41\x7a\x7d\x9f\x96\x10\x91\xf7\x66\x76\x9a\xe9\xf6\xed\x47\xfd\xcb\x91\xb5\x6b\x86\xe8\x17\xb1\x34\x8b\x78\x62\x76\x5e\x96\x53\x72\xb1\xce\xcc\x42\xd5\x09\x81\x59\x4f\x98\x4d\x39\xcc\xa6\x44\x1c\xb7\xdc\x6d\xd6\x59\xb9\xce\xbc\xf4\xe6\xda\xc8\x29\x79\xbf\xd6\x03\x1e\x12\xf1\x31\xc9\x2c\xa7\xe4\x66\x9d\x79\x69\xcc\x82\x91\xa8\x4e\x5d\x50\xa2\x22\xf0\x35\x8d\x16\xac\x8e\x27\xac\xf2\x27\x45\x16\x89\xa2\x64\x95\xfe\x84\x8b\xe8\x86\x47\xc5\xb1\x93\xf6\x5c\x9a\x6e\x3a\xbf\x5a\x67\xef\xd7\x6e\x2e\x6b\xa1\x3b\x28\xcd\x72\xdf\xa0\xd4\xea\x65\x4e\xc9\x7a\x9d\x45\x9a\xc1\x5c\x54\x37\x3e\x43\x0b\x61\x97\x6b\x94\xf9\x08\xc7\x0c\xde\x28\xec\xae\xe0\x9c\x92\x6b\x68\x02\xd8\x0f\xc8\xb1\x6c\xbf\x97\x9e\x42\xb5\xc2\xd1\xfc\xf6\x0a\x47\xde\x56\x06\x74\xaf\x8f\xd7\x28\xa3\xe4\x76\x8d\xc1\x13\x82\x92\xf3\xb5\x59\xe5\xb5\x88\x4f\xe8\x31\x81\x5f\x89\xc0\x56\x4e\x68\x1e\xc9\xab\xcb\x28\x8b\x4a\x76\x15\x59\xdb\xcb\xc3\x75\xc0\x18\xa2\xaf\x74\x58\x4a\x88\x28\x34\x8a\xee\x43\xa4\x59\x3e\x8a\xee\x47\xf8\x59\xf2\x70\x1d\x9c\x9c\x80\xe0\xea\x6d\x21\x41\x7f\x54\xb4\x6e\xaa\x7a\xe9\x85\xdb\x2d\x01\x4e\x39\x61\xec\xc0\x07\x35\x12\xcc\xee\x30\x1c\x1b\x90\xc2\x23\x43\x2e\x6c\xd8\xc2\x89\xbf\x2a\xa9\xb7\x96\x68\x51\x89\xb5\x37\x50\x9b\x46\x06\xc1\x66\xc0\x84\x65\x3a\x8e\x7d\x59\xca\x68\xed\xa9\xae\x8a\x10\x76\xb1\x4d\x9e\xae\x1b\x27\x9c\xa4\xd9\xd0\x31\xb7\xdd\x4e\x4f\x66\x53\x36\xf3\xd5\x64\x93\x0a\xd8\x64\x00\xb0\x74\xbd\x93\xde\xc6\xcc\x6e\x32\x51\xe9\xb9\x62\x6b\x5a\x6d\x55\x1c\xcf\x57\xb4\x10\xaf\xeb\xaf\xc4\x67\x20\xec\x7f\x13\x49\x95\x2d\x61\xfb\x02\xcc\x04\xa6\x1a\x3b\x58\xda\xa8\x67\xac\x2b\x3e\x14\x73\xba\x2a\xfe\xbc\x0d\xd0\x55\x89\x86\x0f\x10\x51\x23\x3a\x72\x8e\xef\x6f\xd7\xb5\x37\x8b\xfe\x0b\x71\xcf\x7d\xbd\x42\x34\x34\x14\xb7\xaf\xfb\x12\xdb\x66\x09\xdb\x7f\xaf\x08\xe2\x6b\x2d\x45\x8f\xef\x3c\x51\x01\xad\x5a\xe0\x42\x91\xcf\x4b\x79\x36\xe2\x3d\x18\x9f\x6d\x22\x01\xce\x06\x5a\xfc\xc7\x58\xdb\x7e\x5f\xa5\xad\xc4\x38\x9e\x8b\xa4\x93\x0a\x8f\xf0\xe8\x74\x0d\x3f\xca\x39\xf9\x21\x64\x89\x35\x3d\xe7\xa0\x8e\xf4\x73\x44\xcf\xb0\xe8\x7e\xdf\xe0\x53\x3a\x5c\xbe\x6e\x1a\x9a\x40\x58\xd4\x1f\x46\x56\x42\xf0\x64\xb5\x88\xe3\x75\x12\x3d\x18\xe8\x36\xcc\x43\x23\xf3\x82\xd7\x0f\xbd\xa8\xeb\xea\xe4\xba\xb8\x1d\x98\xa1\x0f\x60\x11\x29\xa9\xe3\xb0\xfa\xe7\x61\xea\xb7\x6f\x95\x1c\x98\x66\x83\x87\x63\x4e\x74\xb4\x20\x08\xfe\x14\xe1\x9d\x21\x5e\xe3\x4e\x09\xb1\xa4\x73\x4e\x02\xd8\xf3\xcf\xd6\xd9\x6f\xeb\x4c\x80\x0f\x69\x23\xfd\xeb\x75\xf6\xfd\x1a\xa1\xcc\xf1\x7d\xda\xc6\x17\xbd\xca\x82\x84\xba\x01\x77\xfb\x56\x9b\x20\x04\xac\x6c\xfd\x91\xf3\xab\xec\x99\x6e\xce\x6f\x7e\x56\xf1\x6f\xb6\xaa\x8e\x6f\x7e\xbf\xac\xb3\x5f\x5b\x9b\x5f\x4f\x1b\x1a\x90\x67\x6b\x84\xb9\x8e\x48\x01\x76\x3b\x70\x5f\x45\xb0\x49\x18\x3e\x90\xcb\x8e\xcd\x72\xde\x20\x75\x30\x45\x79\xbb\x9b\x2f\xb5\x6a\xf1\x06\x00\xd5\xac\xe4\x89\xb2\xa6\xb8\x65\xa1\xc3\x3e\x50\x4e\x45\xbd\x8f\xac\xf4\x57\x82\xb2\xa1\x55\xd4\x83\x22\x26\xc5\xf9\x29\x04\x0b\x74\xa1\x61\x7a\xbb\x35\x16\x3c\xb0\x2e\xb6\x7b\xc5\x3b\x1d\xde\xaa\xf9\xb4\x64\x29\x5a\x56\x77\xa0\x81\x8a\x07\x92\x8d\x79\xae\xc4\x57\xd9\x34\x79\x88\x5d\xb3\xce\xbb\xc5\x2c\x7d\x7b\x58\x14\xda\x6a\x7b\xcd\xaa\xad\x04\x73\x04\x02\xfb\x0d\x35\xfe\x08\xce\x9f\x21\xfa\x5c\xbf\xa0\xb0\x4a\xee\x3d\x87\x97\x6e\xdd\x2d\x7d\x08\x7e\x77\x73\x7b\xa2\xc7\x07\x4f\x86\xdd\xba\x80\xfe\xd1\xe7\x11\x84\xbd\x2a\xe7\x85\x28\x25\x1e\x7c\xf5\x6f\x72\x50\xf1\x93\x7f\x93\x24\x4a\xd3\x34\x3a\xbd\x6f\x63\x8e\xea\x67\x7c\xee\x19\x2b\x23\x76\x66\xe4\xfa\x79\x1f\x6d\xde\xe3\xe9\x1a\x2e\x8f\xf3\xb4\x48\xcd\x05\x0c\x1b\x14\x2e\x5d\x37\xbc\xf1\xbd\xe7\xcb\x82\x93\xdf\xca\x04\x6a\x48\xf7\x24\x19\xfa\x34\x24\x18\x17\x04\x8f\x35\x41\x17\x2f\xcd\x25\x42\xdd\x41\x8f\x67\x50\x20\x38\xd8\xb0\xbe\x9c\x6c\xaf\xdc\x13\x47\x79\xe5\x40\x84\x4b\xff\x35\x39\x3c\xbf\xf8\x83\xce\x55\x36\x3c\xc3\x60\x44\xe4\x7a\xea\x64\x06\x71\xf0\x73\xf8\xcf\xbe\x2e\x9f\x46\x08\x9e\xcd\xcd\xaa\x06\x20\xf5\x79\xf9\x4f\x65\x52\x61\x15\xdc\xd9\x77\x14\x05\xa5\x35\xcc\x3a\xa2\xe6\xc1\xdf\xae\x58\x30\x41\xc9\xba\x0e\x6c\xcf\xe1\x49\x14\x8e\x3b\xc6\x78\x08\xde\xcb\xba\xb6\x74\x76\x97\x15\xdd\x34\x5f\x13\xb1\xf6\xb7\x2c\xad\xe7\xcb\x2d\x2f\xd6\x6c\xfe\xa2\x10\xc5\x5a\x76\x5b\xe5\x69\x79\xbc\x5d\xbb\x9b\x35\xa0\xbf\x14\xf0\x82\x4f\xe1\x8c\xc8\xa6\xe1\xb4\x08\xca\x5c\x89\x50\x31\x58\x37\x37\x3b\xf3\x74\x8f\xeb\xcf\xfb\x59\x3b\x14\x18\x55\xab\x26\xb4\x59\xe7\x88\xfb\x5e\xe3\x88\x1b\x84\x1f\x13\x27\x93\xde\x68\x8e\xa1\xec\x5b\x54\x49\x74\x6f\x14\xb4\x34\xba\x17\xa1\x7b\xc8\xde\x3d\xb2\x34\xd1\x3c\xe6\x0f\x97\xf7\x24\x88\x01\x4a\x5c\x3c\x55\x43\x63\x70\xc3\x2e\x0d\x48\x49\x5b\x56\x18\x81\x80\x8e\x3c\x0d\x68\xca\xd2\x3f\x48\x9a\xe0\xc4\x24\xac\x13\x13\x23\x6c\xda\x28\x39\x95\xb3\x59\x6d\x41\x00\xe7\x83\xa6\xcc\xc6\xdd\xf9\x3c\x44\x03\x78\xb3\x4d\x29\xff\xb0\xa5\x5b\x6a\xe3\xcb\x98\x75\x67\x42\x40\x82\x24\x80\x21\xda\x6b\x03\x8f\x26\x13\x5e\xe5\xc1\x6f\xb6\x69\x49\x4d\x0b\xc1\x54\x5d\xfd\x05\x76\x8a\x21\xbe\x8f\x73\xf7\x28\xd6\x32\xe4\xaf\x97\x7e\xce\x49\x97\x0e\x75\x7b\x26\xf4\x4f\x82\x26\x76\x09\x78\x3f\x7b\xad\x4b\xd8\xb7\x83\x93\x31\xb6\x47\x6c\x27\x5f\x20\x84\xff\x0a\x78\x34\x04\xea\xb6\x49\x88\x58\x90\xdd\x61\x42\x9b\x22\xac\xb6\xaa\xea\x11\xc2\x35\x4e\x80\x67\x86\xeb\xf8\x24\x4e\x81\x6d\xf1\x2c\x90\x92\x12\x41\x3e\x94\x6e\xd1\xc3\x9d\x78\x0e\x7e\x60\x34\xd9\x81\xe1\x40\x23\x09\x57\x82\x5d\x32\x5e\xac\x32\x85\x35\x5f\xcf\x04\x36\x24\x98\x39\x93\x18\x18\xf1\xb1\xf1\x2d\xce\x7a\x85\x77\x57\xb4\x11\xa0\xe3\xc2\x6d\xba\xd6\x90\x03\x7b\xbe\x37\x3f\x1b\x86\xae\x69\x54\x91\xcb\xc4\x46\x7c\xf4\x5b\x15\xa6\x08\x99\xfb\x98\x52\x55\x6b\x62\x4f\x0e\xc1\x6c\x71\x2d\x1a\x61\x71\x87\x49\x54\xf1\x68\x54\xc7\xbd\xb4\x85\xf6\xfb\x6b\xc6\xcb\xea\x3a\x8e\x7d\x66\x9d\xb2\xdf\xab\xc2\xb9\x4a\xc1\xe2\x49\x16\xf0\xbc\xd2\x7e\x7f\x95\x3c\x2a\x12\x6a\xa2\x4f\x46\x08\x7b\x5c\xe9\x6e\x0f\xd6\x3f\x0e\x3e\xc0\xe2\xa8\xdf\x3d\xb2\x32\x41\x42\xf1\xf2\x0a\xae\x52\x1c\xda\xd2\x02\x78\x72\x7a\xcc\x5c\x87\x42\xcb\xea\xaa\x76\x2f\xd2\x7f\x83\x88\xc3\x4e\xef\x6c\xda\xe5\x27\xd6\xa7\xdb\x78\x16\x62\x87\x7c\xc2\xed\x2f\x3d\x63\xde\x1f\x9c\xf7\xcd\x99\x2b\x0c\x64\x61\x69\x4b\x5f\xc6\x85\xd0\x16\x07\x84\x35\x3c\x1e\xe6\x1b\xab\x9c\xda\x04\xcd\x96\x74\x58\xe7\xf0\xec\x30\x0c\xb1\x33\x08\x16\x7a\x6e\xbd\xcb\xfa\xe9\x35\x08\x97\xd0\xd8\xd3\xec\xbe\xaa\x6f\xb0\x74\x76\xa5\x60\x7f\x89\x63\x63\xa4\x04\x2c\x55\x36\x04\x41\x77\x95\xbb\x72\x8d\xd4\x46\x14\x82\xf7\x4e\xf3\x05\xc4\x37\xa1\x86\x14\xef\x17\xe2\x26\xc5\x21\xd0\x52\x79\x6d\x84\x33\xa7\x97\x35\x06\xf6\xfb\x36\x5b\x40\x71\xdc\x90\x33\x3d\x08\x8f\x0d\xb2\x1b\x7b\x02\x90\x47\x20\xd3\x9e\xb7\xa5\x45\x83\xe8\x8f\x60\xf2\xb7\x8f\x22\x32\xf8\xf0\x17\x46\x8e\xa2\xb3\x9b\xe6\x2a\x85\x48\x7d\xe0\x90\x5a\xaf\xe5\x3c\xf8\x1d\x68\x8e\x7e\xa5\x19\xd3\x5f\x73\x7d\x39\x2e\x62\xd6\x19\xee\xae\xb3\xc0\xaf\xf9\x55\x53\xea\x9f\xf8\x40\x66\xd6\x82\xaa\x3f\x3e\x72\x8d\xfb\x21\x2c\x56\xac\x6c\xd9\xb1\x13\x6c\x3e\x1a\x1f\xe2\xa1\xe8\x7f\x5a\x04\x86\x87\xe1\x5c\x5a\x90\x67\x89\x3b\xac\x42\xb8\xab\x44\xd9\x63\x02\x76\x87\x7a\x65\x0c\xe3\x10\x85\x26\x4f\xfa\x24\x51\x0b\x82\x80\x6d\xc5\x91\x6c\xae\xda\xe7\x5e\xd6\xde\x2b\x08\xcb\x9f\x0b\x1d\x9a\x26\x83\xb3\x2b\x0c\x4a\x26\xc4\x4d\x05\xbd\xde\x1d\x89\x09\x78\x20\x15\x4e\xdf\xea\x60\xe6\xf0\x6a\xaf\xcf\x1c\x9e\x05\x36\x80\xd0\xe1\x50\x47\xe8\x79\x63\x2c\x6d\xe0\xbc\xfc\xf1\x42\x1e\x9f\xcf\x3f\xcd\x3e\x46\x08\x45\x5d\x5b\xd8\x0b\xbb\xa4\x0c\x94\xe4\x89\x70\xd1\xbd\xfc\xc0\x40\x82\x34\x3f\xed\xbc\x98\x2b\x1d\x70\x98\x04\xef\xd7\x51\xf3\x7e\x9d\xc3\x3a\x4d\x0b\xeb\x76\x01\x3f\xdb\x21\x66\x4a\x26\x41\x17\x2d\xc1\xc4\x91\xb8\xb2\x84\xa6\x0b\x84\x8d\x45\x99\x96\x11\x5c\x80\x49\x8b\x38\x76\xad\x3a\x28\x7a\x09\xc2\x1d\x07\x43\x83\xb6\x91\x00\x53\xaf\x35\xe5\xd9\x36\xe2\xf8\x69\x67\x54\x8d\x88\x20\x80\x8a\x7a\x1b\x46\x75\x68\xc3\xc0\xd4\xdd\x32\x10\x76\x63\x1a\xfa\x66\x5e\x76\xe9\x5d\xfb\x16\x7b\x57\xa2\x5f\x96\x56\x14\xf8\x98\xce\x65\xe4\x61\xab\x13\x10\x7f\xc7\x31\x5c\x7c\xc4\x5f\x27\x05\xec\xdb\xdf\x5e\x82\x20\x20\xb5\x7a\x99\xcc\xdc\x4d\x36\x3b\xa7\xe5\xae\xf5\x85\xa9\x40\xd7\x15\x6b\x10\xa3\x11\x8e\x6a\x12\x68\x9d\xc4\x03\x25\x81\xec\xc4\xdd\x04\xb6\x0a\x24\xe1\xdb\x1d\x4e\x01\xad\xf9\x03\x79\x25\x10\xf6\xd6\xd7\x76\x25\xc8\x30\x61\x72\x40\xb3\x84\x70\x0a\xdc\xf8\x54\x71\xc3\x76\x9c\xc1\x48\x92\x17\x36\x2b\x2d\x4c\xe6\xbc\xe2\xa5\x8e\xa0\x5d\xac\x1c\xd5\x48\xf2\xd2\x95\x03\xf3\x4a\xba\x70\xbd\x59\xf4\xf7\xa8\x60\x26\x38\x01\x0b\x54\xaf\xe6\x65\x72\x3c\x97\xf2\x69\x29\xf5\xd9\x05\xf0\xc4\x8a\x30\x13\xe7\x07\x17\xe6\x07\xbc\xee\x33\xce\x2b\x32\x1c\x67\x61\xd2\x19\x5c\x00\xf4\x81\x83\x4c\x46\x65\x8e\xc5\x8a\x3f\x6f\x49\xa1\x6d\xa5\xb8\x02\xc6\x42\xd8\x95\x1f\xa5\xbf\xf1\x6d\xec\x8b\xda\x9d\xc6\xce\x76\x7a\xde\x08\x34\x24\xa7\x0d\x47\x94\x99\xe5\xfa\x9f\x50\x54\xe3\xde\x2c\x7d\x88\x58\xcd\xd3\x2b\xdb\xb3\xde\xea\xbf\xd5\x26\x70\x21\xc9\xb5\x03\xee\x4a\x17\xab\x6c\xb1\x92\xce\x2b\x01\x5e\x5d\x5a\xbf\x7d\xe0\x8a\x55\x76\xfb\x60\x5c\x09\x38\x31\x56\x67\xfb\x3d\x4f\xd5\xb8\x4e\xad\xb6\x8d\xd4\x2f\xfc\xea\xfa\xc3\x72\x3f\x9b\x50\x0b\x60\x0d\xcf\xad\xc0\x08\xe4\x3c\x6d\x3c\xbd\xc4\x71\x2b\xc1\x6d\x16\xfc\x2e\xf2\x89\xe3\xe3\x79\x9d\x16\x1a\xd8\x89\xe3\x6e\x5a\xa7\x86\x43\x94\x17\xcd\x6c\x8a\x29\x61\xdd\x63\x5c\x01\x9b\x80\xec\xe4\xaf\xd8\x15\xfd\x69\x4b\x05\xa3\xd2\x5c\x2a\x70\x13\x8d\x99\xbb\x60\x50\x3b\x1b\xc0\x75\xcd\xf4\x7c\x5d\xbc\xa7\x8f\x60\x47\x4f\x02\x81\xde\xdf\x05\x6a\x48\x77\x28\x8e\xdf\x27\x02\x47\x86\x40\x22\xdc\xf4\x92\xfb\xd1\xb3\xbd\x49\x12\x9a\xcb\xd3\x6b\x56\xc2\x9b\xc7\x8d\xb4\x25\x65\x97\x4b\xb8\x1f\xa1\x8e\xe8\x10\xd1\xaa\x2a\xca\x08\x07\x8f\x8f\x58\x4d\xa0\xbd\x19\xe8\xe6\x7b\xa5\x83\xba\x93\x08\x4d\xdc\x7e\xc2\x5d\x87\xb2\xa7\x0d\xcc\xdb\x37\x04\x7a\x8a\x9a\x46\x41\x53\x57\xc7\x45\x34\x0b\xbe\x56\x83\x70\xc3\xc3\xfb\x91\xd9\x95\x8e\x8c\x5b\x3f\x9c\x1b\xe1\x67\xa2\x59\xeb\x69\x58\xab\xb7\xc7\x23\x15\x9f\x35\xc5\x3f\x2f\xee\x68\x03\xe5\x04\x44\x3e\x55\x08\x1d\x5c\xab\xc1\x77\x1d\x85\xfe\x2c\x10\x5e\x83\x55\xa3\x11\x6f\xf6\x67\x03\x4f\x4b\xa7\x02\x2f\x12\xe5\xbc\x48\xe0\xc6\x99\xbd\x5c\x11\xd4\xfd\xa1\x6b\xae\x3d\x6e\xa7\x65\x0d\x4b\x2d\x93\x4f\x6b\x86\x81\xe1\xa2\x3d\xdf\xef\x29\xee\xbe\x30\x23\xf6\xfb\x44\x76\xb9\xb9\x68\xf1\x72\x7d\x91\xbc\x11\xf0\x57\x7a\x49\x3f\x82\x33\x57\xe1\x39\x2d\x6c\x4f\x44\x80\xf4\x93\xdb\x9d\x17\x8c\x6b\x34\x2d\x32\x9a\x96\x71\xdc\xd7\x21\x4d\xcb\x6e\x97\x61\xed\x96\xb5\x25\xe8\xdf\x94\x3b\x5f\x70\x30\x09\x28\x51\x70\xf3\x94\x75\x04\x12\x85\xcf\x35\xaa\xba\xc0\x41\x19\xd0\xd7\xcd\x24\x7b\xdb\xe1\x21\x14\x21\xdf\x34\x8e\x0b\x7c\xfa\x5b\xa0\x15\x7a\x99\x2e\x59\x59\x52\xff\x78\xd5\xf4\xd7\xab\xc0\xad\xee\xcf\x66\xa1\x50\xba\xfc\xba\x95\x15\x68\x21\x1f\x1c\x19\xe2\x20\xa6\x8c\x05\x81\xdc\xa5\x68\x76\x0f\xb1\xf4\x69\xb9\xf5\x52\xb1\xaf\x68\x78\x4e\x21\xd0\x0e\x22\x8a\x0c\x05\x84\xd8\x33\x14\x13\xc7\x9f\x69\xaa\x03\x32\x77\x4b\xc0\xe3\x4c\x9f\xd0\x61\x06\x1c\x91\x17\x97\x54\x98\x25\xa6\x2f\xca\xc3\x1e\x6d\xe2\xc3\x9c\x2f\x78\xa0\x59\x9e\x3b\xab\x9e\x43\x33\xd6\x66\xbd\x99\x7b\xef\xd4\x4f\xb6\x7d\xbd\xa9\x01\x73\xb8\xa2\x0c\x7c\x3b\x9a\xab\x16\xcb\xa9\x87\x0b\x8a\x48\x72\x14\x13\x06\xe0\x6e\x56\xd0\xc3\x4f\x7d\x73\x60\x5d\xed\x80\x48\xfc\x7c\xfc\x26\xcc\xa1\xb5\x99\x10\x98\x08\xb2\x2a\xfc\x5a\xc0\xd6\x57\xbf\x29\xb1\xa6\xb5\x38\x64\xb6\xbc\x1e\xf6\x59\x16\xaa\x38\x31\xf8\x3f\x99\x4b\x19\xdd\xd1\x4a\xd3\xfc\xeb\x28\x36\x82\x10\xb7\x10\xb3\xd6\x85\x57\x1f\xc0\x63\xc3\x37\x16\x3a\xd0\x2b\x23\x3b\xc7\x2e\xf6\xea\x4e\xf3\x3b\xbd\x20\x9c\x71\xaf\xd8\x26\x3d\xbd\x23\xac\xb7\x6f\x99\xb9\xa7\x0d\x01\x37\x99\x38\xd8\xd5\x1b\xec\x63\x96\x49\x76\xa1\x74\xb7\xc0\x13\x7f\x0d\xfa\x6e\xe1\xe1\xd3\xda\xb0\xbb\x7e\x1c\x87\x6f\x66\xbb\x79\xc9\x93\x50\xcc\x5e\x98\x9b\x37\x7a\x22\xc0\x72\xd9\x72\xe4\x77\xbc\x6f\x38\x6e\x35\xef\x2e\x3a\x93\x27\x45\xe8\xad\x72\xa4\xa3\x95\xe9\xc8\xaa\xff\x77\x35\x16\x4a\xae\x86\x50\x9a\x44\xdd\x3a\xeb\x8e\x50\x7e\xb4\xb5\xc0\x52\xd2\xb9\xb0\xea\xca\x1b\x1c\x22\xcb\x55\x5d\xdf\x66\x08\x46\xe1\xb6\xc5\x02\x94\x39\x4e\x61\xaa\x90\xb0\x02\xc2\x5d\xb9\x2e\x8e\xbb\x69\x9f\x34\xc1\xe8\x70\x4c\xd1\xfa\xd6\x2e\xc5\x88\xad\x2f\x9b\x53\xf0\xa3\xc9\xca\xba\xaa\x54\xfe\xc8\xe5\x79\xaf\x00\x9b\xb9\xdf\x37\x8f\xa1\x5d\x25\x3f\x72\xf3\x20\xde\xcf\xde\xd5\x56\x23\x44\x6b\xde\x59\x3f\xa0\x49\xa7\xf6\x2b\xa3\x93\x07\x0d\x38\x35\xbd\x23\xf6\x36\xbf\xd3\x05\x8f\xe3\x3b\xec\x51\x2c\x28\xaa\x2a\x01\xd6\x6c\xbe\xdf\x87\xa9\xf0\x90\x50\x82\x0e\x10\xae\x01\x8b\x80\xd5\xcb\xc7\x5c\x9b\x22\x4c\x8f\x5a\x01\xd1\xa7\xee\xb0\x7f\x97\x97\xe6\xea\x8e\xcb\xd2\xf5\xd7\x5b\x17\x9c\xe5\xb5\x6b\x08\x64\xf7\x3b\x20\x34\x33\xcc\xad\x7c\x55\x43\x02\xad\x07\xc0\x10\x2b\xff\x6b\xe4\x14\x5b\x55\x2d\xaa\xf9\x56\xc6\xf1\xc7\x1b\xae\x6f\x46\xa7\xba\xbc\x6d\xfd\x7b\x33\xe9\xbe\x4d\xcf\xfd\x7f\x6b\xda\x25\x2c\xaf\xa7\x24\xe9\xca\xd4\x37\xeb\x15\x97\x11\x04\x79\xcf\xb4\xbb\x97\xb3\x80\xe4\xac\x48\xe5\xd5\x25\x1c\x92\x3a\xd3\x47\x0e\xd4\x47\xd9\x25\xaf\x0f\x6e\x81\x44\x99\x2b\x0c\xfe\x68\x99\x01\x35\xf4\x56\x33\x2e\x1d\x29\x5d\x35\x9c\xd8\x3c\xb8\xdf\xb6\xc0\x65\x0b\x67\xbd\xd1\xb3\xe5\x4c\x39\xbd\xa6\xa1\xc0\x1c\x88\xc0\x22\x46\xc9\xb1\x82\xae\x1d\x0d\xa2\x35\x28\xc6\xb1\x4c\xb8\xf1\x49\xf6\xee\xda\x81\x9b\xbf\xf6\xd3\x76\x02\xb9\xa5\xea\xc0\x83\x5f\x9f\x16\xb6\xb3\x29\x09\x3d\x7e\xbf\x3f\x62\x19\x85\xed\x66\x52\x56\x03\xd0\xe1\xe0\xe4\xe3\x3c\x50\xec\x02\xe7\xf1\x1a\x1a\xa1\x21\x81\xf8\x4c\x53\x88\x3c\x2f\x66\x58\xa6\xe7\xf5\xfd\x32\x2d\xbc\xa6\x41\xe5\xfd\xbe\xf9\x0d\xfa\x3d\x32\x37\xcc\xed\x33\xc0\xd4\xdd\xa1\x0c\x24\x87\x6f\xba\x93\x71\x1c\xa3\xee\x5c\x96\xc2\x65\x45\x33\x78\x6b\x8b\xd4\x01\xb0\x3e\x3a\x7b\x3c\xb4\x5e\x86\x53\x7f\xd4\xfe\x6b\x26\xec\xc8\x7c\x01\x8a\xfa\x0d\xa2\xbf\xb6\xe7\xc0\xaf\x8b\x50\x61\xd5\x67\x8f\xa0\x5e\x60\x30\xa5\x59\x0b\x31\x35\x37\x9f\xe2\xd8\xfb\x1e\x19\xe7\xf7\x38\x06\x47\xa9\xc6\x95\x27\x90\x46\x75\x9e\x76\x30\x0d\x73\xcc\x1d\xbf\xe1\x59\xc7\xb1\xe7\x3b\x0f\x1e\x69\x5c\xe2\xb4\x50\xe6\xd1\x20\x1a\xd1\xc6\x65\xe4\x5f\xdc\x21\x83\xdd\x01\xe3\xb8\xf1\xe9\xb4\x8c\xd6\x76\x18\x7e\xb5\xca\x7c\xfa\x26\xf7\xdb\xb1\x7d\xec\xb5\xdd\xc7\x8e\xc9\x50\xbf\x7d\x92\x94\xf4\x5b\x40\x96\x4a\x36\x26\x6f\x62\x4f\xd7\x3c\x7f\xd5\x51\xb6\xbd\xb4\xdd\x0b\x5a\x6e\x76\x1e\x13\x88\x5a\x69\xe6\x5b\x7f\xbe\x5d\x52\xfe\x12\x6e\xe3\x87\xa0\x1f\xc7\xaa\xd9\xcb\x41\x98\xb2\x7e\x14\x06\x9d\x6d\x8b\x9d\x2f\x7c\xa7\xb8\xa1\xb5\x12\x4e\x8e\xdb\xeb\xdc\xb1\xa7\xb6\xd7\xf5\x85\x88\xa1\x08\x8f\xd1\x5f\x9e\xc5\x6f\x4d\x0d\xb7\x9d\xc6\x71\xb0\x07\x3e\xb2\xa9\xfd\x96\x2a\xe0\x5f\xc7\x37\x5c\x6d\xdb\x73\x8e\x34\x8d\x0d\x57\x67\x81\xdd\xe3\xc8\x86\xcb\x3e\x65\xc3\x65\x6e\xc3\xb5\xc3\x0e\x38\x5e\x1c\x53\x69\xe6\xb2\xcf\xfc\xfc\xd4\xd0\xa8\x27\x31\xda\x22\x31\x17\x45\xcf\xb7\xd9\x60\x36\xb4\x99\x67\x2f\x83\x49\x13\xf3\x03\xc3\x24\x6b\x4d\xcd\x04\xbf\x08\xba\xe2\x32\xf4\x97\xba\xb9\xac\xbd\xa4\x28\x82\x08\x9a\x66\x6c\x40\x0f\x00\x31\x58\x45\xeb\x5e\x5d\x28\xc1\x9c\x87\x6e\xc0\x4c\x36\x4c\x2c\xc0\x42\x19\x11\x26\x51\x3f\x2a\x6e\x78\xa1\xcd\xf3\x8f\xf0\x52\x38\xed\xd7\x0f\x68\x67\xf0\xb0\x20\xda\x31\xf2\xfe\x12\x9e\x8f\x04\xb8\x04\x71\xef\xfe\x32\x7c\xf7\x63\xc3\x5a\x3e\x50\xac\x58\x41\x2c\x17\x22\x52\x05\xcf\xa2\x7b\x08\x45\x13\x42\xe3\x28\x0c\x6f\xae\xca\x84\x82\x85\x18\x0c\xee\xfa\x99\x5b\x7b\xb5\x72\x20\xf4\x43\xad\x92\xdc\xba\x97\xf3\x41\x7d\x84\x37\xe3\xdc\x7d\x49\x41\x64\xd8\x2d\xee\x89\x14\x09\xe7\x78\xcc\x78\xf8\x27\x12\x42\x80\x32\x38\xab\xa2\x61\xd5\xf6\xf9\x15\x45\x18\x1e\x69\x31\x4f\x8c\x14\x25\x42\x18\xac\x0b\xc6\x48\x64\xc0\xd3\x23\x58\x27\x3f\x16\x98\x62\xf7\xb4\x7f\xf0\xcb\x1b\x65\x0e\x1a\xe2\x61\x51\xa6\x4c\x6a\xf4\x95\xee\xdd\xcd\xca\x23\x58\xf4\x21\x58\x22\x34\xa9\xd2\x8d\xf6\xc0\x7d\x61\xa0\x1d\x24\xbb\xdd\xfd\x7f\x93\x87\x03\xb2\x6f\xa8\x5a\x5f\x43\x4e\x25\xb8\x62\x31\xbe\x62\x9c\xda\xd7\xf1\x65\x84\x69\x60\x21\x3a\xd4\x33\x5b\x10\x96\xcb\x4c\xca\x44\x62\x0a\xd7\xa8\x6c\x61\x3d\x79\xa4\x4a\xdd\xe3\x42\xe0\x04\x27\xc0\xf6\x44\x18\x82\xcb\x78\xb9\x48\x55\x16\x3e\x63\x29\x0d\x1d\x77\xe8\xa0\x8b\xd5\x5c\x65\x15\x3c\x6c\xea\x1e\x50\x85\x15\x12\x2c\x89\xaa\xd5\x54\xfe\x29\x8d\x9d\xbf\xb0\xef\xf5\x76\xb6\xd3\xc2\xb4\x07\x22\x9c\xa6\x17\xff\x62\x46\x1c\xfb\xdf\xe9\xa6\xf9\x15\x3c\x58\xd4\x4a\x86\x79\xa4\x2d\xfb\x41\x1c\xb7\x53\x0c\x31\x9b\x26\x6a\x20\xfa\x8b\x04\x68\xdc\xca\x46\x8c\x22\xa0\x7a\xe7\x5d\x1a\xd0\x3d\x4c\x07\x07\xda\x74\x79\xf0\xfa\xe4\x90\x5b\x34\xf8\x77\x49\x6b\x8a\x67\xba\x96\x70\x44\x1f\xd6\xec\xe2\x14\x73\x02\x5b\xd4\x90\xf7\x12\xb9\xab\x19\x35\x7e\x37\x68\xab\x63\x9d\x05\xc7\x66\x4e\xf4\xe8\xe0\x35\x14\xae\x49\x49\xe0\xd6\x08\x08\x77\x17\x97\x3d\x4a\x96\xd2\x1a\xb0\xcc\xb5\x7f\xfb\x8e\x96\xdb\x83\xed\x2e\x6e\x99\xd0\x1f\x97\xb5\x2f\x4f\xa3\x38\xc2\x01\xa7\x9c\xcb\x50\x1d\xef\x6f\xd5\xbc\xed\xe0\x2b\xad\xcc\xdc\x7c\xbc\x5a\xf7\x85\x86\x85\xa9\x4b\xad\x98\x0e\xdb\x9f\x53\x0d\x3b\xad\xc4\x71\x7f\xfa\x47\x9f\x67\x28\x1b\x03\x8b\x63\x35\xec\xc8\x3c\x9d\x46\x3b\xc9\xff\x3f\x6d\xff\xfe\xdf\x34\xee\xec\x8f\xe3\xbf\x7f\xfe\x0a\xd7\x87\x0d\x36\x9d\x26\x4d\xef\x75\x56\xe4\x94\x52\x2e\x0b\xa5\x40\x0b\x0b\x24\xd9\xae\x1b\x2b\x89\xa8\x23\x7b\x6d\xb9\x6d\x48\x72\xfe\xf6\xef\x63\x64\x49\xb6\x73\x81\x7d\xbd\xcf\xf9\x3e\x1e\xd0\xd8\xb2\xee\x97\xd1\x68\x34\xf3\x9c\x52\x59\x65\xd7\x0c\x25\xbf\x92\x3a\x72\x51\x70\x9c\x16\x9b\xf1\xbf\xc3\x7d\x29\xd2\x8e\x17\xd3\x6a\xfb\x92\xf5\x35\x5b\xe7\x80\xf2\xce\x6c\x30\x26\xc8\x00\x39\xe0\x4e\xae\x14\x3d\xd0\xf0\xc9\xa0\x5f\xcf\x66\xc8\x42\xd6\xd5\xfc\x85\x92\xe7\xa3\x52\x24\xad\x29\x23\x8f\xf5\x0c\x37\x90\xe2\xfe\x63\xec\x27\xb7\x4a\x2e\x40\x15\xc4\x6c\x91\xac\x59\xf2\x70\x24\x71\x42\x47\x72\x29\xa2\x8b\xb7\x9b\xef\x78\x89\x27\x1d\xed\xa1\xe6\xff\x06\xaf\x97\x9d\x58\x49\x52\x65\xe7\x1b\x3e\xb2\xae\xa9\x5a\x8d\xad\x25\xf5\xdb\xd3\x9c\xfe\xa3\x9b\xec\xb1\x3f\x64\x7d\x0b\xab\x65\xdd\x33\x31\xb2\xb4\x75\xa4\x65\x3f\xde\x4c\x37\x1f\xdb\xd6\x96\x15\xc9\x03\xbb\x56\x38\x2f\x97\xf8\xd8\x9d\xe3\x3a\xc5\x3b\xd8\x21\x15\x78\xff\x8e\x72\x5c\x17\x32\x12\xcd\x66\xba\x3f\x8b\xa1\xa2\x73\x18\x15\xf2\x77\xe1\x4e\xfd\x5a\x0d\x11\xc2\x80\x92\xa8\x1d\x21\x53\x0d\x23\xc3\xdd\xfe\x29\x61\x9f\x4a\xcc\x26\x73\xe7\x2b\x3e\x93\x8e\xe8\x41\x2e\x52\x40\x79\x12\xe3\xc6\x9c\xcd\xc1\x1e\x9c\x0e\xa9\xf0\x32\x48\xa9\xf0\x46\x40\x79\x36\xa6\xda\xdf\xd7\xb2\xff\xaf\x62\x52\x0c\xab\x44\x55\xab\x12\xcb\xc9\x65\xbc\x6b\x97\xf0\xea\x74\x98\x62\xb2\xa4\xd3\x44\xae\x91\x93\x0b\x20\x38\x1d\x0d\xd9\xbd\x44\xc3\x98\x51\x50\x97\x56\xaa\x61\x46\xd3\x52\x5d\xd7\x1a\xf8\xab\x1e\x49\x24\x16\x96\xba\x97\x57\x93\x0f\x93\xdf\xe0\x1a\x56\x6a\x67\xda\xf2\xab\xbc\xc6\x27\xd5\xe6\xa0\xd2\x2c\x32\x16\xfa\xa0\x6b\x89\x16\xdd\x24\x76\xdd\x96\xc0\x76\xda\x05\xfa\x22\x95\xe7\x78\x38\x61\x1d\xba\x29\x7d\x9e\xf3\x02\xe0\xaf\xe4\xf4\xfd\xa6\x60\x83\xcd\xd7\x49\xae\xb1\x49\x89\x68\x0b\xe5\x88\x1d\xd8\xa4\xa2\xbe\x59\x20\xe3\xaa\x54\xcb\xbb\x01\x5a\x30\x4c\xe7\x90\xe4\xe5\xe3\xe5\x96\x67\x47\x5a\x90\x64\x22\xd1\xf6\x24\x75\x38\x20\xe5\xd1\x9b\xba\x9b\x17\x56\xd4\xf1\xbe\xca\xaa\x93\xce\x37\xbf\xa7\x80\x54\x8d\x86\x94\x34\x2a\x57\xe3\x8b\x5e\x57\xf2\x4d\x18\xfd\x44\x6f\xf0\x3a\x4b\x3f\xa2\xe4\xc5\xdd\x42\xd4\x0d\x05\x61\x7f\x31\x90\xfd\xf3\xc2\x41\x2f\x87\x88\x1f\xac\x93\x2c\xf7\xd1\x43\x95\xa3\x6d\x9d\xc9\x77\x17\xf0\x2e\x0a\xa5\x06\xda\xe8\xe3\xdc\x17\xfd\x11\x4d\x1d\xa4\x1c\x7a\x09\xe8\x4c\x50\x99\xe1\x16\xd3\x69\x97\x68\x26\xf7\xdb\x6a\xee\x4a\x58\x44\xf1\xdc\x87\xb7\xa0\x48\xb6\x02\x1a\xa7\xe7\x7e\x5c\x8f\x7d\x21\x68\xc2\x2f\x6e\x52\x9a\xa0\xd6\x23\xf2\xae\xb5\x1a\x43\xbe\x20\x41\xa1\xc4\x52\xa1\xd4\x9d\xa2\x9d\x80\x84\x86\xa6\xf5\xd0\x4f\xc5\x1b\x3a\x71\x41\x36\x20\x75\x41\x15\x9d\x56\xf4\x9a\x55\xeb\xa6\x62\xa9\xb8\xe5\xfc\x15\xf2\xed\x90\x3e\xe4\xc8\x47\xb2\xd6\xf2\xf2\x5f\x99\x9b\xd2\x4a\xd6\xd7\xb8\x09\x98\x37\xa1\x47\x15\x9b\x78\x4b\x27\xad\xd2\x7d\x33\x21\x24\x6a\xa3\x75\x1a\x5a\x30\x9e\xfa\xfd\x11\x75\xf0\xf8\x87\x07\x51\xad\x58\x2d\xd5\xe7\x80\x2b\x47\x0b\x2e\x48\x1d\x70\xaf\x7c\x67\x2d\x69\x76\x19\xd7\x82\x96\x4c\x0d\x5a\x51\x9d\x47\xa7\x7e\xda\xf7\x03\xba\xb4\x70\x64\x51\xb3\x99\x23\x3d\xbe\x61\xef\x4b\x1e\x26\x13\x34\xe8\x70\x0d\x64\x57\x74\xb7\x70\x51\x5d\x3d\x32\xc3\xb4\x1c\xb7\x98\x0f\x54\x79\xc3\x53\x34\x4a\x12\x0c\x65\xd2\x24\x7d\xab\xea\xe9\xa7\xc8\x54\x7f\x84\xb6\x6a\xa8\x1c\xa4\x51\x93\xb9\x46\x08\x2d\x65\x09\x8c\x24\x13\xe5\x88\x42\x05\x2e\x2a\x5a\xb3\x96\x9c\xfc\x65\xf1\x41\xad\x66\x6a\x5d\x34\x8f\x2e\x57\x59\x14\xd7\x5c\x79\x6d\x8c\xce\xd7\xd2\x04\x29\x40\x53\x97\x2b\x52\xf0\x2c\xe9\xc4\x49\x41\xa0\xd4\x7c\x8e\x6c\xe2\x2f\x63\x16\x07\x7e\xec\xe1\x7a\xa4\x8b\xfb\x55\x39\x27\x78\xe6\x41\xde\x01\x04\xd8\x26\x15\x7a\x6f\x86\xd3\x54\x29\x2c\x55\x3e\x94\x4a\xe9\xd8\xea\xaa\xc3\x96\xea\x9f\xd8\xe4\x5f\x97\x95\x60\x41\x3a\x1d\x16\xa3\x2d\xb1\x6a\xb5\x4b\x55\x60\x02\xbc\x52\xba\x89\xbd\xae\x27\xfc\x0e\xc2\x40\x24\xbd\x5c\xa7\xa0\x34\x18\x6c\x8c\x12\x21\x26\x4e\xd5\xa0\x68\x77\xc8\x66\x56\xa3\xa7\x5e\x7f\xf9\xa8\x74\x92\x2e\x61\x9b\xb7\x9c\x84\x5c\x60\x30\x06\xba\xca\x63\x36\x96\xc6\xb1\x09\xab\x6a\x25\x31\x3f\x13\xb9\x79\xcb\x9b\xa4\xb6\xc2\x33\x15\xa8\xf3\x6a\x60\x37\xd3\x2a\xa5\xbb\x34\x94\x6e\x85\xfb\x95\x92\xe2\x2b\x23\x1b\x4d\x48\xc8\x36\xca\xbe\x55\x07\x42\x84\x5e\x64\xd3\xa7\x49\x2b\x9f\xce\x3e\xe1\x9d\xa4\x87\x3b\xbc\x8f\xf2\x68\xcd\xcc\xa1\x4c\xc3\x48\xea\x7d\xb5\x52\xb6\x0b\x1b\x02\x95\x9b\x5b\x4e\x53\xab\x45\x79\xdd\x7d\x17\x92\xcd\xcd\x39\xc3\x63\x9e\x69\x82\x58\xb0\x00\x71\x41\xe3\x98\x60\xb4\x52\x0f\x38\x51\x85\xd4\x9d\x9a\xb6\x9a\x20\x05\x34\xad\x5b\x9e\x28\xc5\x1c\x39\x7b\xcb\xac\x72\x62\xd6\x6c\x3e\x28\xb8\xf3\xb4\x58\xad\xe6\x2b\x2f\xa5\xaa\x2a\x1e\x05\x5c\x0e\x1e\x2b\x17\x9b\xea\xb4\x09\x8e\xa9\xd9\x39\x3a\xbc\x57\x5a\xd9\x4e\x52\x4a\x11\x39\xb4\xa2\x80\x55\x6a\x6b\x0b\x6f\x0b\xe3\xf4\x67\xd3\xbe\x34\xd8\x1c\xdb\x8f\x45\xfb\x38\x54\xe8\x64\x14\x7c\x93\x34\x2a\x95\x68\x1a\x65\x88\x3f\x96\xd2\xe1\x46\x18\xc6\xda\x4c\xd5\x76\x81\xf3\x7f\x9f\x1a\x2d\x7b\x3f\x16\x59\x42\x5f\x26\x51\x16\x2b\x3d\xc6\x8a\x11\xf9\x55\x95\x7f\xc2\x89\x82\xe8\x08\x6c\x91\x41\x93\x84\xae\xd0\xcf\xdf\x40\x8d\x4e\x2d\xd1\x2d\xb1\x71\x58\x0d\xb5\xec\xfa\xb8\x19\x21\x2f\xa4\xe4\x5a\x9a\x50\x63\x30\xf6\xb4\xe8\x49\x15\xbd\x96\x9e\xb9\x2d\x65\xe2\x53\x6c\x64\x3c\xc7\xee\x2c\xd5\xf6\x45\xb9\xb6\xa8\x3b\x8d\x70\xd6\x6f\xe8\x44\x75\xc8\x86\xe2\xf0\xea\xa8\x63\x31\x9b\xa9\x97\x9c\x64\xfb\x98\x41\x6a\x02\xc7\x7e\x1c\xa3\xbe\x67\x29\xf3\x8f\x95\xcc\xf1\x48\x31\x98\xc8\x90\x56\xf5\x38\xe6\x07\x13\xb4\x76\x91\xb7\xab\x25\xa1\x78\xb9\x18\x4d\x8a\x4a\x57\x5d\xdf\xcb\xb9\x17\x4a\xd1\xa5\x4e\x41\xe7\x74\x98\x4c\x8e\x01\xda\x5f\xdf\x4d\x80\xea\x01\x94\xb4\xe6\xb9\xb3\x3c\xa6\x52\x41\xeb\x7f\x94\x4a\xb3\x04\x44\x9f\xcd\x8c\xae\xba\x0b\x17\xbe\x92\xd9\x61\x42\xdd\x68\x50\xa7\x46\xe4\x71\x0c\xa3\x5c\x8e\xd0\x29\x22\xf4\xe4\x5c\xd7\xb2\x96\x92\xa7\x53\x93\x50\xe1\xdb\x94\xed\x11\xfd\x4e\xd6\x6b\x3b\x7a\x16\x55\x7a\x26\xc3\x95\xb5\xc1\xea\x37\x93\xd8\x4f\xd3\xb6\x94\xdc\x60\x09\xaa\x17\xe5\x39\xc2\xc9\x72\xa7\x34\x09\xa9\xcc\xaf\xac\x87\x06\xe6\xea\xae\xc8\x13\x8a\x5b\x55\x6a\x18\x79\x42\x5b\x69\xd3\xe0\x04\x70\xb5\xf2\xb8\x7c\xf1\x38\x79\xa7\x76\x13\xe1\x02\x56\x90\xa0\xd3\x6e\x82\x4f\x88\x36\xc5\xa3\x4f\x1c\x27\x72\xad\xb6\xa2\x58\x29\xb0\x49\x0c\x1a\xaf\x2a\x19\x09\xdb\x20\x0b\x43\xac\xc5\x4b\x2a\xe4\x74\x8e\x2c\xb6\xd0\x97\x2e\xef\x44\x3d\x52\x09\xea\x44\xbd\x12\x82\xa4\x9e\x5e\x9c\x10\x12\x4f\xb4\x1f\x8f\xd2\xd4\x79\xb7\x3c\x75\x8a\x34\x9a\x16\xa9\x1b\x4b\x90\x4e\x63\x74\xd5\x75\xb0\xa6\x68\x0e\x57\xbb\x23\x8a\x9d\x24\xd6\x0c\xba\x00\x2c\x6e\x8a\xf3\x31\x2b\xd6\x68\x35\x7d\x5b\x72\x46\xca\x07\xc6\xc5\xc0\x30\xb1\x2c\xa7\x2b\xf9\xab\xb7\x2e\x39\x51\xa4\x0a\xf4\xe1\xc6\x58\x1d\xa1\x12\xa3\xe6\xb9\xb1\xfb\xe4\x89\x88\x77\x4c\x18\xfa\x43\xcc\x87\x57\xe6\x00\x89\xf2\x52\x24\xcb\x51\xb9\x92\x04\x8a\xb2\x75\x58\x3c\x59\xde\xd5\x9f\x1b\x09\x9a\x3c\x19\x09\x33\x5e\xe5\x59\xea\x2e\x85\x74\x44\xaf\xb4\xe2\x8b\xec\x5e\x97\xc7\x66\x61\x94\x35\x11\xa0\xf7\xd6\x4d\x95\x92\xe4\xd4\xe3\xb3\x66\xc0\xd5\x7d\x4c\x69\xc8\xdf\x56\x87\x5c\x11\x06\xb4\x29\xa0\x86\x9f\xd1\xe4\x77\x81\xbf\x51\x5e\x68\x80\x16\x0c\x8e\x8e\x69\x02\x54\x1c\x17\xf4\x29\xbf\xd2\x54\x86\x53\x85\x57\x8f\x0f\x72\xe8\xcb\xbc\x6d\x89\xe0\xd4\x6a\x95\x0f\x8a\xf6\xa8\xdb\x12\xda\xc6\x7d\x92\xc6\x67\x68\xfe\xcc\xf8\x50\x09\x37\xbc\x8d\x66\x6b\x91\xde\x17\x7a\x9d\x8a\xc8\xca\x08\x11\xef\x2f\x21\xcc\x7c\x5e\x66\xcd\x90\x98\x1a\x41\x86\x3c\xd0\xa0\xed\xb1\xbe\xfc\xf4\x13\xea\x4e\x23\xf2\x29\x75\x8a\xf7\x96\x48\x26\xd3\x44\x41\xa9\x47\xa8\x43\xce\xd5\xe3\xbc\x8f\x07\x54\x27\x73\xa7\x63\xe7\xf1\x98\x26\x58\xb8\x67\xd9\xbf\xa5\xb6\x95\x27\x66\x69\xc4\xad\x81\xcf\x42\x1a\xd4\xad\x17\x7e\x88\xa8\x76\xd6\x8d\xdf\xbf\x45\x58\x73\x16\x50\x2e\x98\x98\xe4\xd0\x1e\x8c\x0f\x1f\xa3\xaa\x60\x42\x28\x96\xa0\xd4\x22\xd4\x5b\xcb\x27\x0f\x52\x98\xa3\xda\x9d\x8e\xfd\x44\x28\x8b\x27\x64\x32\x7d\x63\x5c\x8c\xab\x53\x3d\x96\xfa\xe1\xbc\x2c\xe1\xfc\xe3\xf2\xe2\x1d\x4e\x13\xc6\x87\x6c\x30\xa9\x48\x6b\x3f\xa5\xda\xb0\x47\xda\x5d\x68\xd2\x7d\x2e\x1d\x76\x2d\x49\x24\x84\xfe\x7e\xa6\x04\x1c\x67\x55\x99\x46\x21\xf6\xa5\x68\x1a\xea\x82\xfc\x2e\xb3\xd2\x71\x56\x64\x26\x96\x64\x75\xf6\xd5\x88\x5a\x7f\xab\xe1\xf8\xdb\xca\x2f\xa6\xad\x71\x96\x0a\xeb\x86\x5a\xbe\xa5\x33\x93\x58\x29\xbe\x95\x57\xd3\x4a\x68\x9c\xd0\x14\xbb\x98\x0f\x25\x76\xbc\xec\xee\xc1\x04\x87\x60\xc0\x68\x18\x58\x4e\x94\x58\x7f\x8b\x24\xa3\x7f\xe3\x70\xa0\x24\xb0\xda\x35\x6e\x19\xed\xe6\xcf\xea\x4a\xc3\x7d\x59\x71\x2c\x84\x10\x3f\x19\x4a\x88\xf9\xb4\xb3\xd3\x6b\x9b\x03\x8b\x57\x0e\x6e\x51\x03\xd7\x8b\xe7\x60\x4e\xd6\x6e\xa4\x6e\x9b\x9b\x2b\x5d\x25\x4e\xf0\xf4\x16\x29\x19\xbb\xd4\x58\xa6\xa8\x57\xe5\xea\x8b\x29\x76\x02\xf9\x32\xcd\x59\xa0\x5d\x8a\xf2\x32\xa4\x81\x31\x34\x0b\x5b\xcd\x26\x0f\x91\x39\xe9\x1d\x73\x36\xfb\xa1\x77\x42\x48\xab\x8e\xb7\x0d\xc7\x69\x8e\x18\xd8\x21\xad\x0d\xaa\x92\xb6\xdc\x92\x98\xa5\xc3\x7b\xb2\x76\x15\x32\x2f\x2b\xb9\x40\xf8\xd1\x86\x86\x48\x9a\x5c\xa8\xc6\x24\x52\x59\x27\x95\x87\x46\xf5\x48\xb6\x8b\x26\xb9\xa0\x23\x6c\x12\xf4\x67\xae\x73\x2c\xea\xfa\x6c\x51\x50\x5e\x61\x8b\x72\xd6\xb1\x88\xfd\x4f\x6a\xf0\xe9\x91\x1d\xd0\x0a\xf6\x25\xea\x53\xc1\x4a\x7e\xb4\x3c\x2b\x20\x5a\x33\x2f\xa6\xf3\xea\x84\x40\xf9\x23\xca\x4c\xce\xf3\x39\x50\xab\xfd\x74\x52\xe8\xe5\x81\x28\xa3\x05\x9f\xb5\x8a\x73\x52\xbb\x30\x2e\x63\x86\x91\x71\x01\xa8\xd4\x2d\x19\x80\x27\x09\xaa\xd9\xa9\xb9\xef\x94\xd9\x70\x99\x16\xd0\x75\xe0\x22\x7b\xa3\x3e\x49\xb9\x18\x2e\x2c\x04\xed\x47\x4f\x1d\xf2\xd9\xa1\x39\xab\x0f\x29\x6e\x3d\x86\x05\x72\x81\xcd\x66\xe9\x6c\xf6\xc1\xcc\x23\x8a\xe7\xbd\x94\x85\xe6\x0a\xa5\xd4\xb3\x42\x31\x06\x25\xca\x5c\xea\xea\x0f\xd5\x53\x8e\xb2\x5a\x4a\x0b\x72\xe3\x4e\x65\xeb\xda\xaa\x8d\x9a\x2b\x40\x07\x69\x4e\x62\xb8\xc7\x08\xfd\x55\x40\x54\x4e\x86\x56\x10\x09\xf9\x3a\x2c\xd2\x20\xd7\x90\x96\xd8\x25\x48\x60\x9a\x57\x3a\xbf\xe9\x47\x21\xac\x8e\x8b\xdc\x3b\xb0\x75\xcc\x14\xb0\x76\x8a\x3e\xdb\x93\x65\x06\x6c\x6d\x1a\x57\x26\x89\x2a\x27\x9a\x6f\xab\x5a\x6f\x9c\x54\x27\xc5\xb9\x7e\x85\xd0\x43\x26\x93\x1b\x33\xc5\xb3\x79\xde\xb5\xb9\x42\x38\x77\xe1\x6a\x62\x24\x20\x6a\x66\x55\xda\x0a\x15\xd2\x63\x68\x5b\x4f\xcd\x07\x79\xde\x53\x62\xfc\x57\xa9\xbb\x5c\x78\xb1\xef\x08\xe5\xd8\x9a\x4a\x53\x61\xa4\x58\x1b\x25\xdc\xf9\xa9\x81\x15\x2e\xd7\x4f\x5f\xe5\xb8\xf0\x7e\x82\xc4\x01\xc1\x8d\x32\xd4\x6a\x79\x91\x44\xe3\x56\xfa\xbb\x4e\xae\x5c\x2a\x2d\x26\x47\x1d\x00\xcc\x37\x35\xe5\x14\xd0\x25\x4b\x65\xa5\x79\x5f\x14\x7d\xfe\xaa\xb4\x6b\xae\x50\x8f\x30\xed\x29\x52\xbc\x31\x4c\xa3\x32\x07\x90\xbc\xa3\xba\x9a\xb9\xa5\x93\xb4\xb4\xd6\x7e\xda\x57\xb4\x4c\x71\x50\x44\xa5\x71\x4e\x51\x1b\x4a\x02\x74\x04\xaf\x15\x3b\xf7\x9c\xc6\x78\xf3\xc7\xfb\x4c\x3b\xb1\x68\xb9\xa2\x64\x22\x5f\xd4\xee\xe5\xff\x76\x0b\x43\x8a\x55\x6c\x63\xf2\xae\xed\x67\x24\x4b\xb5\x85\x4b\x15\xae\xca\x7e\x56\x22\x5d\x6a\x0b\x33\xe4\xa5\x7c\x6e\x50\xec\x64\xf2\x4b\xff\x30\xa8\x26\x64\xf9\x56\x20\xbb\xc2\xe7\xa2\xf0\x11\xc3\x23\x2b\x8c\xf8\x90\x26\x96\xae\x02\x0d\x36\xac\xab\x11\x4b\xad\x74\x14\x65\x61\x20\x11\xa4\x46\xb8\x0a\x79\xdd\x7a\x3d\xb0\x26\x51\x66\xf9\x09\xb5\x52\x4a\x91\x53\xc0\x3a\x5a\x37\xd9\x10\xd9\xff\x80\xde\xd1\x30\x8a\xb1\x97\xac\x38\xa4\xe8\xce\x35\xf1\x59\x4a\x25\x77\x91\xa6\x19\xb5\x7c\x61\x8d\x84\x88\x53\xaf\xd1\x18\x32\x31\xca\x6e\x90\x32\x37\x14\xca\xf0\x1f\x97\xfa\xa9\x21\x63\xa7\xd6\x16\xd6\x93\xdf\xa6\xb6\xdb\x62\x15\xc7\x99\xc5\x0e\xfc\xb5\xb4\x03\x97\xa6\xe6\xd7\x55\x1b\xf0\x7f\xb2\xfd\xaa\x6d\x53\x6e\xb6\xbd\x2d\xd2\xac\xbc\xcf\x66\xce\x6b\x07\xf5\x4b\x2a\xa1\x6a\xae\xb8\x2b\x77\xd9\x3f\x8a\x7d\x73\x14\x45\xb7\xf2\x30\xc3\x33\x47\xdb\xbe\x30\xbc\x44\xeb\xd3\xb4\x90\xaa\x4a\xdc\x1e\x32\x2d\xb5\xe9\x8b\x9a\x9e\x6a\xda\x88\x0e\xad\x5f\x0f\x33\x16\x48\x36\xc5\xbc\x54\xb1\x0d\x45\x54\x9a\xd2\x04\x73\x40\xcf\x51\x19\x05\xaa\x5c\xb7\xc9\xda\x68\x94\x92\xb2\x0c\x09\x13\x72\xed\xf2\xcd\x6d\x29\xfb\x78\x95\xdc\x94\x56\x94\x44\xcb\x25\xe5\x17\x88\xe6\xaa\x90\xba\xac\xc3\x7b\x84\x63\x14\x0e\x74\xcd\xcd\x20\x8f\x16\x48\x78\x6b\x2d\x69\xc1\x1b\x65\x46\x92\x48\x5e\xe4\xb9\x06\x5e\x6b\xe1\x3b\x8b\x94\x66\xc8\xf2\x35\x60\xad\xb6\xcc\xae\xcb\x0b\xea\xb6\x4e\x86\x2f\xae\xb7\xaa\x6c\x15\x0d\x4b\xc7\x47\xbc\x4a\x0c\x1d\xfb\xef\xdf\xd2\xbf\xad\x12\x9f\x91\xf3\xdf\x12\xc8\xd0\xb7\xfe\xc6\xad\xcd\xfd\x5b\x41\x56\x21\x92\xf2\x2a\x7d\x32\xc9\xba\x20\x1f\x27\x5b\x86\x2f\xd8\x3a\x57\x5e\x50\x0b\x9a\x78\x0c\xef\xa8\xf1\xa1\xac\x23\xc5\xaa\xfd\x5e\xa8\x1d\x23\xbc\x61\xfe\xe4\xd8\x9b\xd4\x00\xef\xbf\x9d\x94\xc0\xcc\x4a\xd3\x09\xe5\xd8\xf0\xf8\x5a\xdf\x9d\x63\xcb\x10\x59\x8b\x4a\x3c\xad\xb9\xbb\x69\xbb\x2d\x1b\xcf\x81\x88\x6e\x88\x65\x99\x98\x16\x91\x6c\x63\xcb\xb2\x37\x51\x7a\x2f\x67\xf6\x0b\x5d\x02\x5e\x11\xb5\x95\x86\x9c\x70\xbd\xa4\xa8\x77\xa2\xea\x9d\x17\xdf\xc0\x2c\x1a\xca\x9b\x49\x49\x3b\xd9\x6d\xe7\x30\x16\x0e\x75\xcb\x4a\x89\x69\x21\x44\x5e\x47\xa5\x9b\x0b\x0c\x65\xb3\xf7\x9f\x30\x9e\x1f\x6e\xea\xcf\xcf\x9e\x7d\x7a\x59\xab\x9d\xfa\x8e\x0b\x59\xe4\x50\x88\xa4\x65\x18\x05\x1b\x19\x5f\x5b\x8e\x8a\x37\x8a\xe6\x2e\x7c\x9e\xa8\x05\x84\xe7\xcf\x1f\x93\x95\xfb\x16\x9e\xf0\x08\x73\xee\x7d\x87\x96\x75\xd4\xe4\xb9\x30\x17\xf6\xa2\x17\x1f\xe4\x34\xf2\x1e\x7c\x37\x71\xa6\x52\x56\xe3\x45\x58\x36\xad\xcb\x17\x2c\x00\x4b\xf7\xfe\x1c\x49\xc9\x47\x35\x33\xb9\x75\x80\xbe\x59\xf3\x5e\x4f\x1c\x0a\xcb\x45\xd6\xe3\x24\x12\x11\x4e\x66\xc5\x16\xa3\x09\x8d\x66\x91\x29\x2a\x24\xea\xcd\xca\x8b\xcc\xbe\x05\x6a\xb0\x3d\x0a\x5a\x8e\xe0\x99\xe6\xb9\xd3\xd5\xa0\xf1\x2e\xda\xb9\x95\x40\xce\x50\xb6\xa4\x8c\x69\xe0\x26\x58\xdb\x80\x73\xdd\x9d\x2e\x7c\x9a\xd4\x6f\xe8\x10\xef\x92\x5d\x28\xf4\xe5\xf4\x8d\x8c\x06\x02\x8c\x94\x95\x1f\x8a\xbf\xe4\x13\x8a\x72\x7d\xa2\x83\xdb\xfa\x41\x5d\xcf\x20\xd8\x1f\xa8\x98\xe6\x92\x43\xa7\x74\xa1\x50\x09\x5a\x65\x87\xab\x1f\x73\x73\x5c\xaa\xcc\x71\xa9\x06\x6f\xf0\xe7\xee\x9c\x0d\x9c\x4f\x13\xa9\xb4\x23\xb5\xde\xb0\x8d\x34\x54\xca\x73\x19\xc2\x67\x20\x3b\x92\x20\x93\xa7\x5c\x23\xba\x2d\x3d\xdd\xae\xdf\x7f\xbc\x38\x7f\x7d\x79\x76\x59\xab\x65\x1d\x5b\x4a\x4f\xec\x5e\x65\x1e\xe5\x3b\xfd\x9d\x63\xbf\x4f\xa2\x31\xee\xad\x01\xbd\xc9\x86\x43\xdc\x8a\x59\x6a\xd1\xdc\x20\x09\xf0\xbc\x3e\xa2\x61\x6c\x49\x56\xc8\xa2\xc8\x14\xa4\xb8\x99\xea\xad\xdc\xf2\xd3\x09\xef\x8f\x92\x88\x47\x59\x1a\x4e\xea\xd6\x65\x34\xa6\xd6\x4d\x12\xdd\xa7\x34\x49\x11\x55\x32\xb4\xc2\x68\x68\x65\x7c\x24\x6d\xcf\x02\x54\xfa\x91\xe5\x25\x14\x99\x35\x94\x6f\x01\xee\xf9\xf7\x23\xd6\x1f\x59\x7d\xdc\xeb\x91\x33\x40\xf0\xd6\xd4\x1f\xd0\x70\x62\x29\xa0\x16\x2b\x5e\xac\xa8\xd7\xe5\x96\xa5\x26\xc9\x42\xb3\x2d\x62\x0d\xfc\x30\xa5\x2d\xdb\x05\x04\x86\xe5\x79\xd5\x55\xa5\x69\x60\x05\x99\x92\x5d\x60\x17\x22\xc9\x2f\xd4\x4f\x29\x72\xe9\xf5\x54\xf8\xfd\xdb\x5a\x0d\x25\xec\xf2\x11\x35\xf4\xca\x3c\x6b\x64\x36\x19\x13\xc4\x0a\xb7\xb3\x4b\x7b\x82\x94\x14\xa2\xe1\xb4\xd6\xcb\xc9\xd5\x25\x11\xf9\x3a\x54\xc8\x66\xea\x0b\xfa\x79\x01\x5a\xa8\x65\xa9\xdb\x07\x5c\x41\x68\xfb\x9b\x90\x77\x08\x96\x89\x71\xdd\x22\xcc\xc7\xb5\x2d\x3d\x3a\xda\x52\xc9\xca\x96\x52\x5b\x14\x9f\x0d\x59\xdf\x13\xf9\x2f\x44\xc4\x1e\x47\x01\x1b\x4c\x4e\x92\xc4\x9f\xa4\x26\x52\x29\xcc\x13\x95\x57\xc8\x7d\xbe\x6c\x70\x7f\x99\x35\x7c\x29\xf7\x90\xd4\xf2\x79\x60\xa5\xea\xd9\x29\x54\xbc\x5c\xc9\xdd\x21\xd3\x97\x66\x71\x1c\x25\xc8\x43\x2a\xd1\xae\x9e\x1f\xb6\xdb\x8a\xf0\x94\x9b\x2f\x9c\x89\x11\xa0\x88\x89\xf1\x11\x53\x7c\x7f\x33\x74\xa1\x44\xf6\x7d\x45\xf6\x35\x5b\x86\x82\x77\xb5\x2c\x81\x15\xa7\x10\xa9\x01\xff\x3f\x25\xe5\x01\xb4\x91\x42\x5d\x75\x59\x90\x7c\x33\xfb\x5c\x91\x79\xa6\x32\x17\x39\x77\x42\xec\x64\xcb\xde\xfc\x73\xb2\xb9\x89\xa6\xda\x88\x33\x48\x8c\x21\xae\xa8\x5f\xe7\xca\x5d\x92\xe1\x5a\x44\x2f\xa9\xc4\xf3\x39\x1b\xfb\x05\xf6\x8c\x72\x84\x98\x27\x2b\x29\xe8\xe7\x1f\xa5\x85\x95\x51\x70\xac\x7e\x92\x3e\x88\xf5\xd6\xa8\x03\x8d\x36\x00\xbe\xd3\x42\x75\x15\xbd\x6f\x2b\x50\x1e\xcd\x4d\x82\x54\x6f\x16\x3e\x43\x60\x2e\x5a\x3c\xcf\x66\x58\x57\xb4\xdc\x46\x98\x03\x9d\x4c\x61\x1d\x14\x39\x92\x52\xee\xe5\x92\x8c\x2e\x24\x0a\x73\xea\xd7\xb9\x8a\xb7\xd6\x08\x27\xb4\x5e\x0d\x90\xa6\xe3\x98\xb5\x8c\x6d\x60\x83\x8a\x8a\x61\x5d\x4a\x1c\xe8\x28\x72\xdc\xe9\xd2\x24\xfc\x94\xe2\x32\xfe\x5b\xad\xdd\x3a\xee\x69\x7f\x5b\xac\x7c\x1c\x29\x66\xdf\x96\x3c\x73\x48\x76\x0a\x65\x90\x62\x44\x8b\x84\x8a\xaf\x3a\x79\xff\xda\xc2\x56\x50\x3f\x28\x4b\x9f\xfa\x66\xb1\xaf\xd0\x1e\x25\x62\x21\x40\x49\xb2\xfc\x30\x44\x09\xb4\x76\x2b\xf1\x93\xcd\x40\x81\xaa\x2e\x23\xb5\xae\x03\xc0\x0f\x4d\x7d\x2a\xb0\x7e\xb4\x9e\xb4\x19\xf9\x20\x31\x67\xea\xa8\xaf\xe1\xd1\xfa\x43\x3b\x87\x87\x7d\x19\x38\x45\x3f\x9b\x9a\xd2\xfa\x43\x1e\x2d\x79\x30\xf6\x2f\x22\xce\xd3\xe3\x17\x17\x4a\x7c\xf5\x20\x2a\x1d\x9c\x51\xca\xa1\x75\xd8\x6a\x35\x81\x28\x63\x42\xba\xb0\x2b\xe2\xc7\x6a\x15\x61\x1d\x79\x99\x89\x5f\x56\x5b\x72\x6b\xb5\x71\xe4\x14\x33\x48\xcd\x41\xcd\xe4\x17\x79\x8e\x65\x9e\xa8\x4c\xe7\x4e\x35\x91\x35\xf7\x56\xac\x56\x0b\x1d\xdb\x2c\x9a\xd4\xea\x67\x09\x36\x37\x9c\x58\x11\x42\x21\xab\xa9\x60\xa5\x78\x43\x43\x2d\x85\x84\xbc\xa5\x37\x1c\xa4\x55\x8c\xf7\xc3\x2c\xa0\x96\xe1\xe3\x70\x47\x4b\x92\xc9\x86\x44\x34\x40\x6d\xb8\x82\xeb\xd5\x12\x08\x83\x0d\x5f\x24\xd2\xfd\x62\x42\x24\x18\x5b\xf9\x4d\xe3\xda\x08\x22\x89\x71\x89\x99\x92\x47\xa5\xfc\x92\xd8\x24\xc0\xeb\xc1\xe5\x88\x48\xee\x16\x23\x22\xe0\x29\x05\xb6\x80\x6c\xca\xe7\x2e\x6c\x34\xcb\xfa\x16\x77\xe5\xc1\xc9\xf5\x0c\x36\xe8\x12\x91\x7f\x6c\x3a\xd3\xd2\xe8\xb8\xdc\x1f\x23\x2f\x2f\x8f\xf3\x03\x24\x44\x05\x48\xee\xaf\xd6\x05\x53\x88\x97\xea\x4e\x09\xd7\x3e\x53\xe6\xc7\x08\x42\x96\x2a\x7f\x86\x58\x42\x09\x57\xac\x4e\xd7\x62\x84\x49\xaa\xfe\x91\x0e\x0c\xb9\xd5\x87\xde\x09\xde\x3b\xd0\xa4\x08\x50\xc2\x9c\x44\x52\xcb\x47\x93\xfc\xac\x4f\xa1\x54\x8a\x5f\x7e\x19\x94\x5f\x62\x17\x3e\xa8\x14\xa5\xd0\x3b\x89\x9e\x6f\x5e\x11\x17\xaa\xfc\xda\xac\xbe\xee\x54\x5e\x23\x9c\xef\xce\xe3\xab\x11\xb5\x6c\x69\xd2\x6d\x83\x65\x4b\x53\x33\x5b\x6e\xa7\xb6\xb1\x5f\xb3\xad\x80\x25\xc8\x27\xdd\xd1\x34\x47\xb3\xe7\x91\x45\x07\x03\x54\x92\x46\x53\x01\x33\xd7\x1f\x97\xec\x67\x2a\x4a\xf0\x2e\x7c\x53\xb6\x82\xc5\xe0\x0f\xd5\xe0\x9b\x80\x75\x90\x55\xad\x6a\xe7\x99\x13\x09\xcf\x4f\xfd\x38\xff\x13\xbd\xab\x9b\x4e\x77\x2b\x6f\x9d\xa4\x27\x27\x3f\x77\x56\x04\xbb\xc6\x40\x12\xe7\xc5\xea\x4d\x4f\x4a\x46\xcc\xc4\xc0\xab\x50\x56\x81\x89\x2a\x9a\x35\x89\x56\x1b\x63\xb4\x16\xa1\x0d\x56\xcc\xd6\xc2\xbd\xac\x0b\x15\x35\x7f\x3c\xce\x16\x7e\x76\x8c\x51\x66\x51\xea\x4d\xb4\x70\xef\xa1\x8b\x2d\x12\x15\xe7\xd0\x22\xd9\xfd\xba\xca\xae\xe9\xf2\x6f\x2e\x3c\x44\xea\x62\xba\xbc\xdd\x9b\x08\x6f\x5c\x10\xab\x0c\x6a\x4b\x7a\xa5\x85\xb0\xb2\x1c\x55\xb7\x14\x35\xe3\x68\x58\x37\x07\xf2\x6b\x5d\xa5\xf4\xfa\x3a\x87\x2a\x5c\xf7\x15\xef\x53\xbf\xa8\xd3\x56\xf9\x9a\xf3\x21\x2a\xe9\x3f\x97\x0d\xc9\x0b\x23\xf2\xd5\xa3\x6d\x86\x9a\x17\xc6\x89\x3f\x35\x07\xbf\x8d\x8c\x58\x2c\x17\x37\x3e\xa7\xa9\x48\xa2\x89\xbe\x57\xd2\x95\x2d\x59\xb9\x16\x89\xcf\x8a\x6a\x96\x00\x49\xe5\x46\x4c\x94\x19\x54\x19\x13\x86\x14\x9f\x17\x26\x91\x9a\x39\x48\xd8\x34\xdb\x54\x76\x1d\x5c\x42\x45\x54\x3c\x82\x02\x02\x16\xfa\x69\x2d\xa5\x5b\x40\x43\x2c\x64\xac\xaf\x15\x09\x2c\x74\x5b\xf3\x13\x24\xb1\xf3\x5f\x64\xf2\x45\x5b\xe3\xd7\x94\x1d\xae\x1b\xd4\xa8\x02\xde\x46\x6a\xad\xa9\x3a\xa0\xd3\x5a\x52\xa2\x59\x55\xe0\x73\x60\x66\xce\x5f\x47\xce\xb4\xda\x0d\x1e\x2d\x21\x12\x96\x9a\xac\xb2\xf2\xb8\xc2\xb4\x66\x73\x73\x23\x52\x72\xa0\xe7\x2b\xf4\x29\x52\x54\x46\x42\xbc\x9e\x24\x43\x65\xa6\x20\x5f\xa5\xee\xa2\xf1\x0a\x2a\x59\xe2\x0a\xf2\xd3\x75\xa4\xae\xd8\x97\xce\x62\x45\xa3\x8c\xe4\x1c\x05\x25\xd2\xf5\x74\x7a\xcf\x04\x6a\x0e\x17\xed\x16\xee\x54\x9e\x4a\xa3\xbe\x57\x8a\x1d\xdd\x60\x6c\xf9\xe1\xd5\xa8\xf2\x21\x36\x1f\x5e\x56\x3e\x5c\x16\x1f\xde\x54\x3e\xfc\x53\x7c\xf8\x32\xf2\xe4\x62\xd1\x12\x89\x3c\x96\x43\xc9\x8b\xa1\x23\x16\xe6\x9a\x9a\x5f\x45\x45\xa9\xeb\xb6\xb1\x06\xfc\x46\x12\x77\x0f\x9f\x6f\x86\x26\x6f\x51\xa9\xff\x55\xf1\x81\x56\x3e\x24\x45\xc3\xb2\xca\x87\x4c\x7e\x50\xf7\x15\xde\x12\x23\x8e\xe7\x7e\x31\x42\x66\xfc\x8e\x26\x13\xd4\x0d\x40\xd9\x8e\x39\x70\xd7\xad\xf7\xb9\x20\x1f\xbd\x9f\xfc\x4a\x8e\xaf\x28\xcc\xf7\xb4\x91\x54\xe4\xf8\x75\xeb\x4a\x8a\xf1\x37\xec\xf2\x49\xfc\x44\x6d\x66\x0a\xbd\x5c\xae\x4b\x85\xe9\x2c\x9f\xeb\x23\x3f\x3d\xcd\x17\x9a\x04\x0c\xc4\x2f\x68\x1c\x28\x43\xd4\x31\xbc\x98\xf2\x86\x9c\xea\xfc\xcb\x3b\xe3\x22\xa8\x76\x89\x00\x5d\x1a\x72\xae\x77\x1b\xa3\xa0\x53\xf6\x95\xda\xd6\xd4\x5e\x7b\x4a\xd5\xd4\xc8\x73\xc4\xbf\x75\xa0\xbf\xa6\x9e\xf2\xbc\x5a\x86\x1c\x32\x12\x22\xd7\x9d\xaf\xdc\xd5\x8a\xda\x9f\x96\x59\xfb\xa2\x10\x65\xe2\x86\x8f\x52\xd6\x20\xda\xef\x23\xef\x22\xd2\x20\x57\x76\xd5\xe5\xfc\x45\x25\x93\x95\x9b\xde\xfb\x35\x51\x36\xb6\x4b\x91\xae\x22\xad\x2c\x2b\x57\xb5\x51\xab\xaa\x34\xfb\x45\xe4\x56\x56\x7e\x19\x60\xf0\x85\x2c\x45\xef\x81\xb5\x5a\xb1\x1b\x16\x71\x3e\x6a\xf2\x50\x75\x5e\xfe\x8b\x1b\x30\xbd\x7b\xa0\x32\x8c\x1a\x95\xf2\x15\x98\x50\x02\x25\x1a\xd8\x6e\xeb\x17\x63\xa5\x3a\xa1\xb4\x23\x15\x1a\xf2\x3a\x97\x0a\x5e\xdd\xf7\xf2\x66\x5a\x32\x14\x43\xdb\xfb\xe9\x7c\x59\x8b\x4f\x2c\xb7\xe5\x6a\x44\x2d\xa9\x46\xa0\x7d\x5e\x08\xff\x96\xa6\x16\x65\x62\x44\x13\x8b\x47\xc5\xb1\x27\x57\xf1\xe1\xda\x1e\x4f\x9d\xca\x71\x79\xe3\x9a\xc7\x63\xb6\x9d\xb3\x80\x5a\x97\xa2\x60\x32\xf4\x55\xbb\x6d\x23\xaf\x86\x0b\x06\x95\x05\xd4\xaf\x23\x5c\xd4\x78\x6a\xd6\x6a\x0b\xa9\xf4\x57\x6f\x4d\x38\x30\x72\x13\xe8\x37\xc9\x04\xa1\xaa\x81\xbe\x6b\x56\xa0\x2a\xf7\x37\x46\xd0\xc3\xd0\xf7\xec\xd3\xad\x26\xda\x11\x19\xc5\xfa\xdc\x28\x1f\x57\xa9\x16\xe1\xb7\x16\x8a\x93\x97\xda\xdf\x7c\x17\x34\x54\xa9\xe1\xb0\x51\x16\x1c\x2d\x30\x17\xc6\x46\xd6\x8c\xa1\x0b\xd1\xaa\x78\x4b\x58\x66\x9a\x6a\x6b\x0b\x58\xfd\xae\x27\xfb\x0a\x3e\xee\xe7\x42\x84\x4a\x2e\x85\x97\x96\x8a\x38\xc1\x60\xe7\xe9\x09\x87\x69\xa8\xda\x63\x7d\xde\x1f\x45\x89\x9b\xab\xc3\x51\x62\xcc\x49\xab\xc6\xc0\x2b\x7a\x4a\x1b\x96\x6a\xba\xf7\x70\x53\x18\x38\x23\x63\x58\xb2\xc3\x7d\x57\x9e\xc2\xad\x91\x12\xdd\x9b\x7d\x1e\x4a\xcd\x99\x9b\xca\x2e\xc3\x76\xc0\x9a\x70\xd4\x8b\x71\xf8\x8a\x71\xe3\xff\x62\xdc\xf8\xaa\x78\x4b\xe3\xf6\x7f\x39\x1c\xf0\xab\xb1\x58\xdb\xce\x52\x97\x3e\x5f\x3e\xc7\xb3\x15\x83\x87\xb6\x5e\xee\x94\x93\x92\x92\x2d\x77\xf9\xa2\x1c\x46\x76\x16\x3a\xfc\x15\x3d\x78\x1d\xa9\x11\x44\x67\x1f\xf9\xac\x28\x07\xe9\xd1\x36\x66\xc5\x25\x41\xd1\x6b\x23\x9e\xa2\xe4\xda\x39\x45\x7f\x20\x80\x37\xe6\xef\x73\xab\xab\xf6\x09\x7e\x5e\xa1\x96\x21\x77\xb0\x62\x86\x49\xc5\x20\x40\xeb\x0c\x6f\x65\xb0\x29\xef\xad\xea\x03\x55\xa5\x57\xbe\xae\xa6\xb9\xa6\xa3\xed\xad\xa6\xb7\x55\xb6\x53\xfd\xbc\xb4\x5f\xff\x6c\xa0\x57\x1f\xa1\xf0\xcb\x9a\x83\x58\x25\x2f\x4d\xce\x15\xd1\xfb\xe9\x19\xeb\x67\x11\xa0\x64\xdd\xb0\x38\xa1\xb5\x2f\xc7\xa5\x42\xdb\x0b\x13\xdd\x8b\x32\x7d\xba\x74\x5c\x78\x51\x5a\xac\x2a\xe7\x05\xf1\xb2\x69\xd6\x79\x54\x41\x29\x38\x97\x6b\xd9\x5c\xe7\x0f\x78\x47\xa0\x6c\x2a\x2e\x99\xbf\x7e\x2a\x96\xbb\xd1\xa0\x5a\x12\xda\x95\xb7\xa9\xab\x49\x4c\x73\x7e\xf2\xbb\xef\xb6\x2a\x5e\xba\xd4\xf5\x6e\xf7\x89\xbe\xdc\x75\xd1\x54\x61\x3a\x87\x13\x59\x77\xc0\x79\x86\x97\xdc\xa6\xba\xba\x16\x58\x05\xae\xb4\x5e\xc8\x46\xe9\x16\x71\xc1\x2a\x0d\x67\x1b\x77\xd5\xfe\x83\x2f\x02\x36\x34\x12\x64\x55\x41\xee\xcf\xc5\x03\xbf\xe9\xf3\xd5\xfc\xcc\x0f\x33\xd5\xa0\xe4\x65\x67\x69\xa0\xf4\x61\x64\xec\xd8\x6a\xec\x4b\xa3\x26\x19\x0c\x14\xf5\xd1\x00\xfd\x17\xfa\xfa\xde\xcb\xd2\xd3\x63\x1d\x1b\x02\xe5\xe1\xce\x17\xff\x0a\xf2\x40\xc9\x46\x95\x6a\xce\x66\xd5\xf7\xea\x7c\x9b\xcd\x96\xe7\x60\xbe\x93\x96\x2e\x39\x10\x24\xc8\x5d\x11\x58\x4f\x85\x54\x9e\x5a\xd9\x83\xa6\xc5\xd4\x85\x7f\xb7\x1a\x9e\x57\x66\xb0\x21\x47\xa5\x99\xfa\xac\x98\x86\x66\xad\x93\x6b\x69\x98\xf3\xcd\x87\x7f\xbb\xd5\x89\x52\xe6\x9f\xd7\x6f\x71\xff\x54\xe9\x31\x24\xab\x27\xfd\x6c\x86\xf4\x0a\xed\xcd\x70\x48\x98\x91\xa1\x2d\xdc\x1f\xb9\xce\x86\x98\xcd\xae\x1d\xe6\xd6\xe9\x3f\x99\x1f\xa6\x17\xc9\x25\x56\x35\xfd\x93\x09\x49\xd2\xf5\xf5\x18\xde\x00\x87\xa8\xb9\xbe\x2a\x17\xbc\xe5\xd2\x6a\xee\x2b\xbf\x8b\x02\xce\x89\x3c\x52\x44\x5e\x6f\x3f\xb8\x14\xca\xc4\xf6\x91\x22\xb6\x38\xa1\xe5\x02\x64\xa4\x63\x92\xaf\x30\x8f\xd4\x5d\x9f\xf7\xc4\x86\xa8\x4b\x5c\x3a\x74\x88\x8d\x22\xd1\x32\xac\xb2\xf6\x9a\x2e\xaf\x1c\x45\x5d\xbb\x00\x7d\x57\x72\x73\x81\xc4\x40\xea\x5c\x2b\x4b\x3f\xa5\x45\x25\x94\xad\x43\xe0\xa0\x3e\xad\x32\x7f\x31\x91\x5c\x58\x4c\x41\x04\x7a\xa8\x2a\xbb\x50\x35\x00\x7a\xa2\x64\x08\x04\xdf\xe5\xad\xea\x6c\xe6\xab\x5f\x87\x2f\x66\x84\xf8\x38\x68\x35\xaf\x05\xfe\xec\x67\x1d\x20\xed\xe3\xb5\xc6\xb8\x84\x0b\x5f\x2c\x1f\xf5\x27\x2b\xe5\xa3\x29\x82\x2a\x17\x13\x11\x34\x4c\x2d\xdb\xa5\x7c\x50\xa3\xb1\x56\xc3\x89\xd6\x6a\x8d\xeb\x34\x8b\x69\x62\x08\x67\x91\xfa\x5b\xa4\xfd\x10\xcb\x1a\xa2\xae\x95\x68\xb9\xaf\xf2\x3c\xe1\xa5\xfa\x15\xc4\x20\x18\x6d\x10\xf2\xe1\xa6\x6d\x5e\x3d\xe3\xdd\xd8\x2a\x2d\x01\x95\x7e\x7a\x17\x2c\x77\x06\x77\xa7\x6f\x50\x27\x29\x4b\xe9\xf3\x5c\x94\x90\xb6\x45\x71\xa7\xe1\xc9\x6b\x2d\x29\xfd\xab\x9c\xae\xdf\x2c\xaa\x74\x91\x8a\xae\x27\xde\x11\xb5\x0a\x93\x65\xc7\x61\x44\x2a\x87\xe1\x64\x90\x4f\x52\xdd\xde\xc0\x60\x98\xda\x98\xb3\xe8\x46\xce\x12\x31\x77\xbe\x9a\x2d\x51\x8d\x44\xc0\x02\x09\x48\xd1\xa1\xbd\x8a\x81\xb0\xea\xaa\x69\xa5\x56\x45\xb3\x56\x64\xca\xe5\x2c\xb7\xb5\xb6\x0c\xc2\x76\xe9\xd6\x91\x52\x87\xa0\x71\x29\x6a\x7f\x62\xed\x14\xe8\xca\x8a\x31\xee\xf0\x5e\xad\xb6\x62\xf0\xd1\x22\xb8\xc3\x7b\xf5\xeb\xfc\xb8\xa7\x6c\x73\xd4\x96\x0a\x29\x61\xfa\x4b\x0b\xd5\xc9\x59\xf1\x8e\x3a\x05\x7f\xde\x38\xe5\xc4\xe8\x5e\x00\x63\x25\x3a\x80\x24\xe8\x33\x90\xf7\x48\xa2\x6c\x68\xe4\x8b\xc9\xa2\x6d\x9e\x3c\x36\x2f\x8f\xe5\xd7\xc8\x29\xe6\x9c\x28\x34\xa8\xd4\xf0\x01\xcd\x2f\xba\x50\xa5\x91\x93\xed\x96\x78\xca\x5b\x7c\x73\xd3\x95\xb9\x9b\xc8\x25\xa3\x5b\x7d\xd7\x2f\xbd\xd2\x07\x59\x9f\x3a\x7f\xe4\x2e\x4b\x5c\xef\x0f\x25\x30\x2f\x0a\xff\xa3\xd8\x0b\x80\x43\xb2\x4a\x91\x6b\x59\xe1\x4b\x97\x54\x52\x79\x32\xfb\x6e\x34\xb0\x3e\xdc\x60\xb7\x90\x1f\x37\x08\x99\x56\xf6\x0f\x61\xa6\x97\xdc\xe4\xca\x49\x68\x6e\x55\xf8\x4c\x5f\x2b\xe5\xd2\x3a\xa9\x81\x0c\x9c\xdc\xfb\x95\xc9\x03\xbc\xac\xdb\x44\x28\xdc\x22\x3d\x9a\x2a\x51\x5c\xea\x29\x38\x71\x3e\x07\xfa\x20\x28\x0f\x74\xc0\xd7\x08\xee\x13\x26\xd6\x61\xd0\x80\x5e\xc4\x2a\xba\x98\xcf\xa5\x3a\x55\x9e\x09\xaa\x2e\xa2\xf9\xd7\x9f\xa3\x85\x80\xc4\x68\x78\xc9\x03\x93\x7e\xc9\x75\xd2\x4a\xd5\x2e\x14\xc1\x4a\x49\xb0\x7f\x8a\x28\x84\x2b\x45\x97\x2f\x11\x08\x1f\xa8\x0f\xdc\x07\xe6\x43\xe2\x43\xea\x43\xe4\x93\x5d\xf0\x7d\x32\xa5\xa1\x32\x1a\x04\x14\x2c\xf0\xc0\xdb\x68\x16\xb2\xe4\xe9\x9d\x17\xf9\x20\xd0\xef\x2a\x48\x3b\xa4\xe4\x8e\xfe\x39\x62\x22\xc7\xef\xc5\xa8\xa9\xcf\x99\x60\x3f\xf2\x67\x91\xb0\xf8\x34\x1a\xcb\x91\xc5\x4e\x09\x68\xc8\xc6\x0c\xf5\x58\xbc\x8e\x3d\x9d\xda\x60\xcf\xe7\x76\x0f\x30\x5a\x48\x9f\x57\x3f\xe6\x5f\xf1\x73\x81\x94\x2a\x73\x45\x71\x88\x37\x9d\x17\x1a\x73\xd3\x39\x48\x45\x18\xfc\x58\x56\xa7\xc1\x12\xa5\x9a\x0f\x6a\x8e\xb1\x54\x66\x90\x37\x47\xee\xfa\xf8\x19\xfd\xeb\x60\x08\x8f\xa4\x93\x00\x7c\x5c\x3e\x00\x62\x44\x0d\x21\xaa\xfb\xa6\x9f\x2a\xf1\x3d\x8f\x4e\xd3\x54\x42\x54\x22\x9c\xa4\xb7\xd1\x9c\x43\xe6\x13\xdf\x87\x91\x4f\xa6\xa8\x81\xe1\x27\xde\xaa\x29\x3a\x07\x14\xd0\xbe\xe6\xab\x3e\x9e\xe3\x86\x17\x47\xf7\x8e\x80\x5d\x37\x8f\x78\x91\x89\x9f\xc7\xdc\x6a\xc2\xae\xbb\xd9\xd4\xd9\xae\x8e\xef\x88\x06\xa9\xef\xbb\xbf\x37\xdb\xf5\xfd\x27\x95\x52\xbc\xfa\xfe\x13\xa7\x94\xdb\x0e\xe6\xb6\xe3\xce\xe7\xad\x2f\x11\x59\x52\xc4\xd5\xa2\x53\x10\x3e\xb1\x33\x1e\x48\x78\x83\xa0\x60\xb8\xb8\x7f\xc7\x86\x88\x0f\x5a\xab\x35\xbe\xa7\xcf\xa3\xb1\xda\x0d\x4d\x38\x32\x50\x92\xd3\x00\xba\x3a\x07\x5c\x82\x51\x48\x57\xd2\x59\xf5\xad\x7e\xef\x27\xfc\x97\x11\xb0\xa4\x70\x22\x8d\x1d\x57\x63\x49\xa1\x30\x0f\x2b\x67\x1c\x05\xa0\x75\x08\xf7\xf1\x76\x43\x6a\xf2\x39\x7d\xdf\x9d\xe2\x7b\x73\xce\x7c\x32\x95\x78\xd6\x36\xaa\xc3\x7b\x8d\xc6\xfd\xfd\x7d\xfd\x7e\xb7\x1e\x25\xc3\x46\xf3\xf8\xf8\xb8\xf1\x80\x5f\x6d\x18\xfb\x62\xb4\x36\xd6\x51\x03\xbb\x59\xfe\x39\x7f\x6b\x03\x82\x69\xaf\x88\xb8\xb3\xbd\xbd\xdd\x40\xe4\x6d\x78\x08\x19\xbf\x5d\x93\xd7\x71\x43\x7e\xb5\xe1\x61\x75\x71\x5f\xce\xdf\x62\xc5\x8e\x1a\x06\x62\x5b\x46\xe5\xe9\xaa\xc8\xb2\x48\xf9\xb5\x61\xcf\x21\xa9\x8c\xcb\xd2\xc8\xb7\x37\x9a\x9e\x7e\xae\xd5\xf4\x93\x34\xce\x95\x2c\xa6\x3c\x94\xa0\xc0\xe3\x05\xf5\xd1\x80\xdf\x59\x51\xe0\xd5\xc7\xc6\xe5\xe7\x97\xcd\x66\x63\x90\xc7\xf9\xaf\x67\x7e\xca\xfa\x97\x92\xf2\x66\x09\xb5\xc1\x6e\xd6\x9b\xb6\x0b\xa9\x4f\x3a\x76\x64\x83\x3d\x4e\xf1\x4f\xf4\xc3\x06\xfb\x9e\xde\xdc\x32\x61\xf7\xe4\xb1\x35\xf4\x61\xe0\x43\xe0\x43\xec\xc3\xd8\x87\x3b\x1f\x86\x3e\x4c\x7c\xb8\xf1\x91\x09\x0e\x7d\x92\xf8\x6d\x3d\x4b\x2a\xb2\x0b\x5a\xab\x51\x74\xf5\xe3\xd7\x71\xe0\xda\xa6\x19\xf9\x8d\x80\xba\x49\x7b\x77\x29\x3d\xf9\x7a\x0b\xf7\x05\xea\x2b\x6e\x22\xa5\x95\xa6\xc1\x25\x2b\x19\xe7\x67\x6e\xfb\xaa\xa4\x8c\x67\x05\x11\xcd\x0f\x8f\x5a\x4d\xc6\x0c\x51\x6a\x45\x52\x40\x8c\x46\x14\xd6\xcf\x26\x5a\xdd\xba\x1a\x51\x6b\x1c\xa5\xc2\x0a\xd9\x2d\x2a\x6f\xf6\x7d\x54\xb5\x52\x9e\x2c\x95\x12\x26\x53\x1a\xa4\x93\x28\x7b\x9c\x50\x4b\x24\xd2\x54\x54\x44\xea\xd4\x6a\x5d\x7e\x7e\x89\xec\x8e\xcf\xad\x28\xc4\x77\x55\xc1\xba\x75\x49\xa9\x2e\x3e\x88\xfa\x06\x97\xec\x7b\x2a\x07\x0f\x89\x69\x2a\x70\x8a\x6e\xf9\x3c\xd8\x92\x69\xb7\x54\xda\x14\x91\xcb\xad\x71\x94\xa0\x1f\x5a\x24\x8b\x72\x36\xd8\x7a\x63\x5f\xdf\x91\xf0\x25\xca\x99\x95\xc0\x27\xa1\x8f\x5e\x03\xef\x6c\x17\x62\x1c\xff\x31\xae\x45\x2a\x27\x40\xfe\x74\xa9\x10\x75\xed\x1e\xdc\xf8\xab\xb8\x00\x13\x24\x39\x59\x15\x88\x0a\xdd\x0e\x77\xe7\x73\x18\xfa\x24\xf6\x15\x33\xd3\x1a\xfa\x5b\x5b\xb5\xda\xc6\xc0\x97\x82\xed\x31\x7e\xea\x0c\xfd\x1e\x04\x7e\x67\xec\xf7\xdc\x81\x4f\x6e\x7c\x67\xec\xe7\x8e\x76\xb1\x75\xce\xc4\x27\xa9\x49\x3e\xf1\x95\x48\xfc\x0e\x43\x31\xe5\xe6\xd8\xd7\x2e\x92\xb7\xa1\xe9\xd6\x45\xf4\x09\x41\xed\x4e\xfd\x94\x3a\x6e\xf1\x11\x2f\x6c\x9a\xa8\x1c\xde\xb9\xf3\x7b\xee\x34\x2f\xe7\xce\x77\x95\x04\x7d\x80\xae\x16\x07\xe5\xd6\x55\x0f\xbb\x1a\x8c\x38\xbf\x10\x46\x85\x0a\xb4\x4c\x74\x02\xbf\xce\x38\xa7\xc9\xab\xab\xf3\xb7\xc4\xb6\x81\x91\xc0\x47\x89\x55\xbd\x1f\x46\x9c\x62\x34\x07\xcb\xac\x7a\xcc\x90\x4a\x4f\xac\xfe\x4f\x46\x93\x89\xee\x5b\x04\xaf\x44\x73\x19\xc2\x17\x65\xff\x08\xab\x43\x08\xd1\xe6\xcd\x1b\x5a\x64\x24\xd5\x9e\xf2\x4e\xf2\xe5\x95\xb2\x5c\xa0\xf7\x3e\x3c\xf8\x70\xeb\xc3\x99\x0a\xfc\x0f\xc9\xf1\x97\xa8\x56\x5b\x1d\x7d\xcd\x5c\x52\x33\x67\x39\xa7\x07\x9f\xac\xcc\x48\xd1\xfa\x6b\xdf\x9d\x3e\x54\xfb\x1b\x27\x8f\xc0\x93\x88\x02\x76\x9f\xcf\xb1\xf2\xeb\x1a\xc0\x68\xea\x4c\xe7\x28\x5a\x4f\x0d\xab\xb7\x8d\x0c\x79\x91\xbf\x24\x06\xd6\xb5\x3f\x5f\xd7\x2a\xcc\xa3\x98\xfd\x4b\x59\xc1\xed\xea\x36\x30\x9a\x96\x4a\xb9\x5d\x39\x6b\x7e\xa9\x17\xf8\xe0\x17\xc6\x3e\x79\x4b\x55\x51\x39\xd1\x53\xea\xb5\xf7\xa6\x06\x79\x70\xa9\xdc\xfb\x52\xb9\x4a\x0e\x57\x0e\x98\x6b\x22\x60\xc2\x96\x15\x2b\xb1\x0c\x29\x45\x9e\xce\xbd\x32\x8f\x4b\x10\xe5\x4e\xea\x4c\x22\x44\xe6\x9a\x2e\xc8\x71\xfa\x98\x3b\x9f\x3b\xae\xe4\x76\x4f\x7c\xb8\xf4\xe1\xd4\x87\x0b\x53\x69\x93\xe3\x42\x0f\xc0\xfb\x15\x51\xb4\x94\x11\xae\x7c\xd2\xf8\xab\xdb\x51\x77\x74\x4e\xdb\x93\x5c\xe6\xec\x05\x0b\x29\x2a\x50\xb8\xdd\xde\xa3\x06\xbc\xa8\x34\x7f\x0e\x1f\x7d\x3c\xe6\x53\xbf\xbd\x51\x0a\xc6\x6a\x09\xd2\xb1\x7f\xeb\x2b\xb9\x62\xfd\x7b\x6a\xfd\xd6\xdf\xae\x1f\xd6\x77\xad\xdf\xfa\xd2\x26\x0f\x8d\xf3\x50\x70\x0a\xd6\x6f\x7d\xa4\xa4\xf5\x7a\x5d\x82\xec\x86\x51\xe2\x59\xc9\xf0\xc6\x69\x36\xf7\xc0\x6a\xee\x1f\x82\xb5\xbf\xe3\xb6\xac\x41\xc4\xc5\xd6\xbd\xf4\x4d\xe7\x59\x1c\x69\x6e\xd8\xaa\x26\x38\xda\x07\x2b\xff\xff\xff\x97\xe8\x3b\x60\x35\xf7\xb6\xc1\xda\xd9\xd9\x5b\x93\xc0\x42\x15\x80\x2d\xa5\x6c\xc7\x22\xee\x59\xc8\x3a\x26\xc8\x24\xb7\xec\x1e\x50\x62\x7f\xcd\xf7\xa8\x24\xe3\x1c\x37\x29\xd5\x3b\x56\xde\x31\x95\x6e\xb1\xb6\xac\x31\x4d\x53\x7f\x48\x95\xd9\xc3\x0d\xb5\xe2\x04\x4f\x0a\xf2\xe6\x18\xb5\x8d\x15\x17\x68\x8c\x2a\x50\xef\x74\xc0\x1e\xd0\xb2\xe1\x26\xa4\xe3\x5c\x95\x1e\x51\x17\xa4\x9d\xc3\x24\xca\x12\x0b\x79\x45\xd6\x97\xbb\x55\xbd\xcb\xbb\xfc\x2a\x32\xf6\x10\xe5\x11\xf1\x83\xdc\x8d\x97\x85\x55\x47\xeb\x48\x2c\x4e\xca\x78\xad\x48\x9a\x5a\xca\x9c\x96\x0d\x26\x8c\x9d\xc4\xda\xbc\xad\xfb\x11\xe5\x26\x07\x54\xad\x1e\x33\xce\x06\x8c\x06\xa5\x52\x53\xce\xe2\x98\x8a\x95\xf9\x37\x32\xae\x53\x28\x9e\xbb\x34\xef\x1a\x4f\x8a\xaf\x4f\x1a\x73\x57\xd6\xe3\x25\x15\x79\xff\x60\x77\x28\x66\x44\x66\xbd\x76\xef\x2f\x7d\x94\xc6\x1a\xd1\x1d\x4d\x06\x61\x74\x8f\xe7\xce\xc6\x3f\x19\x4d\xb1\xb8\xb4\x21\xfc\xe1\x90\x06\x85\x7a\x49\x29\xd9\x30\x07\x29\x18\x46\xd1\x30\x94\x2a\xed\x8d\x41\x94\x64\xe3\xc6\x7f\x6d\xe4\xbf\x2a\xcd\x56\x25\x91\xb8\x67\x78\xa1\x55\xd5\x59\xc1\x16\xbc\xc0\x1b\x14\xcb\x47\x7b\xd6\xb6\xf5\xb1\x62\xba\x6a\x1a\xf2\x2f\x75\x5e\x30\x3b\xbb\x75\xba\x44\xc3\x38\xd9\xd8\xd0\xa7\x0a\x59\xf9\xd3\x28\x0c\xfd\x38\xa5\x41\x4b\x05\x77\x78\xdb\xae\x7e\xb1\x3d\x3b\x8c\x86\x76\x4f\x09\x8b\x55\x3c\xbc\xa3\x55\x8f\xf5\x30\x1a\xa2\x89\x14\xaf\xd5\x74\x88\xcc\xe1\x0c\xe5\x01\x2e\x9c\xfa\xe4\x85\x3f\x87\xcb\x52\x65\x34\x76\xb9\xb4\x6e\x5b\x3a\x95\xd1\x8e\x16\xce\x6c\x35\x7b\xba\xde\x34\x37\x9e\x46\xca\x89\x00\x1c\x4a\x69\x15\x49\x2c\xf2\xc6\x1a\x40\x46\x49\x66\x5a\x25\x10\x6e\x29\x65\x29\xbd\xe7\x62\x42\xbc\x31\x27\xf6\xef\xf6\x66\xb2\x69\x3f\xb5\xec\x4d\x05\x74\xa3\x6d\xf9\x5a\xe8\x77\x51\x2a\x5b\xa3\x2f\x26\x7d\xcf\x50\xab\x15\xcf\xe6\x02\x46\x06\xe2\x65\xbb\xfd\xc4\x46\x14\x2f\x85\x0c\x98\xba\xf3\xf9\xf2\xf9\xcd\x51\x41\x50\x25\x9a\x9e\xf5\x5b\xdf\xde\x14\x15\x42\x54\xa5\x8b\xeb\x69\x9a\x6d\x9c\xf4\xe1\x8d\x2b\x9c\x54\xc6\x5c\xd7\x20\x8c\x86\x0b\x15\x30\x92\x2a\xb9\xc3\x78\x97\x3e\x39\xf1\x89\x1c\x2a\xd9\x11\xdf\x7d\x62\x3f\xf3\x83\x42\xb5\xc3\x86\x77\x3e\x79\x7c\x62\xf6\x3b\x79\xb5\x94\xc6\xb4\x2f\x57\x35\xb2\x92\x39\xb2\xce\x6f\x29\x58\x37\x99\x40\xb5\x90\xdf\x52\x19\x29\xdf\x28\x69\xf0\x18\x9e\x2f\x4e\x81\xfc\xd3\xe3\x73\x96\x4a\x83\x0c\x54\x16\x47\x25\x71\xb4\xfb\xb4\xad\x38\xcc\x86\x8c\xd7\xad\xaf\x68\x7e\xe1\x4f\x2c\x4e\x73\x9a\x88\xf7\xa8\xe8\x95\xd4\xf2\x55\x14\xeb\x8e\xf9\xeb\x57\xb9\xe6\xf0\xf3\xb8\xe9\x7f\xd9\x98\x79\x6a\xcf\xe1\x75\xb5\x36\xa8\x1d\x63\xc0\x75\xcc\xd5\xc1\x8d\x43\xdd\xca\x04\x1b\x3a\x76\x21\xc7\x91\x16\x59\x98\xae\xea\xde\x01\xb3\x73\x67\x33\x99\x56\xda\x69\x31\xa8\x24\x42\x67\x86\x2a\xee\xb9\x5f\xe7\xd9\xf8\x86\xea\x34\xe7\x7e\xdd\xc7\xed\xb8\x78\xcd\x57\x87\x7a\xc7\x2c\xe7\xf0\xd6\x27\xaf\x7d\xf8\xec\x93\x69\x9e\x76\xe1\xdc\x86\xe3\x67\xac\x0b\xd0\xc8\xbb\x56\xcb\x1c\xea\xb6\x1d\x41\x36\x05\x50\xb2\x49\xd1\x0b\xd0\x96\x00\x5e\x9c\x26\x8b\xb3\xa4\xd8\xa4\x4f\x78\xe9\x28\x58\x7c\x98\x2b\x64\x72\x90\x35\x5c\x55\x28\xde\x5c\xe5\xb6\x67\x1b\xa9\xbc\x29\xdb\x40\x80\x46\xdd\x2f\x98\x38\x67\xdc\x50\xeb\x14\x2f\x80\x20\x22\x09\x91\xd2\x9a\x31\xe3\x8e\x36\x0c\x31\x68\x49\x6e\x2b\x92\xe7\x11\x44\x5e\x7b\xeb\x3b\xa2\x13\xf5\x80\x76\xa2\x5e\xae\x43\x14\x91\xa4\x15\x19\xb7\x21\xad\x08\x51\x23\x72\x90\xb6\x4e\xd4\x2b\xc5\xa0\xcb\x31\x30\x13\xdd\x41\x45\x33\xca\x77\x1a\x49\x8e\xb0\xcc\x91\x61\x46\xf9\x3d\x6a\x31\xea\x66\xcc\xe7\x90\x0f\xca\xda\x2e\x50\x06\x78\x1b\xa3\xbc\x13\x46\x8b\x9d\xc0\xb1\xed\x29\x5e\x83\x25\xda\x52\x3d\x47\x9c\x73\x0d\xd0\x1f\xa0\xb6\xbf\xa3\x5f\xd1\xfc\x17\xb1\x70\x25\x69\x89\x10\x55\x67\xb1\x4b\x5c\x2f\xd5\x6d\x57\xdd\x83\x19\x52\x93\x21\x95\x19\x6e\xe8\x57\x21\x5f\x9d\x54\xf7\x86\x69\x5d\xe1\x74\x0a\x56\xf6\x0c\x44\x84\xe5\xc3\x82\x1a\x1f\x51\x0f\x52\xec\xa2\xa4\xda\x45\xe9\x7c\x3e\x87\x73\x9f\x7c\xf6\xe1\x93\x4f\xee\xe1\x4f\xe4\x1e\xe1\x87\x4f\x1a\xdd\x4e\x37\x7d\xe2\x74\x9f\xcc\x3a\xdb\x5b\xc7\xbd\x59\xa7\xb9\x75\xdc\x93\x8f\x9b\x6e\x37\x7d\xd2\xed\x35\x86\xf0\x0c\xa3\x3d\x69\xc0\x3f\x32\xd1\x23\x9f\x2c\xdd\x89\xa1\xd1\x5e\x1c\x32\xe1\xd8\x75\xad\x31\x97\x8a\x84\x08\xb0\xff\x1b\x51\xe5\xd1\xac\xc8\xa8\xff\x69\x9c\x43\xa3\xf3\x2b\x0f\x3f\xe4\x4c\x1e\x14\x65\x80\x46\xc4\x20\xb4\xb3\xad\x94\xa1\x15\x76\x8c\xd9\x74\x54\x56\x4a\x13\x85\x3c\xf3\xf5\xa5\x58\x45\xc1\xdb\x96\x85\xb7\x71\x84\xbd\x6b\x87\x2a\x75\xc7\xba\xc1\xd9\xcd\xc1\x1f\xc8\x86\x98\xb7\x1e\xf9\x05\x67\x4e\xa6\xcb\x97\xb4\xa5\x99\x55\xac\x3f\xa5\x19\xaa\xaf\xd1\xcb\x37\xba\x73\xc0\xc2\x56\xa5\xba\xd6\xfd\x80\x65\xb7\x95\xa2\x81\x56\x98\x4b\x45\x82\x80\xe7\x9b\x98\x81\xb2\xd0\xf7\x56\x51\x68\xc9\x25\x62\xf1\x6d\xea\x2d\x17\xde\x36\x07\x1d\xea\xa9\xe2\x52\x91\x18\x93\x7f\xa1\x8b\x01\xaa\x9f\xb4\x0f\xd9\xed\x39\x14\x39\xad\xaa\xbd\x68\x6b\xef\x54\x98\xa3\x11\x44\xc8\x2c\xd5\x34\xdd\x6c\xba\x58\x33\x9d\xb5\x94\x5c\xeb\xb3\x4e\x91\xe5\x0a\x23\x40\xdc\xde\xfa\x11\x4d\xfa\x28\xd3\x71\xe7\x20\x27\xc6\xc5\xe0\x3f\x4a\xb3\x00\x85\x5e\x4e\x5b\xea\xb9\xfa\xf5\x42\x3c\x8d\xec\xb5\x18\x4e\x3e\x29\xb8\xa7\x54\x24\x2e\x0a\xad\x71\xba\x7f\xf0\xe1\x9b\x4f\xae\x51\x71\x16\x5e\xf9\xe4\x02\xde\xf8\xc4\x56\xfe\x11\x24\x2f\x1d\x59\xbe\xc5\x23\xbe\x25\x7d\x07\xb0\xbe\x25\x1b\x62\xc3\x4b\x9f\xbc\x6f\xad\x92\x7d\xde\x33\xf4\x32\xd9\xfe\x90\xcb\x30\x3c\x67\x63\x51\x4c\xa0\x6e\x4b\x25\x1d\x47\x0d\x4c\xc9\x0b\x9f\x68\x6d\x8c\x17\x09\x32\x50\x92\x22\xa0\x17\x01\x66\x48\x70\xad\xb6\x2e\x76\x6b\x73\x93\xb9\x6b\xbe\xa1\xde\x58\x87\xf5\x36\xed\x8f\xab\xbe\xda\xbd\xd6\x9a\x74\x12\x15\x4a\x62\x56\x5c\xb1\x31\x8d\x32\x01\xeb\x0a\x28\x9a\x57\xd0\x68\xe3\xb2\x99\x93\xe7\xa8\x06\xcf\xa3\x7b\xc9\x5c\xe6\x9b\x91\xff\xe0\x6c\x43\xf3\x60\xcb\xe1\x5b\xa8\x85\x96\x92\xd2\xed\xaf\x3b\x15\x0e\xdf\x44\x57\x0f\x0c\x95\x0d\xf9\x26\x83\x74\x8e\x4c\x54\xea\xc3\x36\xe4\x7d\xeb\xc2\x07\x9f\xe4\x8f\xab\xeb\x94\x73\x9b\x5f\x7d\xf8\xc3\x27\x1f\xfc\xd6\xd7\xd5\x97\x07\x79\x0e\xb5\x9a\xca\x29\xa6\x89\x14\x42\xf2\xfe\xea\xfb\x84\xe5\x68\x75\x1e\xdd\xb7\x97\xe7\xe4\xea\x88\x8e\x3b\x5f\x31\x7f\x8b\xde\xc9\x67\xe3\x17\x9f\x7c\xf5\x41\x64\x64\x6a\x6e\x17\xbd\x69\x80\x60\x7e\x7d\x79\x2d\x65\xdf\x50\xf4\xa2\xf9\x9a\x33\x61\x6b\x9a\x82\x7c\xa6\x67\x47\xdc\xa4\x40\xb9\xbc\x64\x9f\xab\x49\x51\xaf\xd3\x06\x75\x1e\xf6\xb4\xa1\x1b\x13\xb6\x56\x3c\x1e\xf9\xa9\x75\x43\x29\xb7\x8a\x54\xf2\xf4\x2b\x4f\xce\x4a\x76\x7c\x43\x15\xe2\x90\x34\x1c\xf7\xad\x41\x86\x32\x79\x2b\xa1\xd2\x92\x20\x67\x27\x11\x27\x40\xa9\x2f\x6b\x9b\x5e\x3b\xe2\x95\xa2\x72\x64\x01\x99\x31\x2a\xed\xe4\xa6\xa0\x11\xaa\x4c\xc5\x09\x8b\x12\x4b\x44\x20\x8b\x4e\xe8\xd0\x4f\x82\x90\xa6\xa9\x15\x0d\x30\x68\x92\x5b\x88\x66\x7e\x68\x29\x3e\xd4\x5c\xe1\x5a\x37\xb4\x80\x0f\xc0\xa3\x30\x8a\xb5\xe5\x41\xdb\x30\xb7\x7e\x1f\x61\x78\x64\x8d\x74\x34\xeb\xf9\xc5\x39\xc8\x7a\xda\x91\xd2\x73\xb2\xb5\xb9\xf1\xbf\x94\x6e\x8f\xd9\x10\x45\x15\x7c\xb8\x52\xa0\x5d\x7f\x3c\x2f\xee\x05\x2b\x03\xa2\x43\x57\x8c\xa4\xfa\x30\x9f\xb7\xae\x8a\xad\x4c\xea\x37\x95\xd6\xdb\xb2\xb5\xa0\x9a\x55\xa8\x0d\xd1\x76\x68\x1b\x7f\x25\xb8\x0a\xfe\xba\xa8\x54\xa6\x37\x06\x65\xf8\xa7\x15\x0b\x36\x44\xa7\xf4\xde\x53\x9b\x82\xa9\xab\x31\x14\x34\x21\xc5\x53\xe0\xd6\x6a\x8b\x1f\xd5\x1c\x6b\x8f\xd7\x7c\x70\x3d\x65\x68\xa9\x66\x83\x3c\xda\xac\x9a\x7d\x0c\x01\x44\xef\xa2\x04\x05\x26\x32\xd2\x7f\x3a\x1d\xff\x4f\xc6\x0f\x16\x5a\x51\x54\x70\xf1\x4b\x69\x1c\xf1\x56\xbd\x2d\x0a\x95\xb4\xdc\xd9\x33\xda\x00\x2d\x05\xaa\xb5\x4f\x33\xe0\x19\xb9\x02\x96\x21\x67\x96\xc8\xbf\x29\xfe\x6d\xad\xa0\x46\x0a\x21\xa4\x4d\x33\xa2\x1e\x3d\x87\x66\x4b\xc4\xd8\x58\x25\x20\xf2\x2b\xb2\xc5\x19\xfe\x19\x11\x96\xb5\x58\x25\xb2\x9a\x39\x26\x88\xb9\xd3\x11\xe2\x18\x67\x12\x23\x83\xc1\x88\x08\xe0\xe4\xdc\xc1\xc0\x24\x6b\xfb\x5e\x86\x38\x47\x9f\xd1\xfb\xed\x1c\xf9\x6c\xe6\x24\x19\x92\x72\xe6\xa4\x99\x2b\xef\x51\x85\x84\x6c\x55\x32\xdf\xbe\x3b\x4d\x9d\x7e\x81\x20\x41\xa6\x62\x44\xf9\x0a\x0e\x3f\x17\xdd\xd2\xac\xd8\x11\x12\x30\xe6\x01\xe5\xe8\x28\x0f\x56\xc2\x80\x15\xdd\x23\x2a\x67\x2f\xcc\x98\xcb\x4a\x71\x22\x50\x96\xf2\x09\xe5\xbf\x50\xaa\x5f\x84\xf5\x8b\xdc\xf9\xdc\xe3\xee\xbc\x85\x8a\x43\x3e\x24\x2e\x20\x82\x47\x86\xb8\x3b\x23\xbc\xff\xcb\x6a\x35\x6c\xf0\xdc\x30\xe2\x0c\xcf\x2a\x06\xca\x65\x55\x7f\xca\xf9\x81\x4d\x95\x22\x71\x40\x4e\x30\x9a\x03\xcd\xea\x7e\x18\xae\x8a\xbf\xd8\xf8\x32\xc3\x00\x69\xee\xb7\x00\xf9\x06\xcd\x14\xe8\x73\x8f\x5c\xd6\xd4\x89\x34\xe2\x5f\x91\x35\x24\xee\x54\xac\xdc\xd0\x84\xac\x58\x3b\xff\x29\x8a\x14\xee\x34\xc2\x6b\x1b\x01\x5b\x5b\x6c\x36\xc3\x4c\xe7\x88\x47\xe0\x2c\x86\xe2\x1e\x4d\x10\xda\xb1\x7c\xe9\x93\x3a\xa2\x83\x7a\x74\xa8\x29\x06\xd4\xa8\xb9\xfe\xab\xb6\xba\x53\xa9\x6c\xa3\x13\xe2\x19\xf0\x5f\xa5\xc3\x3e\xc2\x08\x68\xb9\x28\x57\x52\x94\x11\x9a\x81\x5f\x5d\x0e\x41\x64\x19\xf4\x75\xb4\xf0\xa8\x2b\x6b\x33\xdd\x85\x26\x40\x99\xb4\x16\xb6\xf2\x66\xbc\xbf\xf9\x73\xc8\x32\xf2\x27\x8c\xca\x59\xe3\xd4\xad\xa2\x4b\x68\x13\x53\x85\xf0\xa1\xce\x27\xa8\x3a\x52\x58\x84\x46\x59\xe5\xb5\x8f\xd7\x6b\x09\xe5\x26\x40\x44\xc2\x0f\x4f\x75\x68\x91\xc4\x04\xa9\x03\x57\x6e\xfb\xfc\x01\x71\xdf\x4c\x5a\x63\xa9\x5e\x0d\xce\x8b\x3c\x55\xbb\x0e\x1a\x66\xe0\xcd\x76\xdd\x0f\xf4\xe5\x1e\x6a\xeb\xce\x5b\xa3\xac\xd8\x72\xc8\x54\x7f\x2d\xad\x55\xdd\x5c\x55\x13\xa5\xd9\xe9\xae\xa8\x37\xc2\xd2\x2e\x57\x7d\x93\x34\xe7\x10\xd0\x7e\x22\x09\xe5\x05\x7e\x4a\x8b\xec\xdd\xe9\x72\x0a\xc4\xe8\xfb\x43\xd5\xaf\x48\x79\x85\x45\x2d\x25\xac\x54\xa0\x92\xd0\x0f\x02\x6f\xd5\x69\x97\xa5\x52\xad\x47\xfb\x8e\x93\xd5\x29\xea\x90\xb6\x14\x99\xc1\xc5\xeb\x07\x81\xf1\x6a\x5b\xc9\x6b\x45\xbf\x17\xa9\x72\x8e\xe9\xff\xa9\xec\xd7\x8e\x94\x05\x9b\x36\x20\x17\xb5\xd4\x64\x89\x04\x8e\x47\x70\x13\x2d\x9f\x15\xef\x10\xcc\x66\x29\xf6\x42\x2d\xb5\x16\xe4\x07\x77\x69\x42\x19\x0d\xc9\x97\x2e\x54\x87\x5c\x7f\xf8\xaa\x8f\x51\xfd\x0c\xc2\x0c\x06\x19\x19\x65\x10\xc8\x4d\x26\xce\x34\x0e\xa2\x9d\x2b\x02\xdb\x6e\x2b\xcc\xc8\x54\x1e\x49\x57\x50\xfe\xb2\xb3\x79\xe3\x68\x3f\xca\x9c\x15\x14\x80\x11\x81\xc6\x93\xfd\x8c\x4c\xe3\x84\xde\xb1\x28\x4b\x9f\xe1\x36\xe3\xf5\xb3\x92\x62\xd6\xb9\xcf\xfd\x21\x4d\x3c\xac\xc5\x20\x73\x18\xf4\xb3\x5a\xad\x9f\xd5\x97\x62\xb8\x80\xf6\x07\x29\xea\x7f\x09\x3f\xbd\x95\x0f\x8a\x51\x90\xcf\x9a\xc1\xf4\xc4\x1c\x84\xcc\x42\x7f\xd5\x43\x0c\xa8\xe7\xc7\x4b\xb3\xcb\x9d\x7e\x71\x5c\x58\x55\x98\xc6\x48\xdb\xe8\x67\xf5\x4a\xe5\x65\xc6\xba\xa8\x5a\xcd\x29\xbd\x95\xd4\xde\x15\x7e\x3c\x02\x41\x42\x3f\x23\x8b\x99\xc8\x19\xaa\x24\xdf\xa5\x3e\x76\xa7\xd8\xf6\x17\x4e\xa9\xea\x72\x2f\x5a\x76\x9e\x5c\x49\x24\x10\xd3\x41\x76\x22\x59\xd5\x94\xd5\xed\xf3\x83\x40\x4d\xfa\x05\x2f\xd0\x0b\xf5\x59\x9d\xd4\xc4\xd6\xcb\x0d\xf1\xff\x17\x53\x62\x77\x98\xbe\x97\x4d\xfe\x64\x90\x61\x2b\x71\x83\xac\x88\x95\x2f\xc2\x35\x11\x5f\x3b\x41\x26\x7b\x64\xc1\xa1\xf7\xaf\xeb\x5c\x5e\x2e\xa6\xac\xb2\xef\xe9\x55\x53\x1d\xcf\xfe\xfd\x2c\x97\x88\x72\xd2\xcf\x5a\x14\x8d\x32\x2b\x23\xd9\x72\x39\x59\x0c\xe2\x75\x39\x3f\x4d\x39\x52\x83\x42\x38\x7a\x0d\x8e\x33\x12\x66\x70\x27\x57\xdf\x30\x23\x53\xc1\xfa\xb7\xde\xe2\x85\x32\x72\x4e\x92\x49\xe0\xe4\x0b\xde\x0f\x19\x43\x0b\x17\x04\x6a\xf6\xfe\x7e\x97\xe9\x0d\x5d\xa0\x44\x97\x92\xbb\x0c\x5d\x2c\xd2\x3a\x66\xe7\x70\x77\x36\xbb\xcb\x34\x5e\xab\xd8\xda\x82\xa6\xdb\x32\x36\x18\x26\x6d\xfb\x0f\xdf\x19\x66\x32\x89\xeb\x0d\xb3\xba\xba\xab\x45\xf5\xb8\x65\x32\x6c\x06\x09\x8a\x98\xb3\x99\x53\x4e\xb6\x0d\xa5\x0c\x71\x62\xdc\x44\x4b\x44\x44\x0b\x51\x39\x30\x52\x34\x42\x82\x8d\x71\x6c\x04\xfa\xd8\xae\xa3\xd5\x1f\xde\x40\x60\x7f\x2b\xa3\x01\x94\x6f\xe1\x6b\x6e\x02\x33\x9f\xc3\x24\x23\xc3\x0c\x6e\xaa\xec\x03\xe6\xac\x70\x40\x24\x05\x43\xb1\x48\x49\xb8\x21\xbb\x94\xae\x71\x9d\x48\xf5\x19\x09\x85\xb9\xa8\x25\xa2\x0d\x91\x4b\xb7\x16\x52\xde\x8c\x84\x7f\x90\x44\x63\xbd\x95\x2a\x6b\x44\x03\x5a\x51\x4e\xa0\x48\x72\xa9\x8b\xe4\x3b\xf6\x10\x4a\x13\x6e\x2a\xbb\xf8\xda\xa9\x50\xf5\x94\xa2\xb0\x1c\x54\xc7\x54\x0a\x68\x3b\xac\x2c\xcb\x11\x84\x6d\x55\x3b\x03\xc4\x53\x85\x04\x91\xe1\x69\x2a\xe2\x6d\x47\x79\x4f\x41\x0d\xf5\x8a\x3d\x8f\x1c\x05\x5d\x7f\xc4\x98\x29\xe8\x1b\x5a\xb6\x28\x37\xef\x22\x2a\xac\x7b\x54\xe4\x54\xd0\xd8\x48\x2c\x69\xec\x28\xee\x42\x68\xbb\x73\x7d\x70\x76\x4c\xb0\x32\xae\xc1\x02\xcb\x26\x4f\xc6\x24\x19\xbf\x6a\xa4\x65\xe9\x2d\xdb\x48\x92\x8c\xdd\x96\x04\x54\xb2\x5d\x58\x9d\x91\x5a\x09\x09\x34\xdd\x4a\x7f\x21\x7b\xb5\xd1\x74\x3d\xed\xe1\x9f\xfa\x78\x09\xd7\x2e\x3d\x3b\xa2\x51\xe9\x2f\xd7\x5b\x08\x80\xff\xaf\xd4\x81\x9c\x2c\xcd\x00\x87\x96\x96\xaf\xa9\xdd\xcf\xfa\xb5\xe4\x72\x76\x4d\x8f\xe2\x81\x03\x05\x05\xae\x87\x4b\x35\x15\x51\xbc\x34\x6d\x5a\x8b\xcd\x14\x64\x75\xd7\xac\xe8\x63\xf1\xbf\xe9\x63\x01\x4d\x4d\xea\xee\x33\x72\x93\xc1\x43\x46\xb8\x80\x5b\xc9\x54\x44\xb1\x87\xf7\xe0\x67\x19\x49\x04\x5c\x17\xbc\x47\x4e\x9f\x6d\x17\x4e\x32\x92\x0a\xb8\xcc\x48\x24\xe0\x34\x23\x8b\xad\x92\x74\x51\xb5\xe4\x1a\x6b\x90\x4b\x44\xae\x59\x5a\x41\xc3\x99\xb4\xed\x55\x08\x39\xb6\x67\x97\xd0\x02\xa5\x9a\x8a\x32\xd4\xca\x55\xe4\x10\x2a\xd4\xf4\x07\xc5\xce\x40\xdb\x12\xa4\x0a\xba\x71\x5c\x4e\x20\x24\x10\x38\xe8\xee\x1c\x2e\x32\xb2\x62\xe7\x28\x4e\xf3\xe8\x18\x18\xfa\xa0\xef\xfc\x32\xc4\xd6\x35\xd7\xf0\x68\xc6\xa5\xed\xf3\x90\x0e\x3c\x4c\x50\xfc\x9a\x21\x5c\x69\x29\x0e\x5d\x8a\x93\x90\xe7\xe8\xf9\x31\x25\xcf\x1d\xe6\xb6\xd0\xdd\x01\xfa\x11\x6e\xb9\xca\xcb\x18\x30\xf5\x1b\x91\x64\x21\x2e\x5e\xd1\x92\xa4\x9c\x3b\x62\xf7\x56\xac\x17\x53\xe8\x93\x44\x9d\x8a\x34\x7e\x06\x84\x24\x5d\x0c\xea\x13\x42\x42\x7d\x2c\xf3\x49\x5f\x01\x36\xe8\xee\x4b\xd0\xbf\x70\xb8\x10\x98\xba\xe0\x6f\x65\x08\x22\xa9\x74\x1b\x0c\x0e\x01\x1b\x38\x23\x12\x19\xcd\x02\xed\x14\xd9\xf2\xc9\xc8\xa4\xee\x63\x96\xc5\x6b\xb8\x36\xb3\xa5\x2b\x8b\x13\x6e\x65\x9c\x3e\xc4\xb4\x8f\xf2\xaa\x7e\xc4\x03\x66\x26\xf6\x98\x0a\x4b\x9e\x25\xb5\x43\x1c\xd4\xa8\x1d\x51\x2b\x8e\x72\x16\x02\xa5\x5a\xe2\x3e\x2a\x01\x86\xfd\x47\x88\x2c\xbf\x44\x56\xdf\xb0\xdd\x39\xbc\x5f\x39\x8d\x34\xc1\xcf\xa7\x8c\x9f\x18\x5c\x93\xf7\xaa\x6e\x68\xc8\xbb\xf6\x23\x12\x9e\x9d\x1a\x6f\x37\xbd\xad\xa6\xeb\x5d\x64\x32\xe3\x39\x5c\x65\x64\xf1\xac\x91\x46\x9a\x34\x2d\x2f\xa4\x8b\xcc\x7b\x9f\xa9\x25\x7f\x2d\xe1\x83\x24\x7b\xf0\xa2\x92\x4b\x01\xc6\xa5\x6c\x59\x65\x44\x73\xc3\xa3\x92\x6d\x23\x59\x2b\x33\x5d\xc5\xa9\x01\xcd\xd7\xaf\x65\x35\x10\x70\x65\x0e\x1f\x57\x6c\xeb\x9a\xb4\x16\xe4\x6a\x45\x75\x0b\x20\x44\x4f\xb8\x2d\xb9\x80\xa9\xa6\x9c\xf9\x12\xa6\x48\x9f\xe0\x7b\x46\x46\x02\xde\x65\xa4\x2f\xe0\x79\x46\x42\x01\xaf\x33\x32\x10\xf0\x36\x23\x81\x80\xcf\x19\x89\x05\x9c\x67\x64\x4a\xb9\x44\x5b\x2f\xc8\xab\x42\x37\x95\x22\x41\x49\x19\xae\xe5\xa3\x64\x2d\x49\xe5\x6d\x36\xc3\xa3\x4a\x39\x44\x71\x50\xb9\x90\x11\x6f\x44\xf3\x27\x42\xf1\xa0\xbd\x58\x8e\x3b\xad\xa4\xad\xd5\x2a\xaf\x6a\xaa\xb7\x75\x16\xd5\x8f\x72\xf9\x7b\xc6\xc2\x5c\x46\x99\xcf\xe1\x53\x46\xce\x33\xf8\x33\x23\x63\x01\x3f\x32\x32\x11\xf0\x2c\x23\x37\x02\xfe\xc1\x9b\x8c\xdc\xe5\x0c\xda\xc7\x68\xff\x71\xf8\x5c\x72\x0a\x27\x2d\x9f\x1e\x65\xe4\x5e\xc0\x87\x82\x72\x33\x9e\xd2\x44\xd8\x2e\x7c\xcb\xc8\xad\x80\x57\xd9\x0a\x85\x12\xb3\x5f\x68\x99\x8b\xe6\xe9\x28\x54\x45\x32\x0a\xf0\x33\xa0\x03\x8a\x18\x25\xf2\x57\xf3\x0c\x52\xea\x43\x94\x1b\x2c\xf9\xd2\x36\x4f\x9e\x98\xb7\x5e\x55\x58\xa9\xea\x09\x5c\x1f\xfd\x4b\x58\x59\xb8\x79\xa0\xc1\xaa\x53\xae\x90\x72\x37\x2c\xfd\xc4\xb4\x2b\x08\xa2\xea\xb8\x1f\x06\x9f\x8b\x2c\x64\x6e\x73\xd0\xde\x30\xbd\xd5\x33\xb6\x85\x5a\xb8\x45\x7c\x57\xaf\x0a\x55\x97\x52\x8b\xd5\x3c\x95\x72\x82\xf6\x4f\x96\x8a\xa2\x0a\xb4\x80\x37\x75\xbd\x4a\x65\xdd\x39\xe4\x9f\x8a\x2a\xa9\x0e\xd0\xfe\xeb\x75\x25\x0a\x7f\xf6\xdb\xd5\xa1\x50\x1a\x12\xa5\xae\x2f\xb5\x01\x2a\x9d\x01\x2b\x7a\x70\x4d\x67\xc1\x42\xa1\x86\x53\x78\x93\xc1\xcb\x8c\xbc\xca\xe0\x6b\x46\xce\x04\xfc\x91\xad\x86\xec\x6c\xbd\xf9\x57\xd3\xeb\x5f\xcc\xa7\x85\x19\x98\xd0\x21\x7d\x90\x73\xfa\x23\x1d\x9e\x3d\xc4\x8e\xfd\x97\xf2\x29\xa1\x6f\x0b\x9c\x46\xb7\xde\x18\x82\xdd\xed\xd6\x6d\xb7\x14\xf8\x04\x03\x9d\xce\x5f\xdd\x6e\xbd\xb7\xe9\xda\xee\xa6\xfd\x48\x73\x48\xb2\xc9\x05\x90\xa6\x1a\xe3\xbc\xe3\x71\x27\x67\x4a\x42\xf0\xef\x26\x38\xbc\xf9\xf9\x04\x57\x17\x09\x78\x68\xa5\xe4\x6b\x56\xb0\xf0\x95\x06\xeb\xd9\xed\xfe\x4a\x79\xbc\x18\x29\xea\x5c\xe3\x05\x42\x6e\x2e\x51\x6e\x18\x5d\x9e\x66\x45\x3d\x0c\x6c\x85\x28\x94\x46\xdc\xe9\x62\xcd\x14\xae\xc2\x9a\x7a\x88\xa5\x7a\x08\x0d\xa8\x52\xc0\x4f\x55\x39\xe8\x45\x0f\x82\xca\x05\x90\xde\x48\x57\xaf\x35\x29\x97\xff\xf5\x82\x93\xde\x29\xde\x23\x9b\xe6\x08\x57\xd7\xc9\x9d\xab\xeb\x3a\x9d\x5f\x18\x4e\xb4\xdb\x3b\x29\xbb\x29\x02\x56\x75\x53\xd9\x5d\x04\x4a\x39\x53\x91\xa8\xbe\x5b\x6c\x99\xa4\x55\x6e\x75\x05\x49\x5b\xd8\x12\x39\x91\x7a\x48\x1c\xf1\xa1\xcb\xb1\x08\xda\x4e\x3a\x1c\xaa\xf1\x74\xdf\xca\x2e\x90\xe6\xde\x7f\x64\xa5\x55\x2f\x57\x44\x9d\x3e\xd0\x3e\xf2\x11\x4d\x3c\xa3\x75\x16\xb3\x00\x6a\xf4\x2e\x99\xbb\xf0\x8d\xf0\xea\x5a\x54\xaa\x97\xe5\xb9\x0e\x68\xf4\x5b\xb4\xa0\xa8\x6e\x13\x59\x00\xdd\xd7\xcb\xbd\x26\xbb\xc7\xf0\x46\xa5\xd5\xa4\x46\x7b\x36\xab\xac\x31\x15\x4a\xa6\xcb\x34\x51\x77\xfc\xd2\xb8\x69\x68\x93\x6a\x16\x8a\x58\x7d\xc9\x40\x8c\x80\x8e\x80\x8f\x80\x8d\x20\x19\x41\x3a\x22\x6f\x32\x88\x46\xe4\x5a\x80\x3f\xc2\x15\x9f\x8d\xc8\x89\x80\xd1\x88\x5c\x0a\xe8\x8f\x48\xb9\x0d\xba\xe2\x75\x91\xb0\xb1\xe3\xce\x21\x5c\xf5\xdd\x46\xbb\x76\x31\x87\xc1\x88\x9c\x0a\x08\x46\xe4\x42\x40\x3c\x22\xef\x05\x8c\x47\xe4\x4a\xc0\xdd\x68\x91\x44\xc2\x70\x65\x39\x26\xa8\x2c\x7c\x59\xb6\x1d\x67\xca\x76\x9c\x3f\x6d\xb6\xf9\x56\xd3\xdb\xc6\x11\x6f\xb6\xf8\xd3\xa4\x95\x6c\x6e\xba\xac\x93\x6c\x35\xcb\x56\xe4\x49\x6e\xae\x67\x8e\x38\xea\xce\xab\x80\x2d\x52\x28\x60\xd5\x09\x4c\x5d\x40\xaf\x10\xaa\xcc\x0d\xbc\xcd\x5b\xc6\x5a\x3b\xcd\xc1\x5a\xf4\xa1\xc7\xde\x14\x9b\xb6\xf3\x58\xd1\xe3\x4d\xfb\xb1\x9b\x6b\xd7\x96\x42\x0a\x0b\xbc\x04\x89\x2c\x6a\xc8\xfa\xdc\x92\x2a\x9a\xb6\xb9\x17\xea\x93\xf1\xc8\x89\x40\xa0\x39\xfe\x88\xdc\x8d\x3a\x42\xab\x73\x47\x18\x94\x2d\xa3\x99\x2c\xdc\xb9\x99\x2e\x1d\xcd\x5d\xe8\xb7\x17\x9a\x57\x76\x78\x49\x21\x82\xbe\xeb\x2d\xc4\x90\xb8\x5f\xb4\x38\xdc\x43\x86\x62\xac\x11\x19\x8e\x1c\x3b\x8e\x62\xdb\x85\x1b\xf5\x92\xa5\xe8\x4f\xec\x7e\x44\xec\xc6\x13\x63\x16\xf0\x3d\x2d\xce\x20\x56\x2a\x26\x21\x4d\xad\x27\x8d\x2e\xb7\xe1\x61\x84\xc2\xc4\xdb\x11\xd9\x68\xb6\xbe\x44\x6d\x87\x8e\xc8\x3a\x5b\x2a\x99\xce\x76\x81\x8e\xea\x38\x67\x88\x8d\x0b\xb1\xd1\x4f\x51\x87\xb7\x94\x6a\x48\x85\x4a\x92\x3e\x9b\x5c\xf9\x43\xb4\xef\x75\xec\x91\x04\x77\x47\x0d\xc1\x04\xcb\x02\x36\x22\x14\x77\xfc\x49\x48\x2f\x47\x14\x7d\xe5\x8c\x96\x4f\x05\xf7\xa3\xcd\x87\x51\x15\x03\xb5\x98\xe4\x5d\xde\x78\x62\x4d\xed\x4d\x51\x67\xc1\xa6\x3d\xcf\xdb\xb3\x89\xab\x0d\x9b\x37\xd7\x70\x89\x5d\x8e\xfe\xa7\x46\x6d\x36\x42\x34\xd6\x2b\x64\x04\x85\x47\x47\x25\xcb\x38\x01\xc9\x68\x36\x73\xf8\xa8\x62\x08\x47\x47\xae\xac\xea\x36\x9a\x23\x66\xf2\x9a\xac\x42\x4e\x1e\x46\x46\xa8\x89\xbd\xb7\x3d\x47\x3b\xf9\xb0\x44\x72\xdc\xe9\xed\x08\xb9\xfc\x91\x93\xc7\x40\x86\xc5\xf5\x74\x56\x2f\x7c\x15\xff\x85\x9f\x53\x86\xb3\x11\x5c\x8f\xe0\x64\x44\xbe\x64\x70\x39\x22\x1f\x05\x9c\x8e\x0c\xa7\xac\xd4\x56\x5c\xb8\x28\xc2\x8c\x0e\x89\x0b\xef\x47\x64\xaa\x50\x08\x96\x6f\xd0\xa5\xe9\x3b\x79\x87\x92\x01\xf9\x08\x85\x2b\x8b\x15\xd7\x4d\xf3\x39\x5c\x8d\xc8\xfb\x11\xbc\x18\x91\xe7\x02\x3e\x8e\x48\xc3\x69\x7b\x7f\xcd\xba\x73\xb7\x8d\xaa\xa7\x9d\xbf\xba\xd3\xee\x5c\xa9\x9b\x4e\x1b\x43\xf8\x3e\x22\x8d\x6e\xa3\xfb\xa4\xfe\xa4\xdd\x7d\xd2\x6d\x34\x86\xf0\x0e\xd3\x38\x6d\xcf\x69\x7b\xdd\x4e\xe7\xaf\x6e\x6f\xb3\xd7\xed\xb9\x33\xa7\xed\x75\xfe\xea\xa6\xdd\xcd\xee\xd3\xee\xff\x78\x3d\xd7\xdd\x74\x1d\xa7\xed\x95\x02\xbb\x4e\x6f\x13\x13\x21\x4b\xe4\xf6\x36\xbb\xae\xdb\x96\x85\x76\x74\x84\x5e\x1b\x8b\x6d\x0c\xe1\xf9\x88\x34\xfe\xfa\xef\x31\x0d\x98\xdf\x80\xd7\x58\x83\x0e\xc2\x01\x6c\xa9\x75\xbf\xd5\x4f\xd3\xff\x21\x76\x77\xda\xf1\xb7\x7e\x6c\x6f\x1d\x6f\xf5\x36\xbb\x73\x1b\x95\x63\xdf\x8e\x48\x13\x3e\x8f\xc8\x14\xad\x18\x3c\x44\xeb\xb5\x61\x4d\xcf\x21\x00\x08\x4e\x19\xad\xb6\xf0\x76\xb4\xb9\x29\x4d\x20\xab\xa6\xfe\x6d\x19\xc9\x7b\x31\xca\x63\xa3\x32\xbb\xf2\x46\x43\x18\x9c\x8c\xf0\xca\xc3\x99\xb2\x00\xdd\x3f\xe1\xfa\x49\xbd\x04\xb5\xdd\x56\xf5\xfb\xf9\x88\x7c\x1e\xc1\x27\x53\x39\x6c\xd1\xda\xda\xe5\x75\x52\x28\x04\x85\xdd\x06\xd6\x19\xd3\xd5\x6a\x23\xf5\xe4\xba\x06\xeb\x48\x05\x60\x1b\xf0\xa1\xc3\x7a\x90\xd4\x6a\x4b\xf6\x23\xc8\x68\x8e\x1c\x44\xde\x49\x11\x5b\x43\x0a\xf4\xde\xfb\xb9\x89\x81\x6f\xfd\x8d\x49\x8d\x9b\xda\x7b\x26\x46\x1a\xae\x12\x55\x77\x24\xdd\xb4\x62\x63\x86\x87\xe4\x54\x13\x21\x85\x68\xe1\xa2\x39\x53\xc0\xd2\x7e\x94\x25\xfe\x10\xd5\xb8\xfc\xd4\x1a\x4b\xbf\x5b\xb9\xf8\x64\x8c\x11\x94\xfa\x8f\x88\x94\x31\xf3\x4d\x36\x4c\xeb\xd6\x69\xc4\x53\x16\x48\xb5\xb3\xbc\x36\x58\x99\xc2\xa6\x42\x69\x75\x79\x68\xbe\x63\x59\x8d\x86\x25\xa9\x68\xbd\x8e\x6f\x18\xd3\x2b\xa2\x3a\xae\x35\xc5\x60\xcb\x52\x84\x59\xbd\x59\xd6\x78\x72\x21\xdb\xe3\x59\xd3\x79\x1e\x36\x6f\xe1\xef\xdc\x35\xd9\xaa\x72\xb4\x85\xb5\x57\xe4\xaf\x72\x59\xcc\x63\x6e\xe3\xac\xc0\x2a\x90\x73\x5c\x88\xf8\x04\x6a\x38\x16\xe7\x42\x65\x80\xcf\x51\xe8\x67\xb6\xe9\x4a\x3a\xb5\x37\xad\xd0\xfb\x60\x0a\x56\x06\xf9\x27\xb9\x9f\x32\xc4\x2a\x45\x86\x32\xa5\x62\x0d\x4f\xa4\xdc\x7a\x57\xfc\x39\x89\x32\x8c\xb9\xd9\x0b\xcb\x8c\xa5\xf6\x8e\x0a\x1b\xdb\xf3\x39\xfc\x39\x22\x9f\x46\xf0\x03\xd7\x66\x37\xdd\x6c\xb4\xae\xab\x4c\x85\x2c\x06\xd7\x1d\xb1\xdf\xfb\x49\x9a\x63\xce\x29\x4f\xf5\x4a\x6f\x8c\x08\xa9\xc4\xb3\xb8\xad\x0b\xad\xc5\xa3\x15\x31\xa4\xbd\x99\xf4\xa5\xeb\xf7\x6f\xe7\x73\xb8\x1e\x15\x9d\x44\x64\x9a\xe2\x1d\xce\x46\xab\x04\x64\xc8\x3d\x93\xed\xfc\x0c\xa1\x54\x86\xf5\x19\x30\x9f\xdb\x29\xa1\xd8\x6d\x8a\x99\x8b\x52\xa2\x8e\xb9\x68\xec\xa7\xae\x51\xf0\x8c\xa7\x34\xe9\x71\x9b\x29\xbe\x9f\xf1\x40\x45\xc1\xb7\x74\x69\x0b\x53\x46\x12\x9b\x5a\xb5\x66\xd3\x20\x3a\x25\x84\x02\x9d\x83\x86\x43\xc3\x51\x51\xcc\x36\x3e\xca\xfa\x03\xea\x90\xb4\x74\xb5\x7e\x37\x35\xd1\x5a\xc4\x06\xdc\x9f\xfa\x78\x0d\x20\x65\xbb\xb8\x53\x31\x75\x29\x15\xd2\x81\x40\x5b\x3d\x1d\x6b\x9c\x63\xcc\x6a\x3d\xfd\x84\xa6\x59\xa8\xe4\xe4\x71\x94\x22\x27\x8d\x3a\x95\xed\xc5\x00\x74\x81\xe5\x7a\x7c\x0e\x67\xe5\xce\x9f\xe2\x99\x60\xed\x69\x45\xf6\x36\xf2\xd8\x44\x33\xf3\x77\x34\x41\x1c\x18\x83\x3b\x1a\x47\xa9\x74\x37\xab\xd8\x3c\xc4\x22\x62\x12\x8b\x88\x34\x5d\xed\xd7\x3c\x8e\x52\x22\x51\xc3\x50\xeb\x11\x43\x8c\x75\x48\xe1\x3e\x4f\x1a\xda\x0c\xa9\x78\x8b\x56\xc1\x65\xed\x93\x8a\x31\x06\x27\xdb\x80\x4a\xd8\xfa\x66\x4b\x8f\x5d\x87\xf7\xa4\x93\xc9\x85\x30\xe0\x9b\xa4\xc0\xde\x22\x62\x8b\x41\x87\x6f\x36\x81\x6e\x36\x41\xf4\xe6\x20\x91\x16\x96\x1b\x4f\x34\xa2\xa1\xaa\x8c\x69\x05\x9a\xda\x4b\xab\x09\x46\x68\xa7\xd9\xd3\x77\x59\x58\x62\xda\xc1\x0f\x5b\x4d\xb4\x73\xd9\x46\x3b\x9f\x92\xdc\x40\x34\x86\x25\xe3\x92\x12\xaa\x01\xff\x1d\xb3\x41\xcf\xc5\x78\xb1\x0b\xb6\x65\xd9\xe8\xfb\xaf\xcb\xed\x4d\x5c\x4c\x39\x97\x8e\x51\x36\x53\xcd\x1e\x59\x28\x76\xf8\x6b\x6b\x6b\x6b\xcb\x06\x5f\xf2\x16\xd7\x23\xc7\x96\x7c\x33\x4a\xa5\xb1\x2a\xe8\x1a\x70\xd3\xb6\xfa\x23\x1f\xb7\x57\x9a\x58\xf6\x26\xdb\xb4\x3d\xcc\x35\x72\x95\x3b\x73\xbf\x8e\x31\x73\x13\x10\xbf\x6e\xa2\xe6\xcd\xf2\x11\xb3\x30\x11\xe7\x7a\x8d\x83\x8f\x48\x3d\xa2\x3f\x5a\xb4\x32\x28\x4e\x1e\xc5\xf2\xca\x6d\x16\x74\x8f\x81\x9e\x19\xd2\x68\xa1\x6d\x7a\x72\xb3\x98\x32\xc2\xe8\xc0\xe6\x85\x28\x01\xc2\xf2\xb0\xa8\x81\xc4\xfb\xbb\xfc\xb0\xba\xb8\x1e\x5c\xb7\x5c\x00\xb6\x4d\x97\x81\xcd\x9a\xcd\x30\xa4\x28\xcc\x0f\xc3\xe8\xbe\x04\x82\x64\xca\x53\x34\xab\x5c\x19\xe7\xc7\x48\x1e\xf0\x55\x51\x45\xdd\xd6\x75\x80\xbc\x4d\x54\x55\x71\xe7\xc0\xe9\x83\x38\x1d\xf9\xc9\xcf\x12\xe2\x20\x9c\x28\x19\xa5\x4c\x25\x57\x6a\xbe\x09\x93\x95\x0b\x54\xce\xd3\x56\x59\xe1\x1f\xa9\xe5\xd9\xa8\x38\xda\x4b\x5c\xda\x79\x05\x4d\xea\xde\x77\x58\xf1\xea\x16\xc8\x02\x65\x63\x2c\x94\x0a\x39\xa5\x54\x1d\x8e\xd7\xe4\xbc\x70\x03\xa6\xb9\x03\x62\xaa\x08\x34\xe7\x8b\x9f\x8d\xe0\x9f\x11\x3c\x1a\xc1\x87\x11\x39\x1b\xc1\x37\x64\xe0\x5e\x8d\xc8\x0e\xbc\x19\x91\x5d\x78\x39\x22\x7b\xf0\x75\x44\xf6\xe1\x8f\x11\x39\x80\x2f\x23\x72\x08\xa2\x4f\x8e\x80\xf6\xc9\x31\xf0\x3e\x69\x6e\x03\xeb\x93\xe6\x2e\x24\x7d\xd2\xdc\x83\xb4\x4f\x9a\xfb\x10\xf5\x49\xf3\x00\xfc\x3e\x69\x1e\x42\xd6\x27\xcd\x23\x18\xf5\xc9\xce\x36\xf4\xfb\x64\xa7\x09\x61\x9f\xec\xec\xc0\xa0\x4f\x76\x76\x21\xe8\x93\x9d\x3d\x88\xfb\x64\x67\x1f\xc6\x7d\xb2\x73\x00\x77\x7d\xb2\x73\x08\xc3\x3e\xd9\xdd\x86\x49\x9f\xec\x36\xe1\xa6\x4f\x76\x77\xe0\xbe\x4f\x76\x77\xe1\xa1\x4f\x76\xf7\xe0\xb6\x4f\x76\xf7\xe1\xac\x4f\x76\x0f\xe0\xba\x4f\xf6\xb6\xe1\xa4\x4f\xf6\xb7\xe1\xb2\x4f\xf6\x9b\x70\xda\x27\xfb\x3b\x70\xd1\x27\xfb\xbb\xf0\xbe\x4f\xf6\xf7\xe0\xaa\x4f\x0e\xb6\xe1\x45\x9f\x1c\x34\xe1\x63\x9f\xfc\x10\xf0\xbd\x4f\x1a\x7f\x75\xfe\xea\xa6\xa4\xb7\xd9\x80\x77\x7d\xb5\xa5\xc2\xf3\x3e\x79\x26\xe0\x75\x9f\x34\xfe\x72\xba\xc8\x99\x77\xfe\xea\xf2\x6e\xd2\xcd\x76\xb6\x77\x8e\xe4\xdf\xe3\x6e\xa3\xdb\xed\xf4\x66\xdd\x6e\x7d\xd6\xed\xac\x8e\xd1\xeb\x76\x65\x04\xf7\x49\xcf\xdd\x94\xd9\x38\x9d\x21\x1b\x67\x93\x9e\xeb\xb4\x37\x90\x69\xee\x3d\xe9\xee\xb8\xee\x13\xf5\x76\xb2\xf5\xed\xfa\x11\x5a\x99\xb9\x6e\x03\xde\xf6\xc9\x3f\x02\x3e\xf7\xc9\x54\x78\xbc\x0f\xf4\x41\xfa\x6e\x92\x12\xfe\xf3\x3e\xb1\xcf\xf4\x55\x99\x6f\xfd\xe1\xdf\xf9\x97\xfd\x84\xc5\xc2\xa2\x0f\x08\x13\x96\x22\x9b\x02\x9f\xca\xb1\xfa\x61\x24\x19\x4b\x79\x69\x68\xc3\x9f\x7d\xf2\x41\xc0\x0f\xd9\xbe\xb6\xd7\xd9\xdc\xea\xb5\xdd\xed\x27\x58\x41\xf9\x4f\xda\xbb\x75\x83\x27\x6e\xbb\x5b\xef\x06\x9b\xf2\x50\xe1\xb4\xbd\xed\x99\xf9\xd0\xad\xbb\xb3\x6a\x88\x8b\xe9\xe8\x59\x4f\x66\x86\x89\xda\x0d\x78\xd6\x27\xdf\x44\xeb\x19\x72\x2a\x4e\x9b\xd4\xdd\xce\x5f\xf6\xe3\x6e\xb7\xb7\xd9\x96\xf9\x6d\xc8\x3c\x48\x47\x86\x61\x93\xff\xc1\x88\xdd\x2e\x66\xf4\xd8\xee\x76\x6f\x06\x3c\x11\xbd\xd9\x36\xf6\x8e\xec\x95\xd9\x03\xda\xe0\xf9\x5b\x83\x93\xad\x17\xbd\xe9\xce\x7c\x96\x95\xdf\xf7\xe6\x33\x55\x48\xf6\x20\xa3\x37\xe0\x91\xc9\xb0\x9b\x74\xf9\xac\xd3\xcd\xb6\xb7\xb7\x4f\xe4\xdf\xe7\xe5\x91\xea\xb9\x0d\xb9\x08\xfe\xe9\xc3\xa3\x3e\x7c\xe8\x57\xd6\xad\x5a\x3a\x26\xa8\xcc\xd9\x40\xaa\x6e\x8d\xf1\x42\x07\x77\xd4\xc7\xf6\x63\x48\xf0\x90\xbf\x91\xb4\xdc\x94\xd0\x2a\x39\x7a\x36\x72\x67\xb3\x85\xb0\x7f\x4a\x61\xc6\xee\x0d\xd2\x36\xdb\x24\x8f\xed\xc7\x78\x6b\xdc\x7e\xdc\xed\xda\x8f\x3d\xbb\xdb\x7d\x8c\xa6\x7b\x69\xdb\x7e\x6c\x7b\xa9\xe7\x2c\x65\xff\x68\xa4\x12\xda\xdd\x6e\x66\x6f\x3a\xf6\xf6\xf6\xb6\xbd\x99\xca\x7d\xe2\x34\x0a\xe8\x89\x70\x24\x36\x8e\x2a\xa6\x79\xe0\xba\xca\xad\xe0\xd6\x9e\xeb\x25\x78\x08\xd7\x84\x22\x2f\x1d\xfe\xb8\xbc\x78\x87\xb7\xca\x29\x75\x10\xe3\x03\xbe\xf5\xc9\x87\xbe\xf3\x18\x05\x3c\xaf\xe4\xa3\xfd\xd8\x76\xe1\x4d\x7f\x15\xa1\x33\x34\x07\xf5\x7f\xe5\x96\x52\x6e\x23\xe6\x81\x77\xa3\xaf\xfa\xd8\xdc\x15\xdf\xa6\xc2\xeb\xf7\xe1\xce\xe3\x39\x2a\x89\xe4\x45\xe4\x8d\xbe\xeb\x55\x63\x63\x15\x30\xa7\x6f\x2b\x72\x92\xdf\x7e\x92\x13\xfe\xcc\xe1\x25\xae\x03\xb3\x00\x7b\x95\xa5\xf8\xa4\x01\x5f\xfb\xe4\x95\x80\x3f\x7e\x16\xe9\x51\x03\xbe\xf4\xc9\x1b\x01\x22\x24\x2f\x05\xd0\xf0\xdf\x75\xc8\xc2\x5e\xe6\x2c\x55\x7f\x9a\x37\x4d\x84\x8e\x70\xff\x4d\xfc\x79\xde\xdc\x41\x1f\xc6\xab\x9a\x5b\x7d\x9f\x03\x0f\xc9\x57\x01\xec\xff\xaa\xb6\x9d\xbc\xb6\x5c\xd5\xb6\xf2\xad\x97\xd7\x2c\xfc\x97\x35\x4b\x42\xf2\x87\x80\x34\x24\x5f\x04\x44\xa1\xa4\x1f\xde\xff\x74\x1b\x48\x73\xba\xf5\x6e\xbd\xdb\x70\x37\x67\xf8\x53\xbc\x3f\x99\x75\xeb\x6e\xa3\xf5\x8f\xa2\x69\x92\x09\x9b\x29\x18\x81\x19\x2a\x5c\xcd\xf2\x7b\xa3\x19\xaa\xed\x06\xf4\xd3\xc7\xd7\xe6\x5e\xba\x08\x9a\x51\xbe\xf4\xd1\x04\xcd\x58\xfa\x02\x4f\x07\x74\xc6\xd2\x77\xfe\xbb\x99\x5c\x17\x2f\xc2\xc8\x17\xf9\xe3\x6b\x2e\x66\xb8\x60\x66\xe7\xbe\x18\xcd\x30\x86\x31\x96\x93\xbe\x27\xdd\xee\x4d\x03\x1e\xa9\xfa\x49\xc4\xa9\x59\xdf\x4f\xe9\x4c\x9e\xb9\xb0\xaa\x82\xf1\x8c\xce\x24\xf4\xc9\x90\x26\x33\x85\x81\x39\xcb\x2f\x89\x67\x41\x34\xc3\xfb\x9d\xd9\x80\x71\xbc\xb0\x98\x0d\xa2\x64\xa6\xc7\x6d\xc6\x06\x33\xc6\x67\xfa\x62\x3d\x1a\xcc\x38\xbd\x9f\xe5\x23\x38\xcb\xdd\xfe\xcc\x24\x07\x39\x13\xc9\x64\x86\x1c\x44\x34\x98\xdd\xf9\xc9\x0c\xb9\xaa\x99\xd4\xad\x98\xa1\x8c\xc1\x7d\x94\x13\x42\x3f\x84\x2c\x84\x51\x58\x4c\xf9\x47\xd7\x48\x53\x37\x95\xbc\xa9\x5e\x0d\x45\x3a\x8e\x42\x28\x19\xa5\xdb\x73\xdd\x27\x0d\xe8\x97\x12\x5f\x3f\xea\x75\xb6\x2a\x0b\xa6\x01\x61\x48\x04\x85\x41\x75\xee\xa9\x39\x97\xe0\x0c\x9a\xcd\xd2\xfc\x27\xc4\x9f\x39\x04\x21\xa1\x14\xe2\x95\x93\x55\x63\x51\x73\x32\xc0\xc8\xb0\xc1\x97\xac\xf9\x5b\x5c\x42\x86\xe9\xe9\xcc\x48\x80\x31\x5d\x8e\xfb\xeb\x4d\x1f\x1e\x3c\x0e\x89\xc7\xe6\xf2\x0e\x0d\xf1\x15\x36\x16\x26\xb0\x63\xbb\xae\x1c\xb4\xd6\xaa\x95\x90\x98\x59\xbf\xfc\x6d\x21\x23\xd7\x76\x51\xab\x48\x1e\x62\x9c\x4f\x7d\x3c\x9c\x4c\x85\x77\x2d\xab\x30\x07\x94\x0a\xf1\x7a\x44\x12\x63\xe3\xc3\xe7\xad\x2c\x24\x2b\xed\x9d\x4d\xa0\x16\x64\xa8\x45\x8b\xc0\xc0\x78\x21\xd8\x66\x1e\xaf\x14\x2e\x70\x81\xae\xa8\x3e\x23\x17\x21\xea\x20\x31\x54\xfc\xc9\x6b\x76\xde\x77\x61\x9a\x7a\x02\x22\x8f\x81\xf0\xee\xfb\x73\x45\x2e\xe7\xb0\x28\x40\xd6\xfa\x8f\x0a\x20\xcd\xde\xb0\xfe\xc7\xda\x44\xed\x19\x39\xcf\x6c\x7d\x76\xb7\x6c\xec\xa7\x38\x44\xfd\x58\xa0\x85\x83\x13\xfa\x54\xe4\x2a\xb2\x9c\x64\x21\x02\x60\xa3\xd9\x0b\x8a\x00\x5b\x7e\x48\x92\xb9\x93\x5b\xa1\x8e\x43\xb8\x0b\x61\x18\x12\x3f\x6c\xdd\xfd\xdb\xfe\xd0\x7e\x64\x12\xd9\x1f\xb0\x91\x2c\x4f\x8b\x96\x14\x41\x22\x9e\x00\x4e\x8b\x55\x9d\xb3\xb1\xd8\x87\x26\x13\x24\x8c\x44\x4d\x3d\x9b\xa1\x68\x08\x35\x60\x1b\x66\xd6\xe3\x54\xd7\x58\x9c\xe5\x13\x8f\x3e\x34\x6c\xbb\xab\xf3\x5a\x55\x8b\x54\x35\x21\x5d\x4e\x91\xe0\x1c\x3e\xeb\x43\x3e\x5a\x9d\x04\xd2\xde\x7c\xfe\x2f\xc6\xe9\x89\xd5\xb0\x7e\x93\x23\xf5\xfb\xef\xd6\xd3\xa7\xd6\xd3\xa7\x4f\xad\xdf\xad\xdf\x89\xf5\xd4\x7a\x4a\xf0\xc2\xb7\xa0\x27\x16\x21\xd6\x06\xb1\x88\xfc\x21\x56\xcd\xfa\xcb\x9a\x59\xb5\x9a\x35\x9b\x2d\x0c\xef\xf0\x67\xc3\x7b\x57\x1d\xde\x71\x69\x78\x27\x21\xdc\x84\x70\x1f\xc2\x43\x08\xb7\x21\x9c\x85\x70\x1d\xc2\x49\x08\x97\x21\x19\x87\x70\x1a\x12\x4e\xe1\x22\x24\x8c\xc2\xfb\x90\x24\x14\xae\x42\x12\x51\x78\x21\x89\x0c\x76\xb3\xe4\x4c\x9f\x3c\x6a\xc0\xc7\x90\x64\x14\xbe\x87\x64\x44\xe1\x5d\x48\xfa\x14\x9e\x87\x24\xa4\xf0\x3a\x24\x03\x0a\x6f\x43\x12\x50\xf8\x1c\x92\x98\xc2\x39\x26\x96\x9e\x1c\x51\xda\x0d\x9f\x42\x32\xa6\xf0\x67\x48\xee\x28\xfc\xc0\x4f\xdd\xf4\x09\x12\x03\xf9\xf1\x59\x48\x86\x14\xfe\x29\x05\xb3\x81\x3c\x29\x3c\x0a\xc9\x94\xfa\xfd\x91\x77\xda\x07\x9b\x0d\x6c\xef\x44\xfe\x6e\x21\x35\xb5\xbd\xf7\x7d\xb0\xf3\xa7\x8b\x3e\x64\x1c\xed\x6f\xbd\xcb\xfe\x1c\x3e\x84\x64\x42\xe1\x9b\xca\xce\x93\x12\xff\x35\xac\x85\xdb\x80\x57\x2a\x1e\xfc\x22\xde\x9b\xb0\xaa\x1f\xe1\xd8\x9b\x65\xcc\xee\x47\xa1\x96\x46\xcc\x50\x1a\xe1\x76\xbb\x37\xb6\x0b\x2f\x43\x72\x46\xe1\x6b\x48\xec\xdf\x37\x50\x3a\xf1\x47\x48\xec\xad\xad\xa7\x76\x6b\x82\xa5\x3a\x72\x22\xbe\xc8\xc2\x30\xed\x27\x94\xf2\x99\x74\x89\x3e\xf3\x33\x11\x0d\xa2\x7e\x96\xca\xa7\x38\xf4\x27\x33\x85\x6f\x3f\xc3\xfb\x12\xbf\x2f\xe4\x7e\x96\x44\x61\x8a\x9b\x6b\xe8\x27\xb4\xb4\x9d\xc9\xdf\x53\x15\x5f\xbd\x9e\x23\x82\xb2\xfe\x96\x03\x22\xe6\xef\xb8\x11\xe6\xc0\x5d\xc1\x4c\x79\x6e\xc7\xad\x6f\xfc\x2e\xfa\xec\x87\x0c\xef\xf2\x66\x23\x16\x04\x14\x77\xbf\x80\x0a\x9a\x8c\x19\xc7\x40\xc6\x69\x22\x66\x2c\x3d\xf7\xe3\x19\x13\x74\x7c\xd9\x8f\x62\x3a\x0b\xa3\x28\x9e\x8d\xb3\x50\x20\x04\xf2\x6c\x2c\x0b\xe5\xd1\xab\x84\x0e\x66\x3c\xfa\x48\x53\xf6\x83\xce\x78\x74\x39\xf2\x03\xfc\x35\x25\xf0\xe8\xcf\xc4\x8f\x67\x51\x4c\xf9\x2c\x46\x41\xf9\x05\x3f\x7b\x60\x62\x86\x52\xb8\x0b\x1e\x4e\x66\x68\x3e\xcf\x12\x1a\xcc\x12\x7a\x47\x93\x94\x06\xb3\x14\xcb\x0b\x66\x29\xf5\xc7\x38\xec\xb3\x54\xb7\x09\x75\xe8\xb0\x19\x33\x69\x03\x81\xa6\xb3\x33\x91\x64\xf4\x32\xa6\x34\x90\x5b\xf3\x79\x96\x8a\x73\xc9\x13\xdc\xb1\x94\xdd\x84\xd4\x7d\xd4\x60\x70\xa3\xb8\x20\x3f\xa1\xfe\xec\x06\xf9\x86\x9b\x64\xd6\x8f\x42\xec\xf0\xb1\xcf\x83\x59\x10\xf5\x31\xf5\x8c\x8e\x6f\x68\x30\x1b\x25\x33\x36\x1e\xce\x18\x8f\x33\x31\xbb\xa5\x93\x21\xe5\xb3\x90\xf1\xdb\xd9\x98\x0a\x1f\xf9\x15\x7f\x3c\x4b\xa3\x2c\xe9\xcb\x7a\xf4\x6f\x67\xf7\x37\x89\x2c\xe7\x3e\x24\xd3\x7f\xb2\x48\x78\xbb\x7b\xe0\x8f\x63\x6f\xf7\x08\xfc\x38\x4a\xbd\xdd\x63\x08\x85\x77\xb0\x0d\x43\xe1\x1d\xec\x00\xbf\x49\x63\xaf\x79\xb0\x0d\x0c\x4f\xa6\x5e\xf3\xa0\x09\x7d\xb4\xba\x6e\x1e\xec\x40\x8c\x4a\xc8\x5e\xf3\x60\x17\x72\x1f\xc5\x5e\xf3\x60\x0f\x26\x94\x7b\xcd\x83\x7d\xb8\x49\xee\x6e\xfc\xc4\x6b\x1e\x1c\x40\x8a\xe2\xff\xe6\xc1\x21\x64\xe3\xd0\x6b\x1e\x1c\x41\x3f\x8a\x27\x5e\xf3\xe0\x18\xa2\x24\x18\x78\xcd\x43\x44\x8c\xfe\x27\x8b\xbc\xe6\x21\x62\x46\x0b\xaf\x79\xb8\x03\xe9\x68\xe2\x35\x0f\x77\x21\xa1\x43\xaf\x79\xb8\x07\x63\xbf\x9f\x78\xcd\xc3\x7d\x08\x64\xc0\x01\xc4\x61\x96\x8e\xb9\xd7\x3c\x3c\x84\x34\x8b\x77\xbc\xe6\xe1\x11\x3e\xec\x7a\xcd\xc3\x63\xf0\xfb\x99\xa0\x5e\xf3\x68\x1b\xc6\xac\x9f\x44\x5e\xf3\xa8\x09\xd8\x19\x5e\xf3\x68\x07\xc6\x2c\x08\x22\xe1\x35\x8f\x76\xa1\x4f\x03\x16\x7a\xcd\xa3\x3d\x4c\xda\xf4\x9a\x47\xfb\x58\xa7\xb1\xd7\x3c\x3a\x80\x24\xaf\xd3\xd1\x21\x0c\x12\xbf\xdf\xdc\xf3\x9a\x47\x47\xf9\xe3\x8e\xd7\x3c\x3a\x96\x8f\xbb\x7b\x5e\xf3\x78\x1b\x98\x04\x55\xf0\x9a\xc7\x4d\x38\x19\x26\xfe\x1d\xf5\x9a\xc7\x3b\x70\xa2\x6a\x71\xbc\x0b\x27\x7d\x96\xf4\xbd\xe6\xf1\x1e\x9c\x08\x16\x06\x18\xb8\x0f\x27\xb2\x3f\x8e\x0f\xe0\x04\xf9\x6a\xaf\x79\x7c\x08\x27\x67\x21\x1b\x7a\xcd\xe3\x23\x38\x55\x55\x3b\x3e\x86\xb3\x3c\xcb\x9d\xed\x6d\x38\xcb\xb3\xdc\xd9\x6e\xc2\x99\xcc\x72\x67\x7b\x07\xce\x30\x9f\x9d\xed\x5d\x78\xad\x23\xee\xc1\x6b\x1d\x71\x1f\x5e\xab\x88\x07\xf0\x3a\x8f\x78\x08\x67\x57\xaf\xbc\x9d\xed\x23\x78\x97\x57\x66\x67\xfb\x18\x2e\x54\xda\xe6\x36\x5c\xa8\xb4\xcd\x26\x5c\xe4\x69\x9b\x3b\x70\xa1\xa2\x36\x77\xe1\x42\x66\xd3\xdc\x03\xc1\xc6\x34\xf5\x76\x9a\xfb\x70\x91\x86\x7e\x3a\xf2\x76\x9a\x07\xf0\x49\x67\x74\x08\x9f\x74\x46\x47\xf0\x49\x65\x74\x0c\x9f\x64\xea\x9d\x6d\xf8\xaa\xbe\xee\x34\xe1\xea\xd5\xc5\xc7\x77\xde\xce\xce\x0e\xa4\x3f\xb0\x03\x76\x76\x76\xc1\x57\xf9\xec\xec\x81\xaf\x63\xee\x83\x9f\xe7\xb3\x73\x00\xbe\xaa\xd0\xce\x21\xf8\x79\x96\x47\xe0\xcb\x8e\xdc\xd9\x39\x06\x9f\xca\x7c\x76\x51\x0a\x25\x3b\x72\x67\xb7\x09\x54\x65\xb9\xbb\x03\x54\x65\xb9\xbb\x0b\x34\xcf\x72\x77\x0f\xa8\xcc\x67\x77\x1f\x98\x8e\x78\x00\x4c\x47\x3c\x04\xa6\x22\x1e\x01\xcb\x23\x1e\x03\x15\x23\x6f\x67\x6f\x1b\xb8\xaa\xcc\x5e\x13\x22\x95\x76\x6f\x07\x22\x95\x76\x6f\x17\xa2\x3c\xed\xde\x1e\x44\x3a\xea\x3e\x44\x32\x9b\xbd\x03\x08\xd8\x1d\x93\x61\x87\x10\xa9\x9e\xdc\x3b\x82\x4c\xe7\x74\x0c\x99\xca\x69\x7f\x1b\xb2\x3c\xa7\xfd\x26\x64\x32\xf9\xfe\x0e\x4c\xf4\xd7\x5d\x10\xa3\x28\xe1\xde\xce\xfe\x1e\x4c\xf2\xaf\xfb\x70\x21\xe7\xd4\xee\xee\x11\x44\xb2\x53\x76\x77\x8f\xe1\xb2\xef\x27\x11\xf7\x76\xf7\x77\x20\xd5\x8f\xbb\xf0\x15\x93\xec\x1e\x1e\xc0\x80\x47\x03\x6f\x6f\x7b\x07\x64\x59\x87\xcd\x6d\xc8\x2b\x7d\xb8\xbb\x03\x27\x61\x3c\xf2\xbd\xe3\xe6\x2e\x3c\xa3\x02\x1f\xf6\xe0\xa5\x3f\x1e\xe3\xd3\x3e\x3c\xa7\xa1\x0c\x3b\x80\xb3\x38\x65\x61\xc4\xbd\xe3\xe6\x21\x7c\xcb\x23\x1e\xc1\x99\xfc\x3d\x86\xab\x91\x0c\xd9\xd9\x86\xd7\x91\x7c\x68\xc2\x1b\x3f\x8e\xf1\x69\x07\xde\xfa\xe3\x9b\x00\x1f\x77\xe1\x3c\xf3\x8e\x77\xf6\xe0\x1d\xfe\xec\xc3\x17\xe6\x1d\xef\x1c\xc0\x85\x5c\xd2\xdc\x3b\xde\x39\x84\xf7\x18\x74\x04\x1f\x47\x91\x77\xbc\x73\x0c\x97\x6c\x88\x15\xd9\x6d\xc2\x95\x9f\x79\xc7\xbb\x3b\xf0\x49\x57\x63\x77\x17\xde\x8f\x98\x77\xbc\xbb\x07\xa7\xf2\x77\x1f\xde\xa7\xf8\x8b\x19\xd2\x21\xa6\x3a\x04\x3f\x6f\xdb\xde\x3e\xdc\xc8\x0a\xee\x1d\xc0\x30\x6f\xdb\xde\x21\x04\x79\xdb\xf6\x8e\x80\xea\x4c\xf7\x8e\xe1\x87\x8c\xb8\xbf\x0d\xf9\x2f\x5a\xd8\xe4\x4f\x3b\xc0\x64\xdb\xf6\x77\xe1\x36\x6f\xdb\xfe\x1e\x84\xaa\x6d\xfb\xfb\x30\xce\xbc\xe3\xfd\x03\xe0\xf8\x73\x08\x0f\xcc\x3b\xde\x3f\x82\x48\xb7\x6d\xff\x18\x62\xe6\x1d\x1f\x6c\x43\x82\x6d\x3b\x68\x42\x8a\x6d\x1b\x78\xc7\x07\x3b\xf9\xa3\x77\x7c\xb0\x0b\x02\x9b\x79\xb0\x07\x99\xae\xd1\xc1\x3e\xc4\xd8\xbc\x83\x03\xe8\xcb\xdf\x43\x88\xb1\x99\x07\x98\xb7\x6c\xe6\xc1\x71\x5e\xc5\x74\x32\xf6\x8e\x0f\x0f\x65\xd2\x91\x77\x7c\x78\x04\x31\xbb\xf3\x8e\x8f\x76\x80\xf2\x34\xf6\x8e\x90\x58\xd1\x71\xfe\xb4\x8f\x77\x59\x32\x14\x29\xcf\x8f\x7b\xfe\xdd\x3b\x42\x2a\xf3\xe3\x5e\x3e\xec\x43\x98\x8c\xf1\xe1\x00\x92\x50\x3e\x1c\x02\x0f\x70\x1a\x1f\xed\x34\x9b\x30\xd6\x8f\x3b\x10\xa6\x48\x53\x8f\x90\x4e\x24\xfa\xf1\x10\xd2\x1b\xf5\x78\x04\x61\x90\x3f\xee\x6c\x43\xa2\x1f\x9b\x70\xa3\x1f\x77\x20\x40\xfc\xcb\x04\x9f\xf7\xe0\xb9\x79\xde\x87\x1b\xc4\xb9\x3a\xda\xd9\x39\x80\x11\x0d\x43\x86\x55\xdd\xdd\x86\x18\xf9\x04\x0c\xdf\xdb\x86\x38\x61\x63\x8a\x8f\x3b\xf0\x5e\x3f\xee\x42\x98\x4a\x32\x7f\x84\xab\x2c\xd1\xcf\xfb\xdb\x10\xe1\x0d\x8c\x77\x84\x2b\x69\x90\xf8\x29\xe6\x71\xb0\x0d\x34\x4b\x22\xef\x68\xf7\x60\x0f\xd8\x18\x51\x61\x8e\xf6\x0e\xf6\xe1\x9e\x32\x9a\xc4\xde\xd1\xde\xe1\x8e\xd4\x32\xc4\xa7\x03\x34\xac\x0d\x30\xc2\xd1\x0e\xf8\x21\x1d\x60\x77\x1f\xed\x6f\x37\x21\xf4\x93\xc4\x3b\xda\x3f\xde\x81\x4c\x3d\xed\x42\xa2\x9e\xf6\x20\x50\x4f\xfb\x30\x52\x4f\x07\xd0\xcf\x3f\x1f\xec\x1c\x43\x78\x22\x9f\xf6\x0f\x20\x53\x4f\x87\x90\xa8\xa7\x23\x08\xd4\xd3\x31\x8c\xf2\xa7\x83\x6d\x18\x44\x89\x8f\x3d\x73\xb8\xbd\x87\x3b\xa0\xc0\xa7\x03\xa0\x0f\x2c\x95\x8f\x87\x40\xc7\xb1\x98\xe0\xe3\x31\x20\xdb\xe5\x7b\x47\x87\xcd\x26\xb0\x94\x71\x7c\xda\xc1\x0d\x39\x7f\xdc\x05\xce\xf0\x77\x1f\xe2\x24\x0a\xf0\xe9\x18\xd2\x6c\xec\x1d\x1d\xee\x34\x61\xcc\x78\x96\xe2\xe3\x0e\x84\xd1\xbd\x2f\x33\xdf\x39\x04\xf4\x93\xd4\xf7\x8e\x0e\x71\x28\x92\x28\xc6\xa7\x5d\x60\x7c\x20\x73\x44\xce\x83\x0f\xf1\xe1\x00\x7c\x8e\x39\x22\xa1\x4c\xf0\x77\x0f\xfa\x3e\xc6\xde\xdb\x87\x7e\x26\x1f\x0e\x24\x68\xa1\x8d\x8f\x87\x38\x81\x13\xba\xe7\x1d\x1d\xee\x1f\x40\xca\xb0\x0a\x07\x7b\xd0\x8f\x64\x66\x87\xbb\xe0\xa7\x93\x31\x26\x3a\x3c\x00\x1c\xc3\xa3\xed\x6d\x40\xbe\xed\x0e\x1f\x9b\x10\xca\xa0\x3d\xc0\xe1\x3b\xda\xde\x87\x34\xbb\xf1\x8e\x8e\x76\x25\x0b\x80\x0f\xfb\xc0\x55\xd0\x01\x7e\xc3\x58\xbb\x92\xb5\x90\x4f\xc7\x10\x21\xe7\xe1\x1d\x1d\xed\xef\x22\x2d\x1f\x53\xf9\xbc\x8f\x53\x2d\xf6\x8e\x8e\x0e\x8e\x21\x45\xee\xe2\xe8\x18\xc7\xba\x4f\x59\xe8\x1d\x1d\x1f\x1c\x41\xa2\x1f\x8f\x21\x1c\x84\x51\x94\x78\x47\xc7\x87\xdb\x90\x98\x67\x9c\x19\x7c\xe8\x1d\x6f\x6f\x37\x21\x51\x4f\xd8\x99\x3f\x70\x11\xef\x41\x1a\xfb\x68\x9c\x7e\x7c\xb4\xb3\x07\xfd\x30\xbb\x91\x8f\x87\x30\xa2\x7e\x22\xe4\xf3\x31\x04\xcc\x1f\xe3\xe3\xee\xf6\x1c\x1e\x42\xd2\x91\xf3\xb4\xb9\x73\x0c\x72\x65\x21\x39\x97\x2b\x48\x2e\x0c\xb9\x7e\xe4\xc2\x41\xea\x2e\xd7\x07\x6e\x06\x72\x19\xe0\x56\xd1\xdc\x6b\xc2\xee\x51\x13\x9a\x7b\xbb\xd0\xdc\xdb\xc3\x2c\x0e\xf0\xcf\x21\xc8\xb5\x29\x57\xa5\x5c\x6d\x72\x85\xcb\xb5\x8d\x9b\x83\x9c\xee\xb8\x95\xc8\x35\x84\x3b\x4d\x73\xff\x10\x76\x31\xf0\xf0\xa0\x07\xb7\xd5\x93\x4b\xcd\xf9\xaf\xb6\xd3\xf6\x1e\x3a\xdd\xee\x7d\xb7\x1b\xf4\x36\x67\xdd\x6e\xb0\x39\xab\x1e\x67\xee\x17\x8e\x33\x6e\xab\x6d\x83\x3d\xb4\x5d\x38\x0b\x49\xe3\xf7\xc6\x10\xae\x43\xd2\x78\xda\x18\xc2\x49\x48\x1a\xb5\xc6\x50\x9e\x33\xbf\x84\x20\x06\x40\x07\xc0\x07\x90\x0c\x20\x1d\x40\x34\x00\x7f\x90\x1f\xb0\xba\x49\xbb\xcb\x1b\x90\x0d\x48\x43\x3e\x76\x53\x3c\x51\x8e\x06\xeb\x44\x4e\x4a\xfc\x4f\x49\xb3\x45\x0d\xbe\x58\x8b\xe6\x87\x5d\xb4\x4f\xc5\xcb\xbe\x0e\xc5\xeb\xe5\x44\x3e\xec\xf4\xe0\x14\x8f\xf1\xb5\xda\x05\x4a\xd4\x6b\xb5\x53\xea\x24\x6e\xad\x96\x0d\x72\x29\x01\x3e\xfb\xea\x19\x63\x39\x79\xa2\xd2\xb5\x74\x36\x00\xa5\x04\x21\x15\x89\x4d\xb8\x3f\x00\x1b\x21\xfc\xde\xe7\xd9\x9f\xe6\xd9\xeb\x7c\x55\x51\xbc\x3e\xc0\xbb\xd4\x52\x19\x3a\x40\x16\xd4\xec\x11\xb6\x5c\x50\x9e\x88\xe4\x3f\x4b\xe5\xa9\xe6\xbc\xcf\xcb\x73\xa4\x11\x5b\x7d\xe0\x62\x33\xd3\x52\x05\xd2\xc5\x86\xb1\xfa\xa0\xc3\xea\x03\xd5\x67\xa8\xb8\x9a\xfe\xdb\x36\x9a\xdb\x4c\x31\x87\xfe\x80\xac\xd4\xe8\x41\x43\x70\x54\xd0\xc0\x8b\x72\xed\xa0\x6e\x49\x71\x47\xe7\x4b\x31\x5b\x60\x6d\x44\x64\x24\xcc\x13\xf5\x74\xc4\x06\x02\x2f\xa6\x11\xf9\x1c\xed\x75\x1d\xe1\xfe\x3a\x1b\x6e\xb2\xd1\x53\x01\x9b\x85\xf9\x49\x63\x1f\xd4\x98\x0e\x07\xe4\x8a\xc2\x60\x40\x1a\x1d\xab\x2b\xba\x03\xbc\x96\xea\x6d\x36\x86\x10\xe0\x0c\x74\xda\x5e\x9c\xd0\x59\x2a\xef\xf2\x66\x52\xc1\x72\x86\xca\x9f\x78\xe4\x93\x67\xb3\x18\x63\x55\x52\xc2\x78\x21\xaf\x47\x0d\xb8\x53\x79\x61\x94\x59\x37\x99\x75\xb9\xdb\x80\xe1\x80\x34\xaa\x61\x8f\x1a\x30\x19\x90\x17\x14\x6e\x64\x7c\x25\x4d\xe8\x4d\x9b\x30\xf7\xda\xea\x6d\x7b\xeb\xb8\xbb\xd5\x7b\x82\xea\x80\xdd\xa7\x0d\xb8\x1f\xac\x53\xf1\x31\xd7\x60\x6a\x0d\x70\xb4\x16\xcf\x05\x6b\x25\x7b\xcd\x0e\xef\xb9\xb0\xc1\xb4\x04\x6b\x3b\xb7\xfe\xc2\x7e\xdc\x48\x66\xb3\xe4\xa9\x9c\x42\x09\x61\x46\xf6\x99\xcc\x66\x5b\xcd\x39\x3c\x0c\xc8\x47\x0a\xb7\x03\x75\x59\x6b\x3f\x7e\xda\x6d\x10\x6c\xfd\x59\x11\x44\x7e\x7f\xfa\x77\x6f\xb3\xd1\x12\x03\x32\xb5\x45\x92\x51\xdb\xdb\xd8\x06\x5b\x22\x80\xdb\xe8\x69\xc8\x08\xe3\x95\x2a\x01\xd8\x28\xce\xb4\x15\x58\x2c\x1d\x2c\xc8\x4e\xda\x5e\x95\xdc\x88\x41\x95\xdc\xd8\x2e\x70\xd5\xd1\xf2\xca\xd3\x35\xb7\xa7\xdb\xb3\xff\xab\xfb\xd3\x04\x09\xd1\xa3\xee\x14\x35\x35\xa5\x32\xe8\xbc\x31\x84\x14\x4b\x5d\x08\x85\x48\x51\x30\xbc\x88\x0a\xc9\x07\x7d\xbb\xef\x2c\x1a\xa1\x18\x8c\x1d\x6d\x20\xa2\xcc\x01\x94\x6b\xde\x45\xa9\xe3\x1c\x4a\x9a\x48\xa5\x6c\x8c\xc4\x55\xfa\x81\x57\xb3\xbd\x56\x8b\xd4\x0a\xaf\xe8\x3f\xb9\x6d\xe5\x4d\x01\x97\x57\xfd\x6e\xae\x3a\xbc\x50\x4a\xf2\x3a\xeb\x74\x46\x2c\xa1\xaa\xd7\x96\xda\x23\x95\xcb\xce\x74\xe0\x02\xda\x0d\xaa\x18\x8b\x86\x29\x14\x8b\xf5\xf4\x57\x74\x69\xa7\x86\x5d\x5f\xfa\xad\x2b\x73\xb9\x24\x3a\x70\xf3\xcc\x06\xa5\x6c\xfe\x93\x0c\xb8\xca\x60\x93\xfe\x24\x35\x70\xf2\xa6\x5f\x02\xa7\x45\xca\x83\x55\xc9\x9b\x27\xd3\xf3\xfa\x9d\xa1\x91\xc9\x60\x8d\x6e\x12\xca\x6d\x69\x1b\x1d\xca\x79\x7c\xee\xce\x3d\xbe\xb2\x2c\xe5\xae\xb2\x2c\xd7\xb6\xa7\x76\x21\x8d\x56\x80\xe5\x14\x0d\x25\xfe\xc5\x9d\xc6\xdc\x24\x9d\xde\x79\x74\xae\x2e\x5c\xc8\x67\xb4\xd4\xc8\xe9\x00\xed\x48\x9c\x08\xe3\xd7\xe3\xff\x25\xd7\x15\x75\x06\x13\x45\xd6\xd9\xdc\x99\xac\x1e\xe2\xd5\xed\xee\x54\xf3\xc0\x28\x39\xd6\xd3\xaf\x6b\xd8\x5b\xdd\x6e\xa1\x94\x02\x55\xd3\x73\x0d\x76\x5e\xbf\x73\xff\x5f\xf2\xfc\x75\xab\x57\xdd\x48\x55\x7a\xa2\xa7\xf0\xbc\xae\x07\x70\xb2\x92\x84\x4b\xda\xf7\x25\x74\x84\x72\xde\x92\x7a\xb4\x40\x67\xe3\x4a\x57\x71\x0e\x97\x03\x72\x4e\xe1\xb4\xbc\x5f\x2c\xcb\xa1\xbb\x4e\x03\x2e\x90\x70\xe1\xae\xf1\xa8\xd1\xba\x1e\x94\xe9\x51\x79\xd9\x5f\x84\xba\x62\xef\x07\x70\x35\x28\xee\x0b\x57\x6e\x42\x0d\x78\x21\xa3\x74\xd3\x2e\xef\x36\x9e\xf6\x1a\xf0\x11\xdf\x23\xde\x80\xef\xf9\xc3\x96\xae\x54\xb7\xfb\xa4\xdb\xad\x3f\xba\xee\x2d\xbc\xe7\x39\x6d\xba\x8f\x1a\xf0\x4e\xd1\xee\x1c\x07\x68\x26\x35\x6c\x67\xda\xff\xf5\x2c\xb7\x43\x9a\x19\x2d\x5d\x7c\x1a\x30\x14\xa7\x4a\xa1\x2f\x5a\x09\xcc\xb4\x37\xdf\x59\x8e\x8c\x30\xcb\xcd\x6c\x31\xef\xe7\xb8\x05\x49\x0c\xaa\xad\x28\x13\x49\x64\x7b\xb6\xd8\xb6\x41\x86\x78\xb6\x68\xda\x20\x83\x3d\x5b\xec\xd8\x60\x50\xa4\x3c\x3b\x42\xb8\xf7\x01\x99\x96\x74\x7d\x5a\xef\x07\xe8\xd7\xce\xeb\xd8\x21\xb3\x7b\x10\x08\xaf\x63\x07\xc2\x06\x3b\x08\xf0\x35\xa8\xbc\xc6\x9e\xed\x07\x01\x2a\x00\x59\x7e\x22\x58\x3f\xa4\x96\x8f\x7a\xdc\xd6\x4d\x18\xf5\x6f\x51\xa8\x4b\xad\x80\xdd\x59\x41\x68\x0d\xf0\xda\x23\xa5\xc2\x1a\x44\x91\xa0\x09\x02\x36\x8e\xad\x51\xd3\x1a\xed\x58\xa3\x5d\x6b\xb4\x67\x8d\xf6\xad\xd1\x81\x85\xe6\x24\x34\xb1\x46\xd2\x51\x82\x35\x4a\x2c\xd4\xa4\xb3\xc6\x94\x67\x16\xf7\xef\xac\x28\xb4\x62\x2b\x4e\xa8\x95\x52\xb9\xd0\x2c\xe9\x8f\xd1\xca\xc2\xea\xa5\x10\x56\x3a\xc1\x5a\x26\xb1\xdd\x83\x24\xae\xbc\x46\xb1\x90\xb9\x7b\x1d\x5b\x3f\xe6\xa1\xe8\xb2\x44\x86\x49\x6d\xa6\xf2\x47\x81\xd5\xf2\x3a\xb6\xb8\x89\x82\x89\x0d\xb6\xc0\x46\xd8\x3d\x90\xef\xab\xc2\xf1\xd7\x84\xa3\x03\x44\x7c\x49\x6c\x28\x42\xb0\x23\x45\x80\x21\x23\xfc\x93\x60\xd8\x68\x29\x2c\x57\x95\x7b\x3b\x80\xcf\x03\xf2\x89\xc2\xf9\x80\xfc\xa0\xf0\x69\x40\x9e\x51\xf8\x13\xf9\xb0\x2d\x54\x0a\xfb\xeb\xd1\x93\xcd\x76\xdd\x71\x67\x9d\x6e\x6f\x3a\x47\x6b\x88\x1f\x03\xf2\x0f\x85\x67\x38\xe3\xf0\x8e\x45\x5e\x28\xfd\x33\x20\x8d\xf4\xc9\xd3\x85\x1b\x9d\xea\x25\x39\x2e\xa0\x06\x3c\xc2\xb5\x94\x3e\xd9\xda\x7a\xda\x80\x0f\x03\xf2\x88\xc2\x37\xcc\xe9\xbf\xba\xe9\x13\x3c\xb7\x33\x3f\x94\x17\x51\xaf\x06\xe4\x03\x85\x37\x03\xf2\x8d\xc2\xcb\x01\xe9\xbc\x0b\xe1\x7b\x08\x1f\x42\xf8\x1c\xc2\xdb\x10\x9e\x87\x3d\xf8\x3a\x20\x9d\x8f\x61\x0f\xfe\x18\x90\x4e\xfe\xed\x6d\xd8\x83\x2f\x03\x65\xd6\x00\x22\x20\x9d\xb7\x7d\x78\x19\xc2\xe7\x01\x9c\x0f\x7a\x40\x03\xd2\xf9\x31\x80\x0f\x83\x5e\xeb\xcb\xe0\x17\x5c\x85\xf6\x7f\xb1\xe8\x5d\x72\x36\x5b\x70\x2f\x99\xeb\x93\x0b\xd6\xaf\xc6\xe9\x74\x6c\xb0\x7b\x3d\x9c\x20\x26\xc6\xd5\x8a\xbc\x3a\x2a\x62\xcf\x56\xca\xd9\x78\x45\x19\xf8\x49\x50\x44\x23\x08\x2d\x5a\x4e\xa3\x8a\xb7\x15\x7e\x9f\xf0\x87\x29\xe9\x4c\x59\x7a\x29\x4b\x41\xe6\x90\xa5\x79\x59\xf8\x8c\xb7\x3c\xde\x9a\xac\xf1\x3c\x81\xba\x74\x74\x6d\x04\x3c\xeb\xc9\x55\x93\x7a\x2f\x07\x73\x58\x53\xca\x76\x5e\x0a\x2f\xf2\xe3\xe5\x94\x5f\xab\x29\xb7\x97\xeb\xc7\x8a\x94\xac\x9c\xf2\x8f\xf5\x29\x55\x99\x49\x91\x32\x59\x28\x53\xf5\x0f\xde\x50\xe1\x25\x94\xdf\x1f\xd1\x2b\x7f\x98\x6a\x95\x75\xb5\xca\x9f\xd3\x58\x8c\xb4\x9a\x3e\xcd\x6d\xd0\x2e\xa5\xa5\x40\xa7\x07\x0b\xe0\x3a\x94\x4c\xf3\xe3\x8c\xb7\x41\xcb\xc1\xb3\x59\xe5\xb5\x9e\x47\x92\x76\xcf\xb9\x01\xcf\xaf\xe2\x63\x1c\x19\x7d\x0e\xb4\xae\xbd\xa0\x12\x42\x36\xb6\x91\x6f\x2a\x42\xa6\xaa\x82\xa9\x67\xa3\x21\x18\x15\x16\xde\x9d\xc9\x3f\x83\x88\x0b\x0b\x09\x80\x35\x40\xb0\xeb\xfc\x2f\xd2\x44\x24\x2f\x16\xba\xfc\xb3\x50\xda\x15\xd0\x07\xf4\x3c\x74\x8b\xe8\xce\xbe\xc5\xa3\x3c\x9a\xc5\xa3\xbc\xce\xda\x2c\x07\x6f\x91\xc6\x96\x0a\x93\x4d\xb0\x04\x13\x21\xad\x90\x42\x09\xf3\x70\xa2\xfd\x62\xa7\xa8\xcd\xa9\xfb\xb6\xec\xbc\x95\xd0\xea\xbb\x6c\xa8\xb2\x90\x58\x72\x03\x4b\xe8\x8a\x40\x95\xa9\xea\x05\x6d\x28\x48\x8a\x8e\xa9\xd5\x8a\x67\x3d\x88\x0a\x2f\x4a\x07\x9f\x55\x2b\xbb\x36\x71\x35\x9a\x9e\x01\x72\x0b\x53\x9a\xf8\x4c\x19\x7a\xac\x0c\xfe\xe5\x19\x43\x9f\xa6\xdb\xce\xd2\x2c\x7c\xba\xad\xac\x36\xa8\x42\x91\x31\x3b\x11\xe2\xc6\xa0\x05\x86\x85\x73\xde\x76\x21\x1c\x38\xf2\xfc\x21\x56\x74\xf7\xba\x9e\x85\x8d\xff\xf4\x03\x22\x0f\xa1\xb2\xfa\x14\x7d\xf6\xc2\x9d\x17\xf9\xf3\xea\x49\xe7\xd5\xa0\x07\x8b\xcb\x6b\x49\x85\x1d\xe9\x53\x1d\x63\x39\x2b\xb5\x5c\x68\x1d\xdb\xa4\x3a\x65\x4b\x94\xdf\xd0\xe6\x2d\xe7\xa8\x78\x00\x2c\x80\x24\x80\x34\x20\x1d\x7b\xb9\xa6\x36\xd8\x7a\x04\xf1\xb1\xdc\x1d\xc8\x5d\x18\x7a\x26\x77\xbe\x2a\x15\xb6\xcb\x6e\x62\x28\x6e\xd6\x01\x99\x22\xda\xda\xeb\xc0\x7b\x49\x81\xa5\xaf\xfc\x74\x44\x83\xd7\x81\xf7\x15\xdf\xa4\x11\x52\xe0\xfd\x41\xa5\xbd\x38\xbe\x5c\xc8\xcd\x3d\xf5\xbe\x50\xc8\x6d\x5f\x5f\xd1\x30\xa6\x89\xf7\x8a\xa2\x24\x3a\xa5\xde\x1b\x8a\x60\x84\x24\x0a\x20\x0b\xb4\x99\x9e\x76\x8a\xfc\x73\x53\xbd\x56\x11\x8f\xe5\x67\x28\x46\x78\x5d\x87\x01\x35\x8f\x64\x95\x41\x57\x9b\x79\x09\x77\x18\xd0\x65\x93\xcd\x72\x21\xa8\xa4\x43\xaa\x05\xb5\x8b\x42\xbc\x8a\x3b\x3b\x15\x08\x6b\xec\xc7\x12\xc2\x80\x11\xca\xa5\x0f\x7e\xa0\xf5\xeb\x9c\xd1\x34\xe9\xc8\x74\xc0\xbd\x04\x4d\xca\xb2\x50\x78\x6c\x8e\xe8\x53\xaa\x8a\xe5\xc6\xb0\xba\x00\x56\x8f\x6b\xb5\x94\xa3\xfe\x7f\xce\x16\xa4\x18\xe4\xae\xb5\x47\x43\x10\x03\x5e\x3e\x70\xe2\x21\x3b\xe1\x88\x42\x80\x67\x12\x93\x37\xad\x0b\x48\xd1\xed\x8d\xc9\x96\xd6\x63\xb4\xbd\x2e\xe3\xa0\xcf\x61\x14\x90\x2c\x68\x71\x9c\x6d\xd2\xea\x55\x3a\x1d\xb2\x0b\x48\x26\xf5\x82\x1a\x17\x72\x82\x29\xae\x17\x23\xe5\x90\x6e\xf8\x70\xa7\x62\x16\xf3\x2b\xcf\x46\x17\x2d\x67\xa3\x46\x8e\x4c\xed\x1e\xb0\x80\xac\x10\x6e\xe0\x8c\xd1\x56\x66\x25\x67\xfc\x88\xe2\xc3\x82\x62\x74\x4a\xce\x01\xa2\xc4\x63\xc1\xba\x99\xa5\x8d\xa8\xa4\x87\x71\xea\x2c\x66\xdb\x96\xde\x2d\x65\x09\xde\x72\xa1\x6d\xea\xd1\x8a\xb9\x1f\x5f\x9a\x5b\xae\x74\xaf\xad\xb2\x5f\x37\xe5\x88\x69\x19\xa4\x04\xfd\x81\xb6\x98\xf4\xa5\xde\x49\x94\x27\x1d\x5c\xf5\xd2\x3d\x4f\xea\x32\xf4\x97\x83\x4e\x73\x5a\x14\xb1\xa6\xd9\x4f\x6c\x12\x3b\x26\xdb\x1e\x70\x54\x5c\x57\xb3\xa1\x2c\xfa\x5a\xe5\xe2\x5f\xe5\xc0\x08\xba\x78\x6d\xb1\xfa\xf5\x40\x2e\x36\xfc\xad\x33\x84\x08\xa1\x49\x1b\x3f\x11\xf9\x49\x03\x1d\x61\x08\x16\xb3\xed\xa2\xd7\x67\x04\x3c\x0f\x08\x0f\xd6\x98\x9e\x4b\xc0\x01\x16\x38\xa2\x34\x73\x50\xdc\xa4\xd1\xa9\xfb\x01\x84\x01\x0c\x02\x08\x02\x88\x03\x18\x07\x70\x17\x90\x24\x80\x61\x40\x22\x0e\x93\x80\xf4\x79\x2b\x08\xd0\x0e\x1c\x9d\x92\x5f\x8d\x00\x0d\x88\xcf\x47\x80\x66\x9d\xde\x9f\xa3\xc2\xcd\xfa\x28\x98\xc3\x20\xd0\xbe\x28\x65\x93\x33\xdf\x85\x71\x40\x02\xee\x0c\x82\xfa\x80\x85\x82\x26\x2b\x6a\xb8\x11\x04\x1d\xd1\x43\x08\xdd\x58\xc7\x55\x60\x1b\x77\x6b\x1b\x95\x7b\x76\x9b\xa3\x70\x39\x0c\x48\xc7\xc0\x73\xfc\xb4\x9c\x3b\x2c\xa7\x56\xd3\xe5\xc1\x1d\x36\xba\x8e\x0d\xc1\x5f\xdd\x10\x7c\x46\x4b\x6a\xe8\x07\x6b\x0d\xd5\x55\x2d\x42\xee\xd8\x79\x0c\x1b\xf2\x2f\x6b\x08\x5e\x29\x3e\x7e\x2f\x62\x2f\xcf\x28\x1d\x75\xb9\x21\xe5\xbd\x4b\xa6\x43\xee\x43\x3e\xa0\x66\xaf\xfb\xab\xae\x9a\xa3\xee\x0d\x4d\xbc\x30\xc8\x0f\x60\x37\x01\xe9\x07\x70\x1f\x90\x98\xc3\x43\x40\xc6\x1c\x6e\x03\x72\xc7\xe1\x2c\x20\x43\x0e\xd7\x01\x99\x70\x38\x09\xc8\x0d\x87\xcb\x80\xdc\x73\x38\x0d\xc8\x03\x87\x8b\x80\xdc\x72\x78\x1f\x90\x33\x0e\x57\x01\xb9\xe6\xf0\x22\x20\x27\x1c\x3e\x96\x48\x48\x51\xcf\x7c\x95\xa3\x6b\x3d\x84\x22\x2b\x53\x19\x45\x0f\x24\x01\xf9\x36\x52\x27\x0a\x89\x77\x60\x06\x62\xde\xfa\x5e\x21\x33\xb9\x84\xc0\xfb\x18\x80\xf4\xe2\xf4\x2e\x0a\x56\x61\x9c\x98\x12\x8d\xdb\x91\x9f\xc4\xd1\x08\x2a\x58\xc3\x45\x28\x09\x44\x5f\xc0\x32\x14\x7a\x27\x7d\x30\x4e\xa4\x30\xf6\x2a\xbf\x43\xa5\x3e\x6f\x5f\x96\xd3\x79\xe6\x71\x0e\x5a\xe0\xb1\x3a\x99\x02\xee\xc1\x86\x3a\xc5\xb6\x20\xc7\xeb\x5d\x40\xbe\x07\xf0\x3c\x20\x97\x1c\x5e\x07\xe4\x94\xc3\xdb\x80\xac\x24\x73\x0a\x12\x93\x0e\x0a\xd4\xa7\x1c\x8d\xd8\xf0\xbf\x39\x88\x54\x09\x35\xa9\x8a\x79\x48\xc4\x42\xc0\x12\xdc\x14\x23\x59\xe6\xa8\x0c\x28\x2c\x46\xcf\xd1\x6e\xac\xed\x0d\xc2\xda\xe5\x0a\x38\xcc\xf5\xc6\x59\xbd\x82\x90\xac\x30\xc2\x5b\x6f\x2b\x63\xad\xbe\x56\x3a\xa9\x0c\xef\x54\xab\x6d\x88\x5a\x6d\x75\x5e\x8b\x6d\xde\xae\x00\x43\x91\x85\xc6\xe0\xda\xc1\x6b\xfc\x3e\xfd\x88\x65\x66\x55\xd8\x69\x77\x5a\xce\xcc\x51\x1e\xaf\x12\x14\x66\xe3\xfc\xba\x61\x3c\x58\x75\x82\x6f\xe9\xf6\x57\xeb\xec\xf0\x4a\x80\x11\x43\x63\x42\xd3\x65\x08\xc4\x59\x29\x14\x9d\x5a\x40\xc6\xab\x65\x55\x2b\x16\xcc\x66\xe3\xac\xbe\x88\x29\xad\xba\x36\x5f\xee\x9f\x03\xf2\x36\x80\xf3\xe5\x29\xf3\xef\xc7\xbf\x34\xa3\x4a\x53\x41\x7d\xc2\xea\xe1\x55\xc7\xa7\x80\x4c\xed\xff\x56\x2d\xb4\xbd\x69\x9c\xd0\x01\x7b\xf0\xec\xbe\x9d\xeb\x28\x74\x6c\xd4\x69\xa5\x0f\xc2\xee\xcd\xc1\xfe\x6f\x79\x32\x2c\x45\x63\x26\x5a\xfe\x45\x46\xba\xa5\x93\x52\x94\x5b\x13\x05\xc3\x91\xcd\xc9\x23\xce\x5b\xe7\x0b\x73\x68\xb1\xcb\xb0\x1f\x84\x36\xde\x4e\xf0\xfa\x9a\xac\x68\x3d\x30\xf2\x29\xe8\x50\x09\xf3\xb3\xc1\x96\x60\x7b\x1e\x7f\xe2\xb7\x3c\xba\xe7\xb9\x43\x4c\xf4\xfc\x83\xea\xb5\x14\x5d\xfe\xd8\x8f\x37\x29\xfa\xb4\xdc\x42\xb7\x5c\x2c\x28\xbe\xa4\x96\x9f\x50\x2b\x6f\x2c\x58\xd8\x3b\x12\x80\x42\x77\xd3\x63\xe9\x76\x52\x75\x2c\x62\x73\x69\xb9\x74\xa5\xaf\x9d\x6b\xc7\xfe\x6f\x7b\x93\xe1\x51\x6a\xc0\x1e\x36\x2f\x94\x1b\x9c\x3e\x1e\x81\x02\x60\xae\x2b\xb3\xc9\x6f\x03\xb1\x23\x62\x73\x67\xa8\xfa\xc8\x9d\xcd\x56\x7e\xc5\x7e\xcc\xa1\x37\xd0\xbe\x03\xa5\xed\xbc\x1e\x21\xf3\x51\xcf\x55\x4f\xc5\x65\x76\x23\x29\x35\x21\xa7\xfd\x5a\xcd\xcc\x54\x47\x90\x0b\xee\xa0\x4f\x4c\x77\xa1\xc6\x58\x23\xc2\x11\xe1\x5a\xe1\xae\xbf\xc6\x0a\x7c\xa4\x83\xf2\x12\x5d\xd7\x30\xe1\xba\x2d\x4e\x36\xb8\x1a\x17\x44\xc3\x96\xb5\x31\x0f\x05\x8c\xeb\x8a\xa0\x85\xd1\x94\x8e\xca\x16\xa3\x68\x95\xfc\x3a\x4b\x91\x3d\xa6\x41\xfb\x57\xd9\x78\xba\x36\x39\x2c\x5b\xb9\xaf\xfe\x45\x77\xac\x69\x27\x1e\xa0\x65\x5b\x4d\xe6\x6b\x17\x7a\xde\xa5\x8a\x32\xe4\x2f\xf5\x8c\xaf\xee\x5d\xb5\x61\xfc\x19\x90\xf3\x00\x7e\xfc\xdf\xaf\x78\xe4\x3a\x06\x65\xe7\xb8\xeb\x87\xd8\xd0\x85\xd6\x8f\xff\x70\x6d\x62\x19\x2d\x41\xec\x5b\x1b\x01\xd2\xf1\xad\x2e\xda\xf6\x2d\x62\x61\x99\x92\x6f\xe9\xc4\xb3\x59\x25\x48\xce\xe9\x82\x96\x6a\x3c\x8a\x85\x21\x10\x3f\x25\xa9\x83\x6a\xfb\x7e\xd1\xcd\xcf\x02\xf2\x23\x80\x7f\x02\xf2\x9e\xb7\xde\x73\x4d\x91\xc9\xd2\x29\x01\x95\x5d\xd0\x7d\x52\x01\xcd\x8e\xc5\xa4\x6e\x7e\xd1\x35\x48\xa5\xa2\x4b\x07\x33\x18\xd4\x79\x4f\x35\x9c\xab\x86\xf3\xa2\x3a\xd8\xe8\xd2\xab\x6c\xb0\x3e\x6f\xb0\x7c\xe4\x1f\x05\xf0\x21\x20\x57\x1c\xbe\x05\xe4\x05\x87\x57\x01\x5e\x40\xbe\x09\xc8\x0b\x55\xa7\x62\x24\xea\x38\x3c\xad\x47\x8b\x73\x04\xb4\x0b\x5c\x48\x15\x08\x5d\x85\x5b\xc0\x6d\x9f\x24\xb0\x6a\x1e\x2d\xce\x16\xa6\x50\x4a\x70\x01\xea\xad\x37\x15\x88\xea\xa3\x04\x46\x8a\x04\x16\xbe\x67\x62\xca\x03\xc6\x87\x84\xd7\x35\x4a\x88\x9e\x4a\x03\xb9\x43\xa3\x65\x80\xfc\x58\xe5\x77\x4b\xa2\x9d\x0f\x81\x93\x82\xa8\x5c\x5c\x16\xbb\x29\x3a\x30\x99\xbb\x5a\x60\x68\x7c\x95\xc8\xb7\x9b\xec\xe6\x26\xa4\xb8\x8b\x3d\xaa\xcc\xd6\x3c\x7c\xc5\x4c\xf1\x83\x89\x06\xba\xcc\x38\xaa\xa9\xe7\x2c\x21\xf9\xae\x48\x33\xa2\x1c\x56\x5a\xe9\x56\x5e\xc9\x3b\xbe\x9c\x58\x45\x51\x78\x66\x78\x91\x88\xe7\x77\xc7\xad\x76\x6c\x05\xce\x75\xf5\x6c\xd6\xc7\x59\xd1\x12\x64\xa9\x07\x73\xe4\xec\x96\x8b\xd3\x5b\x6f\xdd\xaa\x8b\xbc\x15\xb2\x00\x55\x50\x8a\x67\x51\xba\xd8\x59\x0b\x55\x5d\xb1\xaa\xb5\xa2\x97\x02\xcb\xac\x70\x41\x20\x56\xc2\x40\x22\x21\x96\x38\x4b\x11\x4f\x3b\x0c\x29\x65\x0f\x44\x7b\x55\x4c\x09\x37\xc7\x5c\xcf\x49\xc8\xb7\x60\xa1\xdf\x2b\x4d\x56\x60\x1c\x40\x2b\xe5\xaf\x01\xbc\x51\x4b\xaa\xe4\xaa\x11\x4f\xcd\xed\x52\xdb\xd4\x6c\x53\x4c\xba\x27\x0a\xff\xb3\xed\x42\xe7\x00\x96\x13\xd0\xb9\xbb\xd8\x45\x94\x2c\xb5\x4a\xc2\x60\xc2\xf4\x27\x18\xc5\x5a\xe6\xb4\x42\x28\x86\x7a\x5b\x94\xbc\x46\x81\x98\x82\xe1\x77\x81\xce\x51\x5a\x40\xa6\x01\x8d\x53\xaf\xda\x01\xea\xa4\xf9\x9c\xbb\x28\x5d\x14\xd5\x33\x13\xd6\x4f\x48\x23\xf9\xd8\xf9\xc8\x8d\xa0\x2b\x41\x00\xba\x70\x62\x3c\x89\xcd\x7f\x3a\x8e\xd4\x61\xc0\xd7\xf2\xcc\xcb\x43\xb5\x24\x29\x29\x0e\xd1\x5c\x6f\x2a\x38\x3b\xe7\x9a\x0a\xbf\x0c\xc8\xa3\x00\xbe\x2e\x6f\x76\x58\x7d\x05\x16\xa2\x8a\xc9\xcb\xd0\xd3\x18\xab\x4b\x68\x89\xb8\x2d\x10\x34\xc5\xe0\x9a\x06\x11\xba\xd0\xc2\x65\x95\x36\x35\x4d\xe5\xf8\x13\xe1\x89\xba\x20\x84\x0c\xfb\xed\xc5\x66\x92\x0f\x81\x02\x2a\xa9\xf3\x0a\xb5\x62\x86\x5a\x21\xad\x92\x2e\x83\x5e\x06\x0e\xda\x87\x8a\x9f\xc4\x9b\xb7\xbe\xfe\x87\x67\xa8\x6a\xcb\x4a\x1b\x5d\x85\xba\x40\x69\x3f\x5f\x38\x44\xa9\x26\x56\xb3\x29\x03\xb8\xca\x81\x52\xcf\xaa\x3e\x49\x89\x72\x2c\x92\xac\x85\x0a\xfd\xa4\x3a\xff\x72\x26\xd5\x6a\x8b\x21\x95\xd9\xb3\x1a\xd3\xba\xdc\xb4\xb5\x55\x5f\xc3\x3d\xfc\x2f\xfa\xf6\xe7\xd5\x2e\xc8\xf4\xaf\x0f\xab\x3f\xcb\x67\x21\xb5\x71\xd7\xf3\x47\x40\xbe\x06\xf0\x65\xcd\x02\x32\x4e\x1b\x8b\x85\xb4\xb0\x4e\xa2\x75\x2c\x24\xae\x2f\x56\x61\x1f\xc6\xea\xda\xa6\xe8\xdd\x01\x49\x70\x5d\xad\x3e\x5b\x66\xe6\x40\x8b\x3c\x82\x93\xa2\xe8\x01\xfd\x4e\x44\xae\x9b\x2f\x29\xbc\x86\x24\x8a\xa6\xe1\xb3\x6e\x2b\xc1\x85\xf3\x59\xad\xb1\xa4\xb2\x72\x7c\x19\x11\xd1\x9d\x16\x52\x20\x35\xf3\x8b\x61\x56\x43\x33\xa6\xe8\x7a\x5f\x42\x85\xac\x13\xb3\x61\x59\x7f\xe0\xb4\xf2\x21\xfa\x15\x7b\xd1\xfa\x52\x59\xa8\x43\x8a\x2e\xc9\x91\x14\x7b\x2b\x7d\x0d\xe8\xe2\x65\xd1\x6f\x0d\x09\xde\x90\x98\xf8\xc9\xc4\xf9\x8c\x6e\x90\x96\x5a\xdf\x46\xca\x5c\x74\x4a\xae\x76\x29\x94\xf6\x65\xdd\x46\x62\xfc\x73\xbe\x66\x63\x29\xcb\x5a\xad\x32\x40\x8e\x86\x0a\x53\xb5\x77\x7e\xc2\x55\xeb\x26\x68\xc2\xfe\xed\xe7\xf2\x13\x7d\x1c\xc6\xd2\xcb\x34\x5c\xb6\xb0\x22\x37\x69\x21\x52\xfe\x06\x29\xbe\x6a\x86\x0c\x9f\x09\x53\x72\xf2\x95\x75\x30\x05\xb3\x9c\x94\x1a\xba\x20\x25\x35\x4a\xbc\x5e\xab\x55\xc7\xee\x97\xcb\xaf\xda\x61\xa5\xba\x94\x84\x47\xb0\x14\xd3\xac\xef\xe5\x4f\xd2\x73\xb7\x0b\x2b\x9b\x70\xce\x5d\xa8\xd6\x2f\x3f\x01\x88\x98\x7c\x09\x80\xc6\xe4\x13\x07\x1e\x93\x3f\x39\xb0\x98\xfc\xe0\x90\xc4\x64\x3a\xd4\x34\xef\x75\x90\x4b\xab\x69\x6c\x58\x3f\x1e\xeb\x51\x61\xf1\x1c\xd2\xb8\x44\x0d\x54\xf3\x90\xc3\x20\xaf\x46\x90\xc4\x0a\x85\x51\x6e\x60\xee\xbc\x95\xc6\xe5\x39\xbd\x8c\x41\x6d\x44\xa7\x52\xe2\xae\xb4\x65\xd4\xb8\xc9\x7d\xa4\x6d\xdb\x0a\x49\x18\xdf\x0a\x76\x34\x89\x35\xfd\xd5\x75\x93\x21\x58\x4b\x28\x64\xc2\x6a\xde\x3d\x0f\xfe\xaf\xc4\xbe\x8a\x35\x97\x55\x73\xab\xa2\xdf\x95\x32\x5c\x51\xab\x55\xe5\xdd\xa6\x9f\x93\xb8\xae\x9f\x7f\xe2\x44\xa1\x55\xdd\x3f\x1c\xba\x92\x9d\x42\x5e\x30\xa6\x41\xa7\x1c\x19\xd9\xdd\xdc\xfc\x80\x50\x2c\x09\x1d\x54\xfd\x1b\x67\x0c\x55\x72\x6d\xa6\x50\xd1\x52\x2d\x61\x45\xaf\x77\x0a\xbb\x70\xfe\x6f\x85\xef\x2b\xc5\xe3\xb2\xa1\xc4\xb6\x37\x2b\x9d\xab\x59\x4a\x29\x35\xa7\xae\x47\xd5\x24\x8e\x62\x92\xc6\xe0\xc7\xe4\x19\x87\x2c\x26\xff\x70\x18\xc5\xe4\x11\x87\x7e\x4c\x3e\x70\x08\x63\xf2\x8d\xc3\x20\x26\xaf\x38\x04\x31\x79\xc3\x21\x8e\xc9\x4b\x0e\xe3\x98\x7c\xe5\x70\x57\x9e\xba\x48\x57\xcc\x9c\x59\x02\x88\x1b\xc6\xe4\x0b\x87\x49\x4c\x04\x83\x9b\x98\x84\x0c\xee\x63\x32\x60\xf0\x10\x93\x80\xc1\x6d\x4c\xee\x18\x9c\xad\x5b\x09\x2f\xd5\xe9\x34\x55\x12\x32\xf9\xb2\x20\x35\x2b\xae\x81\xeb\x6a\x4b\x63\xb9\x2e\x43\x40\x2a\x69\x09\xb9\xec\xab\x91\x51\xba\x26\x44\x98\xc7\xfc\x83\x3e\xf6\x17\xc7\x65\x13\x72\x15\x9d\xca\x09\xbc\xea\xcb\x47\xb9\x24\x56\x7d\xf9\xa4\xa6\xb2\xfc\x56\xd4\x93\x69\xca\xc5\x94\xc8\x23\x2d\x5d\xcc\xd4\x99\xd6\xc6\x01\xdb\x5d\x7b\x0c\x9f\x72\xbc\xc7\xf5\xb6\x51\x7c\x83\x82\x1b\x94\xd4\xcc\xe7\x7a\x21\x69\x87\xe7\x2f\x96\x1a\x94\x9f\xd2\xc8\xf6\x32\x85\x39\x8b\x57\x1c\xca\xfd\x58\x13\x81\x2c\x86\x01\x52\x87\x51\xfe\x7b\x12\x86\x5e\xdf\x3c\x1a\x24\xa2\xd4\x0b\xf3\x40\x13\xe2\x0d\xf2\x80\x77\x6a\xe5\x7b\x41\x5c\x9a\xe4\x71\x8c\x67\x22\x2d\xf9\xa9\xcc\x66\x36\x58\xe8\x23\x57\x1f\xb9\x29\xa9\x7e\x50\x8d\x6a\x51\x69\xd0\x81\xab\x80\x2f\xc4\x50\xf2\x66\x5e\xe7\x78\xcc\xd4\xe2\x43\x3e\x5f\x47\x46\x46\xd9\x60\x10\x52\x6f\x6c\xe8\xf5\x5d\xac\x09\xdf\x30\x5e\x45\x3a\x0d\xe1\x99\xc4\xc5\xda\xbc\x89\x35\xdd\xbc\x8f\x8b\x9b\xa9\x87\x58\xfb\xbb\xb8\x8d\xf3\x95\x78\x1d\xc3\x49\x0c\x97\x31\x39\x8b\xe1\x34\x26\x43\x06\x17\x31\x99\x30\x78\x1f\x93\x1b\x06\x57\x31\xb9\x67\xf0\x22\x46\xe9\x16\x22\xe2\x86\xbe\x63\x4b\x1d\x56\xdb\x2d\x21\x97\xdb\x83\x28\xb2\x15\x34\xee\xb5\xef\x4e\xaf\x63\x64\x8c\x4e\x62\x32\xbd\x3a\x79\xf6\xf6\xcc\xeb\x3c\xfe\x5d\x26\xb2\xfa\xa1\x9f\xa6\xc4\x7e\xb0\x9f\x3e\x06\xfb\xf7\x86\x0c\x7c\x6a\xf7\xe0\xea\xd5\xd9\xc9\x73\x13\xed\xe9\xef\x52\x81\x75\x29\x36\x06\x3e\x2d\xa7\x7a\x76\xf1\xfc\x6b\x39\x95\xd4\x55\x5b\x4c\x85\x81\x95\x54\x1f\xcb\x49\x92\xa5\x52\x92\x72\xe4\xcb\xb3\xb7\x67\xa7\x57\x98\x20\x87\xa7\x58\x8c\x9d\x87\x3e\xb5\x7b\xf3\x39\x76\xe6\xc7\xf8\xe7\x3c\x36\xa0\xcb\x4c\x94\xe4\xe3\xbe\xbf\x81\x58\x38\xb9\x8f\x84\x5c\xa2\x73\x1d\x4b\x85\x97\x93\xb8\x43\xeb\x22\x07\xac\xef\xb9\xe8\x0e\xf1\x81\x39\xf6\xf3\xd7\x9f\xd1\x78\xaa\xd4\xeb\xa8\xa7\xb8\x29\x36\xa5\x8e\x22\x9a\x59\xfd\x93\xd1\x64\x92\x23\x83\xa0\x0b\x82\xfa\x83\xed\x82\x9d\x37\x00\x05\xad\x4c\xe7\x29\xad\xd0\x98\x46\x17\x46\x01\x8c\x42\xde\x90\x2b\xa1\xe7\xba\x1e\x95\x57\xf0\x52\x0d\xea\xd3\xc7\xd7\x98\xd6\xaf\xa7\x77\xc3\xf5\x55\x79\xfc\x7b\x7a\x37\x2c\xf7\x0d\xe2\xb6\xfe\xde\x48\xef\x86\x4f\xed\x75\x75\x73\xbd\x3c\x3b\xd3\xd6\x6a\x96\xe2\xff\xad\xee\xad\x88\x30\x74\x7b\x9f\x0a\xe9\xd2\xb9\xe5\x2a\x9f\x9f\x11\xda\xe9\x95\x71\xf5\x23\x79\xa3\x52\x2a\xa3\xa8\x07\x8e\x85\x4f\x8c\x63\x4f\x1f\x57\x76\xd6\xf1\x7b\xca\x41\x22\x3e\x9a\x72\xc9\x46\xd3\x98\x12\x64\x73\xf8\x1e\x93\x5b\x06\xef\x62\x72\xcd\xe0\x79\x4c\x4e\x18\xbc\x8e\xc9\x25\x83\xb7\x31\x39\x65\xf0\x39\x26\x17\x0c\xce\xd7\x6d\x38\x6f\x56\xb0\x5e\xe7\x15\xc2\xa8\xc8\xe1\xa9\x22\x87\x17\x05\x39\x7c\x5f\x26\x6c\x57\xf1\x6a\xd2\xb2\xc4\x63\x29\xaa\xf0\xee\xe7\x64\xe5\x79\x89\xac\xbc\x36\x64\xe5\x79\x50\x90\x95\xb7\x86\xac\x7c\x56\x64\xe5\x53\x0c\x7f\xc6\xf0\x23\x86\x67\x31\xfc\x13\x93\xf3\x18\x1e\x95\x9a\xed\x4e\x7f\xc6\xa5\xcc\xe1\x43\x4c\xde\x33\xf8\x56\xed\xa8\x45\x46\xa4\x3d\x50\x8e\x4c\xf1\x05\x84\x3a\x31\xe2\x8b\x57\xd9\x0a\x6b\xb5\xca\x6b\x1d\xbb\xac\xbd\x1c\xe4\x08\x7d\x2b\xaf\x0c\xa0\x5e\x95\x8b\xc7\xcd\x8f\xd6\xaf\x8d\xf5\x16\xea\x76\xa1\x92\x06\x3a\x63\xd4\x1b\x6a\xc9\x31\xe3\x6c\xb6\x14\x84\x7e\x92\xdc\x7c\x32\x52\x17\x2a\xe5\xaf\xaa\xe1\x09\xa2\xba\x49\x26\xe6\xcd\x62\x3d\xfe\x4d\xda\x62\x53\x54\xb9\xbc\x5c\xdf\x99\x3a\xed\x8a\x4e\x31\xd9\x14\xbd\x33\x87\xaf\x31\xb9\x62\xf0\x47\x4c\x5e\x30\xf8\x12\x93\x8f\x0c\xc4\x98\x34\xfe\x42\x9b\xc2\x59\xc4\x67\x13\x9a\xce\x9a\x8f\x1a\x0c\xe8\x58\x83\x4f\x6d\x3e\x6a\x00\x1f\xaf\xb5\x8d\x54\x95\x41\x41\x81\x2f\xb1\xce\x19\xde\x6a\x70\x92\xd4\xc5\x7d\x74\xef\x4f\x2a\x17\xe8\x0e\x53\xa1\x04\xf9\x0f\x3e\x9b\x89\xb1\x36\x9b\x45\x21\x68\x52\x0f\xfd\x1f\x4b\x29\x30\x8c\xa8\x57\x6a\xe2\xb7\x35\x2c\xa1\xc3\x5d\x6f\x45\x6e\x6c\x0e\x6c\x4c\xbe\xb3\xd6\xa7\x98\xd8\x7e\x28\x5e\x86\x93\x78\x64\xe9\x87\xe7\x74\x60\x9e\x5f\x0b\x3a\xb6\x72\x47\xa2\xf4\x34\x0a\xa3\x44\xbf\x9c\x47\xd8\xe3\xfa\xcd\x78\x6b\xb0\xfa\x21\x8b\xdf\xfb\x62\x64\x0d\xe8\xb3\x90\xf2\xc0\x1a\xe4\xe9\xce\x7d\x91\xb0\x07\xf9\xa6\x7a\x3e\x4f\x43\x13\x1d\x96\x32\x41\xe5\x33\xbf\xc3\x65\x6a\x12\x3c\x67\x83\x41\x96\xd2\xb7\x6c\x38\x92\x1e\x0c\x06\xf4\x39\x4b\xa5\xe1\x2d\x8e\xe6\xb9\x1f\xcb\x38\x78\x39\x2a\x64\x1c\x6b\x40\x5f\x84\x51\x84\x25\xe3\xe5\xd1\x89\xfa\x7d\xa6\x7e\x5f\xaa\xdf\x8f\xd6\x80\xbe\xf4\xb3\x34\x65\x3e\x7f\x16\x66\x58\x8d\xd7\x88\xe0\x60\x0d\xe8\x39\x4d\x8a\x5f\x3c\x36\xe0\x73\x94\xc4\xa3\x28\x8c\x86\x13\x6b\x40\x2f\x06\x03\x54\x17\x1f\xd0\xf7\x11\x2b\x4a\x45\x49\x7a\x16\xfa\x49\xa9\xa6\x97\x71\x64\x3e\x5f\xa1\x47\xca\x01\xbd\xca\x92\x9b\x2c\xc4\x1b\x76\xb4\xbd\xa1\x6c\xc8\x73\xf5\x33\x6b\x88\x7d\xff\x91\x0e\x50\xe7\x9c\xfa\xc9\x4b\x44\x4a\xa0\x5c\x58\xf8\xeb\x87\xe6\x15\x55\x72\x64\x07\xdf\xdd\xd2\x84\x57\x94\xcc\xff\xc4\xd1\xd4\xfa\xd8\xb8\x23\x5a\xe6\xed\x6a\x12\xe7\xba\xef\x2f\x12\xfa\x4f\x46\x79\x7f\x22\xdf\xde\x27\xd1\x00\xab\xd5\xf7\xc3\xfe\x39\x36\x54\x0f\xdf\x27\xce\x04\xfa\xb4\xe1\x02\x0f\x05\x52\xb7\x5d\x66\xa1\x43\x50\xcd\x5d\x06\x04\xf9\xe8\xa0\xe3\x08\x1c\x01\x8b\x06\x43\x2a\x73\x42\x25\xb3\x84\xfb\x21\x8a\x04\x10\x6d\x2a\xfd\xa8\x90\xb2\xd0\x35\xa7\xa0\xc9\x47\x9a\xaa\xa7\xbc\x2c\xd3\xfc\xa1\x6a\x69\x31\xa7\x74\x48\x1e\x11\x9b\x4d\x43\x35\x3d\xf2\x17\xfc\xf0\x56\xb2\xab\xd6\x2d\x9d\xc8\x41\xc1\x78\x93\xcb\x18\xfb\x52\x3e\xa2\x83\x65\x54\x0c\xc7\x48\x27\xc1\xf7\x2c\x45\xec\xf5\x31\xc3\x71\x3a\x8d\x38\x3d\xe1\xc3\x90\x5a\x78\x2d\x43\x93\x57\x54\x8e\x58\xfe\x82\x59\xa7\xea\xc3\x9f\x2c\x10\x23\x6b\xec\xa7\xb7\xa7\x79\x37\xe8\x8f\xe9\x6d\xfe\xc4\xb3\xf1\x45\x5f\xf8\x77\x34\xb5\x50\x34\xa6\xaa\x14\xe7\x86\xab\x95\x34\x2a\xac\x68\xa3\x0a\x50\x5f\x65\x0b\x4e\xc4\x17\xf3\xf4\xd5\x3c\x7d\xb3\xb4\x4e\xb7\xc4\xdd\x29\xde\x50\xb7\x43\x7c\xc4\xdb\x27\x2b\x4e\x50\x89\x9b\xdd\xd1\x3c\xbb\x84\x0e\xbe\xa0\x62\xc7\x57\x2b\xa1\x31\xf5\xc5\x69\x94\xe1\xbc\x92\xcf\xcf\xb3\xc4\xd2\x18\x66\x67\xa8\x17\x88\x18\xd2\xa9\x09\x7a\x41\x7d\x91\x25\x34\x95\x9a\x23\x38\xb9\xcd\x40\xeb\x80\xb3\x07\xed\xf6\x28\xc6\x9b\xc9\x73\x2a\x46\x51\x60\xa5\xc2\x4f\x84\x5a\x26\xa9\x08\x9e\xd3\x3b\x86\x35\xe3\x56\x2a\x10\x93\x14\xd7\x42\x6a\xa5\x59\x32\xf0\xfb\xf4\xb2\xef\x87\xd4\x4a\x27\xa9\xa0\xe3\xb7\x3e\x1f\x66\xb8\x0a\x25\x1f\x2b\xb7\xe9\xd4\x12\x7e\x32\xa4\xe2\x8b\xfa\xfd\x2a\x97\x80\xea\x5b\x94\x56\x3f\x8b\x1e\xe4\xef\x95\xfc\x6c\x3d\xa0\x7f\x39\x4e\x43\xcd\x95\x59\x93\xc5\x80\x1f\x51\x34\x3e\xe1\xc1\x7b\xbf\xba\x7c\x7e\x54\x77\x92\xe2\x60\x24\x69\xb6\x36\x46\xc8\x2d\xdc\x69\x07\xe1\xd5\xeb\x22\x7a\x1b\xdd\xd3\xe4\xd4\x4f\xa9\xe3\xe6\x90\xeb\x5a\x32\x40\xe7\xf0\x2c\x26\x3f\x62\xe7\x53\xac\x35\x3d\xff\x8c\xdd\x5c\x81\x35\x19\x93\x65\x11\x83\xa8\xe6\xa6\xf3\x79\x16\x77\x68\x6f\x36\xa3\x73\x48\xd7\x6c\x34\xc8\xef\xa1\x01\x96\xd1\x8d\xf1\x6c\xe5\x2c\x9a\x4b\xdf\x71\x54\x83\xfc\x6f\xa3\x6b\x6a\xfb\x61\x1c\xf2\x54\x32\x9f\xae\x9b\x2b\x5a\x22\x3e\x7f\x7e\x78\x2f\xf8\x63\x54\xb9\xf1\xeb\x68\x0b\xd3\x4e\xc6\x52\xc2\xd1\xd2\xce\xfd\xa8\xc9\x11\x6d\x52\xf9\x26\xba\x5d\x96\x09\x89\x8c\xa9\x5e\x24\x97\x4d\x98\xdf\x61\x0b\xbd\x54\xfe\xfe\x5e\x6a\x95\x10\x06\x1b\xa5\xc0\x5c\x7d\xc9\xe8\x2c\x99\x70\xcb\xb1\x1f\x6f\xb2\xcd\xc7\xb6\xfb\x78\x0e\xd1\x98\xbc\x63\xe0\x8f\xc9\x73\x06\xd9\x98\xbc\x66\x30\x1a\x93\xb7\x0c\xfa\x63\x32\xb5\xfd\x7e\x9f\xc6\x62\xab\x3f\xc2\x6d\x50\xd8\x9e\x0a\x38\x55\xef\x80\xaf\x69\x8a\x5a\x09\xf2\x4b\x9a\xbe\x41\xad\xac\x9b\x61\x1f\x77\x2a\xcf\xbe\x19\xca\x2d\x0b\x15\xd7\x91\xf3\xb7\xbd\xfc\x17\x29\xaa\x0d\x88\x09\x8c\xa4\xd3\xb3\xf1\xe9\x99\x9f\xca\xb0\x30\x8d\x7d\x8e\x41\xe1\x65\xec\x73\x0c\x91\x8b\x9d\x06\x4c\x4e\x64\xfc\x22\x03\xce\x54\x80\x8d\xca\xc8\x14\xc1\x62\x3c\x1b\x9f\x90\x3c\xd9\x10\xb0\x44\xb9\x26\x62\x49\x5e\x9a\x3d\x88\x12\xdb\xb3\x71\x1c\x5e\xa0\x33\x17\x1b\xdd\x18\x6f\xe1\x7a\xbd\x93\xc1\x22\x3e\x93\xcf\xc0\xd2\xb1\x1f\x7b\xb6\x84\x3d\xb4\x61\xec\x3f\xe4\xb3\xd5\xb3\xc7\xfe\x43\xbe\x56\x6c\xf8\xff\x78\x74\xa7\x80\x0d\x3d\xbb\x00\x39\xb4\x21\xce\x6e\xf0\xc1\xb3\xe3\xec\x06\x11\x93\x6d\x69\xc9\x16\xf1\x70\xe2\xd9\x1a\xe9\xd0\x86\x24\xba\xcf\x5b\x99\x44\xf7\x79\x2b\x85\x8f\x1c\x33\x7d\xf0\xf0\xec\x2c\x4f\x2a\x36\x64\x29\x95\x35\xc9\x52\x8a\x55\x99\x43\x38\x26\x9f\x19\x0c\xc6\xe4\x13\x83\x60\x4c\xfe\x64\x10\x8f\xc9\x0f\x06\xe3\x31\x79\xc6\xe0\x6e\x4c\xfe\x61\x30\x1c\x93\x47\x0c\x26\x63\xf2\x81\xc1\xcd\x98\x7c\x63\x70\x3f\x26\xaf\x18\x3c\x8c\xc9\x1b\x06\xb7\x63\xf2\x92\xc1\xd9\x98\x7c\x65\x70\x3d\x26\x7f\x30\x38\x19\x93\x2f\x0c\x2e\xab\xcb\x48\x49\x27\x50\xfc\xe2\xce\x5b\x97\xe3\x15\x92\x17\x36\xce\xc5\xc7\xfe\x58\xcb\x20\xb2\xb1\x96\x41\x8c\xc6\xc5\x69\x20\x1c\xeb\xd3\xc0\x60\xac\x4f\x00\x27\xe3\xb9\x5c\xbc\xa7\x63\xb8\x18\x93\xcb\x31\xbc\x5f\xb3\x1e\x21\xc1\xc3\x70\xc9\x89\xa7\x9d\xf3\x75\x78\x22\xe6\xb5\x9a\x8d\x3c\x9b\x7a\x5e\xe9\xde\xd3\x61\xf2\x16\xe8\x62\xec\x68\x1b\x36\x4f\x80\x9c\x17\x1c\xa4\x68\xd7\xa3\xe8\x2b\x05\xa5\xac\x5e\x7e\x47\x35\x77\x21\x41\x97\x0f\x0c\x6c\x19\x41\xe5\x9e\x68\xbf\x34\x9a\x98\x38\x8c\x24\x4a\x80\x59\xfa\x0a\xc9\xbc\x55\xd2\x19\xd8\xd0\x77\xc2\x5a\xca\x5c\xab\x39\xa7\x63\x82\x12\x92\x80\xdd\xd9\x8a\x84\x5d\x2d\x36\xbe\x6c\x14\x48\xc4\xff\x46\x67\xd7\x30\x2d\xcb\x12\x43\xd9\x31\x93\x1b\x67\x5a\x6a\x3c\x48\x2d\x38\x0f\xf3\x2f\x34\xfb\x11\xb0\xc1\x9d\xb7\xae\x56\x4d\x02\x53\x6f\x55\x69\x3c\x59\xa9\xd3\x86\x76\x1c\x5a\x31\x71\x2c\x9d\xdf\xd4\xa4\x59\xd1\x72\x73\xba\xa8\xdc\xd5\xaa\xa9\x55\xc4\x2f\x15\xa9\x3b\x89\xa5\x97\x77\x43\x22\x56\x8b\x26\xa0\x52\xad\xb5\x57\x55\xa6\xf4\xe2\xca\x55\xcd\xda\x55\x8a\x2d\x4a\xb5\x80\x54\x93\xea\xc9\xef\x48\x5f\x41\x89\x16\xc6\xcb\xea\xb9\x8b\x23\xb3\xa4\xe9\x5f\x1c\xbd\x68\xa2\x14\x96\x96\x2f\xad\x84\x3b\xe5\xb2\xed\x4a\xef\xd7\x18\x0f\x3a\x79\xf3\xdd\xb9\x0b\xf4\x67\xc9\x52\x2a\x16\xd3\x80\xd2\x5a\x71\xe7\x4b\x95\x24\x2b\x65\xfa\xab\xcf\x88\x85\x77\x04\x7d\x1f\xf5\x62\x4c\xae\xc6\xf0\x71\x71\x9e\xeb\x56\xb6\x69\x55\x8c\x5c\x7c\xc2\x39\xfa\x62\x2c\x63\xcf\x5d\xaf\xd3\x9b\xc3\xf7\x15\xfb\xbd\x42\x64\xd6\x02\xd9\x5f\xad\x1c\xd3\x2a\x22\x4a\x2d\x2c\x8c\x78\x66\x33\xb5\xf4\x7b\x5a\x57\xc8\xc4\x2a\xd9\xcf\x46\x09\x1a\x8f\x49\x6a\xf4\x8c\xe5\x3a\x6b\x98\x03\xba\x49\xd2\x37\x33\x52\x3f\xd4\x9e\x23\x83\xc0\xf1\x96\x46\x3b\x8a\x68\x1a\x05\xd1\x3b\xc7\xbe\xba\x8f\xb6\xee\xfd\x89\x85\x93\x0d\xcf\x64\xc6\xc7\xe7\x7d\x94\xdc\xe6\x4e\xeb\x0a\x8f\x24\xa9\xe5\xfc\xfd\x5b\xfa\xb7\x15\x71\xeb\xf7\xdf\xd2\xa7\xae\x0d\x54\xcb\x62\x92\x8a\x1a\x99\xe2\x0c\x60\xaa\xdc\x0c\x7a\xa6\x2b\xe6\x2e\x6c\x34\x25\x9f\x53\x68\x2c\xfd\x67\xd5\xf9\x2d\xad\x14\x8b\x9a\xd4\x6b\x8a\x91\xda\xb2\x72\xb1\xe0\xe5\xb9\xb9\x40\x48\xda\xb6\xf4\x6a\x5a\x04\x6c\xda\x8f\x0b\x75\x69\xdb\xb3\x4b\x3e\x58\x5a\x63\xc7\xbe\x1a\x51\xeb\xb7\xd4\xba\xa1\xd8\x41\x59\x4a\x03\x3c\xef\x59\x62\xa1\xaa\x2c\xb5\xfc\xf1\x0d\x1b\x66\x51\x96\x82\xd4\xb0\x1e\xfb\x13\xe5\x93\x2f\xe3\x54\xbb\x71\xc9\xed\x00\xcb\x1e\xfa\x70\x1f\x63\x7e\x88\x86\xca\x43\x6b\x12\x65\x89\xf4\x90\x87\xfe\x00\xa5\x09\x27\x42\x21\xa3\xb3\x3f\x95\x3b\x13\x13\x1b\xd2\xd5\x4d\x2e\x75\xcb\x92\xa6\x45\x79\x66\xcc\x17\xa7\x55\x7a\x25\x67\x92\x51\x16\x50\xf1\x88\x76\x75\xb4\x78\x11\x88\xf7\x7c\x5c\x5b\x0d\x20\x89\x53\x54\x57\x5e\x56\xc8\xd6\x7c\xc6\x29\x5c\x78\x58\x5b\xf8\xe0\x94\x2c\x0e\x74\xda\x85\x22\x52\x2c\x02\xb5\x99\xc1\x49\x08\x4f\x1c\xe1\xba\x5a\x8a\x85\xe7\xa4\x5c\x5e\x88\xd8\xe9\x48\x65\xc6\x6a\x0d\xa4\xca\xd3\x68\x7e\x5b\xd8\xfa\x5e\xd9\x34\x46\x3e\x0f\x42\x8a\x47\x88\xe1\x32\x35\x95\xd5\x6c\x55\x9c\xd3\xca\x46\x2f\x92\xa2\x3a\xe2\x70\x94\x0c\x3b\x56\xd4\xba\xdc\x83\xa0\x5b\xaf\x9a\xed\xc2\xaf\x3d\x60\x8b\x15\xa5\x35\xe7\x85\x47\x5b\xbc\x9c\xbe\x91\xf8\xcc\x8b\x8d\xc0\x2d\xa1\x45\x49\xa5\x02\x9c\x2c\x34\xa0\x4c\x43\x4c\x2c\xaa\xe4\x50\xaf\x8b\x96\xd7\xaf\x2b\xe4\x25\xed\x50\xa5\x07\xb9\xac\x90\xc5\x2b\xea\x77\x8b\xe9\x78\xc5\x5f\xf4\x4f\xa2\xa0\xfc\x11\x44\x65\x08\x57\x6c\x93\x73\xa4\xc2\x0a\xc6\x62\x15\x31\x56\x3a\xc3\x8a\xb8\x57\x62\x7c\x1f\x97\xef\x5f\xd7\x38\x0e\x5c\xf6\xda\xc5\x8b\x57\x17\x58\xd9\x3b\x17\x66\x62\x1c\x72\x7d\x1f\xd7\xe9\xc3\xff\x8f\xbd\x6f\x6d\x6e\x5b\x47\x16\xfc\x3e\xbf\x82\xe6\x66\x14\x62\xdd\x91\xed\x9d\xa9\xdd\x2d\xea\xe0\xa8\x92\xd8\x49\x9c\x77\xec\x38\x2f\x1f\x8d\x0f\x29\x41\x14\x63\x89\x54\x48\x88\x36\x63\xe9\xbf\x6f\x35\x5e\x04\x1f\xb2\x9d\xb9\x73\xab\xee\x4e\xdd\x2f\xb6\x08\x82\x78\x76\x37\x1a\xfd\xb4\x13\x72\xbd\x5d\xc0\xe1\x82\x7e\x5f\xc0\xf1\x82\xc6\xd9\xe0\xed\x82\x1e\xea\x81\x7b\x37\x0d\x8c\xb0\x67\xa8\x2c\x2b\x5d\x4b\xfd\xd6\x7a\xeb\xd4\xf8\x1a\x71\x12\x1b\xcb\x85\x3a\xa7\x62\x60\x83\xb6\x3e\x41\xfb\x94\x03\xed\xb0\x85\x5f\x29\xc0\xb6\x36\x13\x59\xdc\x3a\x0f\x85\x25\x22\x4a\xc3\x90\xd3\x9d\x7d\xbf\xf3\xdd\x01\xbe\x3b\xf0\x57\x5e\xeb\x2d\x19\x7a\xf8\x0a\x12\xba\xdb\x7e\x87\x1f\xac\xd7\xae\x8b\x98\x8e\x15\x80\x1b\xa7\x31\xbb\x1e\xd5\x76\x27\x12\xa3\x33\x9a\x0c\xf3\xcc\x3f\x5e\x00\xe6\xed\x9d\x88\x98\x07\xaf\xd1\x92\x30\x61\x99\xe7\xca\xe8\x40\x2e\x1c\x2f\x60\xe7\x80\x00\x5f\xaf\xbd\xae\x6a\x22\xca\xba\x5b\x6b\x56\xd4\x67\x88\x39\xc1\x78\x26\xaa\xf7\x7a\x5d\x9f\x5e\xb2\x72\xb5\x6c\x7f\x4a\x3a\x87\x13\xce\x57\x99\x0b\x99\xa8\xd1\x65\xcb\xa1\x76\xab\xbd\x57\xcd\x6d\x3a\x00\xae\x78\xaf\x3b\x26\xdc\x5d\x6b\xdb\x7c\xb7\x34\xba\x65\x8e\xdb\xaa\xd7\x66\xa9\x7c\x7d\x5f\x2f\xe8\xdb\x05\x7c\x5a\xd0\xd7\xbf\x80\x04\x75\x30\xd7\x2c\xde\xb0\xb3\xd4\x62\xfc\xfc\x5f\x46\x1e\xa3\xd6\x43\x43\xe5\x37\x0b\xfa\x69\x01\x67\x0b\x9a\x66\xf0\x79\x81\x92\xfe\x9f\x35\xdc\x45\x36\xcd\x77\x55\xba\x04\xb7\xe5\x0a\x2e\x5a\xc7\xb0\xff\x48\xdb\xe8\xd9\xc2\x26\x7c\xd1\x2a\x9e\x80\x8b\x62\xd0\xd4\x85\xda\x30\x22\x9b\x23\x76\xb1\x0b\x57\xdb\x2b\xe8\xb6\x2c\xd2\xb8\x81\x5f\x80\x9c\x7b\xa0\x45\x1d\xcc\x3b\xbf\x98\xc7\xe3\x4b\xfd\xc1\x2f\x82\xee\x7f\x04\x52\x1b\xfd\x6e\x3b\xc5\xb7\x1e\xdd\x66\xf1\xba\x6e\x23\xbc\x8b\xed\xe0\xe6\x58\xe4\xf6\xd1\x5d\x3b\x82\x7f\x09\xb8\x14\xa4\x74\x5d\xfa\x8e\xbd\xda\x20\x95\x7d\xf1\x86\xc0\x93\x05\xfd\xb9\x80\x1f\x1d\x70\x27\x40\xe3\x9f\x05\x3a\xf9\xb1\x7d\x7a\xe3\xd1\xbb\x1d\xce\x14\x97\x83\x9f\xa2\x1c\x4b\xa4\x6f\xff\x45\xac\xad\x03\xb6\xc6\x1a\x32\xbc\xa5\x92\x38\xca\xdc\x4a\x9f\xf7\x2b\xa0\x86\x33\xa4\xee\x0d\xe6\xbb\x6f\xcc\x72\x17\x03\x48\x01\xef\xab\x21\xd0\x2e\x06\x2e\x6a\x30\x70\x84\xd6\x3b\xe9\x1c\x27\xfc\x5b\x61\x58\x17\x68\x6f\xeb\x57\xef\x77\xff\x42\xdd\x47\x86\xd5\xcf\xbe\x8a\x19\xaa\x4d\x2a\xb7\x61\x6e\xab\x55\xbd\x41\xbd\xde\xa1\xc5\xc0\xab\x73\x47\x7e\x5f\xf1\x71\x5d\xec\x70\x25\xc3\x1b\xd4\x5a\x50\x15\x6d\x26\x10\x9d\x9f\xbd\xa4\x3d\x33\xc1\x82\xdc\x05\x4b\xe4\x97\x30\xfa\xc1\x82\xfe\x58\xc0\x87\xad\x18\x7d\x4f\xb4\x4a\x52\xbb\x16\xf2\x6b\x28\x98\x68\x92\x03\xc5\xf3\x49\xae\x41\xac\x67\x98\x5e\x2b\x0c\x86\xbb\x69\x85\xfe\x82\xe5\xbf\x4e\x2c\xea\x03\xd4\x97\x36\x53\xbd\x35\x7e\x02\xb7\x55\xe8\xf5\x6a\x7b\x53\xc7\x3f\x05\x28\x82\x61\xe4\xf7\xc3\x67\x60\xf7\x43\x68\x65\x06\xf1\x0b\x5b\xbc\x8d\x4a\x19\x41\x9d\xe9\x13\x01\x6c\xf0\xaf\x1d\x2f\x56\x89\x73\x95\x91\x89\xe6\x1e\x27\xc3\x13\x21\xb8\xf2\x39\xa5\x0c\x92\x3b\xc9\x62\x52\x27\x8b\xa6\x2d\x48\xba\x28\x55\x9d\xae\x24\x75\xda\x96\xfc\xd7\xa5\x6d\xf2\xa3\xda\x66\x0a\x4c\xa1\x3b\x3b\xf5\x69\x1b\xca\x56\x2b\xa5\x5b\x69\x55\x73\x07\x28\xe5\x77\x50\xbc\xfb\x34\x7a\x3f\xfa\x77\x17\x2f\x62\x30\x4b\x89\x7a\x83\x4c\x1a\xce\xae\x32\x8c\x35\x06\xdf\x16\xf4\xc3\x02\x5e\x74\xd0\x25\x35\x0c\x77\x1b\x64\xff\xbb\xf3\x9a\x77\x2d\x6c\xd7\x9e\xe1\x8a\xbe\x5a\xd0\x17\x0b\x78\x5e\x5b\xd1\x5b\xa8\xbb\xa1\x12\x60\x82\x44\xe9\x96\x95\x19\xe1\xc0\x78\xfa\xd3\xfb\xf0\x23\xc2\x01\x93\xd3\xca\x85\x99\x48\x25\xb8\x34\x01\x8e\xa7\x5e\x76\xce\x46\x8d\x4f\xb5\xb9\xa0\x4b\xc8\x4d\x42\x3b\x2a\x68\x52\x13\xd3\x9d\xfd\x81\x48\xa3\xb9\x91\x31\x2a\x44\xcb\xbb\xbb\xec\x37\x2e\xda\xde\xe9\xf8\x56\xa7\x7f\xbb\xab\x71\xd5\xae\x5a\xe3\x9a\x85\x54\x6d\xd6\x46\x9a\xa5\xac\xf8\x95\x6b\x41\xa2\x22\x52\xb5\x80\xa5\xf6\xb1\xd8\xad\xbb\xa0\xb4\x1b\xe8\xda\xed\xdc\x03\xd8\x36\xdd\x7e\x17\xf7\x13\x1f\x6e\x81\xc2\x1a\xc8\x88\xd8\x61\x1d\xb8\xa0\xa1\xc7\xb2\x79\x00\x46\xf7\x07\xc9\xef\x4c\x66\xb8\x90\xc1\xfd\xcf\xd9\x48\xa4\x69\x30\x16\xa3\x5a\x1e\x9f\xd1\xd8\x30\x73\xc3\xb8\xc9\xd7\xc5\x9a\xaf\x13\xa2\xe6\xb3\x6e\x25\x95\x18\x94\x3e\xc2\xf4\x44\x30\x5f\xa4\xde\x5a\xc3\x1f\xb4\x65\x9e\xfb\xff\x01\x59\xe9\xbd\x16\x17\x63\x04\x6e\x08\x7c\x5d\xd0\xe7\x0b\x78\xb9\xa0\x5f\xef\x85\xad\xba\xe7\x0e\x34\xbd\x45\x97\xc6\xb7\xa2\xdb\x6d\xc1\xb3\x3a\x91\x64\xb3\x9d\xd5\x18\xfc\x3a\xa0\xd7\xa4\x7d\xb7\xf1\x21\x15\xfd\x51\x2c\x99\x7d\x1e\x79\xff\xd9\x08\x43\x6e\x9a\xa1\x70\xdc\x8f\xef\x0e\xdf\x09\x55\x55\xbc\x58\xca\x1e\xd8\xc4\x29\x19\x77\xef\x83\x33\x2a\xef\x0b\x47\xc5\x34\xbb\x05\x75\xd0\xe8\x46\xa1\x4e\x42\xf7\x07\xf1\xef\xc9\x20\x41\xd4\xc9\xa8\x54\xdd\x1b\xa4\x11\x36\xeb\x59\x85\x2f\x59\x13\x5f\x94\xbe\x5e\xb3\x96\xb9\xd1\xe5\x3b\xb7\x70\x0a\x66\xcc\xa6\x6e\x43\x9c\x0f\x95\xb3\x7f\xd2\xc4\xb2\x4a\xc7\xc2\x7a\xbd\xef\x5e\x02\x8c\xac\xd7\x5f\xef\xc1\x52\x08\x66\xe6\xff\x73\xc4\x56\x6a\x73\xb4\x60\x9c\xd7\x46\xaf\x07\xd8\x18\x5c\x61\x5d\x36\x1a\xa5\x0a\x00\xd6\xeb\x7b\x75\x6e\x34\x09\x9a\x78\x08\x22\xf3\x65\x41\x5f\x2e\x80\x17\x36\x4b\xf0\x5f\xee\x9c\xd2\xfb\xe8\x77\xec\x47\xab\x45\x34\x37\xcd\x71\x6a\xac\xa0\xbc\x80\xa4\xf8\x35\xc1\xae\x16\xed\x74\xf5\x29\xa0\x9e\x0a\xd3\x67\x91\x9c\xdd\xdb\xa2\xec\x30\x18\x24\xb2\xb9\xe3\x9d\x47\x99\xc7\x73\x1c\x57\x5c\xd0\x59\x06\x59\x41\xe7\x19\xe4\x05\x9d\x66\x90\x16\x74\x92\x41\x50\xd0\x65\x06\xab\x82\xee\xfd\x43\x04\x73\xc6\x9c\x3a\x7d\xaf\xbf\x4b\x30\x5f\xcb\xac\xa0\x65\x06\xe3\x82\x86\x19\xcc\x0b\x14\xfd\x4e\x0b\x7a\xc3\xd3\xd5\x78\x26\x2c\x21\x31\x14\x86\x78\xc2\xe5\x34\x0f\x18\x72\x54\xff\x1e\x63\x6c\xa7\xb9\x79\x9c\xb3\x40\x54\xdc\xc0\xa4\xa0\xd7\x19\x2c\x0b\x7a\x99\xc1\xa2\xa0\x47\x19\x14\x05\xbd\xc8\x20\x2a\xe8\xe3\x0c\xca\xa2\x3b\x54\x12\x5e\xec\x55\xc1\x66\x50\x16\x1d\x56\x30\x59\x81\x8e\x15\xcc\xcf\x0b\x24\x7b\x8f\x45\x13\x7e\x5a\x28\xfb\xa8\x02\xe6\x62\xbf\xfd\x71\xa1\x2d\xa5\x26\x85\xb6\x94\x5a\x16\xc6\xad\x62\x51\xe8\x8b\x6e\x51\x54\x30\x15\x15\x2a\x5e\x63\x41\xcb\x02\xae\x0a\xda\x21\x63\x01\xe5\x3c\xa4\x6d\xa5\x62\x69\x2b\x15\x4f\xbd\x96\x51\x54\x2c\x03\x7d\x21\x63\xa1\xcc\x35\x1f\xb9\xca\x24\xde\x32\xc9\xcc\x85\x71\x50\x58\x78\x5c\x18\x43\x01\x3b\x8f\x47\x04\x52\x4d\x37\xf5\xa6\xa7\x1b\xb8\xbe\x65\x40\x56\xd8\x8d\x2e\x93\x8c\x44\x1b\x65\x20\x85\x4f\xd6\x6b\x06\x5e\x2b\x96\x46\xbc\x5e\x7b\x99\xb1\x54\xd2\xf6\x02\x7e\x0c\xf8\xa1\x9f\x68\x7b\x25\x84\x35\x9a\x59\xea\x07\xb0\x3c\xaa\x85\xfb\x54\x2c\x94\xd7\xac\x1f\x0c\xb5\xf1\x54\xb0\x40\x9b\x84\xc0\x67\xfd\x89\x26\x94\x2d\xd3\x28\xd3\x21\xeb\x4f\xda\x5d\xda\x2d\xd5\x0d\x60\x10\x0a\xb2\x28\x47\x92\xa1\x0d\xa0\xcc\x3b\x09\x33\xb4\x49\x2b\x26\x71\xc6\xcb\xc7\x59\xa4\x9a\x12\x8f\x46\xbe\x54\xef\xa6\xde\xba\x71\xd8\xb7\x6c\x9b\x74\x9f\x09\x8d\xbc\x5a\x98\x60\x0c\xd6\xa6\xdf\xad\xd7\x73\xef\x30\xf0\x62\x2b\x90\xb0\x4b\x08\xd9\x0c\xae\x6b\x30\xbe\x45\xae\x25\xd3\xc0\x74\xf0\xbc\xf6\xb2\x0c\xbd\x84\x9e\x73\x13\x9e\xd5\x7a\x63\xa4\x2a\xd3\x44\x45\x92\x31\x80\x81\x91\x62\xfc\xea\xad\x39\x14\x95\x05\x0f\x81\x1d\xb6\x5e\xef\xa0\x39\x89\x4c\x34\xd2\x0a\xd1\xe7\x1e\xea\xf9\x38\x22\x5b\x95\x88\x9c\xee\x2c\xd0\x4e\x5d\x01\x6e\x90\xe8\x88\xf3\xc2\xbe\x26\x70\x74\x5b\x18\x9f\x7e\x96\x4e\x5c\x22\xf9\xb8\x60\xcc\x57\xc1\x9c\xb2\x7a\x38\x87\x2e\x83\x30\xab\xba\xda\x86\x61\xbb\xa8\x35\x53\x7b\x41\x7c\x75\x58\xcb\x36\xf4\x80\x3c\x23\x9d\xc3\x7d\xf0\x6e\x8f\xa1\xa3\x61\xac\xd7\xab\x3d\xd6\xed\xe8\x74\xcb\x56\x03\xea\x73\x9e\x66\x09\xbe\xa9\x4c\x2b\x64\x24\x88\xce\x71\x11\xd8\x69\xf6\xd3\x78\x34\x18\xa8\x8c\xc0\x2e\x0b\x38\x2a\xe0\xa2\x80\xc7\x05\xbd\x2e\xe0\xb4\xa0\x27\x19\x3c\x2d\xe8\xf7\x0c\xde\x15\xf4\x4d\x06\xef\x6d\x5a\x52\x9d\x7b\x26\xde\xc3\x9e\xc9\x4d\x33\x22\x7b\x11\x74\xdb\x92\xf5\x79\x7a\xb6\x5c\x6a\x0b\xe9\x0d\x46\xdb\x48\x87\xde\x91\x38\x48\x2e\x8a\xca\xf4\x52\x66\x3f\x80\xcb\xa2\xcb\x82\x01\x62\x09\xdc\xef\x0b\x4c\x5a\xb7\x73\x54\x9c\xf3\x11\xb1\x63\xfd\x5d\x88\x12\x51\x4e\xf9\xc0\xc4\x04\x8c\x29\xea\x36\x82\xec\x31\xf7\xf6\x49\x7d\x24\xbb\xdc\x32\xf4\x46\xb5\x36\xcd\x03\x4d\x73\xb5\x50\x20\xa1\x79\x80\x77\x41\xbb\x9b\x64\x37\x1e\x91\x1b\xd9\x53\xb2\x1b\xd7\xef\xe7\xa2\x78\x43\xfc\xcb\x42\x44\x89\x10\xcb\xfc\xb1\x80\x67\x05\x9c\x14\xf4\xb2\x10\x53\x7f\x56\xd0\xab\x18\xf7\x15\x99\xc4\xa7\x2a\x06\xf4\x29\x4e\x7e\xbd\x3e\x0a\x5a\x85\xf0\x71\xcb\x8a\x60\xc8\x15\x5c\x15\x46\x9f\x15\x95\x4f\x5d\x47\x4a\x3f\xe3\xbe\x9c\x52\x76\x7e\x82\x2b\x38\x02\x77\x7f\x79\x8d\x66\x70\x69\xaf\xe7\xa5\x74\x9f\xc8\xa6\x76\x50\x4a\xda\x46\x5f\xe1\xc9\x21\x90\xf6\x41\xc4\xa4\x7f\x8c\xc4\xde\x90\x39\xcb\x20\x47\xf3\xaf\xc0\x91\x2b\x09\x8e\x70\xa3\x72\x82\x2c\x0b\x4a\x27\x9d\xaa\xe2\x1c\x3d\x32\xd0\x48\x29\x41\xa7\x14\xe7\xe9\xe9\xa9\x83\x01\x31\x59\xc6\x63\x96\xbb\x44\x25\xda\x13\x4e\x64\xc6\x17\x21\xc6\x4d\xc0\x0c\x93\xf1\x08\xd4\xc8\xb3\xae\x91\x63\xd8\x70\x9a\xea\x23\x30\xd9\x10\xff\xa3\xb5\xfa\xdf\x0b\xfa\xb1\x80\xb7\x9d\xa7\x22\x4e\xb9\x63\xb5\xcc\x5a\x4a\x90\x54\x4b\x46\x95\x93\x80\xb1\x7d\xe6\x84\x77\x5a\x38\x77\x7c\x9e\x28\xff\x09\x73\x50\x63\x9d\x0d\x1c\x76\xec\xac\xa4\x73\x93\x55\x26\x5c\x4a\x28\x37\x3f\x95\x4a\x80\xb3\x25\xe5\xfd\x9c\xb3\xa5\x8a\xce\x93\xe2\x55\x8f\xe3\x9d\x47\xff\xec\x80\x80\xbe\x8c\x46\x2f\x03\xa1\x21\xa5\x53\x05\xe7\xfa\xc7\x08\xd8\x7a\xed\x15\x78\xfe\xe8\x22\x13\xc2\x9e\x20\x72\x9c\x65\xe2\x10\xe8\x08\x6e\xa6\xeb\x0f\xf5\x0f\xff\x4c\x45\x0e\x92\x8f\x9a\x5a\x0b\x66\x91\x7e\x09\x8c\x05\x72\xa2\xe4\xbc\xe2\xc5\x6e\x6d\xde\x8a\xdc\xad\x92\x04\xcd\x38\x77\xf6\xa1\x14\xa1\x85\x95\x78\x77\x70\x58\x3b\x10\x79\x3c\xbe\xf4\x3b\x50\xc4\x5e\x6c\xdd\xd6\x90\xff\xae\x3b\xd7\x99\x37\x38\x5b\x2a\x42\x89\xab\xea\x1d\xa8\xe1\xe9\xd5\xec\xf5\x6a\x8f\xf8\x7e\xe7\x80\xf8\xb8\x92\x8f\xd4\x57\x41\xc6\x8d\x3e\x43\x4c\xd9\x63\x7b\xb5\xe9\x10\xe8\xec\x2a\x21\xe8\x91\xea\xef\x1c\x6c\x20\xe7\xe9\xb2\x9a\x83\xa2\xff\x41\x98\x66\x9a\xa6\x8b\xdf\x7a\xe9\xd4\x64\xf0\xfe\x27\x49\xf9\x71\x01\xaf\x0b\xf8\x54\xc0\x9b\x02\xce\x0a\xf8\x5c\xc0\xcf\x02\x9e\x14\xf0\xa3\xa0\x87\x05\x3c\x28\xea\x69\x20\x1f\x79\x43\xdf\xdd\xcd\x83\x5a\xea\xc7\x47\x2e\x81\x0f\x75\x80\x6c\x11\xfc\x07\x05\xb8\x28\x4b\xf8\xd6\x68\xb0\xab\x3d\xef\x5c\x9c\x0b\x2e\x81\x17\x5d\x60\xae\xdb\x1e\x7a\xdf\x0a\x95\x5f\x11\x19\x42\x4e\xdd\x47\xee\x2e\xda\x90\x51\xeb\x9c\x11\x4d\xd5\x4e\x18\x3d\x38\x51\xbb\xee\x84\xb3\x21\xd2\xd8\xe5\x95\x38\x62\x9e\xe3\x5f\x41\x77\x5f\xb7\x0f\x9b\xae\x4b\xf0\xeb\xa2\x5f\x65\x63\x18\x7a\x9f\x0a\x6a\x65\x67\x70\xe1\x4d\xed\x59\x84\x9a\x3f\x2b\x68\x95\x34\x42\xb6\x70\xc5\xc2\xcb\x98\x57\x74\x53\xb6\xd3\x2c\x95\xad\x35\x4b\x8f\xac\x36\xf1\xdf\xc1\xc6\x23\xf0\xa9\xe8\xf5\xbc\xcf\x05\xfd\x54\xec\xba\x87\x0a\xaa\x5c\xf8\x29\x0b\x34\xfd\x71\xe1\x89\x2c\xf8\x88\x46\xb2\x91\x0e\x2f\xea\x12\x38\xb6\xb7\x40\x0b\x7c\xc8\x4d\xce\x38\x3a\xe7\xa4\x2b\x2b\x29\x8b\xdc\x20\x15\xf9\x00\x66\x83\x55\xf5\x25\xb9\x49\x7b\xbd\x00\x77\x49\xd2\x10\xbc\x63\x29\x4b\xf5\x5d\xd7\x17\x6b\xa1\x59\xce\x7e\x9c\x1f\x63\x66\x38\x02\x99\xe0\x91\x72\xea\xa9\x6b\xaa\xed\x0a\x20\x2c\xdb\x76\xd5\x0b\xe5\xc9\x0f\xea\x51\xec\xd0\xf9\xcf\x62\x44\x63\x21\x0d\x3c\x29\xa4\x54\xf0\x45\xa1\x53\x96\x82\x4b\xea\x95\x9f\x14\x23\xfa\xa2\xf0\x12\x85\x85\xeb\xb5\x8b\x2e\x92\x41\xd6\xac\xf7\xb9\xc0\x8c\x8d\x1a\x39\xf7\x0e\xd8\xdf\x76\xdd\xdc\x85\x59\x35\x53\x73\x42\x24\x34\x36\xbe\x67\xef\x0b\xef\x43\x21\x6d\x1d\x05\xb9\x47\xdd\x31\x46\x22\x12\x31\x9c\x93\x5e\x4f\xde\xe0\x44\xce\xdc\x03\x8c\x8b\xa0\x65\x24\xde\x2d\x02\x87\x37\x05\xcc\x50\x68\x01\x01\xdd\xd9\x87\x95\x58\xab\x6d\x72\x0e\x53\xb7\x7b\xdb\xb4\x4f\x5f\x06\x63\x98\xc3\x14\x26\xb0\xa4\x7a\x10\x50\xe0\x55\x74\x89\x27\xea\x94\xc6\xe7\x4b\xcc\x47\x96\xef\x4e\xe1\xac\xe8\xf5\x76\x9e\x17\xe7\xd9\x48\xec\xaa\xb5\x46\x27\x85\x37\x25\x23\xca\xce\xa7\x23\x78\x85\x15\xd6\x6b\x6f\x4c\xc5\x4d\x4a\x30\x00\xde\x94\xc8\xf2\x7a\xd9\x8e\xfc\x42\x34\x49\x77\x44\x05\xb8\xad\xfd\x31\x2e\xa0\xb7\x73\x56\xac\xd7\xa2\x1a\xd2\x00\x23\x56\x1d\xf7\x7a\xcd\x3e\x09\xcc\xad\x1d\x99\x2a\x5f\xc0\xf9\x70\xe1\xb9\xa7\x29\xde\x15\x90\xbd\x28\x58\x56\x22\xe3\x81\x52\x21\x67\x26\x02\x48\xb0\x89\x34\x95\xaf\x50\x37\xef\x3b\xef\xe7\x0c\xd3\x66\x65\x41\x9c\x33\x64\x5a\xe2\x3c\x5f\x31\x27\xe0\x0e\x3a\xa2\xe5\xfe\xde\x5e\x14\xf3\xd9\x2a\x44\xda\xbf\xa7\x24\x97\xdf\x73\xfd\x6b\x4f\xd4\xce\x9d\x47\x0e\x9f\x05\xc9\x65\xbe\xe3\x62\x74\xd0\x09\xf3\xe5\x34\x37\xc4\x37\xf0\x30\x87\x03\x02\x13\xba\x77\xfe\x8f\x3f\x26\xa3\xff\xf9\x60\xaf\xcf\xae\xd9\xd8\xc3\x85\x22\x98\xb6\xac\x90\xd7\x78\xa9\x61\x14\xeb\x0e\xb6\x45\xb2\xff\x3a\xf0\x2c\xc1\xcf\x98\x80\xf5\x24\x1a\x21\x90\xaf\xa6\x18\x8b\x7d\xb2\x41\x0f\x26\xb5\xe7\x43\x24\xd1\x3f\x8a\xba\x3f\x91\x06\x7a\xbf\x82\x7f\x50\xa7\xf6\xfb\x1a\xe6\xb8\x04\xf0\x8c\xf2\x5b\x28\x01\xb1\xe0\xd6\x62\xaa\xfb\x91\x8c\x5a\x42\x8b\xf3\x78\x54\xc7\x33\xa9\x62\x47\x5c\xb3\xe7\xe3\x31\xb2\x9b\xf4\xe5\x88\x31\x45\x8c\x3c\x66\xed\xc3\x2f\x55\xb8\xb0\xd9\x10\x5f\xfc\xfe\xe7\x71\x69\x9f\x6c\x20\xc1\xc4\x79\x41\xb9\x5e\x63\x04\x39\xff\xd8\x62\x0e\xbf\x16\xf0\xb2\x80\x2f\x05\xf0\x08\x58\x04\x49\x44\x8f\x0b\xc1\x14\xde\xe2\x31\x26\x7c\x48\xbe\x16\xd4\x9d\xc5\x93\x09\x4b\x5c\x60\x11\x9e\x33\x5f\x0b\x94\xf1\x98\x4a\x5f\x0a\xea\xba\x15\xc3\xc8\x23\xeb\x76\xc1\x23\x5c\xb0\x97\x05\x5e\x2e\x78\x34\x82\xaf\x05\x7d\x59\xec\xba\x2f\x54\x7b\xf5\x96\x7a\x3d\xef\x0b\xbe\xb7\xe4\xc8\x5f\x8a\xa1\xa7\x5f\xb7\xa9\xc4\x97\x62\xd7\x2d\xe2\x3c\x0e\xe3\x79\xcc\x4b\x2d\xcf\xfc\x9c\x11\xf8\x8c\xa4\xd8\xf7\xdc\x34\x99\xa6\xe3\x55\x9e\xae\xb8\x6b\x75\x74\x5b\x9b\xae\xf9\x00\x7e\x66\x04\xee\xaa\x18\x27\x2e\x3c\x41\x7e\xd1\x53\x17\x9d\x76\xbd\x65\x10\xb1\x59\x3c\x61\xb2\xc1\xad\xd5\xc2\xf9\x2a\xbb\xa3\x0a\xb6\x94\xcf\xd2\x2b\xd1\xe5\xf6\x6a\x62\x06\xa2\x0e\x01\x16\xf5\xe5\xe6\x89\x40\x30\x48\x33\xe3\x08\xb2\x08\xf2\x08\xd2\x88\xb2\x48\xb0\x0b\x59\xf4\x6b\xd7\xb4\x38\x6a\x49\x2b\x55\x18\x21\x15\x65\x79\xea\xfd\x9d\x52\x1a\x64\x91\x58\x6e\x1d\x83\xaa\x7d\xdb\xe2\x7d\x15\x78\x42\xb4\xeb\x11\x25\x23\xc9\x9d\x00\xef\x4c\x0b\xa4\x55\x8f\x9c\x55\xce\x9c\x3e\x9f\xb1\xc4\x23\x0e\x46\xcf\xc7\xd0\x4b\xe9\x54\xdc\xc6\x90\x02\x06\x8e\x0e\x0a\xe9\x8a\x90\x39\xa9\x9e\xb1\xbe\x0a\xaa\xa4\x73\x8a\xae\xe2\x85\x1d\xf2\x68\xbd\xf6\xf2\x88\xa6\x2b\xed\x33\xe3\x11\x32\x68\xdf\x25\x30\xa2\x10\xde\xd4\xf0\x02\xcc\x44\x40\x20\xe4\x7e\x19\x01\xf4\xc8\x2e\xbc\x87\x1f\x67\xcc\x71\xff\x9a\xbb\x16\xad\xad\x9c\x96\xf2\x15\x4a\x5c\x90\xd8\x2a\x75\x92\x96\xfb\xf0\xd4\xf9\xb3\x39\xf3\x3f\xfb\xce\xc7\x59\x9c\x3b\x57\xf1\x7c\xee\x88\x7b\x36\x62\x46\xe0\x4c\x57\x7c\x95\x31\x07\x83\x48\x63\xe3\xe9\xd4\x39\x51\x7a\x25\xe7\x59\x9a\x39\x8b\x34\x63\x4e\x9c\x4c\x53\x27\x67\xac\x8b\x96\xab\xda\x2f\x4f\xf7\x4e\x6a\xb4\x7c\xef\x6f\x7f\xdf\x7f\xa8\x2c\xb6\x82\x05\xce\x48\x6c\x9d\x34\xd6\x96\x22\xd8\x74\xe5\xb5\x78\x59\x1d\x26\x0a\x66\x30\xc6\xc5\xde\xa9\x68\xab\x5e\xee\xac\x5a\xeb\x58\xe9\x0d\xb9\x27\xaf\xbd\xac\x96\xa2\x29\x26\x90\xa3\x02\x2e\xa5\x59\xe4\x65\xea\x46\xbf\x42\x02\x33\x33\x6a\xb7\xc1\x0c\xe9\xc7\x98\xb2\xf3\xd9\x08\x02\x9a\xe2\x59\x3a\xb6\x6e\xc6\xc8\xa1\x05\x78\x33\x0e\x76\x68\x7c\x3e\xc6\xa3\x57\x99\xa4\x8d\x09\x64\x8d\x43\x78\x4c\x46\x34\x30\xf7\x52\x0d\x95\x43\x31\xc4\x24\xf2\x32\x88\x21\x81\x1c\x74\x24\x19\x8e\xfc\xb5\xa9\x8d\x87\x5c\x54\x91\xd3\x20\xa2\x71\x04\xab\x3a\x22\x18\x4e\x3d\x59\x2d\x42\x96\xd9\x80\xc4\xe9\x8d\x5e\x28\x9f\x6f\xfc\x0e\x50\xe3\xd4\xcd\xe7\xe9\x15\x4a\x2a\xf8\xb0\xaa\xfc\xbf\xf7\xf7\x37\xbe\x3b\x0d\x72\xde\x7c\xf3\xbf\xf0\x4d\xf5\xf8\x77\x7c\x44\x07\x07\x4e\x6f\xd0\x91\xd8\xbb\xd9\xa8\x28\x83\xb3\x88\xfe\xc8\x60\x1c\xdd\x43\xc5\x30\x8e\x5a\xe2\xd7\x77\x05\x88\x5b\xab\x3f\x8b\x40\x73\x27\xfe\xf7\x02\xf4\x2e\xfb\x6f\x0b\xb0\x61\xd9\x0f\x22\x4c\x92\x82\x9e\xf9\xef\x85\x74\xd1\x5f\x45\xf2\xe6\x36\x8f\x60\x1a\xc1\x24\xa2\xe3\x08\x96\x11\xfd\x90\x0d\xe6\x11\x6d\x4a\x77\x55\x9a\x34\x14\xe6\x32\x5a\x97\xe9\x19\x11\xfb\xce\x81\xc0\x74\x49\xb3\x7a\x3d\x45\xbb\x90\xf9\x09\xb2\xfc\x63\xfa\x84\x1d\x1f\xbd\x66\x79\x7e\xf4\x63\x15\xcc\xff\xaf\xe0\xf6\xc4\x7c\x5c\x0c\x38\xb1\x37\xce\x73\xc1\x24\x0b\xfe\xf8\x74\xc6\x18\x27\xf6\x43\x7f\x9c\xe7\x1f\x31\x1b\x94\x94\x81\x48\xcb\x19\x21\xfd\x10\x71\xb9\x30\x8c\x4c\x2e\xa3\xd7\xcb\x73\x59\x94\x7a\xdc\x8a\xe9\x45\x06\xbc\x16\xc9\x6b\x5b\x94\x4f\x61\x80\x00\xd3\xda\x12\x54\x02\x15\x1c\x71\xaf\x27\x87\xfc\x3d\x28\x02\x99\x98\xd4\xd5\xaa\x4b\x53\x67\xbd\x46\x7e\x50\xbc\x74\x78\x10\x89\xbc\x99\x52\x08\x3c\x51\x24\xc5\x10\x24\xe9\x8d\x88\xce\x84\x18\xf2\x00\x5d\x0c\x43\xe6\x64\xec\x11\x43\x3d\x27\xa6\x22\x41\xbe\xae\xcb\xb3\xd0\xea\x11\x57\x66\xfb\xae\xc8\x7d\x5e\xb4\x77\x15\xaa\x5b\x30\x7e\xac\x75\x1b\xfd\x72\xe8\xfe\xb6\x73\xf8\xee\xe9\xc7\xaf\xef\x8f\xdc\xdd\xfa\xbb\xc9\x64\xd7\xfd\xdd\xf5\x3d\x4e\xdd\xdf\x9a\xef\x18\xf0\xdd\x86\x0e\x57\x06\xd3\x7f\x99\xe9\x2b\x93\x4b\x76\x95\x34\x23\x99\x08\xca\x1c\xcc\x1f\x6f\xaf\x0b\x3a\x51\xb5\x36\xb1\x42\x76\xae\xd7\x7b\x9e\x79\xd8\x08\xb2\xea\x7c\x97\xba\x4e\x65\xb8\x01\xca\x89\xa6\x5e\xff\x6b\xa3\xbe\xb6\xa1\x23\x38\x5e\xf7\x77\x4c\x7f\xc8\xae\x79\x90\xb1\xa0\xf1\xa1\xe1\x78\x8c\x96\xa5\x65\xf9\x31\xe4\xbb\x54\xe7\xf6\xea\xac\x40\xfc\xdb\x5a\x69\x84\xb2\x70\xd5\x18\xb7\x37\x27\x39\xe3\xda\x66\xa3\x1a\x8b\xba\x4d\x50\x14\x13\x70\x05\x0a\xd5\x0a\xcd\x1e\xb5\x61\x85\x11\x02\xe1\x5c\x09\x43\xea\x3b\x2b\x02\xc6\xee\x52\xf7\xb7\xbd\xd6\xa6\x23\x3c\x10\x61\x15\x55\x44\xf4\x4b\x06\x51\x44\x79\x0e\x65\x44\x5b\x96\x55\x26\x7c\x44\x19\x75\xe8\x4c\x1f\x98\xc0\x9d\x1f\x54\xa4\xba\x6f\x55\xa4\xba\x17\xe6\x67\x15\xa3\xcc\x7f\xd5\x0c\xdc\xf9\xbc\x11\xb8\xf3\xab\x1d\xdf\xee\xe5\x12\xec\x15\xf5\xbf\x2c\xa5\x3a\xf6\xd4\x28\x61\x9f\x56\x4a\xd8\xa8\xf2\x6d\x5f\x44\x46\x0b\x1b\x59\x5a\x58\x45\x3e\xc3\x88\x96\x11\x5c\x45\x74\xef\x1f\x22\x31\x3f\x5c\xab\x9f\x7b\x70\x19\xd1\x0e\xa1\xa0\x1d\xbb\x0c\x85\xa1\x4a\xe9\xfa\x47\x22\xb4\xae\x4c\xdc\xc7\x34\xc0\x24\xbd\xde\x55\xa4\x23\x8b\xa1\xa7\x5b\x3e\x8b\xa7\xa8\xe2\x8b\xe9\xa1\x67\xa7\x08\x8b\xab\x8a\xb1\xa8\x28\xb2\x79\x88\x9c\xdf\x19\x9b\xac\x50\x24\x90\x03\x1e\x93\x04\x32\x15\x17\x78\xab\x81\x93\x16\x7a\x65\x42\xd4\xa6\x91\x11\xc7\x47\x80\x6f\xe0\x28\xa2\x71\x0e\x17\x51\xa7\x3c\x5b\xcf\x6b\x98\xd4\x67\xd6\x30\xa7\x02\x2b\x7b\xc3\x90\xed\x72\x9f\xd7\xfa\xf1\xf9\x06\x1e\x47\xf4\xe1\xd3\x74\x35\x9f\x08\x22\x89\xbb\xea\x68\x90\x13\xae\xde\x2a\x77\xa9\x60\xf4\x1e\xc2\x69\xe7\x52\x6b\xbf\xdb\x7b\x05\xc6\x68\xe5\x7d\x40\x5a\x4f\xb9\x0a\xc0\x2b\x44\x30\x54\xa7\x13\x32\xc8\x64\x07\xc1\xcd\xa0\xed\x0e\x68\xe3\x59\x1d\xeb\x4c\x0c\xde\x46\xa9\x89\xbf\x8b\xbb\xd5\x8c\xec\x58\xb3\xfb\x5f\xaf\x3d\x2f\xa1\x47\x51\x53\x7b\x88\x34\x0f\xcd\x6d\x86\xde\xe1\xa4\x69\x40\xd4\x8f\x73\x14\x1a\x55\xbe\xd2\x28\xc9\x51\x13\xc0\xe0\x75\x7e\xe1\x3d\x8e\xaa\x66\x50\xf7\x7b\xda\x85\xab\xcd\xc3\x71\x6b\x4c\x46\x85\xd1\x56\x7d\xb5\xef\xb5\x69\x9b\x0c\x87\x18\x4d\xba\xa6\xc9\xdc\x52\x5f\x85\x5e\xdc\x18\xfa\xd0\xc5\xf0\x39\xb7\xc5\x47\xac\x13\x8e\x7b\x75\x68\xaa\xdb\x3d\x9b\xc2\xfc\x17\xc6\x50\x7d\x64\x46\xa3\xa9\x94\x69\x64\x6b\x0b\xaa\xa6\x5e\x2c\x43\xec\xb6\x7c\xd9\xd8\x1a\xfb\x13\xcb\x58\xfd\xbd\x44\x27\x04\x8e\x6d\x43\x50\xa0\xa3\xd4\x01\x08\x1e\x2a\x76\x26\x7e\x53\x59\x22\x9a\x30\xde\xd6\xdb\xaa\xf0\x6e\x83\x26\x33\x53\x5d\xf1\xf6\xd0\x30\x6a\x54\xeb\x75\xbc\xac\x80\x5d\xb0\xd8\xcd\x43\xf2\x16\x0d\xb8\xa2\xe9\x5b\x46\x34\x49\xc7\xb8\x7c\xcd\xc0\xf0\x87\xea\x51\x2f\xad\xd6\x8d\x68\x9b\x8b\x26\x39\xd8\x07\xbb\xb5\xce\x70\xf6\x9d\x46\xc9\x82\x98\x0d\x2a\xa1\x24\x5f\xaf\xb9\x3e\xd0\x75\xb8\x21\xf5\x16\x8b\x05\x37\x50\xa3\x0a\xae\xbb\xcb\xa1\x03\x16\x08\x1a\x4e\x58\xdb\xd9\xfe\xd2\xbc\xea\xfe\xbc\xd7\xbb\x9d\xc4\x10\xd4\xe9\xd5\x29\xca\x2d\xac\x6c\x2d\x14\xbd\x4d\x97\xd8\x7a\x7d\x3e\x6a\xa4\x35\xa8\x2f\x6e\x67\x5c\x7a\xab\x89\xda\x7a\x36\xc0\xc2\xeb\x36\x51\x68\x76\xe0\x6d\xa3\xd3\xb5\x72\xed\x1d\xbc\xdd\x6e\x88\xab\xd0\x52\x66\x8d\x95\xf5\x10\x12\x78\x1d\xda\xdd\xef\x58\x6d\x13\xf7\x7d\xd3\xe8\xa2\xfb\x20\xe9\x8a\xcd\xb3\x95\x07\xe9\xe6\x06\xb9\x16\x3f\x34\x47\x12\x73\xb6\xc8\xcf\xab\x63\xf1\xd1\xc1\x08\x12\x74\x31\x52\x41\xeb\xbf\xcd\x86\x2a\x3f\xfc\x75\x9d\x09\x90\x4c\x89\x97\x09\x06\x69\x57\x8b\xa1\x63\x42\x86\x17\x91\xc7\x20\x13\x49\xfe\x45\xbc\x7f\xcd\x71\x99\xb1\x37\xb1\xbd\x03\xee\xb6\x61\xba\x31\x3a\xa9\x98\x37\x7b\x49\x6e\xdd\xe7\x76\x5b\x72\xd7\x3d\xde\x00\x10\x21\xc8\xeb\xb0\x02\xd2\xf7\xac\x2d\xd0\xb3\x1d\xae\xaa\xae\x8c\xbd\x4f\xbb\x92\x9d\x09\xa4\x7a\x7b\x52\x6b\x5a\xd1\x9b\xda\xe5\xb7\xf3\x03\x35\x17\x8f\x6c\x69\xd0\xee\xcc\x5e\xaf\x4e\x18\x89\x98\x3a\xa4\x80\x75\xbe\x6f\x1f\x43\xa8\xb2\x4b\x72\x96\xf1\x27\x0c\x43\xb7\xd6\x06\x8f\xec\x8c\x52\x34\x3f\x8d\xe0\x5d\x04\xef\x23\xf8\x18\xd1\xd3\x08\x9e\x45\x74\x95\xc3\x49\x44\x67\x39\x7c\x8f\x04\xba\x25\x2b\xb4\x70\x43\xb6\xc3\x25\xf0\x36\xa2\xe3\x1c\x0e\x23\x3a\xcf\xe1\x38\xa2\xd3\x1c\x5e\x47\x74\x92\xc3\xa7\x88\x2e\x73\x78\x13\xd1\x45\x0e\x67\xd1\xb6\x6c\x87\xda\x1e\x11\x93\x1d\x28\xae\x6b\x10\x0f\x33\xdb\xd4\x78\x11\x64\xfc\x4c\x6c\xbb\x97\x03\x83\x98\xf8\xf6\x6b\x91\x8a\x0e\x8f\xd8\xcf\x11\x0a\xd5\x7e\x46\xf4\xdc\x5d\xa6\x4b\x17\x5c\x14\x87\xb9\xe0\x66\x0c\x85\x88\xcc\x05\x57\xb0\xf6\xf8\x3f\xcd\xc4\x3f\xa1\xb9\x71\xc1\x5d\x25\xf2\xcd\x68\xf0\x33\xea\xf4\x97\xc7\x91\x32\xda\xa1\x87\x63\x2d\x59\x2f\x24\xf4\x31\xda\xc5\xe0\xdd\x21\xa6\xfb\x03\xf6\x7b\x3c\x88\x77\x77\x49\x72\x1e\x8f\xaa\xca\xe7\xf1\x68\xa0\x92\x3d\xa2\x5c\x51\x08\x09\x33\xba\x98\xe9\xa3\x35\x41\x11\xa1\x68\xa7\xe2\x0c\xcf\xf9\xc8\xb2\x65\x03\xd3\x16\x01\x13\x04\x40\x81\x8e\x31\xc1\xcf\x19\xe7\xca\xb8\x22\xa0\xf5\x57\x32\xa9\x89\xc9\xd0\x27\x83\xa9\xa7\x5b\x2a\x9d\x07\x23\xec\x24\x98\x4c\x94\x30\xd5\x4b\x55\xac\x81\xb3\xc8\x4b\x41\x0d\x3a\x33\x42\x43\x1d\x30\x60\xdb\x70\x0e\x20\xdf\x0c\xae\x03\xef\x73\x04\x1c\x6e\x54\x4c\xc0\x0d\x46\x40\x7b\x2a\x14\x2d\x4f\xa3\xfe\xc5\x85\x98\xf8\xc5\xc5\xd0\x7b\x57\xbf\x79\xf0\xea\x1d\xfd\x1c\x6d\xe0\xfd\xf6\xd7\x8d\x15\xdc\x10\xdf\x7b\xd7\x7d\x8d\xc1\x0d\x60\xf4\x67\xa4\x97\x43\x58\x87\x25\xf4\x67\x84\xa6\x61\xd7\x81\xc7\x21\xd1\x03\xfd\x1c\xa1\x75\xee\x38\x4d\xa6\x71\xb4\xca\x44\x74\x4c\xcc\x0c\xd8\x1a\x88\x68\xba\xbb\xe1\x09\x43\x75\x98\xc3\xcf\x45\xfb\xa3\x0d\x81\x77\x51\x7f\x95\x2c\x31\x51\x03\x7d\x1f\x09\xd0\x78\x12\xc1\x8f\x08\x1e\x44\xf0\x21\xa2\xef\xa2\xc1\x93\x88\xde\x48\x3f\x9b\x1a\x69\x55\x0b\x8e\x36\x5d\xbd\x9e\xb7\x53\xb9\xa1\xaf\xd7\x3b\xbc\xb5\xf2\x64\x03\xb8\xef\xdb\x72\xe5\x23\x6a\xff\x88\xb4\x4c\x74\x03\x3f\xb6\x48\x4e\x71\xeb\x35\x17\x21\xd6\x84\xb2\xda\xb5\x89\x5e\x78\x09\xc6\x81\xa9\xc6\xe2\x5d\x07\x1e\x03\x57\x17\xb8\x7a\x2d\x6f\x34\x84\xf9\xe7\x23\xd0\x19\x82\xc5\x83\x1a\xb1\x30\x9c\x69\xad\x35\x7c\x88\xd0\x49\xd9\xea\xc2\xa4\x17\xce\xcf\x95\x37\xf7\x48\x84\xbc\xb9\xe5\x3d\xdd\xef\xfc\x5e\x39\x76\xdf\xd5\xfa\x2e\x3d\x00\xd6\xc6\x14\xf5\x35\x52\x5b\x5c\xbf\x0a\xf6\x44\x0e\xa8\x6a\xe1\xcd\x9a\x57\x8b\xd8\x65\x25\x6a\x4e\x37\xc5\x50\x54\xd6\xbf\x62\xfd\x90\xf2\x9b\x31\xa0\x68\xc3\x8c\x03\x62\x6a\x8d\x1a\x2a\x08\x50\x79\xcc\x44\x30\x3a\x4c\x09\xab\xd5\xec\x38\x0e\xa5\x69\xcf\x5a\xfa\xaa\x07\x91\x90\x3b\x27\x5a\xd9\x9d\xa1\xb2\x3b\x51\x20\x2d\x94\xa5\xf1\xb9\x81\x0c\xb5\x40\x8f\xe8\x01\xec\xb4\x8b\x45\xed\xcc\xd2\xef\x9b\x0a\xb7\xf7\x6e\x77\xbd\x11\x21\xee\x1c\x8d\x45\xd5\x0a\x7c\x30\x58\x64\xe7\x3a\xda\x6c\xe0\x41\x44\x2d\xdb\x81\x2b\x96\x70\xe7\x2a\x4b\x93\x48\xea\x9a\xb2\x80\xcf\x44\x44\x3a\xce\x32\x96\x23\x89\x72\xae\x82\xd2\x15\x68\xf8\x2d\x82\x17\x11\xbc\x8a\xe8\x93\x08\x9e\x2b\xb9\x93\x0c\x9d\x2f\x65\x51\x5f\xeb\x48\xaf\x76\xf5\xb9\x92\x14\x71\x32\x3c\x1f\xf9\x37\x1b\x99\xa4\x45\x29\x81\xa4\xda\xd9\x18\x14\xde\x6c\x50\x38\x9a\x73\x83\x14\x08\xe0\xdf\xba\xd0\xbd\xa6\x71\x34\xcc\x25\x1a\xfc\x5d\xe0\x71\x23\x38\xc2\x76\xda\x2a\xa6\x18\x03\x9d\xb2\x6a\x07\x6d\x6d\xa2\x78\x3c\xdc\x39\xc0\xfc\xad\xf6\x27\xe8\xfe\xa6\xab\x13\xc8\xbd\x8c\xf4\x7a\x55\xa6\x00\x39\x25\xd6\x9f\x07\x39\x26\xcf\x23\xd8\x00\x8a\xba\x5c\xa9\xde\xab\x74\x3a\xd9\x7a\xdd\x61\x43\x98\x11\x42\x04\x3a\xdf\x45\x82\x5e\x58\x24\xe8\x45\x44\xb7\xcc\x1f\x4c\xb4\xb3\x44\xd2\x1b\xb1\xe3\x62\x66\xe6\x22\xa8\xe0\x82\xf2\x3a\x79\x4a\x3a\x48\x17\x9a\xaa\xd2\x44\xcf\x0d\x70\x29\xe5\x3a\xc8\xd7\x69\xf8\x1d\x61\x36\xc7\x5c\xb0\x43\xdb\x11\x0a\x83\x16\xfa\x76\x01\xae\x61\x4c\xb4\x47\x73\x9a\xc5\x51\x9c\x04\xf3\x43\x26\x85\xc7\x69\xa6\x55\x81\x11\xe3\x96\x59\x69\xf5\xde\xd3\xfd\x55\xe3\x12\x12\x45\xa1\x5e\x54\xee\x76\x39\xab\x80\xfe\xb3\xc2\x78\x22\xf4\x79\x9e\xc0\xa1\xbc\x86\x5d\xa4\xd7\xb3\xe9\x92\x92\x96\x17\xb9\x78\x04\x06\x19\xd9\xc0\x8b\x5f\x24\x55\x68\xe7\xbb\x5d\xa6\x23\x98\x74\xb4\x45\x55\x8e\x49\x5e\xad\xd4\x6c\x4f\x1a\x7e\x3f\x37\x93\x1c\x51\xde\xe0\x31\xec\x2d\x24\x60\x3f\x59\xcb\x2d\xd8\x3f\x7b\x77\xe1\xe6\x92\xb1\xe5\xd1\x75\x2c\x10\x59\xad\x8e\x3c\x35\x1a\xa3\x38\x40\xcb\xcc\x0d\xd4\x27\x62\xa4\x1e\xba\x9e\x8e\xe0\x57\x1f\xac\x2e\xbd\x6b\x4e\x5f\x23\x73\x8f\xa9\xaa\x1e\x90\xae\xba\x52\xd7\x7e\x9f\x53\xa0\x73\xa9\x11\x9b\xf9\x3f\x05\x5c\x0c\x25\x1a\x1c\xc1\x4b\x68\xad\x3d\x74\x4e\xc6\xe3\xa3\x09\x61\xd0\x24\xdb\xca\x2c\x2e\xb3\xcc\xe2\xb2\x86\x59\x5c\x42\x3b\x66\x0a\xdd\x84\xb0\x3d\x36\xd8\x82\x43\xeb\xf5\xcd\x55\xa6\x22\x93\xef\xec\x03\x4b\x56\x0b\xa6\xb9\x83\x36\x67\xd6\xb5\xd8\x34\x21\x04\x2f\x3c\x56\x72\xac\x6f\x08\x12\x22\x5b\xd4\xcb\x08\xbe\x44\xc0\x4b\xfa\x2d\x1a\x70\xcc\x36\xfe\x72\x3b\x35\xd6\x7b\x51\x6a\x2f\x68\xb1\x4d\xa4\xd7\x7b\x15\x99\x92\x7b\xb0\x5d\x5f\x2c\x9a\xf7\xa5\x4d\xf3\xda\xf4\xaa\x4e\xe8\xc4\x93\xda\x27\xca\x4b\xc1\x06\xa8\x6f\x65\x05\x61\xab\xaf\x2b\xbc\x8a\xec\x0a\x1b\xf8\xf2\x2f\xe0\x53\x5a\xbd\xd8\x5e\x2b\xad\x31\x5a\x2f\xef\xa2\x25\xb5\xcf\x44\x55\x8f\x9b\xe8\x7a\xac\xa4\x2f\x23\x48\x4a\x1a\xe5\x10\x97\x78\x75\xc8\x4a\x7a\x95\x43\x5e\xd2\xeb\x1c\xd2\x92\x5e\xe4\x10\x94\xf4\x7d\x0e\xab\x92\x7e\xcc\x61\x56\xd6\x96\x56\x0d\xdb\xca\x7b\x6e\xd8\x5a\x4d\x60\xa8\x45\xdb\x15\x0d\x62\x53\xbd\x09\xc8\xee\xd0\x7a\x7d\x4d\xa2\xa0\xe3\x4a\x6e\xb9\x9c\x8f\xb5\x78\xba\xd7\xeb\x28\x6c\x7c\xb7\x19\xcc\xca\x7b\x65\x99\xb6\x26\xd2\xcf\xd3\x29\x3f\x64\x4b\x4d\xf9\x09\xb4\x6a\x54\x49\x7e\xb1\xde\x39\x1a\x03\x64\x23\x21\x82\x68\xac\x41\xbf\xca\xa3\xdc\x6a\x05\x5c\x95\x6d\x7c\xe2\x6a\xf9\xc1\xb8\xa4\xb3\x12\xe6\x9d\x4b\x7d\xc9\x4a\xbd\xc4\x32\xed\x39\x65\xea\x87\x11\x4a\xca\xc2\xdc\x2a\x9c\x05\x99\x18\x22\x65\x7d\xcc\xa4\x8e\xb2\x4a\x55\x5b\xcd\x91\xea\x82\xfa\x94\x10\x1e\x44\xf1\x84\x2d\x85\x5b\x5f\x55\x72\x21\x7c\xfd\xd4\x75\x57\x28\x30\x4f\x56\x98\xb6\x76\x33\x98\xd7\x96\x7a\x8c\xe9\x42\xb2\xd5\x18\x6d\x34\xe7\x25\xd4\xfd\xf2\xaa\xfb\xa3\xe5\x75\x69\x56\x4d\xcf\x33\x2c\xd1\x62\x0b\x0f\x08\xe4\xd6\xed\x08\x03\x68\x8d\x31\x9e\xb3\x20\x7b\x1a\x8c\x67\xea\xbc\xbb\x64\xa5\x15\x6d\x4a\x7f\xab\xf4\x5c\x72\x51\x2c\xa5\xb9\x96\x2d\x23\x56\x30\x52\x5f\xad\x5e\xaf\xf6\xd8\x16\x6c\x58\x1a\x94\x6a\x87\x19\xd4\xb7\x94\x60\x90\x2d\x93\x75\xc1\x7c\x4b\x6e\xec\x65\x54\xe1\xf1\x3a\x4f\x2c\xb1\x34\x10\x63\xc8\x55\x1d\x2b\x3c\x52\x77\x11\x25\x01\x72\x85\x35\x99\x09\xd0\x84\xd3\xdf\x75\x1d\x0b\xc6\x1c\x8c\xa9\x9b\xb1\x31\x9f\x63\x64\xe9\xf9\x9c\x4d\x9c\x98\xe7\x6c\x3e\x55\x86\x1e\xcb\x2c\x0d\x83\x70\x5e\x8a\x7a\xe3\x80\x33\xb4\xa3\x0b\x57\x82\xbb\x97\x56\x1f\xa6\xa9\xbe\x73\xcc\x9d\x18\x33\x03\x2d\x16\x98\x1f\x42\x46\xaa\x9e\x38\x61\xe9\xfc\x29\x68\x64\x3f\x47\x1f\x8c\x7e\xbf\x4f\xfe\x74\x1e\x39\xf1\x14\x2d\x7f\xf9\xc3\x5c\x84\xa2\x1e\x07\x39\x03\x67\x3c\x4f\x13\x15\x9a\x1a\x3f\x70\x04\xf4\x48\xcb\x63\xdd\x84\x38\x0a\x89\xd5\x94\xab\xcf\xec\xa5\xa7\xb7\x56\xd2\xd2\xc6\x92\x18\x82\x2e\xe1\xb3\xca\x77\x69\x60\x14\xef\xf5\xb5\x6d\x95\x97\x30\x9d\x3a\xda\x60\xbd\xb2\xf1\xc2\xb0\x35\xfe\x79\xed\x8b\x3a\xea\x8c\xb6\x0b\xbc\xd0\xbf\x79\x50\x05\xbb\x41\x09\x9d\x6c\x75\x90\x2b\xdf\x39\x46\xf9\x79\x3e\x82\x8e\x4b\x04\x81\x9d\xc0\xcb\x20\xa9\x50\x4f\x05\x52\x36\x51\xd8\xdb\xaf\x68\x26\xf9\x0f\x1c\x33\xca\x82\x62\x72\x53\xc7\xa8\xfe\x38\x58\xa2\x55\xa0\x47\xc4\x75\xaa\x5a\x46\x63\xcc\x81\x10\x4c\xd4\x99\x9e\x93\x9b\x85\xf7\xf0\x59\x10\x23\xbc\xf0\x54\x41\x01\x53\xea\xec\x3a\xb2\x4d\x3d\x74\xd7\x09\xc6\x97\xeb\xb5\x96\x75\x8b\xfd\x51\x29\x93\x37\x6d\x2a\xcf\x84\x5d\x79\x25\x7c\x15\x7c\x18\x3b\x64\x98\xc8\x90\x25\xe3\x98\xa1\x48\x06\x58\xaf\xf7\xeb\x8b\xcf\x3b\x4b\xed\xf5\xd2\xa4\xda\x1a\x10\xae\x3a\x47\x74\x25\x9b\x1a\x66\x1e\x68\xb7\xc5\x1a\x98\x35\xe9\xde\x01\xd8\xe7\x7b\xf3\x38\xd6\x40\x58\x97\x21\x38\xb8\x36\xd6\x3d\x9b\x72\x21\x24\x50\x70\x28\x28\x55\xeb\x2a\xef\x6a\x7b\x5a\xcb\x35\x50\xa0\x4e\xba\xe2\x0e\x36\xcf\xb2\xdc\x09\x32\xe6\xa0\xdb\xef\x23\x44\xd0\xbe\xe3\x09\x1c\x17\x51\xe5\x85\xa1\xf3\x1d\xf6\xa1\x3b\x44\x3b\x12\xcb\xf6\x3c\x6e\xb4\x06\xf6\xee\x74\x51\x71\x2b\x42\x4a\x42\x6b\x1b\x05\x8c\x26\x75\xc7\x51\x9a\xa0\x64\x50\xdc\xb6\x94\xc9\xc2\xbb\xa9\x30\x0c\xf1\x32\x83\xc4\xd5\xee\xac\x12\x43\x60\x63\xb1\xd4\x36\x8d\xd5\x36\xa2\xbc\xd1\x03\x97\x3d\xa0\xe3\x89\xd5\xc3\x7a\x5d\x03\xa8\x5e\x4f\xf0\xe0\xb5\xb2\x5a\x6d\x39\x9e\x67\xb9\x57\x1f\x12\xe0\x58\x77\x3a\x0e\x4d\xcc\x3b\xca\xb3\xd1\xd0\x93\xc6\xb0\x63\x25\x6e\x8e\xd5\xa9\xa4\x19\x7d\x06\x07\xa4\xeb\xcc\x55\x9f\xd3\x5c\x5d\xe6\xce\xcc\x5b\x0f\xaf\x9e\x8d\x75\xb9\x75\x55\x14\x98\x19\x35\x8b\xde\x0c\xb4\x7b\x91\x14\x96\x40\xa6\x78\x8e\x69\x49\xe7\x25\x4c\x4a\x7a\x92\xc3\xb2\xa4\x37\xcf\x1e\x1f\xbf\x3e\x3a\xbc\x78\xfd\xee\xdd\xab\xb3\xf7\x78\xfb\x83\x45\x49\xbf\xe7\x50\x08\x26\x31\x2a\xe9\x61\x0e\x65\x49\x8f\x73\x08\x3b\xf9\x94\x79\x3a\x0e\xe6\xaf\x58\xd9\x14\x1d\x98\x64\x02\xf6\xd5\x84\x32\xf5\xc3\x70\x1b\x55\xe6\x97\x6a\x75\x4c\x91\x29\xd8\x39\xd8\x0c\xc2\x1a\xbb\x71\x57\xa2\x7b\x3d\x8c\xda\x18\x15\x4d\x61\x7c\xb5\x54\x31\x7a\x6f\xb1\x93\xd0\x9d\x0f\xad\xf1\x57\x4c\x09\xda\x10\x21\xd9\x22\xfe\x6d\x81\x4a\xec\xf6\xd4\x88\xba\x9b\x53\x2f\xab\xd6\x90\x7f\xfa\xa4\x77\xdf\x1a\xa5\xbd\xea\x7a\xc9\xcd\x84\x16\xb5\xab\x93\xe9\xbe\xd2\xd6\x2b\x4b\xe2\xd6\x70\xf4\x02\xf9\x95\xc5\x55\xad\xbc\xb6\xb5\xc2\x46\x41\x42\xa3\xdf\x79\xfd\x9a\x18\x66\xfa\x46\x7d\xe2\x73\x98\xb0\xa5\xcf\x20\xca\xd2\xd5\xd2\x4f\x36\xa4\xbe\xc1\xbd\x9e\xbd\x2a\x06\xd6\x6b\x2b\x8d\x92\x76\x63\xa7\x60\xcf\xb2\x32\x37\xb0\x06\xa9\x5a\x54\xfa\x56\xa3\xb6\x54\x6f\x5b\x2b\x57\x27\xe4\x06\xb8\xf5\xf8\x14\x29\x69\x27\xcd\xb0\x87\x9d\xb7\x60\x43\x1c\x10\xab\x65\x8b\xdb\x13\x23\x1d\x6c\x19\xb7\x57\xeb\xd9\x90\xc8\x89\xcd\xc2\x28\x85\xe4\x36\x66\x55\xe5\xf0\x16\x03\x31\x78\x28\xce\x1c\xde\x5a\xe3\x04\xc4\x5d\x01\x58\x5f\xec\x0d\x0a\xf3\x27\x6c\x89\x32\x0e\xc1\x8d\x90\xfa\x63\xd5\x00\x62\x42\x42\x88\x34\x81\xc6\xd3\x4c\x56\xac\x58\xe0\xf6\x91\xf6\x38\xb1\x13\x9d\x30\x2c\x74\xd2\xb1\xc8\x47\x3f\xf9\x97\xfb\x9f\x91\x41\x73\x40\x1e\xd9\x18\x11\x87\x5c\x84\x96\x48\x8c\x28\x5b\x8d\xb6\xe1\x8b\x39\xaf\x75\xcd\xd6\xec\xde\x04\xcb\x65\x2d\x25\xcd\x2c\x28\x98\xa3\xaa\x83\x33\x0e\x12\x34\x1e\xc4\x6c\xa2\x82\x09\xf8\x4f\x98\x70\x27\x30\xea\x01\xf0\x8e\x48\xb5\xfa\x40\x57\x97\xb2\xf5\x5a\x2b\x0a\x0c\xee\x23\x08\xe1\xac\xf2\xf3\x1a\x3d\x68\xd0\xe7\xdb\x01\x92\x63\xde\x61\x35\x2c\xeb\x78\x97\x59\x95\x8c\x2e\x1b\xaf\x78\x08\x87\x5c\xc1\xa1\xde\x2a\x9e\x05\xe3\x4b\xbc\xca\xd9\xb3\xb3\x9a\xa9\x4f\xd2\xfa\x82\x28\x23\x8b\x2d\x94\x4a\x2b\x07\xe2\x69\x1d\xe1\xa4\xeb\xa8\x4a\x4b\x83\x13\x12\xc2\x3c\xc5\x6f\x64\x8a\x9d\x8f\xcf\xb3\x11\x42\x17\x26\xdd\x26\x37\x35\x99\x9e\x0a\x81\x71\xdb\x68\x6d\x8a\xa6\x4e\xe5\xab\x92\xbe\xce\xe1\xba\x79\xc2\x2a\x1f\xc3\xba\x0c\x1f\xdd\x8d\xe8\x3e\x64\x0a\xc1\xad\x2f\x32\xf9\x85\xf0\xbf\x19\x04\x34\x86\x55\xe5\x26\x33\x49\xf1\x8a\x94\x5a\xd9\x23\x39\x04\x4a\x93\x94\x6a\x46\x35\x47\x72\xf3\xe8\x60\x10\xd0\x74\xf7\x60\x73\x35\x8b\xe7\xcc\x4b\xce\xd3\x51\xaf\xb7\xfa\xbd\xf2\x8b\x49\x65\x46\x1f\x2f\xc6\x40\x77\x90\x4a\xc1\xa6\x87\xdf\x62\xfc\x89\x74\x84\x8d\xa4\xa8\xe7\xbd\x2c\xe9\xa7\x1c\x8e\x04\x27\x71\x51\xd2\xcf\x39\x3c\x2e\xe9\x93\x1c\x4e\x4b\xfa\x23\x87\xa7\x25\x7d\x90\xc3\xbb\x92\x7e\xcb\xe1\x7d\x49\x6f\x30\x4c\x5f\x3c\x8e\xb9\x60\x42\x3e\x96\xf4\x26\x49\x9f\x06\xf9\x38\x98\x08\xe1\x24\x3c\x2b\xe9\xab\x1c\x4e\x4a\xfa\x3c\x87\xef\x25\x6d\x01\x31\x72\xa5\x98\x32\x6b\x12\x2c\xb9\x58\x1c\x54\x38\x00\xde\xc5\x14\xca\x40\xaa\xc2\x42\x20\x33\x09\x81\x5c\x3e\x01\xdc\x2a\x77\x86\xac\x46\xd5\xd5\x43\x34\x94\x66\x39\x8d\x15\xcd\x48\xa4\xfb\x20\xe5\xe6\xa7\x92\xf1\xa0\x4c\xc2\xc8\x4b\xc4\xd3\x9b\x60\x29\x12\xc3\x54\x46\x2a\x08\x49\xf4\x46\xf7\xee\x9b\x97\xee\x84\x4d\x83\xd5\x9c\xbb\xa6\x48\xb5\x83\x1f\x60\x33\xbf\xf2\x8d\x4a\x2d\xfb\x2e\x14\x79\x62\xb3\x8a\xb7\xc2\x14\xae\x71\x30\xcf\x1b\x83\x52\x8a\xaf\x46\xa9\x25\x04\x68\x7e\xa0\xae\x95\xcf\x11\x43\xbb\x38\xb7\x63\xb5\x85\xf6\xfd\xc1\x54\x93\xcb\x57\x3d\xeb\xfd\x7e\xaa\xca\xf5\x0a\x9a\x6d\x37\x6b\x8a\x3b\x49\x95\x88\x4b\xef\x99\x19\x99\xbc\x13\x88\xbb\x0e\x31\xe8\x25\xd4\x7c\x18\xad\x46\x9c\x7a\x19\x31\x75\x32\xa2\x25\xed\xba\x21\xac\xa4\x05\x45\x22\x15\x9a\x66\xe4\x3c\x82\x76\x64\xe6\xe4\xc3\xa8\xb4\xea\x02\x82\x0d\xa5\x24\xe8\xf5\xd0\xb3\xce\x09\x7a\xbd\xb9\xe7\x3e\x95\xf4\x7d\x11\x2c\xd1\x6d\x26\x70\xc6\x8d\xfb\x5b\xe9\x78\x0f\xff\x9a\x3f\xc4\x9c\x68\xb5\x75\x66\x72\xac\xa9\x68\xbd\x1e\xc8\x69\xf0\xbd\xc6\xf2\x0a\x80\xf4\x93\x12\x84\x6d\x8b\x5a\x35\x3f\x2d\x41\xed\x8a\x1f\x94\x50\x09\xc9\xfc\x55\x09\xaa\x07\x7f\x52\xa2\xa5\xab\xbf\x50\x62\xb9\xa8\x14\x9c\x62\x89\xff\xb2\x4b\xff\xaa\x84\x05\xe6\xbf\xf6\x2f\xcb\x8a\xb1\xbb\xc0\xdf\xe2\xe6\xee\x3f\x2e\x95\xe0\xf9\xb4\xc4\xb3\xd1\x7f\x5a\x82\x65\x69\xe4\xbf\x2b\x2b\x31\xf7\xb3\xd2\x26\xb9\x27\xa5\xca\x73\x54\xd2\xef\xe5\xe0\x65\xbe\x4d\x5c\xf8\x32\x87\x90\x45\x71\xd2\x16\xd3\xc6\xa8\xf6\x43\x3f\xb1\xca\x16\x01\x49\x01\xeb\x3e\xc4\x94\x30\x78\xa0\x65\x7d\xd5\xd7\x4c\x7d\x3d\xfc\xa2\x6e\x77\x3f\x56\x6c\xc5\x80\xa9\x6c\xf6\x9c\xf8\x3c\x55\x16\x45\x04\xec\x43\xb0\x3d\x00\x45\xb0\x0f\x4b\xfa\x32\x87\xe3\x92\xb2\x14\x5e\x97\x74\xef\x8f\x07\x7f\xdc\x78\xe7\xff\xf8\x63\x33\xda\x25\x7f\x6c\xf6\x22\xf8\x54\x1a\x03\x30\x33\x57\x97\xc0\x9b\x5a\xf1\x34\x8e\x5c\x02\x67\xb2\xec\xb0\xc4\xb4\x3b\x31\xc7\xfc\xde\x25\xdd\x87\x9f\x25\x3d\x77\x35\x15\xc2\x14\xb5\x5a\xe8\x9d\xbb\x56\x50\xb4\xdc\x35\xa1\x69\xc0\x15\xf1\x0a\xb1\xc4\x76\x31\xc7\x67\xe5\xa1\x80\x3f\x2b\x47\xd4\xdc\x1d\xc1\x93\x92\xe6\x29\xfc\x28\xe9\x38\x1d\x8c\xd3\x7b\x58\xd7\x0b\x69\x89\x56\xea\x69\xa1\x26\x74\x98\xbc\x6e\x89\x42\xa6\x5d\x71\xeb\xb6\x86\x56\x88\x50\x02\x76\xd3\x07\xf7\x89\x28\x56\x37\xa0\xde\x62\xae\xd9\xb6\xc1\x94\x3b\xf9\xa0\x7e\xe8\x42\x82\xa1\xa4\x24\x4c\x29\x37\x56\x19\x40\x02\xa9\xd1\x12\xff\x60\x0c\x15\x88\x74\x34\xc3\x15\x6d\x2a\x21\x60\xa6\x4d\xf5\x52\x9a\xae\xd7\x78\x5c\x7b\x13\x48\x31\x42\xa1\xf2\xb0\xa2\x99\x90\xcc\x4f\xce\x5d\x77\x44\x4d\xa9\xe0\xbc\x05\x61\xc7\x58\x96\xbd\x9e\xca\x2c\xf8\xdb\x5f\xf3\xbd\xdf\x1d\xb3\xf9\xce\x2c\x40\xa1\xad\xaa\xe9\xfc\xc9\xe6\x7f\x1a\x12\x33\x70\x62\xf9\x3e\x64\x2c\x71\x26\x71\x9e\xb1\x28\xc8\x26\x4c\x86\x22\x11\x4e\xbc\x63\xba\x1a\x8c\x07\x82\x9f\x1d\xf7\x85\xb9\xaf\xb6\x94\x4d\xc7\xe4\x66\x4e\x75\x21\x8e\x29\x88\x13\x96\x29\x7e\x66\x4c\xc7\x6a\x9a\x5a\x4c\x96\xf4\x7a\x4a\x3b\x29\x7c\x75\x13\xb2\xf5\x2a\x82\xc2\xa2\x54\x88\x81\x3a\x63\x4c\xa5\x24\xa6\x8f\xa7\x5e\x4a\x00\x13\x60\xd3\x78\x18\xab\x88\xb8\xa9\x49\x44\x8d\xa6\xe6\x29\x11\xaf\x77\xf6\xab\x2b\x47\xee\xa5\x1d\x01\xba\x58\xb6\x70\xae\x56\x5c\x04\xd2\xf2\x52\x32\xf4\x0a\xfc\xee\x46\x72\xa0\x2a\xf4\x83\x25\xde\x51\xbc\xa3\xb9\x79\x67\x74\x9e\x7a\x1c\x52\x74\x7e\xaa\x00\x03\x85\x8d\x26\x21\xe5\x70\x3a\x0c\xf0\x86\x84\xe9\x4f\x95\xe9\x8c\xef\x89\xd1\xe9\x58\xbb\x8a\x86\x60\xcf\xc4\xc7\xda\x95\x24\xc7\x3a\x77\x34\xd3\xe9\xa1\x43\x31\x3e\xab\xb1\x50\x8e\x61\x7b\x64\x64\xc9\x1f\x25\x0e\xa6\x36\x12\xab\x77\xe2\xcb\x6e\x37\x04\x22\x49\xcb\x64\xcc\xfe\x80\x5e\x05\x76\x22\x3a\x02\x4f\x4a\x2f\x80\x1b\x36\xf7\xf1\xd0\xc4\x23\x44\xc5\x24\xc5\xb3\xd5\x5f\x82\x26\x10\x7e\x0a\x42\x8f\xe8\xcf\xa4\x1a\x72\xbd\xb6\xa0\x52\x94\xc0\x22\x9d\xc4\xd3\x52\x58\x0c\xe6\x58\xcd\x7a\x04\x41\xae\xfc\x99\xe4\xc2\x36\x70\x23\x41\xc6\x9f\x81\x81\x5f\x9f\x83\x81\x2d\x7f\x0e\x7a\x35\x7c\x8c\x47\x8a\x01\x6a\x94\xf3\x47\xee\x4f\x60\x9c\xe7\xc7\x93\xdc\x5f\xa1\xc3\xed\xf1\x24\xdf\x10\x98\x22\x6b\xc9\xf5\xb2\x65\x39\x8d\x20\xd8\xc0\x87\x92\x2e\x53\xf8\xd6\xc9\x13\x1a\x89\x21\x2a\x13\x07\x6c\x80\xc7\x3f\xeb\x5f\xcc\xe3\x82\x19\xc7\x97\x0f\x2b\x96\xc5\x2c\x3f\x77\x2f\x30\x7c\x12\x7a\x06\x20\x47\x90\xa8\xb3\xc1\x18\x87\xa1\x04\x5b\xdb\xfc\x6c\xe0\x45\x49\x8b\x14\x5e\x95\x34\x4a\xe1\x79\x49\xcb\x14\xbe\x96\x34\x4c\xe1\x65\x49\xaf\x52\xf8\x52\x91\x79\x7d\x38\xa2\x4b\x71\x48\x2f\x53\x60\x21\xed\xa0\x65\xda\xc9\x9a\x6c\x06\x2c\xb4\xe9\xb0\xf2\x5a\x3a\x89\x84\x97\x8f\xff\x36\x32\x7e\x46\x87\x11\xb4\x7c\x78\xfc\xe3\xa8\xe1\x87\xf8\x3a\xaa\xfb\x21\x7e\x8a\x2c\x3f\xc4\x37\x91\x64\x09\x5e\x94\x9a\xcc\xbe\x2a\xb5\xdf\xe1\xf3\xb2\xb2\xdb\xff\x5a\x6a\xb2\xfa\xb2\xac\x4c\xd7\x79\x28\x49\x68\x12\x52\x16\x42\x6c\xcf\x4b\xcd\x4a\x4c\x81\x8d\xc1\x16\x7e\xf7\xb5\xef\x5c\x7f\xbc\x19\xc4\x9d\x73\x9d\x58\x43\xdc\x22\x4e\x43\xf7\xe6\xbb\x9c\x65\xb0\x8e\x3e\xaa\xf0\x77\xd3\x6b\xe6\x69\xba\xc0\x13\xc1\x12\xcd\x13\x02\x7f\x31\xd5\xbb\xfc\x16\x74\x07\xee\x6f\x3b\x8f\x1e\x29\x55\x9c\xf8\x72\xd7\x7d\xf4\xe8\x77\xb7\x2b\xdc\x32\xea\x9c\x15\x47\x82\x83\x50\x10\xf4\xb6\x0a\xdc\x62\xd9\xc1\x63\x05\x7d\x30\x65\x21\x8d\x43\xc8\xc3\x4e\xb0\xae\x56\x37\x55\xab\x6b\xb0\x8a\xb2\xd6\x91\x64\x79\xd0\x18\x44\xa4\x88\x08\xe6\xa9\xd5\x46\xa3\x40\xb7\xd4\x3e\xed\xd4\xcd\xc3\x2e\xa3\x49\xa3\x40\x40\x49\x4c\x3b\x5d\x15\x13\xf4\x22\x16\xde\xa1\x17\x75\x0a\x80\xa6\xdf\xd9\x7a\xed\x2d\xbc\xfb\x39\x5f\x3e\xdc\x8d\x77\x1f\xba\x0f\x2d\xaf\x1e\xed\xd2\x93\x51\xe3\xb3\xa3\xb9\x00\xe3\x0c\x63\xb9\xbc\x60\x6d\x5f\xef\x4f\x95\x61\x3c\x6b\xf8\xc2\xb4\xd6\xc4\x76\x87\xc9\xbd\xa4\x5f\xc6\x6c\x3e\x41\x63\xf0\x78\x44\x86\xb5\x47\x45\x52\xd0\xb8\xcc\xaf\xbd\xa0\x42\xbe\x32\xba\x2d\xf0\x79\x3c\xad\x37\xad\x2e\xf6\xbf\x1f\xb4\x4f\xc0\xc7\x16\xbb\x60\xd6\x6a\x8c\x61\x46\x50\x91\x2b\xe4\x52\xa8\x99\xbd\xb9\x11\x23\x70\x77\xbd\x78\xe8\x3a\xee\x6e\xec\xbb\x18\xfc\x6e\xb3\x71\x26\x6c\x3c\x0f\x64\x2c\x0a\x8c\x00\x15\x38\x3c\x5e\x30\x74\xc0\xdd\x0c\xf2\x2e\x84\xdd\x82\x7f\xff\xed\x52\x69\x5c\x2a\x2d\x02\x6c\xda\xa9\x37\xd2\x06\xab\x2e\xf7\xc8\x3b\xe9\xa2\x35\x16\x55\xb3\x3b\x1d\xe1\xb6\x95\x30\x8c\x38\xbf\xd3\x17\xd1\x7c\xa3\x9d\x75\x7e\x81\xf7\xde\x42\x27\x1b\x95\x55\xc3\x9c\x80\x4a\xa9\x65\xe0\xba\xc2\x04\x43\x54\x46\x55\x9e\xad\x5f\xbb\x30\x6c\xa7\xf2\x8d\x79\xea\x8a\xe6\xee\x90\x86\x34\x0f\x21\xe8\x38\xf8\x2c\x0c\xb2\x89\x5d\xb0\x19\x04\x35\x04\x12\xa7\xef\xb3\x40\xaf\xf4\xb3\xa0\x5a\x98\x67\x81\x75\xb1\x0e\x6e\x3f\x8b\xea\x01\x32\xac\xce\x31\x20\x82\x1a\xec\x2a\xa4\x41\x08\xb3\x90\x1e\xa5\x30\x0e\xe9\xe3\x14\xe6\x21\x3d\x4d\x61\x1a\xd2\xa7\x29\x4c\x42\xfa\x31\x85\x65\x48\x4f\x52\x58\x84\xb7\xc4\x4b\x58\x84\x1d\xb7\xc4\xab\x89\x8e\x97\x70\x3d\x91\xf1\x12\x2e\x27\x26\x5e\xc2\xd1\x04\x5a\x88\xe1\x5f\x4c\x1a\x38\xfa\x78\x52\x47\x93\x53\x9b\x09\x78\x3a\x01\x2b\xae\xb8\xff\x5e\x3c\x8a\x6a\x1f\x27\x15\x70\x3f\x9b\x48\x6e\x66\x16\x6a\x18\x18\x87\x46\xa4\x71\x9c\x4c\xd8\xf5\x09\x9b\xfa\xad\xe3\x54\x59\x9b\xa9\x7a\x6c\xa2\x6b\xe6\x03\x21\x1d\xb5\x24\xa6\x32\x9c\x81\xa0\xe1\x15\x76\xcc\x43\x6b\x6f\x42\x8d\x02\x93\xd0\x12\x85\xfc\x33\x7d\x33\xa3\x40\xb6\xfa\x87\x5a\x46\x87\xa5\xe2\xbf\x8a\x10\xa2\x10\xca\x90\x2e\x42\x08\x43\xfa\x3d\x85\xab\x90\x9e\xbb\x1a\xea\xea\xf7\xff\x5b\xc4\x08\x52\x7a\x30\x82\xeb\xd0\x70\xaf\x42\xf2\xe3\x12\xb8\xb4\x41\x02\xe9\x9f\x7e\x72\x12\x8f\xa1\x39\x00\xb9\x11\xa6\xd7\xba\xa3\x73\x3e\xc2\xab\x43\xd7\x95\x90\xe9\x1b\x27\x1f\xe3\x72\xd6\x1d\xbe\x3d\x82\x2f\xd0\x4e\x56\x25\xd8\x03\x66\xf0\xaf\xd7\x4b\xbc\xea\x49\xba\x7e\x8a\xae\x21\xb7\xca\x73\x32\xb4\xab\xc9\x1a\x3e\xd6\x10\x1f\xc8\xb7\xd5\xb7\xbe\x19\x4e\x2e\x86\xa3\xb9\x7d\xd9\x99\x7e\x6a\xf7\x5a\xbd\x23\x60\x9a\xf8\x82\xba\x39\xec\xaa\x8a\x39\x83\x37\x89\x5a\x41\x35\xe2\xce\xb8\x33\xaa\x7e\xe7\x3b\xf1\x29\x9e\xc2\xea\x56\x8d\x32\x08\x45\xa8\x92\x86\x3c\x45\x0e\x53\x9b\x50\x59\xbb\x42\x19\xc4\xb4\x96\x0c\x17\x8d\xbb\x21\xdb\xb2\x55\xb6\x71\x0f\x30\x4b\x0f\x68\xa7\xbb\x85\x78\x03\x47\x21\x7d\x9b\xc2\x45\x48\xa3\x99\x67\x3c\x09\x09\x3c\x0e\xe9\x61\x0a\xa7\xb2\x58\x3a\x0f\x12\x78\xaa\x1e\xd1\xbb\x90\xc0\x3b\xf5\x24\xa0\xdd\x25\xf0\x3e\xa4\xaf\x53\xf8\x18\xd2\x4f\x29\x3c\x0b\xbb\xee\x51\x27\x21\x3d\x4b\xe1\x7b\x48\x3f\xa7\xf0\x36\xa4\x3f\x53\x38\xac\xea\x69\xec\xc0\x40\xaf\xb2\x65\xed\xb5\x48\xe0\x75\x48\x9f\xa4\xf0\xc9\xaa\x2c\x84\x54\x28\x97\x0b\xe9\x8f\x14\xce\x42\x7a\x13\x4c\x26\xfe\xf3\x40\x07\xac\xf2\xaf\x57\x9a\xb2\x1d\xad\x04\x81\xf2\x1f\xaf\x0c\x65\x7b\xbb\xea\xa0\x6c\x87\xab\x06\x65\x3b\xd6\x05\xea\x80\xf7\x5f\xcb\x82\xf7\x82\x47\xf6\x3f\xe1\x53\xc6\xfc\x9f\x2b\xa4\x64\xfe\x93\x15\xfa\x77\xb1\x8c\xfb\x0f\x56\x4a\x30\xfb\x6d\x05\xa9\x94\xe9\xfb\xab\x99\xfe\xf9\x2e\x19\x33\x7f\x36\x83\x74\x3a\xf5\xa7\x33\x48\x13\x7f\x82\x7f\xc7\xcc\x5f\xce\x60\x99\x2e\xfd\x72\x06\x88\x43\x7e\x38\xd3\x94\xea\x74\xa6\x24\xb9\x21\x92\xc5\xdc\x20\x9e\x7f\xa9\x9e\x8d\x8f\xfa\x11\x16\x08\x6f\x50\xff\x22\x14\x52\xdf\xc7\x21\x88\x55\xf4\x4f\x43\x40\x73\x3d\xff\x69\x08\x92\x40\xf9\xef\x42\xc8\x57\x21\xaa\xdd\xb8\xff\x3e\xac\x0e\xad\x8f\x48\x18\xa3\x68\xce\xfc\x13\xfc\xf5\xe2\xe3\x9b\xd7\xfe\x77\xf1\x8b\x2f\xe6\xf8\xcb\x10\xb2\xb7\xf8\x5b\x36\x7f\x1c\x6a\xc9\xe1\x6b\xfd\xeb\x8d\xb0\x81\x78\x13\x6e\xe0\x73\x48\xb7\x99\x86\xaf\xd7\x1f\x52\x2c\x23\x43\x53\xc3\xe8\xcf\xa8\x7b\x91\xaf\x96\x2c\xc3\x08\x8f\x88\x26\xda\xe7\x44\x96\x6a\x14\xb2\x8a\xa8\x30\xc7\xdc\xe2\x44\x1a\x6b\x75\xa7\xaa\x9b\x61\x56\x39\x0c\x13\xf3\x33\xa4\xdf\x52\x78\x12\xd2\xaf\x29\xfc\x08\x69\x8b\xd6\x0b\x0d\xd5\xcd\xc6\xf4\x27\x62\x1c\x70\xe3\x3d\x40\x86\x5e\x6c\x94\x50\x2a\xf8\x4b\xdc\xb6\xa7\xe9\xe7\x3c\x03\xe5\x5e\x80\x92\x48\x2f\xa1\x3f\x26\x52\xd6\x73\x5d\x36\xee\x5d\x22\x2c\x82\xae\xfb\x63\x62\xe4\x4d\x09\x41\xa3\x41\x3f\xde\x0c\x8a\xfa\x38\xad\xa5\x70\x34\x7d\x4b\xa7\x4e\x11\x0a\x9f\x19\xe7\x89\x5a\x0d\x4e\x7c\xc4\xa0\x22\x14\x36\x63\x51\x48\x6f\x0e\x8f\x9e\x9c\x3d\xf7\x6b\xce\x06\x82\x74\xf8\x28\xaf\x17\x2f\x2f\xde\x9f\xbc\x7b\x73\x7c\x7a\x74\xba\xad\x96\xcc\x0d\xe4\x2b\xdf\xae\x27\xe1\x46\x9f\xf1\xc7\xc9\x34\xd5\xc5\x3f\xc2\x0d\xca\xaa\x72\xa6\x0b\x5e\x4d\x37\xf0\x5e\x46\x82\xd4\x45\xe9\x6a\x03\x79\x11\xe9\xc7\x2c\xd8\x28\x99\x96\x2a\x48\x82\x0d\x7c\x3a\x3a\x39\x3d\x7e\xf7\x56\x17\xb9\xfb\xfd\xff\xd3\xff\x9b\xbb\x01\x2d\x6f\xef\x1a\xe4\xcd\x66\x53\xc9\xb1\xb6\xd6\xa8\x8e\xd4\x6d\x35\x54\x88\xd9\x8e\xb7\xb3\x60\x03\xac\xb8\xad\xf5\x9a\x60\xbf\xab\xd2\x9b\x60\x53\x09\xf3\xb6\x34\x62\xa9\x00\xb6\xf4\xb3\x81\xcb\xc0\x13\x6c\x05\x81\xc2\x66\xf8\x62\xef\x2c\x84\x55\x50\x2f\xed\x5b\xda\x1c\x5a\x84\xf8\x4e\xcb\x0c\xa9\x5d\x4f\x30\x2b\x0f\x42\x5a\xf9\xbc\xa1\x40\x58\x89\x81\x0f\x91\x3f\x4e\xd2\xab\x1d\x4a\x1f\x84\xeb\xb5\x2a\x95\x0c\xb7\xd5\x15\xcf\xe2\x45\xbd\x8a\x25\x85\xae\xbf\x68\x38\x35\x6b\x36\xea\xf6\x4a\xea\x18\xbc\xbd\xd2\x22\x58\xde\xd1\x8a\x70\x68\x51\x75\xba\x02\xe8\xea\xc8\x84\xb5\xc7\x56\xa4\x56\xd1\x40\xfb\x7e\x7f\xcc\x9d\x79\x9a\x5e\xe6\xce\x3c\xbe\x64\x4e\x99\xae\x1e\x66\x68\x38\x82\xfc\x1e\x1a\xad\xa2\x02\x12\xe3\xf8\x29\x3b\xcf\xfe\xf7\x5c\xa8\x29\x13\x27\xc5\x1b\x93\x13\x66\xe9\x55\xce\xb2\xbe\xf3\x35\x5d\x3d\x9c\xcf\x9d\x84\x49\xb3\x5f\xfc\x04\xc5\x02\x18\x6b\x72\xc6\x9c\x87\x73\x16\x05\xe3\xd2\x09\x57\xf1\x7c\x92\x3f\xc4\x26\xd2\x0c\xbf\x17\x16\xc2\x98\xba\x62\x85\xb1\x57\x75\x54\x51\x7f\x6f\x6f\x92\x8e\x8d\x16\xfd\x7b\xde\x4f\xb3\x68\x0f\x0f\x93\x9c\xef\xc9\xa6\x1e\xc9\xa6\x9c\xa9\x1d\x96\x34\x5b\x88\x7b\x4a\xdf\x95\x81\x45\x3f\x84\xb4\x08\x35\x85\xfc\x10\x6e\xc8\xe0\x2f\x7b\x7b\xff\xc3\xc9\xd3\x55\x36\x66\xca\xc4\xe6\xec\xe4\x35\x55\xdd\xf4\x17\x71\xd2\xff\x9e\xf7\x17\xc1\xf2\x2f\xff\x6f\x00\x93\x40\xbf\x2b\x59\x85\x02\x00")
 
 func webJsRactiveMinJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -146,7 +146,7 @@ func webJsRactiveMinJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/js/ractive.min.js", size: 165209, mode: os.FileMode(436), modTime: time.Unix(1461095360, 0)}
+	info := bindataFileInfo{name: "web/js/ractive.min.js", size: 165209, mode: os.FileMode(436), modTime: time.Unix(1480012644, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,91 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// buildsBranch returns whether or not the given branch is built by the project.  If no branches are set, then every
+// branch is built
+func (p *Project) buildsBranch(branch string) bool {
+	p.RLock()
+	defer p.RUnlock()
+
+	if len(p.Branches) == 0 {
+		return true
+	}
+
+	for i := range p.Branches {
+		if ok, _ := path.Match(p.Branches[i], branch); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cycleBranches returns the branches to fetch and build in the current cycle.  A cycle triggered for a specific
+// branch only builds that branch.  Projects without any branches return a single blank branch, and are built the
+// same as they always have been, even when a push to a branch triggered the cycle
+func (p *Project) cycleBranches() []string {
+	if len(p.Branches) == 0 {
+		return []string{""}
+	}
+
+	for _, env := range p.triggerEnv {
+		if strings.HasPrefix(env, envBranch+"=") {
+			return []string{strings.TrimPrefix(env, envBranch+"=")}
+		}
+	}
+
+	if len(p.BranchList) == 0 {
+		// without a branch list, patterns can't be matched against anything
+		var branches []string
+		for i := range p.Branches {
+			if strings.ContainsAny(p.Branches[i], `*?[\`) {
+				vlog("Branch pattern %s in Project: %s is skipped, because there is no branchList script\n",
+					p.Branches[i], p.id())
+				continue
+			}
+			branches = append(branches, p.Branches[i])
+		}
+		return branches
+	}
+
+	var output bytes.Buffer
+	timeout := p.scriptTimeout("branchList")
+	ctx, cancel := scriptContext(p.cycleContext(), timeout)
+	err := p.scriptErr(ctx, "branchList", timeout, runScript(ctx, p.Shell, p.BranchList, p.dir(), p.env(), &output))
+	cancel()
+	if err != nil {
+		p.errHandled(fmt.Errorf("%s\n%s", err, output.Bytes()))
+		return nil
+	}
+
+	var branches []string
+	for _, branch := range strings.Split(output.String(), "\n") {
+		branch = strings.TrimSpace(branch)
+		if branch != "" && p.buildsBranch(branch) {
+			branches = append(branches, branch)
+		}
+	}
+
+	return branches
+}
+
+func (p *Project) setBranch(branch string) {
+	p.Lock()
+	defer p.Unlock()
+
+	if branch != "" {
+		vlog("Building branch %s for Project: %s\n", branch, p.id())
+	}
+
+	p.branch = branch
+}
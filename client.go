@@ -0,0 +1,581 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/timshannon/ironsmith/datastore"
+)
+
+const defaultServer = "http://localhost:8026"
+
+// client makes requests against the web API of a running ironsmith server
+type client struct {
+	server string
+	token  string
+	http   *http.Client
+}
+
+var clientCommands = map[string]func(c *client, args []string) error{
+	"projects":      clientProjects,
+	"versions":      clientVersions,
+	"log":           clientLog,
+	"tail":          clientTail,
+	"trigger":       clientTrigger,
+	"cancel":        clientCancel,
+	"download":      clientDownload,
+	"notifications": clientNotifications,
+}
+
+// usage of each of the client commands, in the order they're listed
+var clientUsages = []string{
+	"projects [-branch <branch>]",
+	"versions [-branch <branch>] <project>",
+	"log [-branch <branch>] <project> <version> [stage]",
+	"tail [-branch <branch>] <project> [version] [stage]",
+	"trigger [-secret <secret>] <project>",
+	"cancel [-secret <secret>] <project>",
+	"download [-branch <branch>] <project> [path]",
+	"notifications <project>",
+}
+
+func clientUsage(flags *flag.FlagSet) {
+	out := flags.Output()
+	fmt.Fprintln(out, "Usage: ironsmith client [-server <url>] [-token <token>] <command>")
+	fmt.Fprintln(out, "Commands:")
+	for _, usage := range clientUsages {
+		fmt.Fprintf(out, "  %s\n", usage)
+	}
+	fmt.Fprintln(out, "Flags:")
+	flags.PrintDefaults()
+}
+
+// runClient runs one of the client commands against an ironsmith server
+func runClient(args []string) int {
+	server := os.Getenv("IRONSMITH_SERVER")
+	if server == "" {
+		server = defaultServer
+	}
+
+	flags := flag.NewFlagSet("client", flag.ExitOnError)
+	token := os.Getenv("IRONSMITH_TOKEN")
+
+	flags.StringVar(&server, "server", server, "Address of the ironsmith server, defaults to $IRONSMITH_SERVER")
+	flags.StringVar(&token, "token", token, "API token for servers with authentication, defaults to $IRONSMITH_TOKEN")
+	flags.Usage = func() { clientUsage(flags) }
+	_ = flags.Parse(args)
+
+	run, ok := clientCommands[flags.Arg(0)]
+	if !ok {
+		flags.Usage()
+		return exitError
+	}
+
+	c := &client{
+		server: strings.TrimSuffix(server, "/"),
+		token:  token,
+		http:   &http.Client{},
+	}
+
+	err := run(c, flags.Args()[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		return exitError
+	}
+
+	return exitSuccess
+}
+
+// commandFlags parses the flags of a client command, and checks the number of arguments left
+func commandFlags(name string, args []string, min, max int, define func(flags *flag.FlagSet)) ([]string, error) {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	if define != nil {
+		define(flags)
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() < min || flags.NArg() > max {
+		for _, usage := range clientUsages {
+			if strings.HasPrefix(usage, name+" ") {
+				return nil, fmt.Errorf("Usage: ironsmith client %s", usage)
+			}
+		}
+	}
+
+	return flags.Args(), nil
+}
+
+// url builds the url for the route, each of the path parts are escaped the same way splitPath unescapes them
+func (c *client) url(route string, query url.Values, parts ...string) string {
+	escaped := make([]string, len(parts))
+	for i := range parts {
+		escaped[i] = url.QueryEscape(parts[i])
+	}
+
+	u := c.server + "/" + route + "/" + strings.Join(escaped, "/")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// request sends a request to the server, with the api token if there is one
+func (c *client) request(method, u string, body io.Reader, accept string) (*http.Response, error) {
+	req, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	return c.http.Do(req)
+}
+
+// jsendError returns the message of a failed JSend response
+func jsendError(res *http.Response) error {
+	response := &JSend{}
+	if json.NewDecoder(res.Body).Decode(response) == nil && response.Message != "" {
+		return fmt.Errorf("%s (%s)", response.Message, res.Status)
+	}
+	return fmt.Errorf("Request failed: %s", res.Status)
+}
+
+// do sends the request and decodes the data of the JSend response into result, result can be nil
+func (c *client) do(method, u string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	res, err := c.request(method, u, reader, "application/json")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return jsendError(res)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	response := &JSend{Data: result}
+	err = json.NewDecoder(res.Body).Decode(response)
+	if err != nil {
+		return fmt.Errorf("Invalid response from %s: %s", u, err)
+	}
+
+	if response.Status != statusSuccess {
+		return errors.New(response.Message)
+	}
+	return nil
+}
+
+func branchQuery(branch string) url.Values {
+	if branch == "" {
+		return nil
+	}
+	return url.Values{"branch": []string{branch}}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
+
+// clientProjects lists every project, its current stage, and its last release
+func clientProjects(c *client, args []string) error {
+	branch := ""
+	_, err := commandFlags("projects", args, 0, 0, func(flags *flag.FlagSet) {
+		flags.StringVar(&branch, "branch", "", "Only list what was built from the branch")
+	})
+	if err != nil {
+		return err
+	}
+
+	var list []*webProject
+	err = c.do("GET", c.url("log", branchQuery(branch)), nil, &list)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PROJECT\tSTAGE\tRELEASE\tLAST VERSION\tNEXT RUN")
+	for _, p := range list {
+		stage := p.Stage
+		if p.QueuePosition > 0 {
+			stage = fmt.Sprintf("%s #%d", stage, p.QueuePosition)
+		}
+		if p.Branch != "" {
+			stage = fmt.Sprintf("%s (%s)", stage, p.Branch)
+		}
+
+		last := ""
+		if p.LastLog != nil {
+			last = p.LastLog.Version
+		}
+
+		next := ""
+		if p.NextRun != nil {
+			next = formatTime(*p.NextRun)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", p.ID, stage, p.ReleaseVersion, last, next)
+		for _, problem := range p.Problems {
+			fmt.Fprintf(w, "\t  %s\t\t\t\n", problem)
+		}
+		for _, warning := range p.Warnings {
+			fmt.Fprintf(w, "\t  warning: %s\t\t\t\n", warning)
+		}
+	}
+
+	return w.Flush()
+}
+
+// clientVersions lists every version of the project, and the last stage each one reached
+func clientVersions(c *client, args []string) error {
+	branch := ""
+	args, err := commandFlags("versions", args, 1, 1, func(flags *flag.FlagSet) {
+		flags.StringVar(&branch, "branch", "", "Only list versions built from the branch")
+	})
+	if err != nil {
+		return err
+	}
+
+	result := struct {
+		Versions []*datastore.Log `json:"versions"`
+	}{}
+
+	err = c.do("GET", c.url("log", branchQuery(branch), args[0]), nil, &result)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tBRANCH\tSTAGE\tWHEN")
+	for _, ver := range result.Versions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", ver.Version, ver.Branch, ver.Stage, formatTime(ver.When))
+	}
+
+	return w.Flush()
+}
+
+// clientLog prints the output of every stage of a version, or of a single stage
+func clientLog(c *client, args []string) error {
+	branch := ""
+	args, err := commandFlags("log", args, 2, 3, func(flags *flag.FlagSet) {
+		flags.StringVar(&branch, "branch", "", "The output of the version built from the branch")
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 3 {
+		entry := &datastore.Log{}
+		err = c.do("GET", c.url("log", branchQuery(branch), args...), nil, entry)
+		if err != nil {
+			return err
+		}
+		fmt.Print(entry.Log)
+		return nil
+	}
+
+	var logs []*datastore.Log
+	err = c.do("GET", c.url("log", branchQuery(branch), args...), nil, &logs)
+	if err != nil {
+		return err
+	}
+
+	// oldest stage first, like a terminal
+	sort.SliceStable(logs, func(i, j int) bool { return logs[i].When.Before(logs[j].When) })
+
+	for _, entry := range logs {
+		fmt.Printf("==> %s %s\n", entry.Stage, formatTime(entry.When))
+		fmt.Print(entry.Log)
+		if entry.Log != "" && !strings.HasSuffix(entry.Log, "\n") {
+			fmt.Println()
+		}
+	}
+	return nil
+}
+
+// clientTail streams the output of a stage until it completes, if the version and stage aren't given, the project's
+// most recent stage is followed
+func clientTail(c *client, args []string) error {
+	branch := ""
+	args, err := commandFlags("tail", args, 1, 3, func(flags *flag.FlagSet) {
+		flags.StringVar(&branch, "branch", "", "Follow the most recent stage built from the branch")
+	})
+	if err != nil {
+		return err
+	}
+
+	project, version, stage := args[0], "", ""
+	if len(args) > 1 {
+		version = args[1]
+	}
+	if len(args) > 2 {
+		stage = args[2]
+	}
+
+	if version == "" || stage == "" {
+		prj := &webProject{}
+		err = c.do("GET", c.url("log", branchQuery(branch), project), nil, prj)
+		if err != nil {
+			return err
+		}
+		if prj.LastLog == nil {
+			return fmt.Errorf("Project %s hasn't logged anything yet", project)
+		}
+
+		if version == "" {
+			version = prj.LastLog.Version
+		}
+		if stage == "" {
+			stage = prj.LastLog.Stage
+		}
+		fmt.Fprintf(os.Stderr, "==> %s %s\n", version, stage)
+	}
+
+	query := branchQuery(branch)
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("follow", "")
+
+	res, err := c.request("GET", c.url("log", query, project, version, stage), nil, "text/event-stream")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return jsendError(res)
+	}
+
+	return readEvents(res.Body, os.Stdout)
+}
+
+// readEvents writes the data of the server sent events sent by logFollow to w, until the done event is sent
+func readEvents(r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxJSONSize)
+
+	event := ""
+	var data []string
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = append(data, strings.TrimPrefix(line, "data: "))
+		case line == "":
+			switch event {
+			case "done":
+				return nil
+			case "error":
+				return errors.New(strings.Join(data, "\n"))
+			default:
+				if _, err := io.WriteString(w, strings.Join(data, "\n")); err != nil {
+					return err
+				}
+			}
+			event = ""
+			data = nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return errors.New("The server closed the stream before the stage completed")
+}
+
+// secretCommand runs a command that's authorized with the project's trigger secret
+func secretCommand(c *client, name string, args []string) error {
+	secret := os.Getenv("IRONSMITH_SECRET")
+	args, err := commandFlags(name, args, 1, 1, func(flags *flag.FlagSet) {
+		flags.StringVar(&secret, "secret", secret, "The project's trigger secret, defaults to $IRONSMITH_SECRET")
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.do("POST", c.url(name, nil, args[0]), &triggerInput{Secret: secret}, nil)
+}
+
+// clientTrigger starts a new cycle of the project
+func clientTrigger(c *client, args []string) error {
+	err := secretCommand(c, "trigger", args)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Cycle triggered")
+	return nil
+}
+
+// clientCancel cancels the project's running cycle
+func clientCancel(c *client, args []string) error {
+	err := secretCommand(c, "cancel", args)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Cycle cancelled")
+	return nil
+}
+
+// clientDownload downloads the files of the project's last release.  If path is an existing directory, or the
+// release has more than one file, the files are written to the directory by name, otherwise the single release file
+// is written to path
+func clientDownload(c *client, args []string) error {
+	branch := ""
+	args, err := commandFlags("download", args, 1, 2, func(flags *flag.FlagSet) {
+		flags.StringVar(&branch, "branch", "", "Download the last release built from the branch")
+	})
+	if err != nil {
+		return err
+	}
+
+	project, path := args[0], "."
+	if len(args) > 1 {
+		path = args[1]
+	}
+
+	release := &datastore.Release{}
+	err = c.do("GET", c.url("release", branchQuery(branch), project), nil, release)
+	if err != nil {
+		return err
+	}
+
+	dir := ""
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		dir = path
+	} else if len(release.Files) > 1 {
+		err = os.MkdirAll(path, 0755)
+		if err != nil {
+			return err
+		}
+		dir = path
+	}
+
+	for _, file := range release.Files {
+		dest := path
+		if dir != "" {
+			dest = filepath.Join(dir, filepath.Base(file.FileName))
+		}
+
+		// the file is downloaded from the branch the release was built from, in case another branch has built
+		// the same version
+		err = c.downloadFile(c.url("release", branchQuery(release.Branch), project, release.Version, file.FileName),
+			dest, file.SHA256)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Downloaded %s version %s to %s\n", file.FileName, release.Version, dest)
+	}
+
+	return nil
+}
+
+// downloadFile writes the file at the url to dest, and checks its checksum if there is one
+func (c *client) downloadFile(u, dest, checksum string) error {
+	res, err := c.request("GET", u, nil, "")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return jsendError(res)
+	}
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(f, hash), res.Body)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	if checksum != "" && hex.EncodeToString(hash.Sum(nil)) != checksum {
+		return fmt.Errorf("The checksum of %s doesn't match the release, the download may be corrupt", dest)
+	}
+
+	return nil
+}
+
+// clientNotifications lists the deliveries of the project's notifications
+func clientNotifications(c *client, args []string) error {
+	args, err := commandFlags("notifications", args, 1, 1, nil)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []*datastore.Delivery
+	err = c.do("GET", c.url("notify", nil, args[0]), nil, &deliveries)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "WHEN\tEVENT\tVERSION\tURL\tATTEMPTS\tRESULT")
+	for _, d := range deliveries {
+		result := "delivered"
+		if !d.Delivered {
+			result = d.Error
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", formatTime(d.When), d.Event, d.Version, d.URL, d.Attempts, result)
+	}
+
+	return w.Flush()
+}
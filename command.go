@@ -0,0 +1,284 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/timshannon/ironsmith/datastore"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// exit codes of the validate and run commands, run exits with the code of the stage that failed
+const (
+	exitSuccess   = 0
+	exitError     = 1 // the command couldn't be run
+	exitInvalid   = 2 // the project file is invalid or couldn't be loaded
+	exitFetch     = 3
+	exitBuild     = 4
+	exitTest      = 5
+	exitRelease   = 6
+	exitStage     = 7 // one of the project's own named stages
+	exitCancelled = 130
+)
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, `Usage:
+  ironsmith [-v]
+	Run the ironsmith server
+  ironsmith validate <project.json>
+	Check a project file for problems
+  ironsmith run [-data <dir>] <project.json>
+	Run a single cycle of a project in the foreground
+  ironsmith client [-server <url>] <command>
+	Query and trigger the projects of a running server, run "ironsmith client" for the list of commands
+  ironsmith hash-password
+	Hash a password read from stdin, for the users setting
+  ironsmith secrets <key | list | set <name> | remove <name>>
+	Manage the encrypted secrets that project scripts can use
+
+Server flags:
+`)
+	flag.PrintDefaults()
+}
+
+// validateCommand checks the project file and prints any problems with it
+func validateCommand(args []string) int {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: ironsmith validate <project.json>")
+		return exitError
+	}
+
+	data, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading project file: %s\n", err)
+		return exitError
+	}
+
+	_, problems, warnings := validateProject(data)
+	printWarnings(args[0], warnings)
+	if len(problems) > 0 {
+		printProblems(args[0], problems)
+		return exitInvalid
+	}
+
+	fmt.Printf("%s is valid\n", args[0])
+	return exitSuccess
+}
+
+func printProblems(filename string, problems []string) {
+	fmt.Fprintf(os.Stderr, "%s is invalid:\n", filename)
+	for i := range problems {
+		fmt.Fprintf(os.Stderr, "\t%s\n", problems[i])
+	}
+}
+
+func printWarnings(filename string, warnings []string) {
+	for i := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s: %s\n", filename, warnings[i])
+	}
+}
+
+// runCommand runs a single forced cycle of the project file, outside of the server, with the output of every script
+// written to the terminal.  The exit code is set by the stage that failed
+func runCommand(args []string) int {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	dataFlag := flags.String("data", "", "Directory to keep the run's logs and release files in, "+
+		"if not set a temporary directory is used and removed afterwards")
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: ironsmith run [-data <dir>] <project.json>")
+		flags.PrintDefaults()
+		return exitError
+	}
+	filename := flags.Arg(0)
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading project file: %s\n", err)
+		return exitError
+	}
+
+	prj, problems, warnings := validateProject(data)
+	printWarnings(filename, warnings)
+	if len(problems) > 0 {
+		printProblems(filename, problems)
+		return exitInvalid
+	}
+
+	if len(prj.Secrets) > 0 {
+		// secrets are read from the server's secrets store
+		loadSettings()
+	}
+
+	verbose = true
+
+	if *dataFlag != "" {
+		dataDir = *dataFlag
+	} else {
+		dataDir, err = ioutil.TempDir("", "ironsmith")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating temporary data directory: %s\n", err)
+			return exitError
+		}
+		defer func() {
+			_ = os.RemoveAll(dataDir)
+		}()
+	}
+
+	p := &Project{
+		filename: filepath.Base(filename),
+		stage:    stageLoad,
+	}
+	output := &maskWriter{w: os.Stdout, project: p}
+	p.output = output
+
+	err = p.open()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening project datastore: %s\n", err)
+		return exitError
+	}
+	defer func() {
+		_ = p.close()
+	}()
+
+	p.setData(prj)
+	p.Notify = nil // notifications are only sent by the server
+
+	start := time.Now()
+	p.startCycle(nil)
+	onInterrupt = func() {
+		p.cancelCycle()
+	}
+
+	p.setVersion("Version not yet set")
+	if !p.errHandled(p.loadSecrets()) {
+		p.buildBranches(true)
+	}
+	// flushed before the cycle ends, while the secrets are still masked
+	_ = output.Flush()
+	p.endCycle()
+
+	return p.runResult(start)
+}
+
+// runResult returns the exit code for the cycle that started at the given time, and prints the stage that failed
+// or the release files
+func (p *Project) runResult(start time.Time) int {
+	versions, err := p.ds.Versions("")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading the results: %s\n", err)
+		return exitError
+	}
+
+	code := exitSuccess
+	built := false
+
+	for _, ver := range versions {
+		if ver.When.Before(start) {
+			continue
+		}
+		built = true
+
+		if ver.Stage == stageReleased {
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "\n%s failed in the %s stage\n", versionName(ver), ver.Stage)
+		if c := stageExitCode(ver.Stage); code == exitSuccess || c < code {
+			code = c
+		}
+	}
+
+	if !built {
+		fmt.Fprintln(os.Stderr, "\nNothing was built")
+		return exitError
+	}
+
+	if code != exitSuccess {
+		return code
+	}
+
+	releases, err := p.ds.Releases("")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading the releases: %s\n", err)
+		return exitError
+	}
+
+	for _, release := range releases {
+		if release.When.Before(start) {
+			continue
+		}
+		for _, file := range release.Files {
+			path, _ := p.ds.FilePath(file)
+			fmt.Printf("Released %s %s %s\n", file.FileName, file.SHA256, path)
+		}
+	}
+
+	return exitSuccess
+}
+
+func versionName(ver *datastore.Log) string {
+	if ver.Branch != "" {
+		return fmt.Sprintf("Branch %s Version %s", ver.Branch, ver.Version)
+	}
+	return fmt.Sprintf("Version %s", ver.Version)
+}
+
+// stageExitCode returns the exit code for the stage, variant and parallel step prefixes are ignored
+func stageExitCode(stage string) int {
+	for _, name := range strings.Split(stage, ":") {
+		switch name {
+		case stageCancel:
+			return exitCancelled
+		case stageLoad, stageInvalid:
+			return exitInvalid
+		case stageFetch:
+			return exitFetch
+		case stageBuild:
+			return exitBuild
+		case stageTest:
+			return exitTest
+		case stageRelease:
+			return exitRelease
+		}
+	}
+
+	return exitStage
+}
+
+// hashPasswordCommand reads a password from stdin, and prints its bcrypt hash for use in the users setting
+func hashPasswordCommand() int {
+	fmt.Fprint(os.Stderr, "Password: ")
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && password == "" {
+		fmt.Fprintf(os.Stderr, "Error reading password: %s\n", err)
+		return exitError
+	}
+
+	password = strings.TrimRight(password, "\r\n")
+	if password == "" {
+		fmt.Fprintln(os.Stderr, "The password can't be blank")
+		return exitError
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error hashing password: %s\n", err)
+		return exitError
+	}
+
+	fmt.Println(string(hash))
+	return exitSuccess
+}
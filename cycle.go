@@ -5,14 +5,17 @@
 package main
 
 import (
-	"encoding/json"
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/timshannon/ironsmith/datastore"
@@ -23,14 +26,22 @@ Project life cycle:
 (Load Project file) -> (Fetch) -> (Build) -> (Test) -> (Release) - > (Sleep for polling period) ->
 	(Reload Project File) -> (Fetch) -> etc...
 
+If the project defines its own stages, then those stages are run in place of build, test, and release
+
+If the project has a schedule, the sleep lasts until the next scheduled time, or the polling period, whichever
+is sooner
+
 */
 
 // load is the beginning of the cycle.  Loads / reloads the project file to make sure that the scripts are up-to-date
 // call's fetch and triggers the next poll if one exists
-func (p *Project) load(forceBuild bool) {
+func (p *Project) load(forceBuild bool, env ...string) {
 	p.processing.Lock() // ensure only one cycle is running at a time per project
 	defer p.processing.Unlock()
 
+	p.startCycle(env)
+	defer p.endCycle()
+
 	p.setStage(stageLoad)
 	p.setVersion("Version not yet set")
 	p.start = time.Time{}
@@ -44,39 +55,86 @@ func (p *Project) load(forceBuild bool) {
 		// project has been deleted
 		// don't continue polling
 		// move project data to deleted folder with a timestamp
+		p.stopTimer()
 		if p.errHandled(p.close()) {
 			return
 		}
-		p.errHandled(os.Rename(p.dir(), filepath.Join(dataDir, deletedProjectDir,
-			strconv.FormatInt(time.Now().Unix(), 10), p.id())))
+		deletedDir := filepath.Join(dataDir, deletedProjectDir, strconv.FormatInt(time.Now().Unix(), 10))
+		if p.errHandled(os.MkdirAll(deletedDir, 0777)) {
+			return
+		}
+		p.errHandled(os.Rename(p.dir(), filepath.Join(deletedDir, p.id())))
 		return
 	}
 
-	data, err := ioutil.ReadFile(filepath.Join(projectDir, enabledProjectDir, p.filename))
-	if p.errHandled(err) {
+	// the file is loaded before queuing, so the cycle is queued with the project's current priority
+	if !p.loadFile() {
+		// keep to the last valid poll interval or schedule, so the project starts running again once its file is
+		// fixed, even if the change to the file is missed
+		p.scheduleNext()
 		return
 	}
 
-	new := &Project{}
-	if p.errHandled(json.Unmarshal(data, new)) {
+	// start polling or wait for the next scheduled run, even if the cycle is cancelled before it completes
+	defer p.scheduleNext()
+
+	p.setStage(stageQueued)
+	if p.errHandled(buildQueue.wait(p.cycleContext(), p)) {
 		return
 	}
+	defer buildQueue.done()
+	p.setStage(stageLoad)
 
-	p.setData(new)
-
-	p.fetch(forceBuild)
-
+	if !p.errHandled(p.loadSecrets()) {
+		p.buildBranches(forceBuild)
+	}
+	p.sendFailure()
 	p.setStage(stageWait)
 
 	//full cycle completed
 	p.errHandled(p.ds.TrimVersions(p.MaxVersions))
+}
 
-	if p.poll > 0 {
-		//start polling
-		time.AfterFunc(p.poll, func() {
-			p.load(false)
-		})
+// buildBranches fetches, and if there is a new version, builds each of the branches in the cycle
+func (p *Project) buildBranches(forceBuild bool) {
+	for _, branch := range p.cycleBranches() {
+		if p.cycleContext().Err() != nil {
+			break
+		}
+		p.setBranch(branch)
+		p.setVersion("Version not yet set")
+		p.fetch(forceBuild)
+		p.sendFailure()
+	}
+
+	p.setBranch("")
+}
+
+// loadFile reads the project file, and if it's valid applies its definition to the project.  Returns false if the
+// file couldn't be loaded or is invalid, in which case the project is left in the invalid stage until the file
+// is fixed
+func (p *Project) loadFile() bool {
+	data, err := ioutil.ReadFile(filepath.Join(projectDir, enabledProjectDir, p.filename))
+	if p.errHandled(err) {
+		return false
 	}
+
+	new, problems, warnings := validateProject(data)
+	p.setProblems(problems, warnings)
+	for i := range warnings {
+		vlog("Warning for Project %s: %s\n", p.id(), warnings[i])
+	}
+	if len(problems) > 0 {
+		log.Printf("Project %s is invalid and won't be run until its project file is fixed:\n\t%s\n", p.id(),
+			strings.Join(problems, "\n\t"))
+		p.setStage(stageInvalid)
+		return false
+	}
+
+	p.setData(new)
+	p.checkTriggers()
+
+	return true
 }
 
 // fetch first runs the fetch script into a temporary directory
@@ -85,8 +143,9 @@ func (p *Project) load(forceBuild bool) {
 func (p *Project) fetch(forceBuild bool) {
 	p.setStage(stageFetch)
 	p.start = time.Now()
+	p.releaseFiles = nil
 
-	if p.Fetch == "" {
+	if len(p.Fetch) == 0 {
 		return
 	}
 
@@ -97,23 +156,31 @@ func (p *Project) fetch(forceBuild bool) {
 	}
 
 	//fetch project
-	fetchResult, err := runCmd(p.Fetch, tempDir, p.Environment)
-	if p.errHandled(err) {
+	// fetch output can't be streamed, because the version isn't known until after the fetch
+	var fetchResult bytes.Buffer
+	timeout := p.scriptTimeout("fetch")
+	ctx, cancel := scriptContext(p.cycleContext(), timeout)
+	err := p.scriptErr(ctx, "fetch", timeout, runScript(ctx, p.Shell, p.Fetch, tempDir, p.env(),
+		p.teeOutput(&fetchResult)))
+	cancel()
+	if err != nil {
+		p.errHandled(fmt.Errorf("%s\n%s", err, fetchResult.Bytes()))
+		p.errHandled(os.RemoveAll(tempDir))
 		return
 	}
 
 	// fetched succesfully, determine version
-	version, err := runCmd(p.Version, tempDir, p.Environment)
-
+	version, err := p.scriptVersion(tempDir)
 	if p.errHandled(err) {
+		p.errHandled(os.RemoveAll(tempDir))
 		return
 	}
 
-	p.setVersion(strings.TrimSpace(string(version)))
+	p.setVersion(version)
 
 	if !forceBuild {
 		// if not forced build, then check if this specific version has attempted a build yet
-		lVer, err := p.ds.LastVersion(stageBuild)
+		lVer, err := p.ds.LastVersion(p.branch, p.firstStage())
 		if err != datastore.ErrNotFound && p.errHandled(err) {
 			return
 		}
@@ -140,99 +207,293 @@ func (p *Project) fetch(forceBuild bool) {
 	}
 
 	//log fetch results
-	if p.errHandled(p.ds.AddLog(p.version, p.stage, string(fetchResult))) {
+	if p.errHandled(p.ds.AddLog(p.branch, p.version, p.stage, p.maskLog(fetchResult.String()))) {
+		return
+	}
+
+	// continue to the rest of the pipeline
+	if !p.runVariants() {
+		p.discardRelease()
 		return
 	}
 
-	// continue to build
-	p.build()
+	p.released()
 }
 
-// build  runs the build scripts to build the project which should result in the a single file
-// configured in the ReleaseFile section of the project file
-func (p *Project) build() {
-	p.setStage(stageBuild)
+// scriptVersion runs the version script in the passed in dir.  If the version script has multiple lines, then
+// the output of the last line is used as the version
+func (p *Project) scriptVersion(dir string) (string, error) {
+	// blank lines are skipped, the same as in every other script, so the version is the output of the last line
+	// that isn't blank
+	last := len(p.Version) - 1
+	for last >= 0 && strings.TrimSpace(p.Version[last]) == "" {
+		last--
+	}
 
-	if p.Build == "" {
-		return
+	if last < 0 {
+		return "", errors.New("No version script defined")
 	}
 
-	output, err := runCmd(p.Build, p.workingDir(), p.Environment)
+	var output bytes.Buffer
 
-	if p.errHandled(err) {
-		return
+	timeout := p.scriptTimeout("version")
+	ctx, cancel := scriptContext(p.cycleContext(), timeout)
+	defer cancel()
+
+	err := p.scriptErr(ctx, "version", timeout, runScript(ctx, p.Shell, p.Version[:last], dir, p.env(), &output))
+	if err != nil {
+		return "", fmt.Errorf("%s\n%s", err, output.Bytes())
 	}
 
-	if p.errHandled(p.ds.AddLog(p.version, p.stage, string(output))) {
-		return
+	output.Reset()
+	err = p.scriptErr(ctx, "version", timeout, runCmd(ctx, p.Shell, p.Version[last], dir, p.env(), &output))
+	if err != nil {
+		return "", fmt.Errorf("%s\n%s", err, output.Bytes())
 	}
 
-	// built successfully, onto test stage
-	p.test()
+	return strings.TrimSpace(output.String()), nil
 }
 
-// test runs the test scripts
-func (p *Project) test() {
-	p.setStage(stageTest)
+// runStage runs either the stage's script or its parallel steps
+func (p *Project) runStage(stage *Stage) error {
+	if len(stage.Parallel) > 0 {
+		if len(stage.Script) > 0 {
+			return fmt.Errorf("Stage %s can't have both a script and parallel steps", stage.Name)
+		}
+		return p.runParallel(stage)
+	}
 
-	if p.Test == "" {
-		return
+	return p.runStep(p.cycleContext(), p.stageName(stage.Name), stage, p.env())
+}
+
+// runParallel runs all of the stage's parallel steps at the same time in the working dir.  Each step is logged
+// separately, and the stage fails if any of the steps fail.  If the stage is set to fail fast, the remaining steps
+// are cancelled as soon as one fails
+func (p *Project) runParallel(stage *Stage) error {
+	ctx, cancel := context.WithCancel(p.cycleContext())
+	defer cancel()
+
+	name := p.stageName(stage.Name)
+	env := mergeEnv(p.env(), stage.Environment)
+	errs := make([]error, len(stage.Parallel))
+	cancelled := make([]bool, len(stage.Parallel))
+
+	var wg sync.WaitGroup
+
+	for i := range stage.Parallel {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			step := name + ":" + stage.Parallel[i].Name
+			p.setStepRunning(step, true)
+			defer p.setStepRunning(step, false)
+
+			errs[i] = p.runStep(ctx, step, stage.Parallel[i], env)
+			if errs[i] == nil {
+				return
+			}
+
+			cancelled[i] = ctx.Err() != nil
+			if stage.FailFast {
+				cancel()
+			}
+		}(i)
 	}
-	output, err := runCmd(p.Test, p.workingDir(), p.Environment)
 
-	if p.errHandled(err) {
-		return
+	wg.Wait()
+
+	var failed, stopped, passed []string
+	for i := range errs {
+		switch {
+		case cancelled[i]:
+			stopped = append(stopped, stage.Parallel[i].Name)
+		case errs[i] != nil:
+			failed = append(failed, stage.Parallel[i].Name)
+		default:
+			passed = append(passed, stage.Parallel[i].Name)
+		}
 	}
 
-	if p.errHandled(p.ds.AddLog(p.version, p.stage, string(output))) {
-		return
+	if len(failed) > 0 || len(stopped) > 0 {
+		msg := fmt.Sprintf("Parallel steps failed: %s", strings.Join(failed, ", "))
+		if len(stopped) > 0 {
+			msg += fmt.Sprintf("\nParallel steps cancelled: %s", strings.Join(stopped, ", "))
+		}
+		return errors.New(msg)
 	}
 
-	//  Tests passed, onto release
-	p.release()
+	return p.ds.AddLog(p.branch, p.version, name, fmt.Sprintf("Parallel steps completed: %s", strings.Join(passed, ", ")))
 }
 
-// release runs the release scripts and builds the release file
-func (p *Project) release() {
-	p.setStage(stageRelease)
+// runStep runs the step's script in the working dir, capturing the output into a log entry with the passed in name
+// as it runs
+func (p *Project) runStep(parent context.Context, name string, step *Stage, env []string) error {
+	output, err := newStageWriter(p.ds, p.mask, p.branch, p.version, name)
+	if err != nil {
+		return err
+	}
 
-	if p.Release == "" {
-		return
+	ctx, cancel := scriptContext(parent, step.timeout)
+	defer cancel()
+
+	err = runScript(ctx, p.Shell, step.Script, p.workingDir(), mergeEnv(env, step.Environment),
+		p.teeOutput(output))
+	err = p.scriptErr(ctx, name, step.timeout, err)
+	if err != nil {
+		return output.fail(err)
 	}
 
-	output, err := runCmd(p.Release, p.workingDir(), p.Environment)
+	return output.Close()
+}
 
-	if p.errHandled(err) {
-		return
+// scriptContext returns the context for running a script, which is cancelled when the timeout has elapsed
+func scriptContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(parent)
 	}
 
-	if p.errHandled(p.ds.AddLog(p.version, p.stage, string(output))) {
-		return
+	return context.WithTimeout(parent, timeout)
+}
+
+// scriptErr replaces the error from a script that ran past its timeout or was cancelled with one that says so
+func (p *Project) scriptErr(ctx context.Context, name string, timeout time.Duration, err error) error {
+	if err == nil {
+		return nil
 	}
 
-	//get release file
-	f, err := os.Open(filepath.Join(p.workingDir(), p.ReleaseFile))
-	if p.errHandled(err) {
-		return
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return fmt.Errorf("The %s script timed out after %s", name, timeout)
+	case context.Canceled:
+		return fmt.Errorf("The %s script was cancelled", name)
 	}
+	return err
+}
 
-	buff, err := ioutil.ReadAll(f)
-	if p.errHandled(err) {
-		return
+// runPipeline runs each stage in the project's pipeline in order, stopping at the first stage that fails.
+// If every stage succeeds, then the release file is stored.  Returns true if every stage succeeded
+func (p *Project) runPipeline() bool {
+	stages, release := p.pipeline()
+
+	for i := range stages {
+		p.setStage(p.stageName(stages[i].Name))
+
+		if p.errHandled(p.runStage(stages[i])) {
+			return false
+		}
 	}
 
-	if p.errHandled(p.ds.AddRelease(p.version, filepath.Base(p.ReleaseFile), buff)) {
-		return
+	if !release {
+		return false
+	}
+
+	return p.release()
+}
+
+// release stores the files matching the project's release files, returns false if they couldn't be stored.
+// The stored files aren't added to a release until the version is released
+func (p *Project) release() bool {
+	for _, pattern := range p.releasePatterns() {
+		matches, err := filepath.Glob(filepath.Join(p.workingDir(), pattern))
+		if p.errHandled(err) {
+			return false
+		}
+
+		if len(matches) == 0 {
+			p.errHandled(fmt.Errorf("No release files found matching %s", pattern))
+			return false
+		}
+
+		for i := range matches {
+			info, err := os.Stat(matches[i])
+			if p.errHandled(err) {
+				return false
+			}
+
+			if info.IsDir() {
+				continue
+			}
+
+			if p.errHandled(p.storeReleaseFile(matches[i])) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// storeReleaseFile stores a single release file in the datastore
+func (p *Project) storeReleaseFile(filename string) error {
+	name := p.variantFileName(filepath.Base(filename))
+
+	for i := range p.releaseFiles {
+		if p.releaseFiles[i].FileName == name {
+			return fmt.Errorf("More than one release file is named %s", name)
+		}
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+
+	file, err := p.ds.StoreFile(name, f)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	p.releaseFiles = append(p.releaseFiles, file)
+
+	return f.Close()
+}
+
+// discardRelease removes any release files stored for a version that wasn't released
+func (p *Project) discardRelease() {
+	for i := range p.releaseFiles {
+		p.errHandled(p.ds.DeleteFile(p.releaseFiles[i]))
+	}
+	p.releaseFiles = nil
+}
+
+// released marks the version as successfully released, and cleans up the working dir
+func (p *Project) released() {
+	files := p.releaseFiles
+
+	if len(p.releaseFiles) > 0 {
+		if key := p.signingKey(); key != nil {
+			for i := range p.releaseFiles {
+				if p.errHandled(signReleaseFile(key, p.releaseFiles[i])) {
+					p.discardRelease()
+					return
+				}
+			}
+		}
+
+		if p.errHandled(p.ds.AddRelease(p.branch, p.version, p.releaseFiles)) {
+			p.discardRelease()
+			return
+		}
+		p.releaseFiles = nil
 	}
 
 	p.setStage(stageReleased)
 
-	if p.errHandled(p.ds.AddLog(p.version, p.stage,
-		fmt.Sprintf("Project %s Version %s built, tested, and released successfully and took %s.\n", p.id(), p.version,
-			time.Now().Sub(p.start)))) {
+	msg := fmt.Sprintf("Project %s Version %s built, tested, and released successfully and took %s.\n", p.id(),
+		p.version, time.Now().Sub(p.start))
+
+	if triggered := p.triggerDownstream(files); len(triggered) > 0 {
+		msg += fmt.Sprintf("Triggered downstream projects: %s\n", strings.Join(triggered, ", "))
+	}
+
+	if p.errHandled(p.ds.AddLog(p.branch, p.version, p.stage, msg)) {
 		return
 	}
 
+	p.notify(notifyRelease, p.stage, msg)
+
 	//build successfull, remove working dir
 	p.errHandled(os.RemoveAll(p.workingDir()))
 
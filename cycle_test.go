@@ -0,0 +1,96 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCancelQueuedCycle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ironsmith")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %s", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	oldProjectDir, oldDataDir, oldQueue := projectDir, dataDir, buildQueue
+	defer func() {
+		projectDir, dataDir, buildQueue = oldProjectDir, oldDataDir, oldQueue
+	}()
+	projectDir = filepath.Join(dir, "projects")
+	dataDir = filepath.Join(dir, "data")
+
+	err = os.MkdirAll(filepath.Join(projectDir, enabledProjectDir), 0777)
+	if err != nil {
+		t.Fatalf("Error creating project dir: %s", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(projectDir, enabledProjectDir, "queued.json"),
+		[]byte(`{"name": "queued", "fetch": "true", "version": "echo 1.0", "pollInterval": "1h"}`), 0666)
+	if err != nil {
+		t.Fatalf("Error writing project file: %s", err)
+	}
+
+	// the only spot in the queue is taken, so the cycle waits in the queue until it's cancelled
+	buildQueue = &cycleQueue{max: 1, running: 1}
+
+	p := &Project{filename: "queued.json"}
+	err = p.open()
+	if err != nil {
+		t.Fatalf("Error opening project: %s", err)
+	}
+	defer func() {
+		p.stopTimer()
+		_ = p.close()
+	}()
+
+	projects.Lock()
+	projects.data[p.id()] = p
+	projects.Unlock()
+	defer func() {
+		projects.Lock()
+		delete(projects.data, p.id())
+		projects.Unlock()
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		p.load(false)
+		close(done)
+	}()
+
+	for start := time.Now(); buildQueue.position(p) == 0; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("Cycle was never queued")
+		}
+	}
+
+	if !p.cancelCycle() {
+		t.Fatalf("No cycle to cancel")
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Cancelled cycle never returned from the queue")
+	}
+
+	if buildQueue.position(p) != 0 {
+		t.Errorf("Cancelled cycle was left in the queue")
+	}
+
+	p.RLock()
+	next := p.nextCycle()
+	p.RUnlock()
+
+	if next == nil {
+		t.Errorf("Project cancelled while queued was never scheduled to poll again")
+	}
+}
@@ -8,6 +8,8 @@ package datastore
 import (
 	"encoding/json"
 	"errors"
+	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/boltdb/bolt"
@@ -22,6 +24,10 @@ var ErrNotFound = errors.New("Value not found in datastore")
 // run on top of a Bolt DB file
 type Store struct {
 	bolt *bolt.DB
+	dir  string // folder the datastore file is in
+
+	verified     map[string]fileStamp // artifacts whose contents matched their checksum, by hash
+	verifiedLock sync.Mutex
 }
 
 // Open opens an existing datastore file, or creates a new one
@@ -34,7 +40,9 @@ func Open(filename string) (*Store, error) {
 	}
 
 	store := &Store{
-		bolt: db,
+		bolt:     db,
+		dir:      filepath.Dir(filename),
+		verified: make(map[string]fileStamp),
 	}
 
 	err = store.bolt.Update(func(tx *bolt.Tx) error {
@@ -42,6 +50,10 @@ func Open(filename string) (*Store, error) {
 		if err != nil {
 			return err
 		}
+		_, err = tx.CreateBucketIfNotExists([]byte(bucketLogOutput))
+		if err != nil {
+			return err
+		}
 		_, err = tx.CreateBucketIfNotExists([]byte(bucketReleases))
 		if err != nil {
 			return err
@@ -52,6 +64,11 @@ func Open(filename string) (*Store, error) {
 			return err
 		}
 
+		_, err = tx.CreateBucketIfNotExists([]byte(bucketDeliveries))
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 
@@ -67,26 +84,31 @@ func (ds *Store) Close() error {
 	return ds.bolt.Close()
 }
 
-// TrimVersions Removes versions from the datastore file until it reaches the maxVersions count
+// TrimVersions Removes versions from the datastore file until it reaches the maxVersions count.  Each branch
+// keeps its own maxVersions
 func (ds *Store) TrimVersions(maxVersions int) error {
 	if maxVersions <= 0 {
 		// no max set
 		return nil
 	}
 
-	versions, err := ds.Versions()
+	versions, err := ds.Versions("")
 	if err != nil {
 		return err
 	}
 
-	if len(versions) <= maxVersions {
-		return nil
-	}
+	count := make(map[string]int)
+	var remove []*Log
 
-	remove := versions[maxVersions:]
+	for i := range versions {
+		count[versions[i].Branch]++
+		if count[versions[i].Branch] > maxVersions {
+			remove = append(remove, versions[i])
+		}
+	}
 
 	for i := range remove {
-		err = ds.deleteVersion(remove[i].Version)
+		err = ds.deleteVersion(remove[i].Branch, remove[i].Version)
 		if err != nil {
 			return err
 		}
@@ -95,10 +117,13 @@ func (ds *Store) TrimVersions(maxVersions int) error {
 	return nil
 }
 
-// removes the earliest instance of a specific version
-func (ds *Store) deleteVersion(version string) error {
-	return ds.bolt.Update(func(tx *bolt.Tx) error {
+// removes the earliest instance of a specific version of a branch
+func (ds *Store) deleteVersion(branch, version string) error {
+	var hashes []string
+
+	err := ds.bolt.Update(func(tx *bolt.Tx) error {
 		// remove all logs for this version
+		var logKeys [][]byte
 		c := tx.Bucket([]byte(bucketLog)).Cursor()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -109,39 +134,88 @@ func (ds *Store) deleteVersion(version string) error {
 				return err
 			}
 
-			if lg.Version != version {
-				break
+			if lg.Branch != branch || lg.Version != version {
+				if len(logKeys) > 0 {
+					break
+				}
+				continue
 			}
 
-			err = c.Delete()
+			logKeys = append(logKeys, append([]byte{}, k...))
+		}
+
+		for i := range logKeys {
+			err := tx.Bucket([]byte(bucketLog)).Delete(logKeys[i])
+			if err != nil {
+				return err
+			}
+
+			err = deleteOutput(tx, logKeys[i])
 			if err != nil {
 				return err
 			}
 		}
 
 		// remove all releases for this version
-		release, err := ds.Release(version)
-		if err == ErrNotFound {
-			return nil
-		}
-		if err != nil {
-			return err
-		}
+		var releaseKeys [][]byte
+		c = tx.Bucket([]byte(bucketReleases)).Cursor()
 
-		err = tx.Bucket([]byte(bucketReleases)).Delete(release.FileKey.Bytes())
-		if err != nil {
-			return err
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			release := &Release{}
+			err := json.Unmarshal(v, release)
+			if err != nil {
+				return err
+			}
+
+			if release.Branch != branch || release.Version != version {
+				continue
+			}
+
+			release.upgrade()
+
+			// remove release files for this version
+			for i := range release.Files {
+				if release.Files[i].SHA256 != "" {
+					// artifacts are removed once the transaction completes
+					hashes = append(hashes, release.Files[i].SHA256)
+					continue
+				}
+
+				if release.Files[i].FileKey == nil {
+					continue
+				}
+
+				err = tx.Bucket([]byte(bucketFiles)).Delete(release.Files[i].FileKey.Bytes())
+				if err != nil {
+					return err
+				}
+			}
+
+			releaseKeys = append(releaseKeys, append([]byte{}, k...))
 		}
 
-		// remove release file for this version
-		err = tx.Bucket([]byte(bucketFiles)).Delete(release.FileKey.Bytes())
-		if err != nil {
-			return err
+		for i := range releaseKeys {
+			err := tx.Bucket([]byte(bucketReleases)).Delete(releaseKeys[i])
+			if err != nil {
+				return err
+			}
 		}
 
 		return nil
 	})
 
+	if err != nil {
+		return err
+	}
+
+	for i := range hashes {
+		err = ds.removeArtifact(hashes[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (ds *Store) get(bucket string, key []byte, result interface{}) error {
@@ -0,0 +1,250 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package datastore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// release files are stored on the file system in a content addressed artifact store in the same folder as the
+// datastore file.  Files are stored by the SHA-256 hash of their contents
+//
+//	artifacts/<first 2 characters of hash>/<hash>
+const artifactDir = "artifacts"
+
+// ErrChecksum is the error returned when a release file's contents no longer match the checksum it was stored with
+var ErrChecksum = errors.New("Release file does not match its checksum")
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
+// fileStamp is the size and modification time of an artifact when its contents were last verified
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// StoreFile streams the contents of the reader into the artifact store, and returns the release file that
+// refers to it.  Files with the same contents are only stored once
+func (ds *Store) StoreFile(fileName string, r io.Reader) (*ReleaseFile, error) {
+	dir := filepath.Join(ds.dir, artifactDir)
+	err := os.MkdirAll(dir, 0777)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := ioutil.TempFile(dir, "upload-")
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		// no-op if the temp file was already moved into place
+		_ = os.Remove(tmp.Name())
+	}()
+
+	hash := sha256.New()
+
+	size, err := io.Copy(io.MultiWriter(tmp, hash), r)
+	if err != nil {
+		_ = tmp.Close()
+		return nil, err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	file := &ReleaseFile{
+		FileName: fileName,
+		SHA256:   fmt.Sprintf("%x", hash.Sum(nil)),
+		Size:     size,
+	}
+
+	err = os.MkdirAll(filepath.Dir(ds.artifactPath(file.SHA256)), 0777)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Rename(tmp.Name(), ds.artifactPath(file.SHA256))
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// OpenFile opens a release file for reading.  The file's contents aren't verified, VerifyFile should be called
+// before any of it is read.  The caller is responsible for closing the file
+func (ds *Store) OpenFile(file *ReleaseFile) (io.ReadSeekCloser, error) {
+	if file.SHA256 == "" {
+		if file.FileKey == nil {
+			return nil, ErrNotFound
+		}
+
+		data, err := ds.ReleaseFile(*file.FileKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return nopSeekCloser{bytes.NewReader(data)}, nil
+	}
+
+	f, err := os.Open(ds.artifactPath(file.SHA256))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// VerifyFile checks the contents of a release file opened with OpenFile against its checksum, and returns
+// ErrChecksum if they don't match.  Artifacts are only hashed again if their size or modification time has changed
+// since they were last verified, so large files aren't read in full every time they're opened
+func (ds *Store) VerifyFile(r io.ReadSeeker, file *ReleaseFile) error {
+	if file.SHA256 == "" {
+		// files stored in the datastore from before the artifact store have no checksum
+		return nil
+	}
+
+	var stamp *fileStamp
+	if f, ok := r.(*os.File); ok {
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		stamp = &fileStamp{size: info.Size(), modTime: info.ModTime()}
+
+		ds.verifiedLock.Lock()
+		last, ok := ds.verified[file.SHA256]
+		ds.verifiedLock.Unlock()
+		if ok && last == *stamp {
+			return nil
+		}
+	}
+
+	err := verify(r, file)
+	if err != nil || stamp == nil {
+		return err
+	}
+
+	ds.verifiedLock.Lock()
+	ds.verified[file.SHA256] = *stamp
+	ds.verifiedLock.Unlock()
+
+	return nil
+}
+
+// FilePath returns the path of the release file in the artifact store.  Files stored in the datastore from before
+// the artifact store don't have a path, and return ErrNotFound
+func (ds *Store) FilePath(file *ReleaseFile) (string, error) {
+	if file.SHA256 == "" {
+		return "", ErrNotFound
+	}
+
+	path, err := filepath.Abs(ds.artifactPath(file.SHA256))
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = os.Stat(path); os.IsNotExist(err) {
+		return "", ErrNotFound
+	}
+
+	return path, err
+}
+
+// verify checks the contents of the reader against the file's checksum and size, and seeks
+// back to the start of the reader
+func verify(r io.ReadSeeker, file *ReleaseFile) error {
+	hash := sha256.New()
+
+	size, err := io.Copy(hash, r)
+	if err != nil {
+		return err
+	}
+
+	if fmt.Sprintf("%x", hash.Sum(nil)) != file.SHA256 || (file.Size != 0 && size != file.Size) {
+		return ErrChecksum
+	}
+
+	_, err = r.Seek(0, io.SeekStart)
+	return err
+}
+
+// DeleteFile removes a release file's data, unless the data is still used by a release
+func (ds *Store) DeleteFile(file *ReleaseFile) error {
+	if file.SHA256 == "" {
+		if file.FileKey == nil {
+			return nil
+		}
+		return ds.bolt.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket([]byte(bucketFiles)).Delete(file.FileKey.Bytes())
+		})
+	}
+
+	return ds.removeArtifact(file.SHA256)
+}
+
+func (ds *Store) artifactPath(hash string) string {
+	return filepath.Join(ds.dir, artifactDir, hash[:2], hash)
+}
+
+// removeArtifact removes the artifact with the given hash from the artifact store if no releases refer to it
+func (ds *Store) removeArtifact(hash string) error {
+	found := false
+
+	err := ds.bolt.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(bucketReleases)).Cursor()
+
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			r := &Release{}
+			err := json.Unmarshal(v, r)
+			if err != nil {
+				return err
+			}
+
+			for i := range r.Files {
+				if r.Files[i].SHA256 == hash {
+					found = true
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return nil
+	}
+
+	err = os.Remove(ds.artifactPath(hash))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
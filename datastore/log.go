@@ -5,7 +5,10 @@
 package datastore
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
+	"math"
 	"time"
 
 	"github.com/boltdb/bolt"
@@ -14,19 +17,24 @@ import (
 // Log is a version log entry for a project
 type Log struct {
 	When    time.Time `json:"when,omitempty"`
+	Branch  string    `json:"branch,omitempty"`
 	Version string    `json:"version,omitempty"`
 	Stage   string    `json:"stage,omitempty"`
 	Log     string    `json:"log,omitempty"`
 }
 
-const bucketLog = "log"
+const (
+	bucketLog       = "log"
+	bucketLogOutput = "logOutput" // output appended to log entries, in chunks keyed by the entry's key + chunk number
+)
 
-// AddLog adds a new log entry
-func (ds *Store) AddLog(version, stage, entry string) error {
+// AddLog adds a new log entry, branch is blank for projects that aren't built per branch
+func (ds *Store) AddLog(branch, version, stage, entry string) error {
 	key := NewTimeKey()
 
 	data := &Log{
 		When:    key.Time(),
+		Branch:  branch,
 		Version: version,
 		Stage:   stage,
 		Log:     entry,
@@ -35,9 +43,112 @@ func (ds *Store) AddLog(version, stage, entry string) error {
 	return ds.put(bucketLog, key.Bytes(), data)
 }
 
-// LastVersion returns the last version in the log for the given stage.  If stage is blank,
-// then it returns the last of any stage
-func (ds *Store) LastVersion(stage string) (*Log, error) {
+// AppendLog appends to the log entry with the given key, creating the entry if it doesn't already exist.  Used
+// for capturing the output of a stage as it runs.  Appended output is stored as a new chunk after the entry's
+// existing output, so the output that's already been logged is never rewritten
+func (ds *Store) AppendLog(key TimeKey, branch, version, stage, entry string) error {
+	return ds.bolt.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(bucketLog))
+
+		if bucket.Get(key.Bytes()) == nil {
+			dsValue, err := json.Marshal(&Log{
+				When:    key.Time(),
+				Branch:  branch,
+				Version: version,
+				Stage:   stage,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = bucket.Put(key.Bytes(), dsValue)
+			if err != nil {
+				return err
+			}
+		}
+
+		if entry == "" {
+			return nil
+		}
+
+		output := tx.Bucket([]byte(bucketLogOutput))
+
+		// chunk 0 is the output stored in the entry itself, by versions from before output was chunked
+		chunk := uint32(1)
+		if last, ok := lastChunk(output.Cursor(), key.Bytes()); ok {
+			chunk = last + 1
+		}
+
+		return output.Put(chunkKey(key.Bytes(), chunk), []byte(entry))
+	})
+}
+
+func chunkKey(key []byte, chunk uint32) []byte {
+	k := make([]byte, len(key)+4)
+	copy(k, key)
+	binary.BigEndian.PutUint32(k[len(key):], chunk)
+	return k
+}
+
+// lastChunk returns the number of the last chunk of output appended to the log entry with the given key
+func lastChunk(c *bolt.Cursor, key []byte) (uint32, bool) {
+	k, _ := c.Seek(chunkKey(key, math.MaxUint32))
+	if k == nil {
+		k, _ = c.Last()
+	} else if !bytes.Equal(k, chunkKey(key, math.MaxUint32)) {
+		k, _ = c.Prev()
+	}
+
+	if k == nil || len(k) != len(key)+4 || !bytes.HasPrefix(k, key) {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint32(k[len(key):]), true
+}
+
+// readOutput adds the output chunks appended to the log entry with the given key to the entry's log, starting at
+// the given chunk.  Returns the number of the next chunk that hasn't been appended yet
+func readOutput(tx *bolt.Tx, key []byte, l *Log, from uint32) uint32 {
+	c := tx.Bucket([]byte(bucketLogOutput)).Cursor()
+
+	var output []byte
+	next := from
+	if next == 0 {
+		next = 1
+	}
+
+	for k, v := c.Seek(chunkKey(key, from)); k != nil && bytes.HasPrefix(k, key); k, v = c.Next() {
+		output = append(output, v...)
+		next = binary.BigEndian.Uint32(k[len(key):]) + 1
+	}
+
+	l.Log += string(output)
+
+	return next
+}
+
+// deleteOutput removes all of the output chunks appended to the log entry with the given key
+func deleteOutput(tx *bolt.Tx, key []byte) error {
+	c := tx.Bucket([]byte(bucketLogOutput)).Cursor()
+
+	var keys [][]byte
+	for k, _ := c.Seek(key); k != nil && bytes.HasPrefix(k, key); k, _ = c.Next() {
+		keys = append(keys, append([]byte{}, k...))
+	}
+
+	for i := range keys {
+		err := tx.Bucket([]byte(bucketLogOutput)).Delete(keys[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LastVersion returns the last version in the log for the given branch and stage.  If branch or stage are blank,
+// then it returns the last of any branch or stage
+func (ds *Store) LastVersion(branch, stage string) (*Log, error) {
 	last := &Log{}
 
 	err := ds.bolt.View(func(tx *bolt.Tx) error {
@@ -50,8 +161,9 @@ func (ds *Store) LastVersion(stage string) (*Log, error) {
 				return err
 			}
 
-			if l.Version != "" {
+			if l.Version != "" && l.inBranch(branch) {
 				if stage == "" || l.Stage == stage {
+					readOutput(tx, k, l, 0)
 					last = l
 					return nil
 				}
@@ -68,8 +180,9 @@ func (ds *Store) LastVersion(stage string) (*Log, error) {
 	return last, nil
 }
 
-// Versions lists the versions in a given project, including the last stage that version got to
-func (ds *Store) Versions() ([]*Log, error) {
+// Versions lists the versions in a given project, including the last stage that version got to.  If branch
+// isn't blank, only the versions built from that branch are listed
+func (ds *Store) Versions(branch string) ([]*Log, error) {
 	var vers []*Log
 
 	err := ds.bolt.View(func(tx *bolt.Tx) error {
@@ -84,8 +197,13 @@ func (ds *Store) Versions() ([]*Log, error) {
 				return err
 			}
 
+			if !l.inBranch(branch) {
+				continue
+			}
+
 			// capture the newest entry for each version
 			if l.Version != current {
+				readOutput(tx, k, l, 0)
 				vers = append(vers, l)
 				current = l.Version
 			}
@@ -102,8 +220,45 @@ func (ds *Store) Versions() ([]*Log, error) {
 	return vers, nil
 }
 
-// VersionLog returns all the log entries for a given version
-func (ds *Store) VersionLog(version string) ([]*Log, error) {
+// Branches lists every branch that has been built in the project, most recently built first
+func (ds *Store) Branches() ([]string, error) {
+	var branches []string
+
+	err := ds.bolt.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(bucketLog)).Cursor()
+
+		found := make(map[string]bool)
+
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			l := &Log{}
+			err := json.Unmarshal(v, l)
+			if err != nil {
+				return err
+			}
+
+			if l.Branch != "" && !found[l.Branch] {
+				found[l.Branch] = true
+				branches = append(branches, l.Branch)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return branches, nil
+}
+
+func (l *Log) inBranch(branch string) bool {
+	return branch == "" || l.Branch == branch
+}
+
+// VersionLog returns all the log entries for a given version built from the given branch.  If branch is blank, the
+// entries are from the branch that most recently built the version
+func (ds *Store) VersionLog(branch, version string) ([]*Log, error) {
 	var logs []*Log
 
 	if version == "" {
@@ -122,13 +277,19 @@ func (ds *Store) VersionLog(version string) ([]*Log, error) {
 				return err
 			}
 
+			if !l.inBranch(branch) {
+				continue
+			}
+
 			if verFound && l.Version != version {
 				return nil
 			}
 
 			if l.Version == version {
+				readOutput(tx, k, l, 0)
 				logs = append(logs, l)
 				verFound = true
+				branch = l.Branch
 			}
 
 		}
@@ -143,37 +304,80 @@ func (ds *Store) VersionLog(version string) ([]*Log, error) {
 	return logs, nil
 }
 
-// StageLog returns the log entry for a given version + stage
-func (ds *Store) StageLog(version, stage string) (*Log, error) {
+// StageLog returns the log entry for a given version + stage built from the given branch.  If branch is blank, it's
+// the most recent entry from any branch
+func (ds *Store) StageLog(branch, version, stage string) (*Log, error) {
 	var entry *Log
 
-	if version == "" || stage == "" {
-		return nil, ErrNotFound
+	err := ds.bolt.View(func(tx *bolt.Tx) error {
+		k, l, err := findStage(tx, branch, version, stage)
+		if err != nil {
+			return err
+		}
+
+		readOutput(tx, k, l, 0)
+		entry = l
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
-	err := ds.bolt.View(func(tx *bolt.Tx) error {
-		c := tx.Bucket([]byte(bucketLog)).Cursor()
+	return entry, nil
+}
 
-		for k, v := c.Last(); k != nil; k, v = c.Prev() {
-			l := &Log{}
-			err := json.Unmarshal(v, l)
-			if err != nil {
-				return err
-			}
+// StageOutput returns the key of the log entry for a given version + stage built from the given branch, and the
+// output appended to it starting at the chunk from.  Used to follow a running stage without reading all of its
+// output again every time.  The returned chunk is where to start reading the output the next time
+func (ds *Store) StageOutput(branch, version, stage string, from uint32) (TimeKey, string, uint32, error) {
+	var key TimeKey
+	var output string
+	var next uint32
 
-			if l.Version == version && l.Stage == stage {
-				entry = l
-				return nil
-			}
+	err := ds.bolt.View(func(tx *bolt.Tx) error {
+		k, l, err := findStage(tx, branch, version, stage)
+		if err != nil {
+			return err
+		}
+		copy(key[:], k)
 
+		if from > 0 {
+			// the entry's own output has already been read
+			l.Log = ""
 		}
 
-		return ErrNotFound
+		next = readOutput(tx, k, l, from)
+		output = l.Log
+		return nil
 	})
 
 	if err != nil {
-		return nil, err
+		return key, "", 0, err
 	}
 
-	return entry, nil
+	return key, output, next, nil
+}
+
+// findStage returns the key and entry of the newest log entry for the version + stage built from the given branch
+func findStage(tx *bolt.Tx, branch, version, stage string) ([]byte, *Log, error) {
+	if version == "" || stage == "" {
+		return nil, nil, ErrNotFound
+	}
+
+	c := tx.Bucket([]byte(bucketLog)).Cursor()
+
+	for k, v := c.Last(); k != nil; k, v = c.Prev() {
+		l := &Log{}
+		err := json.Unmarshal(v, l)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if l.Version == version && l.Stage == stage && l.inBranch(branch) {
+			return k, l, nil
+		}
+	}
+
+	return nil, nil, ErrNotFound
 }
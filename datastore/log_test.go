@@ -0,0 +1,191 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package datastore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/boltdb/bolt"
+)
+
+func tempStore(t *testing.T) (*Store, func()) {
+	dir, err := ioutil.TempDir("", "ironsmith")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %s", err)
+	}
+
+	ds, err := Open(filepath.Join(dir, "test.ironsmith"))
+	if err != nil {
+		t.Fatalf("Error opening datastore: %s", err)
+	}
+
+	return ds, func() {
+		_ = ds.Close()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestAppendLog(t *testing.T) {
+	ds, cleanup := tempStore(t)
+	defer cleanup()
+
+	key := NewTimeKey()
+
+	for _, entry := range []string{"", "first\n", "second\n"} {
+		err := ds.AppendLog(key, "", "1.0", "building", entry)
+		if err != nil {
+			t.Fatalf("Error appending log: %s", err)
+		}
+	}
+
+	l, err := ds.StageLog("", "1.0", "building")
+	if err != nil {
+		t.Fatalf("Error getting stage log: %s", err)
+	}
+
+	if l.Log != "first\nsecond\n" {
+		t.Errorf("Invalid appended log want %q got %q", "first\nsecond\n", l.Log)
+	}
+
+	logs, err := ds.VersionLog("", "1.0")
+	if err != nil {
+		t.Fatalf("Error getting version log: %s", err)
+	}
+
+	if len(logs) != 1 {
+		t.Errorf("Appending to a log created more than one entry.  want %d got %d", 1, len(logs))
+	}
+
+	k, output, next, err := ds.StageOutput("", "1.0", "building", 0)
+	if err != nil {
+		t.Fatalf("Error getting stage output: %s", err)
+	}
+	if k != key || output != "first\nsecond\n" {
+		t.Errorf("Invalid stage output want %q got %q", "first\nsecond\n", output)
+	}
+
+	err = ds.AppendLog(key, "", "1.0", "building", "third\n")
+	if err != nil {
+		t.Fatalf("Error appending log: %s", err)
+	}
+
+	_, output, _, err = ds.StageOutput("", "1.0", "building", next)
+	if err != nil {
+		t.Fatalf("Error getting stage output: %s", err)
+	}
+	if output != "third\n" {
+		t.Errorf("Invalid stage output since the last read want %q got %q", "third\n", output)
+	}
+
+	err = ds.deleteVersion("", "1.0")
+	if err != nil {
+		t.Fatalf("Error deleting version: %s", err)
+	}
+
+	err = ds.bolt.View(func(tx *bolt.Tx) error {
+		if k, _ := tx.Bucket([]byte(bucketLogOutput)).Cursor().First(); k != nil {
+			t.Errorf("Log output wasn't deleted with its version")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error reading log output: %s", err)
+	}
+}
+
+func TestBranchVersions(t *testing.T) {
+	ds, cleanup := tempStore(t)
+	defer cleanup()
+
+	for _, l := range []*Log{
+		{Branch: "master", Version: "1.0"},
+		{Branch: "dev", Version: "2.0-beta"},
+		{Branch: "master", Version: "1.1"},
+		{Branch: "dev", Version: "2.0-rc"},
+	} {
+		err := ds.AddLog(l.Branch, l.Version, "building", "")
+		if err != nil {
+			t.Fatalf("Error adding log: %s", err)
+		}
+	}
+
+	last, err := ds.LastVersion("master", "building")
+	if err != nil {
+		t.Fatalf("Error getting last version: %s", err)
+	}
+
+	if last.Version != "1.1" {
+		t.Errorf("Invalid last version for branch want %s got %s", "1.1", last.Version)
+	}
+
+	branches, err := ds.Branches()
+	if err != nil {
+		t.Fatalf("Error getting branches: %s", err)
+	}
+
+	if len(branches) != 2 || branches[0] != "dev" || branches[1] != "master" {
+		t.Errorf("Invalid branches want %v got %v", []string{"dev", "master"}, branches)
+	}
+
+	err = ds.TrimVersions(1)
+	if err != nil {
+		t.Fatalf("Error trimming versions: %s", err)
+	}
+
+	for branch, want := range map[string]string{"master": "1.1", "dev": "2.0-rc"} {
+		vers, err := ds.Versions(branch)
+		if err != nil {
+			t.Fatalf("Error getting versions: %s", err)
+		}
+
+		if len(vers) != 1 || vers[0].Version != want {
+			t.Errorf("Invalid versions left in branch %s after trimming want %s got %v", branch, want, vers)
+		}
+	}
+}
+
+func TestBranchVersionLog(t *testing.T) {
+	ds, cleanup := tempStore(t)
+	defer cleanup()
+
+	for _, l := range []*Log{
+		{Branch: "master", Stage: "building", Log: "master build"},
+		{Branch: "master", Stage: "released", Log: "master release"},
+		{Branch: "dev", Stage: "building", Log: "dev build"},
+	} {
+		err := ds.AddLog(l.Branch, "1.0", l.Stage, l.Log)
+		if err != nil {
+			t.Fatalf("Error adding log: %s", err)
+		}
+	}
+
+	for branch, want := range map[string]int{"master": 2, "dev": 1, "": 1} {
+		logs, err := ds.VersionLog(branch, "1.0")
+		if err != nil {
+			t.Fatalf("Error getting version log: %s", err)
+		}
+
+		if len(logs) != want {
+			t.Errorf("Invalid number of log entries for branch %q want %d got %d", branch, want, len(logs))
+		}
+	}
+
+	l, err := ds.StageLog("master", "1.0", "building")
+	if err != nil {
+		t.Fatalf("Error getting stage log: %s", err)
+	}
+
+	if l.Log != "master build" {
+		t.Errorf("Invalid stage log for branch want %q got %q", "master build", l.Log)
+	}
+
+	_, err = ds.StageLog("dev", "1.0", "released")
+	if err != ErrNotFound {
+		t.Errorf("Found a stage log from another branch.  want %s got %v", ErrNotFound, err)
+	}
+}
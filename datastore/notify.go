@@ -0,0 +1,89 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package datastore
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+const bucketDeliveries = "deliveries"
+
+// maxDeliveries is how many notification deliveries are kept, the oldest are removed first
+const maxDeliveries = 100
+
+// Delivery is the result of sending a notification to one of a project's notify endpoints
+type Delivery struct {
+	When       time.Time `json:"when"`
+	URL        string    `json:"url"`
+	Event      string    `json:"event"`
+	Branch     string    `json:"branch,omitempty"`
+	Version    string    `json:"version"`
+	Attempts   int       `json:"attempts"`
+	StatusCode int       `json:"statusCode,omitempty"` // status code of the last attempt's response
+	Error      string    `json:"error,omitempty"`      // why the last attempt failed
+	Delivered  bool      `json:"delivered"`
+}
+
+// AddDelivery records the result of a notification, only the latest maxDeliveries are kept
+func (ds *Store) AddDelivery(delivery *Delivery) error {
+	key := NewTimeKey()
+	delivery.When = key.Time()
+
+	value, err := json.Marshal(delivery)
+	if err != nil {
+		return err
+	}
+
+	return ds.bolt.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(bucketDeliveries))
+
+		err := bucket.Put(key.Bytes(), value)
+		if err != nil {
+			return err
+		}
+
+		var keys [][]byte
+		c := bucket.Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			keys = append(keys, append([]byte(nil), k...))
+		}
+
+		for i := 0; i < len(keys)-maxDeliveries; i++ {
+			err = bucket.Delete(keys[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Deliveries returns the recorded notification deliveries, newest first
+func (ds *Store) Deliveries() ([]*Delivery, error) {
+	var deliveries []*Delivery
+
+	err := ds.bolt.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(bucketDeliveries)).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			d := &Delivery{}
+			err := json.Unmarshal(v, d)
+			if err != nil {
+				return err
+			}
+			deliveries = append(deliveries, d)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
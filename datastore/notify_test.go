@@ -0,0 +1,53 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package datastore
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestDeliveries(t *testing.T) {
+	ds, cleanup := tempStore(t)
+	defer cleanup()
+
+	deliveries, err := ds.Deliveries()
+	if err != nil {
+		t.Fatalf("Error getting deliveries: %s", err)
+	}
+	if len(deliveries) != 0 {
+		t.Fatalf("Invalid number of deliveries in an empty datastore want %d got %d", 0, len(deliveries))
+	}
+
+	for i := 0; i < maxDeliveries+5; i++ {
+		err = ds.AddDelivery(&Delivery{
+			URL:       "http://example.com",
+			Event:     "failure",
+			Version:   strconv.Itoa(i),
+			Attempts:  1,
+			Delivered: true,
+		})
+		if err != nil {
+			t.Fatalf("Error adding delivery: %s", err)
+		}
+	}
+
+	deliveries, err = ds.Deliveries()
+	if err != nil {
+		t.Fatalf("Error getting deliveries: %s", err)
+	}
+
+	if len(deliveries) != maxDeliveries {
+		t.Fatalf("Deliveries weren't trimmed want %d got %d", maxDeliveries, len(deliveries))
+	}
+
+	if deliveries[0].Version != strconv.Itoa(maxDeliveries+4) {
+		t.Errorf("Newest delivery isn't first want %s got %s", strconv.Itoa(maxDeliveries+4), deliveries[0].Version)
+	}
+
+	if deliveries[len(deliveries)-1].Version != "5" {
+		t.Errorf("Oldest deliveries weren't removed want %s got %s", "5", deliveries[len(deliveries)-1].Version)
+	}
+}
@@ -7,18 +7,71 @@ package datastore
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"time"
 
 	"github.com/boltdb/bolt"
 )
 
-// Release is a record of the fully built and ready to deploy release file
+// Release is a record of the fully built and ready to deploy release files
 type Release struct {
-	When     time.Time `json:"when"`
-	Version  string    `json:"version"`
-	FileName string    `json:"fileName"`
-	FileKey  TimeKey   `json:"fileKey"`
+	When     time.Time      `json:"when"`
+	Branch   string         `json:"branch,omitempty"`
+	Version  string         `json:"version"`
+	FileName string         `json:"fileName"`          // name of the first file in the release
+	FileKey  *TimeKey       `json:"fileKey,omitempty"` // key of the file in releases from before multiple files were supported
+	Files    []*ReleaseFile `json:"files"`
+}
+
+// ReleaseFile is a single file in a release
+type ReleaseFile struct {
+	FileName string   `json:"fileName"`
+	SHA256   string   `json:"sha256,omitempty"` // SHA-256 of the file, which is also its address in the artifact store
+	Size     int64    `json:"size,omitempty"`
+	FileKey  *TimeKey `json:"fileKey,omitempty"` // key of files stored in the datastore before the artifact store
+
+	// Signature is the detached ed25519 signature of the file's SHA-256 checksum, if releases are being signed
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// File returns the file in the release with the given name
+func (r *Release) File(fileName string) (*ReleaseFile, error) {
+	for i := range r.Files {
+		if r.Files[i].FileName == fileName {
+			return r.Files[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// Checksums returns the SHA-256 checksums of the files in the release in the same format as
+// the sha256sum utility
+func (r *Release) Checksums() []byte {
+	var sums bytes.Buffer
+
+	for i := range r.Files {
+		if r.Files[i].SHA256 == "" {
+			continue
+		}
+		fmt.Fprintf(&sums, "%s  %s\n", r.Files[i].SHA256, r.Files[i].FileName)
+	}
+
+	return sums.Bytes()
+}
+
+// releases from before multiple files were supported only have the single file name and key
+func (r *Release) upgrade() {
+	if len(r.Files) == 0 && r.FileName != "" {
+		r.Files = []*ReleaseFile{
+			{
+				FileName: r.FileName,
+				FileKey:  r.FileKey,
+			},
+		}
+	}
 }
 
 const (
@@ -26,33 +79,27 @@ const (
 	bucketFiles    = "files"
 )
 
-// AddRelease adds a new Release
-func (ds *Store) AddRelease(version, fileName string, fileData []byte) error {
+// AddRelease adds a new Release made up of the passed in files, which have already been stored with StoreFile
+func (ds *Store) AddRelease(branch, version string, files []*ReleaseFile) error {
+	if len(files) == 0 {
+		return errors.New("A release must have at least one file")
+	}
+
 	key := NewTimeKey()
 
 	r := &Release{
 		When:     key.Time(),
+		Branch:   branch,
 		Version:  version,
-		FileName: fileName,
-		FileKey:  key,
-	}
-
-	dsValue, err := json.Marshal(r)
-	if err != nil {
-		return err
+		FileName: files[0].FileName,
+		Files:    files,
 	}
 
-	return ds.bolt.Update(func(tx *bolt.Tx) error {
-		err = tx.Bucket([]byte(bucketReleases)).Put(key.Bytes(), dsValue)
-		if err != nil {
-			return err
-		}
-
-		return tx.Bucket([]byte(bucketFiles)).Put(key.Bytes(), fileData)
-	})
+	return ds.put(bucketReleases, key.Bytes(), r)
 }
 
-// ReleaseFile returns a specific file from a release for the given file key
+// ReleaseFile returns a specific file stored in the datastore for the given file key.  Only releases from before
+// the artifact store will have files stored in the datastore
 func (ds *Store) ReleaseFile(fileKey TimeKey) ([]byte, error) {
 	var fileData bytes.Buffer
 
@@ -77,8 +124,9 @@ func (ds *Store) ReleaseFile(fileKey TimeKey) ([]byte, error) {
 	return fileData.Bytes(), nil
 }
 
-// Release gets the release record for a specific version
-func (ds *Store) Release(version string) (*Release, error) {
+// Release gets the release record for a specific version built from the given branch.  If branch is blank, it's
+// the most recent release of the version from any branch
+func (ds *Store) Release(branch, version string) (*Release, error) {
 	r := &Release{}
 	err := ds.bolt.View(func(tx *bolt.Tx) error {
 		c := tx.Bucket([]byte(bucketReleases)).Cursor()
@@ -89,7 +137,8 @@ func (ds *Store) Release(version string) (*Release, error) {
 				return err
 			}
 
-			if r.Version == version {
+			if r.Version == version && (branch == "" || r.Branch == branch) {
+				r.upgrade()
 				return nil
 			}
 		}
@@ -104,8 +153,9 @@ func (ds *Store) Release(version string) (*Release, error) {
 	return r, nil
 }
 
-// Releases lists all the releases in a given project
-func (ds *Store) Releases() ([]*Release, error) {
+// Releases lists all the releases in a given project.  If branch isn't blank, only the releases built from that
+// branch are listed
+func (ds *Store) Releases(branch string) ([]*Release, error) {
 	var vers []*Release
 
 	err := ds.bolt.View(func(tx *bolt.Tx) error {
@@ -118,6 +168,11 @@ func (ds *Store) Releases() ([]*Release, error) {
 				return err
 			}
 
+			if branch != "" && r.Branch != branch {
+				continue
+			}
+
+			r.upgrade()
 			vers = append(vers, r)
 		}
 
@@ -131,23 +186,27 @@ func (ds *Store) Releases() ([]*Release, error) {
 	return vers, nil
 }
 
-// LastRelease lists the last release for a project
-func (ds *Store) LastRelease() (*Release, error) {
+// LastRelease lists the last release for a project.  If branch isn't blank, it's the last release built from
+// that branch
+func (ds *Store) LastRelease(branch string) (*Release, error) {
 	r := &Release{}
 
 	err := ds.bolt.View(func(tx *bolt.Tx) error {
-		_, v := tx.Bucket([]byte(bucketReleases)).Cursor().Last()
+		c := tx.Bucket([]byte(bucketReleases)).Cursor()
 
-		if v == nil {
-			return ErrNotFound
-		}
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			err := json.Unmarshal(v, r)
+			if err != nil {
+				return err
+			}
 
-		err := json.Unmarshal(v, r)
-		if err != nil {
-			return err
+			if branch == "" || r.Branch == branch {
+				r.upgrade()
+				return nil
+			}
 		}
 
-		return nil
+		return ErrNotFound
 	})
 
 	if err != nil {
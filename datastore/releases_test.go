@@ -0,0 +1,163 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package datastore
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReleaseFiles(t *testing.T) {
+	ds, cleanup := tempStore(t)
+	defer cleanup()
+
+	var files []*ReleaseFile
+
+	for _, name := range []string{"linux.tar.gz", "windows.zip"} {
+		file, err := ds.StoreFile(name, strings.NewReader(name))
+		if err != nil {
+			t.Fatalf("Error storing file: %s", err)
+		}
+		files = append(files, file)
+	}
+
+	err := ds.AddLog("", "1.0", "building", "")
+	if err != nil {
+		t.Fatalf("Error adding log: %s", err)
+	}
+
+	err = ds.AddRelease("", "1.0", files)
+	if err != nil {
+		t.Fatalf("Error adding release: %s", err)
+	}
+
+	r, err := ds.Release("", "1.0")
+	if err != nil {
+		t.Fatalf("Error getting release: %s", err)
+	}
+
+	if len(r.Files) != 2 {
+		t.Fatalf("Invalid number of release files want %d got %d", 2, len(r.Files))
+	}
+
+	f, err := r.File("windows.zip")
+	if err != nil {
+		t.Fatalf("Error getting release file by name: %s", err)
+	}
+
+	rf, err := ds.OpenFile(f)
+	if err != nil {
+		t.Fatalf("Error opening release file: %s", err)
+	}
+
+	data, err := ioutil.ReadAll(rf)
+	if err != nil {
+		t.Fatalf("Error reading release file: %s", err)
+	}
+
+	if err = rf.Close(); err != nil {
+		t.Fatalf("Error closing release file: %s", err)
+	}
+
+	if string(data) != "windows.zip" {
+		t.Errorf("Invalid release file data want %s got %s", "windows.zip", data)
+	}
+
+	err = ds.deleteVersion("", "1.0")
+	if err != nil {
+		t.Fatalf("Error deleting version: %s", err)
+	}
+
+	_, err = ds.Release("", "1.0")
+	if err != ErrNotFound {
+		t.Errorf("Release wasn't deleted with its version.  want %s got %v", ErrNotFound, err)
+	}
+
+	for i := range files {
+		if _, err = os.Stat(ds.artifactPath(files[i].SHA256)); !os.IsNotExist(err) {
+			t.Errorf("Release file %s wasn't deleted with its version", files[i].FileName)
+		}
+	}
+}
+
+func TestSharedArtifact(t *testing.T) {
+	ds, cleanup := tempStore(t)
+	defer cleanup()
+
+	first, err := ds.StoreFile("first.txt", strings.NewReader("same contents"))
+	if err != nil {
+		t.Fatalf("Error storing file: %s", err)
+	}
+
+	err = ds.AddRelease("", "1.0", []*ReleaseFile{first})
+	if err != nil {
+		t.Fatalf("Error adding release: %s", err)
+	}
+
+	second, err := ds.StoreFile("second.txt", strings.NewReader("same contents"))
+	if err != nil {
+		t.Fatalf("Error storing file: %s", err)
+	}
+
+	if first.SHA256 != second.SHA256 {
+		t.Fatalf("Files with the same contents have different hashes: %s and %s", first.SHA256, second.SHA256)
+	}
+
+	// discarding an unreleased file must not remove the data still used by the first release
+	err = ds.DeleteFile(second)
+	if err != nil {
+		t.Fatalf("Error deleting file: %s", err)
+	}
+
+	f, err := ds.OpenFile(first)
+	if err != nil {
+		t.Fatalf("Shared artifact was removed while still in use by a release: %s", err)
+	}
+	_ = f.Close()
+}
+
+func TestCorruptArtifact(t *testing.T) {
+	ds, cleanup := tempStore(t)
+	defer cleanup()
+
+	file, err := ds.StoreFile("release.tar.gz", strings.NewReader("release contents"))
+	if err != nil {
+		t.Fatalf("Error storing file: %s", err)
+	}
+
+	if file.Size != int64(len("release contents")) {
+		t.Errorf("Invalid file size want %d got %d", len("release contents"), file.Size)
+	}
+
+	verifyFile := func() error {
+		f, err := ds.OpenFile(file)
+		if err != nil {
+			t.Fatalf("Error opening release file: %s", err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+
+		return ds.VerifyFile(f, file)
+	}
+
+	err = verifyFile()
+	if err != nil {
+		t.Fatalf("Error verifying release file: %s", err)
+	}
+
+	// the file was verified above, changing it must still be detected
+	err = ioutil.WriteFile(ds.artifactPath(file.SHA256), []byte("corrupted contents"), 0666)
+	if err != nil {
+		t.Fatalf("Error corrupting artifact: %s", err)
+	}
+
+	err = verifyFile()
+	if err != ErrChecksum {
+		t.Errorf("Corrupted file wasn't detected.  want %s got %v", ErrChecksum, err)
+	}
+}
@@ -5,49 +5,92 @@
 package main
 
 import (
-	"fmt"
+	"context"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 )
 
-func runCmd(cmd, dir string, env []string) ([]byte, error) {
-	s := strings.Fields(strings.Replace(cmd, "@dir", dir, -1))
+const (
+	defaultShell     = "/bin/sh -c"
+	processWaitDelay = 5 * time.Second
+)
 
-	for i := range env {
-		env[i] = strings.Replace(env[i], "@dir", dir, -1)
+// runScript runs each line of the script in sequence, stopping at the first line that fails.  Each line is a
+// separate shell, so the working dir and variables set by one line aren't kept for the next.
+// If the script has more than one line, each line is written to the output before it's run
+func runScript(ctx context.Context, shell string, script Script, dir string, env []string, output io.Writer) error {
+	for i := range script {
+		if strings.TrimSpace(script[i]) == "" {
+			continue
+		}
+
+		if len(script) > 1 {
+			_, err := io.WriteString(output, "$ "+script[i]+"\n")
+			if err != nil {
+				return err
+			}
+		}
+
+		err := runCmd(ctx, shell, script[i], dir, env, output)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runCmd runs a single command through the passed in shell, writing the combined stdout and stderr to the output
+// as the command runs.  The command is run in its own process group, and if the context is cancelled
+// the entire process group is killed, not just the shell
+func runCmd(ctx context.Context, shell, cmd, dir string, env []string, output io.Writer) error {
+	if strings.TrimSpace(shell) == "" {
+		shell = defaultShell
 	}
 
-	var args []string
+	s := strings.Fields(shell)
+	cmd = strings.Replace(cmd, "@dir", dir, -1)
 
-	if len(s) > 1 {
-		args = s[1:]
+	cmdEnv := make([]string, len(env))
+	for i := range env {
+		cmdEnv[i] = strings.Replace(env[i], "@dir", dir, -1)
 	}
 
-	name := s[0]
-	ec := &exec.Cmd{
-		Path: name,
-		Args: append([]string{name}, args...),
-		Dir:  dir,
-		Env:  env,
+	if len(env) == 0 {
+		cmdEnv = nil
 	}
 
+	name := s[0]
 	if filepath.Base(name) == name {
-		lp, err := lookPath(name, env)
+		lp, err := lookPath(name, cmdEnv)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		ec.Path = lp
+		name = lp
+	}
+
+	ec := exec.CommandContext(ctx, name, append(s[1:], cmd)...)
+	ec.Args[0] = s[0]
+	ec.Dir = dir
+	ec.Env = cmdEnv
+	ec.Stdout = output
+	ec.Stderr = output
+	ec.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	ec.Cancel = func() error {
+		// negative pid kills the whole process group
+		return syscall.Kill(-ec.Process.Pid, syscall.SIGKILL)
 	}
+	// don't wait forever on orphaned processes that are still holding onto the output
+	ec.WaitDelay = processWaitDelay
 
 	vlog("Executing command: %s in dir %s\n", cmd, dir)
 
-	result, err := ec.CombinedOutput()
-	if err != nil {
-		return nil, fmt.Errorf("%s\n%s", err, result)
-	}
-	return result, nil
+	return ec.Run()
 }
 
 // similar to os/exec.LookPath, except it checks if the passed in
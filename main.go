@@ -5,7 +5,9 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/signal"
@@ -16,11 +18,14 @@ import (
 
 //settings
 var (
-	projectDir = "./projects" // /etc/ironsmith/
-	dataDir    = "./data"     // /var/ironsmith/
-	address    = ":8026"
-	certFile   = ""
-	keyFile    = ""
+	projectDir     = "./projects" // /etc/ironsmith/
+	dataDir        = "./data"     // /var/ironsmith/
+	address        = ":8026"
+	certFile       = ""
+	keyFile        = ""
+	signingKeyFile = "" // private key used to sign release files, generated if it doesn't exist
+	secretsKey     = "" // base64 encoded key the secrets store is encrypted with, $IRONSMITH_SECRETS_KEY overrides it
+	externalURL    = "" // address the server is reached at, for links in notifications, i.e. https://ci.example.com
 )
 
 //flags
@@ -37,33 +42,57 @@ func init() {
 	go func() {
 		for sig := range c {
 			if sig == os.Interrupt {
-				projects.stopAll()
-				os.Exit(0)
+				onInterrupt()
 			}
 		}
 	}()
 }
 
+// onInterrupt is run when the program is interrupted
+var onInterrupt = func() {
+	projects.stopAll()
+	os.Exit(0)
+}
+
 func main() {
+	flag.Usage = usage
 	flag.Parse()
 
-	settingPaths := config.StandardFileLocations("ironsmith/settings.json")
-	vlog("IronSmith will use settings files in the following locations (in order of priority):\n")
-	for i := range settingPaths {
-		vlog("\t%s\n", settingPaths[i])
+	switch flag.Arg(0) {
+	case "validate":
+		os.Exit(validateCommand(flag.Args()[1:]))
+	case "run":
+		os.Exit(runCommand(flag.Args()[1:]))
+	case "client":
+		os.Exit(runClient(flag.Args()[1:]))
+	case "hash-password":
+		os.Exit(hashPasswordCommand())
+	case "secrets":
+		os.Exit(secretsCommand(flag.Args()[1:]))
 	}
-	cfg, err := config.LoadOrCreate(settingPaths...)
+
+	cfg := loadSettings()
+
+	projectKeyFiles := make(map[string]string)
+	err := settingValue(cfg.FileName(), "projectSigningKeys", &projectKeyFiles)
 	if err != nil {
-		log.Fatalf("Error loading or creating IronSmith settings file: %s", err)
+		log.Fatalf("Error reading projectSigningKeys setting: %s", err)
 	}
 
-	vlog("IronSmith is currently using the file %s for settings.\n", cfg.FileName())
-
-	projectDir = cfg.String("projectDir", projectDir)
-	dataDir = cfg.String("dataDir", dataDir)
-	address = cfg.String("address", address)
-	certFile = cfg.String("certFile", certFile)
-	keyFile = cfg.String("keyFile", keyFile)
+	err = loadAuth(cfg.FileName())
+	if err != nil {
+		log.Fatalf("Error reading users and apiTokens settings: %s", err)
+	}
+	err = loadRoles(cfg.FileName())
+	if err != nil {
+		log.Fatalf("Error reading roles setting: %s", err)
+	}
+	if !authEnabled() {
+		log.Printf("No users or apiTokens are set, anyone who can reach %s can see every project", address)
+		if len(authRoles) > 0 {
+			log.Printf("The roles setting is ignored without any users or apiTokens")
+		}
+	}
 
 	vlog("Project Definition Directory: %s\n", projectDir)
 	vlog("Project Data Directory: %s\n", dataDir)
@@ -79,6 +108,11 @@ func main() {
 		log.Fatalf("Error Creating project data directory at %s: %s", dataDir, err)
 	}
 
+	err = loadSigningKeys(signingKeyFile, projectKeyFiles)
+	if err != nil {
+		log.Fatalf("Error loading release signing keys: %s", err)
+	}
+
 	err = prepTemplateProject()
 	if err != nil {
 		log.Fatalf("Error Creating project template file: %s", err)
@@ -97,3 +131,56 @@ func main() {
 	}
 
 }
+
+// loadSettings loads the settings file, creating it if it doesn't exist, and reads the simple settings
+func loadSettings() *config.Cfg {
+	settingPaths := config.StandardFileLocations("ironsmith/settings.json")
+	vlog("IronSmith will use settings files in the following locations (in order of priority):\n")
+	for i := range settingPaths {
+		vlog("\t%s\n", settingPaths[i])
+	}
+	cfg, err := config.LoadOrCreate(settingPaths...)
+	if err != nil {
+		log.Fatalf("Error loading or creating IronSmith settings file: %s", err)
+	}
+
+	vlog("IronSmith is currently using the file %s for settings.\n", cfg.FileName())
+
+	projectDir = cfg.String("projectDir", projectDir)
+	dataDir = cfg.String("dataDir", dataDir)
+	address = cfg.String("address", address)
+	certFile = cfg.String("certFile", certFile)
+	keyFile = cfg.String("keyFile", keyFile)
+	signingKeyFile = cfg.String("signingKey", signingKeyFile)
+	secretsKey = cfg.String("secretsKey", secretsKey)
+	externalURL = cfg.String("externalURL", externalURL)
+	secretsFile = filepath.Join(dataDir, secretsFileName)
+	buildQueue.max = cfg.Int("maxConcurrentBuilds", buildQueue.max)
+
+	return cfg
+}
+
+// settingValue reads a setting that isn't a simple value, such as a list or a map, directly from the settings file
+// into result.  If the setting isn't in the file, result is left unchanged
+func settingValue(fileName, name string, result interface{}) error {
+	data, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	settings := make(map[string]json.RawMessage)
+	err = json.Unmarshal(data, &settings)
+	if err != nil {
+		return err
+	}
+
+	value, ok := settings[name]
+	if !ok {
+		return nil
+	}
+
+	return json.Unmarshal(value, result)
+}
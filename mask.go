@@ -0,0 +1,188 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const maskedValue = "****"
+
+// masker replaces secret values in script output before it's logged
+type masker struct {
+	values   []string // longest first, so a value that contains another is masked whole
+	replacer *strings.Replacer
+}
+
+func newMasker(values []string) *masker {
+	unique := make(map[string]bool, len(values))
+	m := &masker{}
+
+	for i := range values {
+		if values[i] == "" || unique[values[i]] {
+			continue
+		}
+		unique[values[i]] = true
+		m.values = append(m.values, values[i])
+	}
+
+	if len(m.values) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(m.values, func(i, j int) bool { return len(m.values[i]) > len(m.values[j]) })
+
+	pairs := make([]string, 0, len(m.values)*2)
+	for i := range m.values {
+		pairs = append(pairs, m.values[i], maskedValue)
+	}
+	m.replacer = strings.NewReplacer(pairs...)
+
+	return m
+}
+
+// mask replaces every secret value in s, a nil masker returns s as is
+func (m *masker) mask(s string) string {
+	if m == nil {
+		return s
+	}
+	return m.replacer.Replace(s)
+}
+
+// split masks the output that's safe to log now, and returns the rest of the output to be held back until more
+// output is written.  Output is held back from the start of any trailing text that could be the beginning of a
+// secret value, so a value that's written in more than one piece is still masked.  If final is set, there is no
+// more output, so nothing is held back
+func (m *masker) split(data []byte, final bool) (string, []byte) {
+	if m == nil || final {
+		return m.mask(string(data)), nil
+	}
+
+	cut := len(data)
+
+	for _, v := range m.values {
+		n := len(v) - 1
+		if n > len(data) {
+			n = len(data)
+		}
+		for ; n > 0; n-- {
+			if bytes.HasSuffix(data, []byte(v[:n])) {
+				if len(data)-n < cut {
+					cut = len(data) - n
+				}
+				break
+			}
+		}
+	}
+
+	// don't cut through the middle of a value that's already complete
+	for moved := true; moved; {
+		moved = false
+		for _, v := range m.values {
+			start := cut - len(v) + 1
+			if start < 0 {
+				start = 0
+			}
+			if i := bytes.Index(data[start:], []byte(v)); i >= 0 && start+i < cut {
+				cut = start + i
+				moved = true
+			}
+		}
+	}
+
+	held := make([]byte, len(data)-cut)
+	copy(held, data[cut:])
+
+	return m.mask(string(data[:cut])), held
+}
+
+// sensitiveValues returns the values of the project's environment variables that are marked as sensitive.  If
+// the project doesn't set the variable, the value from ironsmith's own environment is used, since the scripts
+// inherit it.  Must be called with the project locked
+func (p *Project) sensitiveValues() []string {
+	var values []string
+
+	envs := [][]string{p.Environment}
+	var stageEnvs func(stages []*Stage)
+	stageEnvs = func(stages []*Stage) {
+		for i := range stages {
+			envs = append(envs, stages[i].Environment)
+			stageEnvs(stages[i].Parallel)
+		}
+	}
+	stageEnvs(p.Stages)
+
+	for _, name := range p.Sensitive {
+		found := false
+		for _, env := range envs {
+			for i := range env {
+				if strings.HasPrefix(env[i], name+"=") {
+					values = append(values, strings.TrimPrefix(env[i], name+"="))
+					found = true
+				}
+			}
+		}
+
+		if !found {
+			values = append(values, os.Getenv(name))
+		}
+	}
+
+	return values
+}
+
+// maskLog masks the secrets of the current cycle in an entry before it's logged.  The mask is read without
+// locking, the same as the context in errHandled, because it's only changed by the cycle's own goroutine
+func (p *Project) maskLog(entry string) string {
+	return p.mask.mask(entry)
+}
+
+// maskWriter masks secret values in the output written through it before passing it on, holding back any output
+// that could be the start of a secret until the next write or Flush
+type maskWriter struct {
+	sync.Mutex
+	w       io.Writer
+	project *Project
+	held    []byte
+}
+
+func (m *maskWriter) Write(p []byte) (int, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.project.RLock()
+	mask := m.project.mask
+	m.project.RUnlock()
+
+	out, held := mask.split(append(m.held, p...), false)
+	m.held = held
+
+	_, err := io.WriteString(m.w, out)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush writes out any held back output
+func (m *maskWriter) Flush() error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.project.RLock()
+	mask := m.project.mask
+	m.project.RUnlock()
+
+	out, _ := mask.split(m.held, true)
+	m.held = nil
+
+	_, err := io.WriteString(m.w, out)
+	return err
+}
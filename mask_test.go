@@ -0,0 +1,67 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// maskChunks masks the chunks as if they were written one after the other, holding back output between them
+func maskChunks(m *masker, chunks []string) string {
+	var output string
+	var held []byte
+
+	for _, chunk := range chunks {
+		var out string
+		out, held = m.split(append(held, chunk...), false)
+		output += out
+	}
+
+	out, _ := m.split(held, true)
+	return output + out
+}
+
+func bytesOf(s string) []string {
+	return strings.Split(s, "")
+}
+
+func TestMaskerSplit(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		chunks []string
+		want   string
+	}{
+		{"no secrets", nil, []string{"nothing ", "secret"}, "nothing secret"},
+		{"single write", []string{"hunter2"}, []string{"pw=hunter2!"}, "pw=****!"},
+		{"across chunks", []string{"secret"}, []string{"my sec", "ret here"}, "my **** here"},
+		{"across several chunks", []string{"secret"}, []string{"my s", "ec", "r", "et here"}, "my **** here"},
+		{"ends on a secret", []string{"secret"}, []string{"my sec", "ret"}, "my ****"},
+		{"prefix that isn't a secret", []string{"secret"}, []string{"sec", "ond"}, "second"},
+		{"one byte per write", []string{"hunter2"}, bytesOf("pw=hunter2!"), "pw=****!"},
+		{"repeated in one byte writes", []string{"ab"}, bytesOf("aab ab abab"), "a**** **** ********"},
+		{"value inside another", []string{"abc", "abcdef"}, []string{"xxabc", "def"}, "xx****"},
+		{"value inside another one byte per write", []string{"abc", "abcdef"}, bytesOf("abcdef abc"),
+			"**** ****"},
+		{"overlapping values", []string{"abcd", "cdef"}, []string{"xab", "cdefx"}, "x****efx"},
+		{"overlapping values one byte per write", []string{"abcd", "cdef"}, bytesOf("cdefabcd"), "********"},
+	}
+
+	for _, test := range tests {
+		m := newMasker(test.values)
+
+		got := maskChunks(m, test.chunks)
+		if got != test.want {
+			t.Errorf("%s: want %q got %q", test.name, test.want, got)
+		}
+
+		// the output should be the same no matter how it was split up
+		whole := maskChunks(m, []string{strings.Join(test.chunks, "")})
+		if got != whole {
+			t.Errorf("%s: masked differently when written in one piece want %q got %q", test.name, whole, got)
+		}
+	}
+}
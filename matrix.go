@@ -0,0 +1,222 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// variant is a single combination of the environment variables in a project's build matrix
+type variant struct {
+	name string
+	env  []string
+}
+
+// variants expands the project's matrix into every combination of its values.  Variables are combined in
+// alphabetical order, and a variant's name is its values joined with dashes, i.e. linux-amd64
+func (p *Project) variants() []*variant {
+	p.RLock()
+	defer p.RUnlock()
+
+	if len(p.Matrix) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(p.Matrix))
+	for k := range p.Matrix {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	variants := []*variant{{}}
+
+	for _, key := range keys {
+		var expanded []*variant
+		for _, v := range variants {
+			for _, value := range p.Matrix[key] {
+				name := value
+				if v.name != "" {
+					name = v.name + "-" + value
+				}
+
+				expanded = append(expanded, &variant{
+					name: name,
+					env:  append(append([]string{}, v.env...), key+"="+value),
+				})
+			}
+		}
+		variants = expanded
+	}
+
+	return variants
+}
+
+func (p *Project) setVariant(v *variant) {
+	p.Lock()
+	defer p.Unlock()
+
+	if v != nil {
+		vlog("Building variant %s for Project: %s Version: %s\n", v.name, p.id(), p.version)
+	}
+
+	p.variant = v
+}
+
+// stageName returns the name of the stage prefixed with the current variant if there is one
+func (p *Project) stageName(stage string) string {
+	p.RLock()
+	defer p.RUnlock()
+
+	if p.variant == nil {
+		return stage
+	}
+
+	return p.variant.name + ":" + stage
+}
+
+// env returns the project's environment with the variables passed in with the cycle's trigger, the branch being
+// built, and the current variant's variables added to it
+func (p *Project) env() []string {
+	p.RLock()
+	defer p.RUnlock()
+
+	env := mergeEnv(mergeEnv(p.Environment, p.secretEnv), p.triggerEnv)
+	if p.branch != "" {
+		env = mergeEnv(env, []string{envBranch + "=" + p.branch})
+	}
+
+	if p.variant == nil {
+		return env
+	}
+
+	return mergeEnv(env, p.variant.env)
+}
+
+// runVariants runs the pipeline once for each variant in the project's matrix, each in its own copy of the fetched
+// working dir.  A failing variant doesn't stop the rest from running, but cancelling the cycle does.  Returns true
+// if every variant succeeded
+func (p *Project) runVariants() bool {
+	variants := p.variants()
+	if len(variants) == 0 {
+		return p.runPipeline()
+	}
+
+	baseDir := p.workingDir()
+	success := true
+
+	for _, v := range variants {
+		if p.cycleContext().Err() != nil {
+			// cancelled, the rest of the variants aren't run
+			success = false
+			break
+		}
+
+		p.setVariant(v)
+
+		if p.errHandled(os.RemoveAll(p.workingDir())) {
+			success = false
+			continue
+		}
+
+		if p.errHandled(copyDir(baseDir, p.workingDir())) {
+			success = false
+			continue
+		}
+
+		if !p.runPipeline() {
+			success = false
+			continue
+		}
+
+		p.errHandled(os.RemoveAll(p.workingDir()))
+	}
+
+	p.setVariant(nil)
+
+	if !success {
+		p.errHandled(os.RemoveAll(baseDir))
+	}
+
+	return success
+}
+
+// variantHash returns the suffix used on the working dir for the given variant
+func variantHash(v *variant) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(v.name)))
+}
+
+// copyDir recursively copies the contents of the src directory into the dest directory
+func copyDir(src, dest string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm())
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case !info.Mode().IsRegular():
+			// skip sockets, devices, etc
+			return nil
+		}
+
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(src, dest string, perm os.FileMode) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := in.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := out.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// variantFileName returns the release file name prefixed with the current variant
+func (p *Project) variantFileName(fileName string) string {
+	p.RLock()
+	defer p.RUnlock()
+
+	if p.variant == nil {
+		return fileName
+	}
+
+	return strings.Replace(p.variant.name, string(filepath.Separator), "_", -1) + "-" + fileName
+}
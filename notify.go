@@ -0,0 +1,286 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/timshannon/ironsmith/datastore"
+)
+
+// notification events
+const (
+	notifyFailure = "failure"
+	notifyRelease = "release"
+)
+
+const (
+	notifyAttempts   = 4
+	notifyTimeout    = 10 * time.Second
+	maxNotifyMessage = 2000 // failure messages longer than this are cut off from the front, where the error is last
+)
+
+// notifyRetryDelay is how long to wait before retrying a failed delivery, it doubles after every attempt
+var notifyRetryDelay = 5 * time.Second
+
+var notifyClient = &http.Client{Timeout: notifyTimeout}
+
+// Notify is an HTTP endpoint that's sent a notification whenever one of the project's cycles fails or releases
+type Notify struct {
+	URL    string   `json:"url"`
+	On     []string `json:"on,omitempty"`     // failure, release, or both if not set
+	Secret string   `json:"secret,omitempty"` // if set, the payload is signed in the X-Ironsmith-Signature-256 header
+}
+
+func (n *Notify) sendsOn(event string) bool {
+	if len(n.On) == 0 {
+		return true
+	}
+	for i := range n.On {
+		if n.On[i] == event {
+			return true
+		}
+	}
+	return false
+}
+
+// notification is the JSON payload posted to a project's notify endpoints
+type notification struct {
+	Project  string    `json:"project"`
+	Name     string    `json:"name"`
+	Event    string    `json:"event"`
+	Success  bool      `json:"success"`
+	Branch   string    `json:"branch,omitempty"`
+	Version  string    `json:"version"`
+	Stage    string    `json:"stage"`
+	Message  string    `json:"message,omitempty"`
+	Duration float64   `json:"duration"` // seconds since the version was fetched
+	LogURL   string    `json:"logURL"`
+	When     time.Time `json:"when"`
+}
+
+// failure is the first error of the current version, sent to the notify endpoints once the version is done
+type failure struct {
+	stage   string
+	message string
+}
+
+// serverURL returns the address the server can be reached at for links in notifications, either the externalURL
+// setting, or the address it's listening on
+func serverURL() string {
+	if externalURL != "" {
+		return strings.TrimSuffix(externalURL, "/")
+	}
+
+	scheme := "http://"
+	if certFile != "" && keyFile != "" {
+		scheme = "https://"
+	}
+
+	if strings.HasPrefix(address, ":") {
+		return scheme + "localhost" + address
+	}
+	return scheme + address
+}
+
+// setFailure records the first error of the current version.  Like the context, it's accessed without locking
+// because it's only used by the cycle's own goroutine, and errHandled can be called with the project locked
+func (p *Project) setFailure(message string) {
+	if p.ctx == nil || p.failure != nil {
+		return
+	}
+
+	p.failure = &failure{
+		stage:   p.stage,
+		message: message,
+	}
+}
+
+// sendFailure notifies the project's endpoints of the current version's failure, if it failed
+func (p *Project) sendFailure() {
+	if p.failure == nil {
+		return
+	}
+
+	f := p.failure
+	p.failure = nil
+
+	message := f.message
+	if len(message) > maxNotifyMessage {
+		message = "..." + message[len(message)-maxNotifyMessage:]
+	}
+
+	p.notify(notifyFailure, f.stage, message)
+}
+
+// notify sends the event to each of the project's notify endpoints that want it in the background
+func (p *Project) notify(event, stage, message string) {
+	p.RLock()
+	defer p.RUnlock()
+
+	if len(p.Notify) == 0 {
+		return
+	}
+
+	n := &notification{
+		Project: p.id(),
+		Name:    p.Name,
+		Event:   event,
+		Success: event == notifyRelease,
+		Branch:  p.branch,
+		Version: p.version,
+		Stage:   stage,
+		Message: message,
+		LogURL:  serverURL() + "/project/" + url.PathEscape(p.id()) + "/" + url.PathEscape(p.version),
+		When:    time.Now(),
+	}
+	if p.branch != "" {
+		// logs are by branch, another branch could have built the same version
+		n.LogURL += "?" + branchQuery(p.branch).Encode()
+	}
+	if !p.start.IsZero() {
+		n.Duration = time.Since(p.start).Seconds()
+	}
+
+	payload, err := json.Marshal(n)
+	if err != nil {
+		log.Printf("Error building the %s notification for project %s: %s", event, n.Project, err)
+		return
+	}
+
+	for i := range p.Notify {
+		if p.Notify[i].sendsOn(event) {
+			go p.deliver(p.Notify[i], n, payload)
+		}
+	}
+}
+
+// deliver posts the payload to the endpoint, retrying on network errors, server errors and rate limiting.  The
+// result is recorded in the project's delivery log
+func (p *Project) deliver(endpoint *Notify, n *notification, payload []byte) {
+	d := &datastore.Delivery{
+		URL:     endpoint.URL,
+		Event:   n.Event,
+		Branch:  n.Branch,
+		Version: n.Version,
+	}
+
+	delay := notifyRetryDelay
+
+	for d.Attempts = 1; d.Attempts <= notifyAttempts; d.Attempts++ {
+		retry := false
+		d.StatusCode, retry, d.Error = post(endpoint, payload)
+		if d.Error == "" {
+			d.Delivered = true
+			break
+		}
+		if !retry || d.Attempts == notifyAttempts {
+			break
+		}
+
+		vlog("Notification to %s for project %s failed, retrying in %s: %s\n", endpoint.URL, n.Project, delay,
+			d.Error)
+		time.Sleep(delay)
+		delay *= 2
+	}
+
+	if !d.Delivered {
+		log.Printf("Error sending the %s notification for project %s to %s: %s", n.Event, n.Project,
+			endpoint.URL, d.Error)
+	}
+
+	p.RLock()
+	defer p.RUnlock()
+
+	if p.ds == nil {
+		return
+	}
+
+	err := p.ds.AddDelivery(d)
+	if err != nil {
+		log.Printf("Error recording the notification delivery for project %s: %s", n.Project, err)
+	}
+}
+
+// post sends the payload to the endpoint once, returning the response's status code, whether or not a failure
+// should be retried, and why it failed
+func post(endpoint *Notify, payload []byte) (status int, retry bool, failure string) {
+	req, err := http.NewRequest("POST", endpoint.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, false, err.Error()
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ironsmith")
+	if endpoint.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(endpoint.Secret))
+		_, _ = mac.Write(payload)
+		req.Header.Set("X-Ironsmith-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	res, err := notifyClient.Do(req)
+	if err != nil {
+		return 0, true, err.Error()
+	}
+	_ = res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res.StatusCode, false, ""
+	}
+
+	retry = res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests
+	return res.StatusCode, retry, fmt.Sprintf("Endpoint responded with %s", res.Status)
+}
+
+/*notify routes
+/notify/<project-id>
+	Lists the deliveries of the project's notifications, newest first
+*/
+func notifyGet(w http.ResponseWriter, r *http.Request) {
+	prj, _, _ := splitPath(r.URL.Path)
+
+	project, ok := projects.get(prj)
+	role := requestRole(r, prj)
+	if !ok || role < roleViewer {
+		four04(w, r)
+		return
+	}
+
+	if rolesEnabled() && role < roleAdmin {
+		// endpoint urls often have tokens in them
+		errHandled(&Fail{
+			Message:    "Only admins can see this project's notification deliveries",
+			HTTPStatus: http.StatusForbidden,
+		}, w, r)
+		return
+	}
+
+	deliveries, err := project.deliveries()
+	if errHandled(err, w, r) {
+		return
+	}
+
+	respondJsend(w, &JSend{
+		Status: statusSuccess,
+		Data:   deliveries,
+	})
+}
+
+func (p *Project) deliveries() ([]*datastore.Delivery, error) {
+	p.RLock()
+	defer p.RUnlock()
+
+	return p.ds.Deliveries()
+}
@@ -0,0 +1,133 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+// Stage is a single named step in a project's pipeline
+type Stage struct {
+	Name        string   `json:"name"`
+	Script      Script   `json:"script"`
+	Environment []string `json:"environment,omitempty"` // added to the project's environment, overriding any matching keys
+	Timeout     string   `json:"timeout,omitempty"`     // if not set, the project's default timeout is used
+
+	Parallel []*Stage `json:"parallel,omitempty"` // steps run at the same time in place of the stage's script
+	FailFast bool     `json:"failFast,omitempty"` // cancel the rest of the parallel steps as soon as one fails
+
+	timeout time.Duration
+}
+
+// pipeline returns the stages to run after a new version has been fetched, and whether or not the release file
+// should be stored once every stage has completed.  If the project doesn't define its own stages, then
+// the build, test, and release scripts are used, stopping at the first one that isn't set
+func (p *Project) pipeline() (stages []*Stage, release bool) {
+	p.RLock()
+	defer p.RUnlock()
+
+	if len(p.Stages) > 0 {
+		return p.Stages, true
+	}
+
+	shorthand := []struct {
+		name   string
+		stage  string
+		script Script
+	}{
+		{"build", stageBuild, p.Build},
+		{"test", stageTest, p.Test},
+		{"release", stageRelease, p.Release},
+	}
+
+	for i := range shorthand {
+		if len(shorthand[i].script) == 0 {
+			return stages, false
+		}
+
+		timeout, ok := p.timeouts[shorthand[i].name]
+		if !ok {
+			timeout = p.timeout
+		}
+
+		stages = append(stages, &Stage{
+			Name:    shorthand[i].stage,
+			Script:  shorthand[i].script,
+			timeout: timeout,
+		})
+	}
+
+	return stages, true
+}
+
+// firstStage returns the name of the first stage run after a new version is fetched
+func (p *Project) firstStage() string {
+	stages, _ := p.pipeline()
+	if len(stages) == 0 {
+		return stageFetch
+	}
+
+	variants := p.variants()
+	if len(variants) > 0 {
+		return variants[0].name + ":" + stages[0].Name
+	}
+
+	return stages[0].Name
+}
+
+// setStages sets the project's stages, and parses their timeouts
+func (p *Project) setStages(stages []*Stage) {
+	p.Stages = stages
+	p.setTimeouts(p.Stages, p.timeout)
+}
+
+// setTimeouts parses the timeouts of the stages and any of their parallel steps. Parallel steps without a timeout
+// use the timeout of their stage
+func (p *Project) setTimeouts(stages []*Stage, defaultTimeout time.Duration) {
+	for i := range stages {
+		stages[i].timeout = defaultTimeout
+		if stages[i].Timeout != "" {
+			timeout, err := time.ParseDuration(stages[i].Timeout)
+			if !p.errHandled(err) {
+				stages[i].timeout = timeout
+			}
+		}
+
+		p.setTimeouts(stages[i].Parallel, stages[i].timeout)
+	}
+}
+
+// mergeEnv returns the base environment with the overrides added to it.  Any keys in the base that are also
+// in the overrides are replaced.  If the base environment is empty, the current process's environment is used
+func mergeEnv(base, overrides []string) []string {
+	if len(overrides) == 0 {
+		return base
+	}
+
+	if len(base) == 0 {
+		base = os.Environ()
+	}
+
+	env := make([]string, 0, len(base)+len(overrides))
+
+	for i := range base {
+		key := strings.SplitN(base[i], "=", 2)[0]
+		overridden := false
+		for k := range overrides {
+			if strings.SplitN(overrides[k], "=", 2)[0] == key {
+				overridden = true
+				break
+			}
+		}
+
+		if !overridden {
+			env = append(env, base[i])
+		}
+	}
+
+	return append(env, overrides...)
+}
@@ -5,9 +5,11 @@
 package main
 
 import (
+	"context"
 	"crypto/sha1"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -15,6 +17,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/robfig/cron"
 	"github.com/timshannon/ironsmith/datastore"
 )
 
@@ -25,6 +28,7 @@ const (
 
 //stages
 const (
+	stageQueued   = "queued"
 	stageLoad     = "loading"
 	stageFetch    = "fetching"
 	stageBuild    = "building"
@@ -32,6 +36,8 @@ const (
 	stageRelease  = "releasing"
 	stageReleased = "released"
 	stageWait     = "waiting"
+	stageCancel   = "cancelled"
+	stageInvalid  = "invalid"
 )
 
 const projectFilePoll = 30 * time.Second
@@ -49,41 +55,120 @@ type Project struct {
 	Name string `json:"name"` // name of the project
 
 	Environment []string `json:"environment"` // Environment for each of the scripts below, if empty will use the current processes environment
+	Shell       string   `json:"shell,omitempty"`
 
-	Fetch   string `json:"fetch"`   //Script to fetch the latest project code into the current directory
-	Build   string `json:"build"`   //Script to build the latest project code
-	Test    string `json:"test"`    //Script to test the latest project code
-	Release string `json:"release"` //Script to build the release of latest project code
+	Secrets   map[string]string `json:"secrets,omitempty"`   // Environment variables set to secrets from the secrets store, by secret name
+	Sensitive []string          `json:"sensitive,omitempty"` // Environment variables whose values are masked in the logs, like secrets
 
-	Version string `json:"version"` //Script to generate the version num of the current build, should be indempotent
+	Fetch   Script `json:"fetch"`   //Script to fetch the latest project code into the current directory
+	Build   Script `json:"build"`   //Script to build the latest project code
+	Test    Script `json:"test"`    //Script to test the latest project code
+	Release Script `json:"release"` //Script to build the release of latest project code
 
-	ReleaseFile   string `json:"releaseFile"`
-	PollInterval  string `json:"pollInterval,omitempty"`  // if not poll interval is specified, this project is trigger only
-	TriggerSecret string `json:"triggerSecret,omitempty"` //secret to be included with a trigger call
-	MaxVersions   int    `json:"maxVersions,omitempty"`   // Max number of versions to keep in the project datastore
+	Version Script `json:"version"` //Script to generate the version num of the current build, should be indempotent
+
+	Stages []*Stage `json:"stages,omitempty"` // Ordered stages run after fetch, if set they are used in place of build, test and release
+
+	Matrix map[string][]string `json:"matrix,omitempty"` // Environment variables and their values, the pipeline is run for every combination
+
+	Branches   []string `json:"branches,omitempty"`   // branches built separately, each with their own versions, can include glob patterns
+	BranchList Script   `json:"branchList,omitempty"` // Script that lists the repository's branches, one per line, to match against the branch patterns
+
+	ReleaseFile   string   `json:"releaseFile"`
+	ReleaseFiles  []string `json:"releaseFiles,omitempty"`  // additional release files, paths can include glob patterns
+	PollInterval  string   `json:"pollInterval,omitempty"`  // if not poll interval is specified, this project is trigger only
+	Schedule      string   `json:"schedule,omitempty"`      // cron expression for when to run the project, alongside or instead of polling
+	TriggerSecret string   `json:"triggerSecret,omitempty"` //secret to be included with a trigger call
+	MaxVersions   int      `json:"maxVersions,omitempty"`   // Max number of versions to keep in the project datastore
+	Priority      int      `json:"priority,omitempty"`      // queued cycles with a higher priority run first
+	Triggers      []string `json:"triggers,omitempty"`      // ids of projects to run after every successful release
+
+	Notify []*Notify `json:"notify,omitempty"` // endpoints sent a notification whenever a cycle fails or releases
+
+	Timeout  string            `json:"timeout,omitempty"`  // default timeout for every script, if not set scripts never time out
+	Timeouts map[string]string `json:"timeouts,omitempty"` // timeouts for specific scripts: fetch, version, build, test, release
 
 	filename string
 	poll     time.Duration
+	schedule cron.Schedule
+	timer    *time.Timer // runs the next polled or scheduled cycle
+	nextRun  time.Time
+	timeout  time.Duration
+	timeouts map[string]time.Duration
 	ds       *datastore.Store
 	stage    string
 	status   string
 	version  string
 	hash     string
+	branch   string
+	variant  *variant
 	start    time.Time // the last start time of the latest cycle
+	ctx      context.Context
+	cancel   context.CancelFunc
+
+	releaseFiles []*datastore.ReleaseFile // files stored for the release of the current version
+	triggerEnv   []string                 // environment passed in with whatever triggered the current cycle
+	secretEnv    []string                 // the project's secrets, only decrypted for the length of a cycle
+	mask         *masker                  // masks the values of the secrets and sensitive variables in the cycle's logs
+	failure      *failure                 // the first error of the current version, for notifications
+	cancelLogged bool                     // whether the cancellation of the current cycle has been logged
+	steps        map[string]bool          // log names of the parallel steps that are currently running
+	problems     []string                 // why the project file is invalid
+	warnings     []string                 // what in the project file might stop it from running
+	output       io.Writer                // if set, script output is copied here as it runs, i.e. to the terminal
 
 	sync.RWMutex
 	processing sync.Mutex
 }
 
+// Script is one or more lines to be run through the project's shell. In the project file
+// a script can be either a single string, or an array of strings which will be run in order, each in its own shell
+type Script []string
+
+// MarshalJSON implements JSON marshaler, single line scripts are written as a string
+func (s Script) MarshalJSON() ([]byte, error) {
+	if len(s) == 0 {
+		return json.Marshal("")
+	}
+	if len(s) == 1 {
+		return json.Marshal(s[0])
+	}
+
+	return json.Marshal([]string(s))
+}
+
+// UnmarshalJSON implements JSON unmarshaler
+func (s *Script) UnmarshalJSON(data []byte) error {
+	var line string
+	if err := json.Unmarshal(data, &line); err == nil {
+		*s = nil
+		if strings.TrimSpace(line) != "" {
+			*s = Script{line}
+		}
+		return nil
+	}
+
+	var lines []string
+	err := json.Unmarshal(data, &lines)
+	if err != nil {
+		return err
+	}
+
+	*s = Script(lines)
+	return nil
+}
+
 func (p *Project) errHandled(err error) bool {
 	if err == nil {
 		return false
 	}
 
-	vlog("Error in project %s: %s\n", p.id(), err)
+	msg := p.maskLog(err.Error())
+
+	vlog("Error in project %s: %s\n", p.id(), msg)
 
 	if p.ds == nil {
-		log.Printf("Error in project %s: %s\n", p.id(), err)
+		log.Printf("Error in project %s: %s\n", p.id(), msg)
 		return true
 	}
 	defer func() {
@@ -98,10 +183,34 @@ func (p *Project) errHandled(err error) bool {
 		}
 	}()
 
-	lerr := p.ds.AddLog(p.version, p.stage, err.Error())
+	// the context is read without locking, because errHandled can be called while the project is already locked
+	// i.e. from setData.  It's only ever changed at the start and end of a cycle, by the cycle's own goroutine, and
+	// the same goes for cancelLogged
+	if p.ctx != nil && p.ctx.Err() == context.Canceled {
+		// every step left in the cycle fails once it's cancelled, but the cancellation is only logged once
+		if p.cancelLogged {
+			return true
+		}
+		p.cancelLogged = true
+
+		lerr := p.ds.AddLog(p.branch, p.version, stageCancel, fmt.Sprintf("Cycle was cancelled during the %s stage", p.stage))
+		if lerr != nil {
+			log.Printf("Error logging the cancellation of project %s: %s", p.id(), lerr)
+		}
+		return true
+	}
+
+	p.setFailure(msg)
+
+	if _, ok := err.(*loggedError); ok {
+		// already written to the stage log
+		return true
+	}
+
+	lerr := p.ds.AddLog(p.branch, p.version, p.stage, msg)
 	if lerr != nil {
 		log.Printf("Error logging an error in project %s: Original error %s, Logging Error: %s",
-			p.id(), err, lerr)
+			p.id(), msg, lerr)
 	}
 
 	return true
@@ -131,6 +240,10 @@ func (p *Project) workingDir() string {
 	// timestamp on instead would work just fine, but I like having the working dir tied directly to the
 	// version returned by project script
 
+	if p.variant != nil {
+		return filepath.Join(p.dir(), p.hash+"-"+variantHash(p.variant))
+	}
+
 	return filepath.Join(p.dir(), p.hash)
 }
 
@@ -189,24 +302,47 @@ func (p *Project) setStage(stage string) {
 	p.stage = stage
 }
 
+func (p *Project) setProblems(problems, warnings []string) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.problems = problems
+	p.warnings = warnings
+}
+
 type webProject struct {
 	ID             string         `json:"id"`
 	Name           string         `json:"name"`
 	ReleaseVersion string         `json:"releaseVersion"` //last successfully released version
 	Stage          string         `json:"stage"`          // current stage
 	LastLog        *datastore.Log `json:"lastLog"`
+	Branch         string         `json:"branch,omitempty"`        // branch currently being built
+	Branches       []string       `json:"branches,omitempty"`      // every branch that has been built
+	NextRun        *time.Time     `json:"nextRun,omitempty"`       // when the next polled or scheduled cycle will run
+	QueuePosition  int            `json:"queuePosition,omitempty"` // place in the build queue, if the project is waiting to run
+	Problems       []string       `json:"problems,omitempty"`      // why the project file is invalid
+	Warnings       []string       `json:"warnings,omitempty"`      // what in the project file might stop it from running
 }
 
-func (p *Project) webData() (*webProject, error) {
+// webData returns the project's current state, if branch is set the last log and release are from that branch
+func (p *Project) webData(branch string) (*webProject, error) {
+	// found before the project is locked, so the queue and project locks are never held at the same time
+	position := buildQueue.position(p)
+
 	p.RLock()
 	defer p.RUnlock()
 
-	last, err := p.ds.LastVersion("")
+	last, err := p.ds.LastVersion(branch, "")
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := p.ds.LastVersion(branch, stageReleased)
 	if err != nil {
 		return nil, err
 	}
 
-	release, err := p.ds.LastVersion(stageRelease)
+	branches, err := p.ds.Branches()
 	if err != nil {
 		return nil, err
 	}
@@ -217,57 +353,174 @@ func (p *Project) webData() (*webProject, error) {
 		ReleaseVersion: release.Version,
 		Stage:          p.stage,
 		LastLog:        last,
+		Branch:         p.branch,
+		Branches:       branches,
+		NextRun:        p.nextCycle(),
+		QueuePosition:  position,
+		Problems:       p.problems,
+		Warnings:       p.warnings,
 	}
 
 	return d, nil
 }
 
-func (p *Project) versions() ([]*datastore.Log, error) {
+func (p *Project) versions(branch string) ([]*datastore.Log, error) {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.ds.Versions()
+	return p.ds.Versions(branch)
 }
 
-func (p *Project) versionLog(version string) ([]*datastore.Log, error) {
+func (p *Project) versionLog(branch, version string) ([]*datastore.Log, error) {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.ds.VersionLog(version)
+	return p.ds.VersionLog(branch, version)
 }
 
-func (p *Project) stageLog(version, stage string) (*datastore.Log, error) {
+func (p *Project) stageLog(branch, version, stage string) (*datastore.Log, error) {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.ds.StageLog(version, stage)
+	return p.ds.StageLog(branch, version, stage)
 }
 
-func (p *Project) releases() ([]*datastore.Release, error) {
+// stageOutput returns the key of the stage's log entry, and its output starting at the chunk from
+func (p *Project) stageOutput(branch, version, stage string, from uint32) (datastore.TimeKey, string, uint32, error) {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.ds.Releases()
+	return p.ds.StageOutput(branch, version, stage, from)
+}
+
+// startCycle sets up the context for a new cycle, which can be stopped early with cancelCycle.  The passed in
+// environment variables are added to the environment of every script run in the cycle
+func (p *Project) startCycle(env []string) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	p.triggerEnv = env
+	p.failure = nil
+	p.cancelLogged = false
+}
+
+// endCycle releases the context of the completed cycle
+func (p *Project) endCycle() {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.ctx = nil
+	p.cancel = nil
+	p.secretEnv = nil
+	p.mask = nil
+}
+
+// cancelCycle cancels the currently running cycle, killing any running scripts.  Returns false if there is no
+// cycle currently running
+func (p *Project) cancelCycle() bool {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.cancel == nil {
+		return false
+	}
+
+	vlog("Cancelling the current cycle for Project: %s\n", p.id())
+	p.cancel()
+	return true
 }
 
-func (p *Project) lastRelease() (*datastore.Release, error) {
+// cycleContext returns the context for the currently running cycle
+func (p *Project) cycleContext() context.Context {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.ds.LastRelease()
+	if p.ctx == nil {
+		return context.Background()
+	}
+	return p.ctx
 }
-func (p *Project) releaseData(version string) (*datastore.Release, error) {
+
+// running returns whether or not the given version and stage, or parallel step, are currently being processed.  If
+// branch is blank, the version can be from any branch
+func (p *Project) running(branch, version, stage string) bool {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.ds.Release(version)
+	return (branch == "" || p.branch == branch) && p.version == version && (p.stage == stage || p.steps[stage])
 }
 
-func (p *Project) releaseFile(fileKey datastore.TimeKey) ([]byte, error) {
+// setStepRunning records whether or not the parallel step with the given log name is running
+func (p *Project) setStepRunning(step string, running bool) {
+	p.Lock()
+	defer p.Unlock()
+
+	if !running {
+		delete(p.steps, step)
+		return
+	}
+
+	if p.steps == nil {
+		p.steps = make(map[string]bool)
+	}
+	p.steps[step] = true
+}
+
+func (p *Project) releases(branch string) ([]*datastore.Release, error) {
+	p.RLock()
+	defer p.RUnlock()
+
+	return p.ds.Releases(branch)
+}
+
+func (p *Project) lastRelease(branch string) (*datastore.Release, error) {
+	p.RLock()
+	defer p.RUnlock()
+
+	return p.ds.LastRelease(branch)
+}
+func (p *Project) releaseData(branch, version string) (*datastore.Release, error) {
+	p.RLock()
+	defer p.RUnlock()
+
+	return p.ds.Release(branch, version)
+}
+
+// releasePatterns returns the paths and glob patterns of all of the project's release files
+func (p *Project) releasePatterns() []string {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.ds.ReleaseFile(fileKey)
+	var patterns []string
+	if p.ReleaseFile != "" {
+		patterns = append(patterns, p.ReleaseFile)
+	}
+
+	return append(patterns, p.ReleaseFiles...)
+}
+
+// openReleaseFile opens the release file and verifies its checksum.  Only opening the file is done with the project
+// locked, so that verifying a large file doesn't hold up the running cycle, or anyone else reading the project
+func (p *Project) openReleaseFile(file *datastore.ReleaseFile) (io.ReadSeekCloser, error) {
+	p.RLock()
+	ds := p.ds
+	f, err := ds.OpenFile(file)
+	p.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ds.VerifyFile(f, file)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return f, nil
 }
 
 // releaseFile
@@ -278,6 +531,9 @@ func (p *Project) setData(new *Project) {
 
 	p.Name = new.Name
 	p.Environment = new.Environment
+	p.Secrets = new.Secrets
+	p.Sensitive = new.Sensitive
+	p.Shell = new.Shell
 
 	p.Fetch = new.Fetch
 	p.Build = new.Build
@@ -286,10 +542,17 @@ func (p *Project) setData(new *Project) {
 	p.Version = new.Version
 
 	p.ReleaseFile = new.ReleaseFile
+	p.ReleaseFiles = new.ReleaseFiles
 	p.PollInterval = new.PollInterval
 	p.TriggerSecret = new.TriggerSecret
+	p.Branches = new.Branches
+	p.BranchList = new.BranchList
 	p.MaxVersions = new.MaxVersions
+	p.Priority = new.Priority
+	p.Triggers = new.Triggers
+	p.Notify = new.Notify
 
+	p.poll = 0
 	if p.PollInterval != "" {
 		var err error
 		p.poll, err = time.ParseDuration(p.PollInterval)
@@ -297,6 +560,44 @@ func (p *Project) setData(new *Project) {
 			p.poll = 0
 		}
 	}
+
+	p.setSchedule(new.Schedule)
+
+	p.Timeout = new.Timeout
+	p.Timeouts = new.Timeouts
+
+	p.timeout = 0
+	if p.Timeout != "" {
+		var err error
+		p.timeout, err = time.ParseDuration(p.Timeout)
+		if p.errHandled(err) {
+			p.timeout = 0
+		}
+	}
+
+	p.timeouts = make(map[string]time.Duration, len(p.Timeouts))
+	for name, timeout := range p.Timeouts {
+		d, err := time.ParseDuration(timeout)
+		if p.errHandled(err) {
+			continue
+		}
+		p.timeouts[name] = d
+	}
+
+	p.setStages(new.Stages)
+	p.Matrix = new.Matrix
+}
+
+// scriptTimeout returns the timeout for the named script, falling back to the project's default timeout
+func (p *Project) scriptTimeout(name string) time.Duration {
+	p.RLock()
+	defer p.RUnlock()
+
+	if timeout, ok := p.timeouts[name]; ok {
+		return timeout
+	}
+
+	return p.timeout
 }
 
 func (p *Project) close() error {
@@ -318,11 +619,12 @@ const projectTemplateFilename = "template.project.json"
 
 var projectTemplate = &Project{
 	Name:    "Template Project",
-	Fetch:   "git clone root@git.townsourced.com:tshannon/ironsmith.git .",
-	Build:   "go build -a -v -o ironsmith",
-	Test:    "go test ./...",
-	Release: "tar -czf release.tar.gz ironsmith",
-	Version: "git describe --tags --long",
+	Shell:   defaultShell,
+	Fetch:   Script{"git clone root@git.townsourced.com:tshannon/ironsmith.git ."},
+	Build:   Script{"go build -a -v -o ironsmith"},
+	Test:    Script{"go test ./..."},
+	Release: Script{"tar -czf release.tar.gz ironsmith"},
+	Version: Script{"git describe --tags --long"},
 
 	ReleaseFile:  "release.tar.gz",
 	PollInterval: "15m",
@@ -396,6 +698,12 @@ func (p *projectList) load() error {
 		}
 	}
 
+	err = watchProjects()
+	if err != nil {
+		log.Printf("Error watching the project directory, changes will be found by polling every %s: %s\n",
+			projectFilePoll, err)
+	}
+
 	time.AfterFunc(projectFilePoll, startProjectLoader)
 
 	return nil
@@ -410,10 +718,16 @@ func (p *projectList) get(name string) (*Project, bool) {
 }
 
 func (p *projectList) add(name string) {
-	vlog("Adding project %s to the project list.\n", name)
 	p.Lock()
 	defer p.Unlock()
 
+	if _, ok := p.data[projectID(name)]; ok {
+		// already added by either the project file watcher or poller
+		return
+	}
+
+	vlog("Adding project %s to the project list.\n", name)
+
 	prj := &Project{
 		filename: name,
 		Name:     name,
@@ -444,13 +758,31 @@ func (p *projectList) removeMissing(names []string) {
 			}
 		}
 		if !found {
-			vlog("Removing project %s from the project list, because the project file was removed.\n",
-				i)
-			delete(p.data, i)
+			p.removeProject(i)
 		}
 	}
 }
 
+// remove removes the project with the given file name from the project list
+func (p *projectList) remove(name string) {
+	p.Lock()
+	defer p.Unlock()
+
+	if _, ok := p.data[projectID(name)]; ok {
+		p.removeProject(projectID(name))
+	}
+}
+
+// removeProject removes the project from the list, and runs its load so that its data is moved to the deleted
+// folder right away rather than at its next poll.  Must be called with the list locked
+func (p *projectList) removeProject(id string) {
+	vlog("Removing project %s from the project list, because the project file was removed.\n", id)
+	prj := p.data[id]
+	delete(p.data, id)
+
+	go prj.load(false)
+}
+
 func (p *projectList) stopAll() {
 	p.RLock()
 	defer p.RUnlock()
@@ -463,14 +795,19 @@ func (p *projectList) stopAll() {
 	}
 }
 
-func (p *projectList) webList() ([]*webProject, error) {
+// webList returns every project the user is allowed to view
+func (p *projectList) webList(branch, user string) ([]*webProject, error) {
 	p.RLock()
 	defer p.RUnlock()
 
 	list := make([]*webProject, 0, len(p.data))
 
 	for i := range p.data {
-		prj, err := p.data[i].webData()
+		if userRole(user, p.data[i].id()) < roleViewer {
+			continue
+		}
+
+		prj, err := p.data[i].webData(branch)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,122 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// cycleQueue limits how many project cycles can run at once.  Cycles that can't run yet wait in the queue, and are
+// started highest priority first, then in the order they were queued
+type cycleQueue struct {
+	sync.Mutex
+	max     int // if 0, there is no limit
+	running int
+	pending []*queuedCycle
+	next    uint64
+}
+
+type queuedCycle struct {
+	project  *Project
+	priority int
+	order    uint64
+	ready    chan struct{}
+}
+
+var buildQueue = &cycleQueue{}
+
+// wait blocks until the project's cycle can run.  If the context is cancelled while waiting, the cycle is removed
+// from the queue and the context's error is returned.  If nil is returned, then done must be called once the cycle
+// completes
+func (q *cycleQueue) wait(ctx context.Context, p *Project) error {
+	// read before the queue is locked, so the queue and project locks are never held at the same time
+	priority := p.priority()
+
+	q.Lock()
+	if q.max <= 0 || (q.running < q.max && len(q.pending) == 0) {
+		q.running++
+		q.Unlock()
+		return nil
+	}
+
+	c := &queuedCycle{
+		project:  p,
+		priority: priority,
+		order:    q.next,
+		ready:    make(chan struct{}),
+	}
+	q.next++
+	q.pending = append(q.pending, c)
+	sort.SliceStable(q.pending, func(i, j int) bool {
+		if q.pending[i].priority != q.pending[j].priority {
+			return q.pending[i].priority > q.pending[j].priority
+		}
+		return q.pending[i].order < q.pending[j].order
+	})
+	q.Unlock()
+
+	vlog("Project: %s is queued behind %d running cycles\n", p.id(), q.max)
+
+	select {
+	case <-c.ready:
+		return nil
+	case <-ctx.Done():
+		q.Lock()
+		defer q.Unlock()
+
+		for i := range q.pending {
+			if q.pending[i] == c {
+				q.pending = append(q.pending[:i], q.pending[i+1:]...)
+				return ctx.Err()
+			}
+		}
+
+		// the cycle was started at the same time it was cancelled, so give up its spot
+		q.release()
+		return ctx.Err()
+	}
+}
+
+// done frees up the spot of a completed cycle, and starts the next one in the queue
+func (q *cycleQueue) done() {
+	q.Lock()
+	defer q.Unlock()
+
+	q.release()
+}
+
+func (q *cycleQueue) release() {
+	q.running--
+
+	for len(q.pending) > 0 && (q.max <= 0 || q.running < q.max) {
+		c := q.pending[0]
+		q.pending = q.pending[1:]
+		q.running++
+		close(c.ready)
+	}
+}
+
+// position returns the project's place in the queue starting at 1, or 0 if it's not waiting in the queue
+func (q *cycleQueue) position(p *Project) int {
+	q.Lock()
+	defer q.Unlock()
+
+	for i := range q.pending {
+		if q.pending[i].project == p {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+func (p *Project) priority() int {
+	p.RLock()
+	defer p.RUnlock()
+
+	return p.Priority
+}
@@ -0,0 +1,108 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+)
+
+// role is what a user or api token is allowed to do with a project, each role can do everything the roles below it
+// can
+type role int
+
+const (
+	roleNone      role = iota
+	roleViewer         // see the project's logs and download its releases
+	roleTriggerer      // trigger and cancel cycles without the trigger secret, if the project has one
+	roleAdmin          // trigger and cancel cycles of any project, even those without a trigger secret
+)
+
+var roleNames = map[string]role{
+	"viewer":    roleViewer,
+	"triggerer": roleTriggerer,
+	"admin":     roleAdmin,
+}
+
+func (r role) String() string {
+	for name := range roleNames {
+		if roleNames[name] == r {
+			return name
+		}
+	}
+	return ""
+}
+
+// authRoles are the roles of each user and api token name, by project id or project id pattern
+var authRoles map[string]map[string]role
+
+// loadRoles reads the roles setting, i.e. {"tim": {"*": "admin"}, "ci": {"web-*": "triggerer"}}
+func loadRoles(settingsFile string) error {
+	var settings map[string]map[string]string
+
+	err := settingValue(settingsFile, "roles", &settings)
+	if err != nil {
+		return err
+	}
+
+	authRoles = nil
+	if len(settings) == 0 {
+		return nil
+	}
+
+	authRoles = make(map[string]map[string]role, len(settings))
+	for user, projects := range settings {
+		authRoles[user] = make(map[string]role, len(projects))
+		for pattern, name := range projects {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("Invalid project pattern %q for %s: %s", pattern, user, err)
+			}
+
+			r, ok := roleNames[name]
+			if !ok {
+				return fmt.Errorf("Invalid role %q for %s, must be one of viewer, triggerer, or admin", name, user)
+			}
+			authRoles[user][pattern] = r
+		}
+	}
+
+	return nil
+}
+
+// rolesEnabled is whether or not access to projects is limited by role, if it isn't every user can view every
+// project, and triggering a project always needs its trigger secret
+func rolesEnabled() bool {
+	return authEnabled() && len(authRoles) > 0
+}
+
+// userRole returns the highest role the user has from every project pattern that matches the project id
+func userRole(user, projectID string) role {
+	if !rolesEnabled() {
+		return roleViewer
+	}
+
+	result := roleNone
+	for pattern, r := range authRoles[user] {
+		if match, _ := path.Match(pattern, projectID); match && r > result {
+			result = r
+		}
+	}
+
+	return result
+}
+
+// requestRole returns the role of the request's user for the project
+func requestRole(r *http.Request, projectID string) role {
+	return userRole(requestUser(r), projectID)
+}
+
+// requestRoleName returns the name of the request's role for the project, or blank if roles aren't set
+func requestRoleName(r *http.Request, projectID string) string {
+	if !rolesEnabled() {
+		return ""
+	}
+	return requestRole(r, projectID).String()
+}
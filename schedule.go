@@ -0,0 +1,85 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+// setSchedule parses the project's cron schedule, must be called with the project locked
+func (p *Project) setSchedule(schedule string) {
+	p.Schedule = schedule
+	p.schedule = nil
+
+	if schedule == "" {
+		return
+	}
+
+	s, err := cron.ParseStandard(schedule)
+	if p.errHandled(err) {
+		return
+	}
+
+	p.schedule = s
+}
+
+// scheduleNext sets the timer for the project's next cycle, whichever comes first of the poll interval from now,
+// or the next time in the project's schedule.  Any previously set timer is replaced, so there is only ever one
+// cycle waiting to run no matter how the last cycle was started
+func (p *Project) scheduleNext() {
+	p.stopTimer()
+
+	p.Lock()
+	defer p.Unlock()
+
+	now := time.Now()
+	next := time.Time{}
+
+	if p.poll > 0 {
+		next = now.Add(p.poll)
+	}
+
+	if p.schedule != nil {
+		scheduled := p.schedule.Next(now)
+		if !scheduled.IsZero() && (next.IsZero() || scheduled.Before(next)) {
+			next = scheduled
+		}
+	}
+
+	p.nextRun = next
+
+	if next.IsZero() {
+		// trigger only
+		return
+	}
+
+	p.timer = time.AfterFunc(next.Sub(now), func() {
+		p.load(false)
+	})
+}
+
+// stopTimer stops the project's next polled or scheduled cycle from running
+func (p *Project) stopTimer() {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	p.nextRun = time.Time{}
+}
+
+// nextCycle returns when the project's next cycle is set to run, or nil if it only runs when triggered
+func (p *Project) nextCycle() *time.Time {
+	if p.nextRun.IsZero() {
+		return nil
+	}
+
+	next := p.nextRun
+	return &next
+}
@@ -0,0 +1,314 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	secretsFileName = "secrets.json"
+	envSecretsKey   = "IRONSMITH_SECRETS_KEY"
+)
+
+var errNoSecretsKey = errors.New("No key is set for the secrets store, set secretsKey in the settings file or " +
+	envSecretsKey + ", a new key can be generated with: ironsmith secrets key")
+
+// secretsFile is the secrets store in the data dir, set when the settings are loaded
+var secretsFile = filepath.Join(dataDir, secretsFileName)
+
+var validSecretName = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// secretStore holds secrets encrypted with AES-256-GCM in a file in the data dir.  Each value is encrypted
+// separately, with its name as additional data, so values can't be swapped between names
+type secretStore struct {
+	sync.Mutex
+	file string
+	aead cipher.AEAD
+}
+
+// openSecrets returns the secrets store, using the key from the environment or the settings
+func openSecrets() (*secretStore, error) {
+	encoded := os.Getenv(envSecretsKey)
+	if encoded == "" {
+		encoded = secretsKey
+	}
+	if encoded == "" {
+		return nil, errNoSecretsKey
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(key) != 32 {
+		return nil, errors.New("The secrets key must be 32 bytes encoded in base64")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretStore{
+		file: secretsFile,
+		aead: aead,
+	}, nil
+}
+
+// generateSecretsKey returns a new random key for the secrets store, encoded in base64
+func generateSecretsKey() (string, error) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// read returns the encrypted values of every secret, by name
+func (s *secretStore) read() (map[string][]byte, error) {
+	data, err := ioutil.ReadFile(s.file)
+	if os.IsNotExist(err) {
+		return make(map[string][]byte), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]byte)
+	err = json.Unmarshal(data, &values)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid secrets file %s: %s", s.file, err)
+	}
+	return values, nil
+}
+
+// write replaces the secrets file, only the ironsmith user can read it
+func (s *secretStore) write(values map[string][]byte) error {
+	data, err := json.MarshalIndent(values, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	temp := s.file + ".tmp"
+	err = ioutil.WriteFile(temp, data, 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(temp, s.file)
+}
+
+func (s *secretStore) get(name string) (string, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	values, err := s.read()
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := values[name]
+	if !ok {
+		return "", fmt.Errorf("Secret %s doesn't exist", name)
+	}
+
+	size := s.aead.NonceSize()
+	if len(value) < size {
+		return "", fmt.Errorf("Secret %s is corrupt", name)
+	}
+
+	plain, err := s.aead.Open(nil, value[:size], value[size:], []byte(name))
+	if err != nil {
+		return "", fmt.Errorf("Secret %s can't be decrypted, it may have been stored with a different key", name)
+	}
+
+	return string(plain), nil
+}
+
+func (s *secretStore) set(name, value string) error {
+	if !validSecretName.MatchString(name) {
+		return fmt.Errorf("Invalid secret name %q, names can only contain letters, numbers, _ . and -", name)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	values, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+
+	values[name] = s.aead.Seal(nonce, nonce, []byte(value), []byte(name))
+
+	return s.write(values)
+}
+
+func (s *secretStore) remove(name string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	values, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := values[name]; !ok {
+		return fmt.Errorf("Secret %s doesn't exist", name)
+	}
+	delete(values, name)
+
+	return s.write(values)
+}
+
+func (s *secretStore) names() ([]string, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	values, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// loadSecrets decrypts the secrets the project uses for the environment of the current cycle's scripts, and masks
+// them and the values of the project's sensitive variables in the cycle's logs
+func (p *Project) loadSecrets() error {
+	p.Lock()
+	defer p.Unlock()
+
+	p.secretEnv = nil
+	values := p.sensitiveValues()
+	p.mask = newMasker(values)
+
+	if len(p.Secrets) == 0 {
+		return nil
+	}
+
+	store, err := openSecrets()
+	if err != nil {
+		return err
+	}
+
+	env := make([]string, 0, len(p.Secrets))
+	for variable, name := range p.Secrets {
+		value, err := store.get(name)
+		if err != nil {
+			return err
+		}
+		env = append(env, variable+"="+value)
+		values = append(values, value)
+	}
+	sort.Strings(env)
+
+	p.secretEnv = env
+	p.mask = newMasker(values)
+
+	return nil
+}
+
+// secretsCommand manages the secrets store from the command line
+func secretsCommand(args []string) int {
+	usage := func() int {
+		fmt.Fprintln(os.Stderr, `Usage:
+  ironsmith secrets key		generate a new key for the secrets store
+  ironsmith secrets list		list the names of every secret
+  ironsmith secrets set <name>	set a secret to the value read from stdin
+  ironsmith secrets remove <name>	remove a secret`)
+		return exitError
+	}
+
+	if len(args) == 0 {
+		return usage()
+	}
+
+	if args[0] == "key" {
+		key, err := generateSecretsKey()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error generating key: %s\n", err)
+			return exitError
+		}
+		fmt.Println(key)
+		return exitSuccess
+	}
+
+	loadSettings()
+
+	store, err := openSecrets()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		return exitError
+	}
+
+	switch {
+	case args[0] == "list" && len(args) == 1:
+		names, err := store.names()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading secrets: %s\n", err)
+			return exitError
+		}
+		for i := range names {
+			fmt.Println(names[i])
+		}
+	case args[0] == "set" && len(args) == 2:
+		fmt.Fprintf(os.Stderr, "Value of %s, end with Ctrl-D: ", args[1])
+		value, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading value: %s\n", err)
+			return exitError
+		}
+
+		err = os.MkdirAll(filepath.Dir(secretsFile), 0777)
+		if err == nil {
+			err = store.set(args[1], strings.TrimRight(string(value), "\r\n"))
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "\nError setting secret: %s\n", err)
+			return exitError
+		}
+		fmt.Fprintf(os.Stderr, "\nSecret %s set\n", args[1])
+	case args[0] == "remove" && len(args) == 2:
+		err = store.remove(args[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error removing secret: %s\n", err)
+			return exitError
+		}
+	default:
+		return usage()
+	}
+
+	return exitSuccess
+}
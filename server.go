@@ -84,16 +84,49 @@ log Routes
 	/log/<project-id> - list all versions in a project, triggers new builds
 	/log/<project-id>/<version> - list combined output of all stages for a given version
 	/log/<project-id>/<version>/<stage> - list output of a given stage of a given version
+		?follow streams the output of the stage as server sent events
+	?branch=<branch> on /log/ and /log/<project-id> lists only what was built from the given branch
 
 release routes
-	/release/<project-id>/<version>
+	/release/<project-id>/<version>/<file-name>
 
 	/release/<project-id> - list last release for a given project  ?all returns all the releases for a project
-	/release/<project-id>/<version> - list release for a given project version
+		?branch=<branch> only includes releases built from the given branch
+	/release/<project-id>/<version> - list release and its files for a given project version
+		?sig returns the signature of the release's first file
+	/release/<project-id>/<version>/<file-name> - download a single file from a release
+		?sig returns the file's detached ed25519 signature
+	/release/<project-id>/<version>/SHA256SUMS - checksums of every file in a release
+
+key routes
+	/key/<project-id>
+
+	/key/ - the server's public key for verifying release signatures
+	/key/<project-id> - the public key for verifying a given project's release signatures
 
 trigger routes
 	/trigger/<project-id>
 		Triggers a project to start a cycle
+
+cancel routes
+	/cancel/<project-id>
+		Cancels the currently running cycle of a project
+
+hook routes
+	/hook/<service>/<project-id>
+		Triggers a project from the push event webhook of a hosted git service: github, gitlab, or gitea
+
+notify routes
+	/notify/<project-id>
+		Lists the deliveries of a project's notifications, newest first
+
+login routes
+	/login
+		GET the logged in user, POST a username and password to log in, DELETE to log out
+
+If any users or apiTokens are set, the log, release, trigger, cancel, and notify routes need either a session cookie from
+/login or an "Authorization: Bearer <token>" header.  Hooks are authorized by their project's secret, and public
+keys and the web UI's assets are always available
 */
 
 func routes() {
@@ -111,16 +144,38 @@ func routes() {
 		get: assetGet,
 	})
 
-	webRoot.Handle("/log/", &methodHandler{
+	webRoot.Handle("/log/", authenticate(&methodHandler{
 		get: logGet,
-	})
+	}))
 
-	webRoot.Handle("/release/", &methodHandler{
+	webRoot.Handle("/release/", authenticate(&methodHandler{
 		get: releaseGet,
+	}))
+
+	webRoot.Handle("/key/", &methodHandler{
+		get: keyGet,
 	})
 
-	webRoot.Handle("/trigger/", &methodHandler{
+	webRoot.Handle("/trigger/", authenticate(&methodHandler{
 		post: triggerPost,
+	}))
+
+	webRoot.Handle("/cancel/", authenticate(&methodHandler{
+		post: cancelPost,
+	}))
+
+	webRoot.Handle("/notify/", authenticate(&methodHandler{
+		get: notifyGet,
+	}))
+
+	webRoot.Handle("/hook/", &methodHandler{
+		post: hookPost,
+	})
+
+	webRoot.Handle("/login", &methodHandler{
+		get:    loginGet,
+		post:   loginPost,
+		delete: loginDelete,
 	})
 
 }
@@ -0,0 +1,140 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/timshannon/ironsmith/datastore"
+)
+
+// signing keys are ed25519 private keys stored in PEM encoded PKCS #8 files, the same format generated by
+// openssl genpkey -algorithm ed25519.  If a configured key file doesn't exist, a new key is generated for it
+var (
+	signingKey         ed25519.PrivateKey
+	projectSigningKeys = make(map[string]ed25519.PrivateKey)
+)
+
+// loadSigningKeys loads the server's signing key and any project specific signing keys
+func loadSigningKeys(serverKeyFile string, projectKeyFiles map[string]string) error {
+	var err error
+	if serverKeyFile != "" {
+		signingKey, err = loadSigningKey(serverKeyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	for id, filename := range projectKeyFiles {
+		projectSigningKeys[id], err = loadSigningKey(filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func loadSigningKey(filename string) (ed25519.PrivateKey, error) {
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		vlog("Generating a new signing key in %s\n", filename)
+		return generateSigningKey(filename)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("No PEM data found in the signing key file %s", filename)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the signing key file %s: %s", filename, err)
+	}
+
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("The signing key in %s is not an ed25519 key", filename)
+	}
+
+	return edKey, nil
+}
+
+func generateSigningKey(filename string) (ed25519.PrivateKey, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ioutil.WriteFile(filename, pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: data,
+	}), 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// publicKeyPEM returns the PEM encoded public key of the passed in signing key
+func publicKeyPEM(key ed25519.PrivateKey) ([]byte, error) {
+	data, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: data,
+	}), nil
+}
+
+// signingKey returns the key used to sign the project's release files, if the project doesn't have its own key
+// the server's key is used.  If neither are set, then nil is returned and releases aren't signed
+func (p *Project) signingKey() ed25519.PrivateKey {
+	return projectSigningKey(p.id())
+}
+
+// projectSigningKey returns the signing key for the project id, whether or not a project with that id exists
+func projectSigningKey(id string) ed25519.PrivateKey {
+	if key, ok := projectSigningKeys[id]; ok {
+		return key
+	}
+
+	return signingKey
+}
+
+// signReleaseFile signs the SHA-256 checksum of the release file. The signature is over the raw 32 bytes of the
+// checksum, rather than the whole file, so large files don't need to be loaded into memory to be verified
+func signReleaseFile(key ed25519.PrivateKey, file *datastore.ReleaseFile) error {
+	if file.SHA256 == "" {
+		return errors.New("Release files without a checksum can't be signed")
+	}
+
+	sum, err := hex.DecodeString(file.SHA256)
+	if err != nil {
+		return err
+	}
+
+	file.Signature = ed25519.Sign(key, sum)
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/timshannon/ironsmith/datastore"
+)
+
+const stageFlushInterval = 1 * time.Second
+
+// stageWriter captures the output of a running stage and periodically appends it to a single log entry in the
+// project's datastore, so the output can be followed while the stage is still running
+type stageWriter struct {
+	sync.Mutex
+	ds      *datastore.Store
+	key     datastore.TimeKey
+	branch  string
+	version string
+	stage   string
+	mask    *masker
+	buff    bytes.Buffer
+	err     error
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+// loggedError is an error that has already been written to a stage's log, and doesn't need to be logged again
+type loggedError struct {
+	error
+}
+
+func newStageWriter(ds *datastore.Store, mask *masker, branch, version, stage string) (*stageWriter, error) {
+	s := &stageWriter{
+		ds:      ds,
+		mask:    mask,
+		key:     datastore.NewTimeKey(),
+		branch:  branch,
+		version: version,
+		stage:   stage,
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	// create the empty entry right away so followers know the stage has started
+	err := ds.AppendLog(s.key, branch, version, stage, "")
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		defer close(s.stopped)
+		ticker := time.NewTicker(stageFlushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.flush(false)
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+
+	return s, nil
+}
+
+func (s *stageWriter) Write(p []byte) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	return s.buff.Write(p)
+}
+
+// flush appends the buffered output to the log, masking any secrets.  Unless it's the final flush, output that
+// could be the start of a secret is held back until the next flush
+func (s *stageWriter) flush(final bool) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.err != nil || s.buff.Len() == 0 {
+		return s.err
+	}
+
+	entry, held := s.mask.split(s.buff.Bytes(), final)
+	s.buff.Reset()
+	s.buff.Write(held)
+
+	if entry == "" {
+		return nil
+	}
+
+	s.err = s.ds.AppendLog(s.key, s.branch, s.version, s.stage, entry)
+
+	return s.err
+}
+
+// fail writes the error to the end of the stage's log and closes the writer.  The returned error
+// won't be logged again by errHandled
+func (s *stageWriter) fail(err error) error {
+	_, _ = s.Write([]byte("\n" + err.Error()))
+
+	cerr := s.Close()
+	if cerr != nil {
+		return cerr
+	}
+	return &loggedError{err}
+}
+
+// Close stops the periodic flushing and writes out any remaining output
+func (s *stageWriter) Close() error {
+	select {
+	case <-s.stop:
+		return s.err
+	default:
+		close(s.stop)
+	}
+
+	<-s.stopped
+
+	return s.flush(true)
+}
+
+// teeOutput returns a writer that also copies the script output to the project's output, if it has one
+func (p *Project) teeOutput(w io.Writer) io.Writer {
+	if p.output == nil {
+		return w
+	}
+
+	return io.MultiWriter(w, p.output)
+}
@@ -0,0 +1,162 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/timshannon/ironsmith/datastore"
+)
+
+// environment passed to projects triggered by an upstream release
+const (
+	envUpstreamProject     = "IRONSMITH_UPSTREAM_PROJECT"
+	envUpstreamBranch      = "IRONSMITH_UPSTREAM_BRANCH"
+	envUpstreamVersion     = "IRONSMITH_UPSTREAM_VERSION"
+	envUpstreamRelease     = "IRONSMITH_UPSTREAM_RELEASE"      // path to the first release file
+	envUpstreamReleaseName = "IRONSMITH_UPSTREAM_RELEASE_NAME" // file name of the first release file
+)
+
+// triggerDownstream starts a cycle of every project the released project triggers.  Returns the ids of the projects
+// that were triggered
+func (p *Project) triggerDownstream(files []*datastore.ReleaseFile) []string {
+	p.RLock()
+	ids := p.Triggers
+	env := []string{
+		envUpstreamProject + "=" + p.id(),
+		envUpstreamVersion + "=" + p.version,
+	}
+	if p.branch != "" {
+		env = append(env, envUpstreamBranch+"="+p.branch)
+	}
+	p.RUnlock()
+
+	if len(files) > 0 {
+		path, err := p.ds.FilePath(files[0])
+		if err != nil && err != datastore.ErrNotFound {
+			p.errHandled(err)
+		}
+		if path != "" {
+			// the path is in this project's artifact store, so it's only valid until this version is trimmed
+			env = append(env, envUpstreamRelease+"="+path, envUpstreamReleaseName+"="+files[0].FileName)
+		}
+	}
+
+	var triggered []string
+
+	for _, id := range ids {
+		downstream, ok := projects.get(id)
+		if !ok {
+			vlog("Project: %s can't trigger Project: %s because it doesn't exist\n", p.id(), id)
+			continue
+		}
+
+		vlog("Project: %s Version: %s triggered Project: %s\n", p.id(), p.version, id)
+		go downstream.load(true, env...)
+		triggered = append(triggered, id)
+	}
+
+	return triggered
+}
+
+// checkTriggers looks for a cycle in the trigger graph of the enabled project files that includes this project.
+// If one is found, the project's triggers are cleared so that the cycle isn't endlessly rebuilt
+func (p *Project) checkTriggers() {
+	p.RLock()
+	triggers := p.Triggers
+	p.RUnlock()
+
+	if len(triggers) == 0 {
+		return
+	}
+
+	graph, err := triggerGraph()
+	if p.errHandled(err) {
+		return
+	}
+	graph[p.id()] = triggers
+
+	cycle := findCycle(graph, p.id())
+	if cycle == nil {
+		return
+	}
+
+	p.errHandled(fmt.Errorf("Project triggers form a cycle: %s.  No downstream projects will be triggered",
+		strings.Join(cycle, " -> ")))
+
+	p.Lock()
+	p.Triggers = nil
+	p.Unlock()
+}
+
+// triggerGraph reads the triggers from every enabled project file
+func triggerGraph() (map[string][]string, error) {
+	dir := filepath.Join(projectDir, enabledProjectDir)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := make(map[string][]string, len(files))
+
+	for i := range files {
+		if files[i].IsDir() || filepath.Ext(files[i].Name()) != ".json" {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, files[i].Name()))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		prj := &Project{}
+		if json.Unmarshal(data, prj) != nil {
+			// invalid project files are reported when they are loaded
+			continue
+		}
+
+		graph[projectID(files[i].Name())] = prj.Triggers
+	}
+
+	return graph, nil
+}
+
+// findCycle returns the path of the first cycle found that leads back to start, or nil if there isn't one.  Each
+// project is only explored once, because a project that doesn't lead back to start won't no matter how it's reached
+func findCycle(graph map[string][]string, start string) []string {
+	explored := make(map[string]bool)
+
+	var search func(id string, path []string) []string
+	search = func(id string, path []string) []string {
+		if id == start && len(path) > 0 {
+			return append(path, id)
+		}
+		if explored[id] {
+			// either already found not to lead back to start, or part of a cycle that doesn't include start,
+			// which will be reported by one of the projects in it
+			return nil
+		}
+		explored[id] = true
+
+		path = append(path, id)
+		for _, next := range graph[id] {
+			if cycle := search(next, path); cycle != nil {
+				return cycle
+			}
+		}
+
+		return nil
+	}
+
+	return search(start, nil)
+}
@@ -0,0 +1,313 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+var validEnvName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// shell builtins and keywords that can start a script line, but aren't executables on the PATH
+var shellBuiltins = map[string]bool{
+	".": true, ":": true, "[": true, "alias": true, "break": true, "case": true, "cd": true, "command": true,
+	"continue": true, "do": true, "done": true, "echo": true, "elif": true, "else": true, "esac": true,
+	"eval": true, "exec": true, "exit": true, "export": true, "false": true, "fi": true, "for": true,
+	"if": true, "printf": true, "pwd": true, "read": true, "return": true, "set": true, "shift": true,
+	"source": true, "test": true, "then": true, "trap": true, "true": true, "type": true, "ulimit": true,
+	"umask": true, "unset": true, "until": true, "wait": true, "while": true, "{": true, "}": true,
+	"(": true, ")": true, "!": true,
+}
+
+// stages ironsmith logs on its own, which a project's stages can't be named
+var reservedStages = map[string]bool{
+	stageQueued: true, stageLoad: true, stageFetch: true, stageReleased: true, stageWait: true, stageCancel: true,
+	stageInvalid: true,
+}
+
+// validateProject parses the project file data, and checks the definition for every problem that would stop the
+// project from running correctly.  Warnings are things that might stop it from running, but can't be known for sure
+// until the scripts run, and don't make the project invalid.  If the data can't be parsed, the returned project is nil
+func validateProject(data []byte) (prj *Project, problems, warnings []string) {
+	prj = &Project{}
+	err := json.Unmarshal(data, prj)
+	if err != nil {
+		if serr, ok := err.(*json.SyntaxError); ok {
+			line := bytes.Count(data[:serr.Offset], []byte("\n")) + 1
+			return nil, []string{fmt.Sprintf("Invalid JSON on line %d: %s", line, err)}, nil
+		}
+		return nil, []string{fmt.Sprintf("Invalid project file: %s", err)}, nil
+	}
+
+	problem := func(format string, a ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, a...))
+	}
+
+	raw := make(map[string]interface{})
+	if json.Unmarshal(data, &raw) == nil {
+		for _, key := range unknownKeys(raw, reflect.TypeOf(Project{}), "") {
+			problem("Unknown setting %s", key)
+		}
+	}
+
+	if len(prj.Fetch) == 0 {
+		problem("The fetch script is required")
+	}
+	if strings.TrimSpace(strings.Join(prj.Version, "")) == "" {
+		problem("The version script is required")
+	}
+
+	duration := func(name, value string) {
+		if value == "" {
+			return
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			problem("%s %q is not a valid duration, i.e. 30s, 15m, or 1h: %s", name, value, err)
+		} else if d <= 0 {
+			problem("%s %q must be greater than zero", name, value)
+		}
+	}
+
+	duration("pollInterval", prj.PollInterval)
+	duration("timeout", prj.Timeout)
+	for name, timeout := range prj.Timeouts {
+		duration("timeouts."+name, timeout)
+	}
+
+	if prj.Schedule != "" {
+		if _, err := cron.ParseStandard(prj.Schedule); err != nil {
+			problem("schedule %q is not a valid cron expression: %s", prj.Schedule, err)
+		}
+	}
+
+	for variable, name := range prj.Secrets {
+		if !validEnvName.MatchString(variable) {
+			problem("secrets variable %q is not a valid environment variable name", variable)
+		}
+		if !validSecretName.MatchString(name) {
+			problem("secrets name %q for %s is not a valid secret name", name, variable)
+		}
+	}
+
+	for _, variable := range prj.Sensitive {
+		if !validEnvName.MatchString(variable) {
+			problem("sensitive variable %q is not a valid environment variable name", variable)
+		}
+	}
+
+	for i, n := range prj.Notify {
+		if n == nil {
+			problem("notify[%d] must be an object with a url", i)
+			continue
+		}
+		u, err := url.Parse(n.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problem("notify[%d] url %q must be a full http or https url", i, n.URL)
+		}
+		for _, event := range n.On {
+			if event != notifyFailure && event != notifyRelease {
+				problem("notify[%d] on %q must be either %s or %s", i, event, notifyFailure, notifyRelease)
+			}
+		}
+	}
+
+	if prj.MaxVersions < 0 {
+		problem("maxVersions must be zero for no limit, or a positive number of versions to keep")
+	}
+
+	var checkStages func(stages []*Stage, path string)
+	checkStages = func(stages []*Stage, path string) {
+		for i, stage := range stages {
+			name := fmt.Sprintf("%s[%d]", path, i)
+			if stage.Name == "" {
+				problem("%s needs a name", name)
+			} else {
+				name = fmt.Sprintf("%s %q", path, stage.Name)
+			}
+
+			if reservedStages[stage.Name] {
+				problem("%s is the name of one of ironsmith's own stages", name)
+			}
+			// : separates variant and parallel step names, and / separates the parts of the log urls
+			if strings.ContainsAny(stage.Name, ":/") {
+				problem("%s can't have a : or / in its name", name)
+			}
+
+			if len(stage.Script) == 0 && len(stage.Parallel) == 0 {
+				problem("%s needs either a script or parallel steps", name)
+			}
+			if len(stage.Script) > 0 && len(stage.Parallel) > 0 {
+				problem("%s can't have both a script and parallel steps", name)
+			}
+
+			duration(name+" timeout", stage.Timeout)
+			checkStages(stage.Parallel, name+" parallel")
+		}
+	}
+	checkStages(prj.Stages, "stages")
+
+	keys := make([]string, 0, len(prj.Matrix))
+	for key := range prj.Matrix {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if len(prj.Matrix[key]) == 0 {
+			problem("matrix %s needs at least one value", key)
+		}
+		// values are part of the variant's stage and log names, the same as stage names
+		for _, value := range prj.Matrix[key] {
+			if strings.ContainsAny(value, ":/") {
+				problem("matrix %s value %q can't have a : or /", key, value)
+			}
+		}
+	}
+
+	for _, missing := range prj.missingExecutables() {
+		warnings = append(warnings, fmt.Sprintf("%s was not found on the PATH", missing))
+	}
+
+	return prj, problems, warnings
+}
+
+// unknownKeys returns the keys in the raw json object that don't match a field of the passed in struct type,
+// checking nested structs and slices of structs as well
+func unknownKeys(raw map[string]interface{}, t reflect.Type, path string) []string {
+	var unknown []string
+
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || f.Anonymous {
+			continue
+		}
+
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = f.Type
+	}
+
+	for key, value := range raw {
+		ft, ok := fields[strings.ToLower(key)]
+		if !ok {
+			unknown = append(unknown, path+key)
+			continue
+		}
+
+		for ft.Kind() == reflect.Ptr || ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.Struct {
+			continue
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			unknown = append(unknown, unknownKeys(v, ft, path+key+".")...)
+		case []interface{}:
+			for i := range v {
+				if obj, ok := v[i].(map[string]interface{}); ok {
+					unknown = append(unknown, unknownKeys(obj, ft, fmt.Sprintf("%s%s[%d].", path, key, i))...)
+				}
+			}
+		}
+	}
+
+	sort.Strings(unknown)
+	return unknown
+}
+
+// missingExecutables returns the shell, and any commands at the start of the project's script lines that can't be
+// found on the PATH the script runs with.  Commands in the fetched code, i.e. ./build.sh, and commands installed by
+// an earlier script line can't be checked ahead of time, so a missing command is only a warning
+func (p *Project) missingExecutables() []string {
+	var missing []string
+	checked := make(map[string]bool)
+
+	check := func(name string, env []string) {
+		path := ""
+		for i := range env {
+			if strings.HasPrefix(env[i], "PATH=") {
+				path = env[i]
+			}
+		}
+
+		if checked[name] || checked[name+"\x00"+path] {
+			return
+		}
+		checked[name+"\x00"+path] = true
+
+		if _, err := lookPath(name, env); err != nil {
+			checked[name] = true
+			missing = append(missing, name)
+		}
+	}
+
+	checkScript := func(script Script, env []string) {
+		for _, line := range script {
+			fields := strings.Fields(line)
+
+			// skip any leading variable assignments, i.e. GOOS=linux go build
+			for len(fields) > 0 && strings.Contains(fields[0], "=") {
+				fields = fields[1:]
+			}
+			if len(fields) == 0 {
+				continue
+			}
+
+			cmd := fields[0]
+			if shellBuiltins[cmd] || strings.ContainsAny(cmd, "$`\"'(){};|&<>@*?") ||
+				(strings.Contains(cmd, "/") && !strings.HasPrefix(cmd, "/")) {
+				continue
+			}
+
+			check(cmd, env)
+		}
+	}
+
+	env := mergeEnv(p.Environment, nil)
+
+	shell := p.Shell
+	if shell == "" {
+		shell = defaultShell
+	}
+	if s := strings.Fields(shell); len(s) > 0 {
+		check(s[0], env)
+	}
+
+	for _, script := range []Script{p.Fetch, p.Version, p.Build, p.Test, p.Release, p.BranchList} {
+		checkScript(script, env)
+	}
+
+	// stages and their parallel steps add their own environment to their parent's, which can change the PATH
+	var checkStages func(stages []*Stage, env []string)
+	checkStages = func(stages []*Stage, env []string) {
+		for i := range stages {
+			stageEnv := mergeEnv(env, stages[i].Environment)
+			checkScript(stages[i].Script, stageEnv)
+			checkStages(stages[i].Parallel, stageEnv)
+		}
+	}
+	checkStages(p.Stages, env)
+
+	return missing
+}
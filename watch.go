@@ -0,0 +1,125 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// projectRemoveDelay is how long to wait after a project file is removed or renamed before removing the project.
+// Many editors save by renaming the original file away and creating a new one in its place, which shouldn't
+// delete the project's history
+const projectRemoveDelay = 5 * time.Second
+
+// watchProjects watches the enabled project directory so that project files which are added, changed, or removed
+// are acted on right away.  The project directory is still polled in case the watcher misses anything
+func watchProjects() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	err = watcher.Add(filepath.Join(projectDir, enabledProjectDir))
+	if err != nil {
+		_ = watcher.Close()
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				projectFileChanged(event)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Printf("Error watching the project directory: %s\n", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func projectFileChanged(event fsnotify.Event) {
+	name := filepath.Base(event.Name)
+	if filepath.Ext(name) != ".json" {
+		return
+	}
+
+	if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+		time.AfterFunc(projectRemoveDelay, func() { removeIfMissing(event.Name) })
+		return
+	}
+
+	info, err := os.Stat(event.Name)
+	if os.IsNotExist(err) {
+		// removed again before it could be read, the removal event will handle it
+		return
+	}
+	if err != nil {
+		log.Printf("Error reading the changed project file %s: %s\n", event.Name, err)
+		return
+	}
+
+	if info.IsDir() || event.Op&(fsnotify.Create|fsnotify.Write) == 0 {
+		return
+	}
+
+	prj, ok := projects.get(projectID(name))
+	if !ok {
+		projects.add(name)
+		return
+	}
+
+	go prj.reload()
+}
+
+// removeIfMissing removes the project if its file still doesn't exist
+func removeIfMissing(filename string) {
+	_, err := os.Stat(filename)
+	if os.IsNotExist(err) {
+		projects.remove(filepath.Base(filename))
+	}
+}
+
+// reload applies the changes in the project file without waiting for the project's next cycle, i.e. a new poll
+// interval or schedule takes effect right away.  If a cycle is running, the changes are applied once it completes
+func (p *Project) reload() {
+	p.processing.Lock()
+	defer p.processing.Unlock()
+
+	vlog("Reloading the changed project file for Project: %s\n", p.id())
+
+	p.RLock()
+	wasInvalid := p.stage == stageInvalid
+	p.RUnlock()
+
+	p.setStage(stageLoad)
+	p.setVersion("Version not yet set")
+
+	if !p.loadFile() {
+		return
+	}
+
+	p.setStage(stageWait)
+
+	if wasInvalid {
+		// the project never got to run while it was invalid
+		go p.load(false)
+		return
+	}
+
+	p.scheduleNext()
+}
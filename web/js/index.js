@@ -21,7 +21,14 @@ Ractive.DEBUG = false;
                 projects: [],
                 error: null,
                 formatDate: formatDate,
+                encode: encodeURIComponent,
+                branchQuery: branchQuery,
                 releases: {},
+                branch: null,
+                login: false,
+                user: null,
+                username: "",
+                password: "",
             };
         },
         decorators: {
@@ -36,18 +43,76 @@ Ractive.DEBUG = false;
         },
     });
 
+    unauthorized = function() {
+        r.set({
+            "login": true,
+            "error": null,
+        });
+    };
+
+    getUser();
     setPaths();
 
 
     r.on({
         "triggerBuild": function(event) {
             event.original.preventDefault();
-            var secret = window.prompt("Please enter the trigger secret for this project:");
-            triggerBuild(r.get("project.id"), secret);
+            triggerBuild(r.get("project.id"), triggerSecret());
+        },
+        "cancelBuild": function(event) {
+            event.original.preventDefault();
+            cancelBuild(r.get("project.id"), triggerSecret());
+        },
+        "login": function(event) {
+            event.original.preventDefault();
+            ajax("POST", "/login", {
+                    username: r.get("username"),
+                    password: r.get("password"),
+                },
+                function(result) {
+                    window.location.reload();
+                },
+                function(result) {
+                    r.set({
+                        "password": "",
+                        "error": err(result).message,
+                    });
+                });
+        },
+        "logout": function(event) {
+            event.original.preventDefault();
+            ajax("DELETE", "/login", null,
+                function(result) {
+                    window.location = "/";
+                },
+                function(result) {
+                    r.set("error", err(result).message);
+                });
         },
     });
 
 
+    function getUser() {
+        get("/login",
+            function(result) {
+                r.set("user", result.data.user);
+            },
+            function(result) {
+                r.set("error", err(result).message);
+            });
+    }
+
+
+    // triggerers and admins don't need the project's trigger secret
+    function triggerSecret() {
+        var role = r.get("project.role");
+        if (role == "triggerer" || role == "admin") {
+            return "";
+        }
+        return window.prompt("Please enter the trigger secret for this project:");
+    }
+
+
     function triggerBuild(projectID, secret) {
         ajax("POST", "/trigger/" + projectID, {
                 secret: secret
@@ -61,6 +126,19 @@ Ractive.DEBUG = false;
     }
 
 
+    function cancelBuild(projectID, secret) {
+        ajax("POST", "/cancel/" + projectID, {
+                secret: secret
+            },
+            function(result) {
+                window.location = "/";
+            },
+            function(result) {
+                r.set("error", err(result).message);
+            });
+    }
+
+
     function setPaths() {
         var paths = window.location.pathname.split("/");
 
@@ -74,14 +152,15 @@ Ractive.DEBUG = false;
         }
 
         if (paths[1] == "project") {
+            var branch = queryParam("branch");
             if (paths[2]) {
                 if (paths[3]) {
                     if (paths[4]) {
-                        getStage(paths[2], paths[3], paths[4]);
+                        getStage(paths[2], paths[3], paths[4], branch);
                     }
-                    getVersion(paths[2], paths[3]);
+                    getVersion(paths[2], paths[3], branch);
                 }
-                getProject(paths[2]);
+                getProject(paths[2], branch);
             }
             getProjects();
             return;
@@ -117,13 +196,14 @@ Ractive.DEBUG = false;
             });
     }
 
-    function getProject(id) {
-        get("/log/" + id,
+    function getProject(id, branch) {
+        get("/log/" + id + branchQuery(branch),
             function(result) {
+                r.set("branch", branch);
                 r.set("project", result.data);
                 if (result.data.versions) {
                     for (var i = 0; i < result.data.versions.length; i++) {
-                        hasRelease(result.data.id, result.data.versions[i].version);
+                        hasRelease(result.data.id, result.data.versions[i].version, result.data.versions[i].branch);
                     }
                 }
             },
@@ -132,8 +212,9 @@ Ractive.DEBUG = false;
             });
     }
 
-    function getVersion(id, version) {
-        get("/log/" + id + "/" + version,
+    function getVersion(id, version, branch) {
+        hasRelease(id, version, branch);
+        get("/log/" + id + "/" + version + branchQuery(branch),
             function(result) {
                 if (!result.data || !result.data.length || !result.data[0].version) {
                     r.set("version", version);
@@ -147,25 +228,48 @@ Ractive.DEBUG = false;
             });
     }
 
-    function getStage(id, version, stage) {
-        get("/log/" + id + "/" + version + "/" + stage,
+    function getStage(id, version, stage, branch) {
+        get("/log/" + id + "/" + version + "/" + stage + branchQuery(branch),
             function(result) {
                 r.set("logs", result.data);
                 r.set("currentStage", stage);
+                followStage(id, version, stage, branch);
             },
             function(result) {
                 r.set("error", err(result).message);
             });
     }
 
-    function hasRelease(id, version) {
+    function followStage(id, version, stage, branch) {
+        if (!window.EventSource) {
+            return;
+        }
+
+        var output = "";
+        var query = branchQuery(branch);
+        var source = new EventSource("/log/" + id + "/" + version + "/" + stage + (query ? query + "&" : "?") +
+            "follow");
+
+        source.onmessage = function(event) {
+            output += event.data;
+            r.set("logs.log", output);
+        };
+        source.addEventListener("done", function() {
+            source.close();
+        });
+        source.addEventListener("error", function() {
+            source.close();
+        });
+    }
+
+    function hasRelease(id, version, branch) {
         /*/release/<project-id>/<version>*/
-        get("/release/" + id + "/" + version,
+        get("/release/" + id + "/" + version + branchQuery(branch),
             function(result) {
-                r.set("releases." + id + version, result.data);
+                r.set("releases." + id + version + (branch || ""), result.data);
             },
             function(result) {
-                r.set("releases." + id + version, undefined);
+                r.set("releases." + id + version + (branch || ""), undefined);
             });
 
 
@@ -173,7 +277,11 @@ Ractive.DEBUG = false;
 
     function setStatus(project) {
         //statuses 
-        if (project.stage != "waiting") {
+        if (project.stage == "invalid") {
+            project.status = "Invalid Project File";
+        } else if (project.stage == "queued" && project.queuePosition) {
+            project.status = "queued #" + project.queuePosition;
+        } else if (project.stage != "waiting") {
             project.status = project.stage;
         } else if (!project.lastLog || !project.lastLog.version) {
             project.status = "waiting";
@@ -190,6 +298,10 @@ Ractive.DEBUG = false;
                 project.status = "Tests Failing";
             } else if (project.lastLog.stage == "releasing") {
                 project.status = "Release Failing";
+            } else if (project.lastLog.stage == "cancelled") {
+                project.status = "Cancelled";
+            } else if (project.lastLog.stage) {
+                project.status = project.lastLog.stage + " Failing";
             } else {
                 project.status = "Failing";
             }
@@ -198,6 +310,9 @@ Ractive.DEBUG = false;
 
 })();
 
+// called when a request needs the user to log in
+var unauthorized;
+
 function ajax(type, url, data, success, error) {
     "use strict";
     var req = new XMLHttpRequest();
@@ -219,6 +334,10 @@ function ajax(type, url, data, success, error) {
             }
 
             //failed
+            if (req.status === 401 && req.getResponseHeader("WWW-Authenticate") && unauthorized) {
+                unauthorized();
+                return;
+            }
             if (error && typeof error === 'function') {
                 error(req);
             }
@@ -259,6 +378,27 @@ function err(response) {
     return error;
 }
 
+function queryParam(name) {
+    "use strict";
+    var params = window.location.search.substring(1).split("&");
+    for (var i = 0; i < params.length; i++) {
+        var pair = params[i].split("=");
+        if (decodeURIComponent(pair[0]) == name) {
+            return decodeURIComponent(pair[1] || "");
+        }
+    }
+    return null;
+}
+
+// branchQuery returns the query string that limits a request to the given branch, if there is one
+function branchQuery(branch) {
+    "use strict";
+    if (!branch) {
+        return "";
+    }
+    return "?branch=" + encodeURIComponent(branch);
+}
+
 function formatDate(strDate) {
     "use strict";
     var date = new Date(strDate);
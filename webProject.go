@@ -6,6 +6,9 @@ package main
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"log"
 	"net/http"
 	"net/url"
 	"strings"
@@ -41,13 +44,18 @@ func splitPath(path string) (project, version, stage string) {
 	/log/<project-id> - list all versions in a project,  POST triggers new builds
 	/log/<project-id>/<version> - list combined output of all stages for a given version
 	/log/<project-id>/<version>/<stage> - list output of a given stage of a given version
+		?follow streams the output of the stage as server sent events until the stage completes
+
+	?branch=<branch> limits the projects and versions listed to those built from the given branch, and the output
+		of a version to the one built from the given branch
 */
 func logGet(w http.ResponseWriter, r *http.Request) {
 	prj, ver, stg := splitPath(r.URL.Path)
+	branch := r.URL.Query().Get("branch")
 
 	if prj == "" {
 		///log/ - list all projects
-		pList, err := projects.webList()
+		pList, err := projects.webList(branch, requestUser(r))
 		if errHandled(err, w, r) {
 			return
 		}
@@ -61,7 +69,7 @@ func logGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	project, ok := projects.get(prj)
-	if !ok {
+	if !ok || requestRole(r, prj) < roleViewer {
 		four04(w, r)
 		return
 	}
@@ -71,12 +79,12 @@ func logGet(w http.ResponseWriter, r *http.Request) {
 	if ver == "" {
 		///log/<project-id> - list all versions in a project
 
-		vers, err := project.versions()
+		vers, err := project.versions(branch)
 		if errHandled(err, w, r) {
 			return
 		}
 
-		prjData, err := project.webData()
+		prjData, err := project.webData(branch)
 		if errHandled(err, w, r) {
 			return
 		}
@@ -86,9 +94,11 @@ func logGet(w http.ResponseWriter, r *http.Request) {
 			Data: struct {
 				*webProject
 				Versions []*datastore.Log `json:"versions"`
+				Role     string           `json:"role,omitempty"` // the requesting user's role, if roles are set
 			}{
 				webProject: prjData,
 				Versions:   vers,
+				Role:       requestRoleName(r, prj),
 			},
 		})
 		return
@@ -97,7 +107,7 @@ func logGet(w http.ResponseWriter, r *http.Request) {
 	//ver found
 	if stg == "" {
 		///log/<project-id>/<version> - list combined output of all stages for a given version
-		logs, err := project.versionLog(ver)
+		logs, err := project.versionLog(branch, ver)
 		if errHandled(err, w, r) {
 			return
 		}
@@ -111,7 +121,12 @@ func logGet(w http.ResponseWriter, r *http.Request) {
 	//stage found
 	///log/<project-id>/<version>/<stage> - list output of a given stage of a given version
 
-	log, err := project.stageLog(ver, stg)
+	if _, follow := r.URL.Query()["follow"]; follow {
+		logFollow(w, r, project, branch, ver, stg)
+		return
+	}
+
+	log, err := project.stageLog(branch, ver, stg)
 	if errHandled(err, w, r) {
 		return
 	}
@@ -123,20 +138,94 @@ func logGet(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
+// logFollow streams the output of a stage as server sent events.  Output is sent as it's written to the
+// datastore, and a done event is sent once the stage is no longer running
+func logFollow(w http.ResponseWriter, r *http.Request, project *Project, branch, version, stage string) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		errHandled(errors.New("Streaming is not supported by the response writer"), w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	var key datastore.TimeKey
+	next := uint32(0)
+
+	for {
+		running := project.running(branch, version, stage)
+
+		current, output, chunk, err := project.stageOutput(branch, version, stage, next)
+		if err == nil && next > 0 && current != key {
+			// stage has been restarted, so start again from the beginning of the new entry
+			current, output, chunk, err = project.stageOutput(branch, version, stage, 0)
+		}
+		if err != nil && err != datastore.ErrNotFound {
+			sendEvent(w, "error", err.Error())
+			return
+		}
+
+		if err == nil {
+			key, next = current, chunk
+
+			if output != "" {
+				sendEvent(w, "", output)
+			}
+		}
+
+		if !running {
+			sendEvent(w, "done", "")
+			flusher.Flush()
+			return
+		}
+
+		flusher.Flush()
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(stageFlushInterval):
+		}
+	}
+}
+
+// sendEvent writes a single server sent event.  Each line of the data is sent as a separate data field, so the
+// client will see the data exactly as it was passed in
+func sendEvent(w http.ResponseWriter, event, data string) {
+	if event != "" {
+		fmt.Fprintf(w, "event: %s\n", event)
+	}
+
+	for _, line := range strings.Split(data, "\n") {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+	fmt.Fprint(w, "\n")
+}
+
 /*
-	/release/<project-id>/<version>
+	/release/<project-id>/<version>/<file-name>
 
 	/release/<project-id> - list last release for a given project
-		?all returns all the releases for a project ?file returns the last release file
-	/release/<project-id>/<version> - list release for a given project version ?file returns the file for a given release version
+		?all returns all the releases for a project ?file returns the first file of the last release
+		?branch=<branch> only includes releases built from the given branch
+	/release/<project-id>/<version> - list release and its files for a given project version
+		?branch=<branch> is the release of the version built from the given branch
+		?file returns the first file for a given release version ?sig returns its signature
+	/release/<project-id>/<version>/<file-name> - returns the named file from a given release version
+		?sig returns the file's signature
+	/release/<project-id>/<version>/SHA256SUMS - returns the checksums of all the files in a given release version
 */
 func releaseGet(w http.ResponseWriter, r *http.Request) {
-	prj, ver, _ := splitPath(r.URL.Path)
+	prj, ver, fileName := splitPath(r.URL.Path)
 
 	values := r.URL.Query()
 
 	_, all := values["all"]
 	_, file := values["file"]
+	_, sig := values["sig"]
+	branch := values.Get("branch")
 
 	if prj == "" {
 		four04(w, r)
@@ -144,7 +233,7 @@ func releaseGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	project, ok := projects.get(prj)
-	if !ok {
+	if !ok || requestRole(r, prj) < roleViewer {
 		four04(w, r)
 		return
 	}
@@ -153,10 +242,11 @@ func releaseGet(w http.ResponseWriter, r *http.Request) {
 
 	if ver == "" {
 		///release/<project-id> - list last release for a given project
-		//	?all returns all the releases for a project ?file returns the last release file
+		//	?all returns all the releases for a project ?file returns the last release file ?sig returns its signature
+		//	?branch=<branch> limits the releases to those built from the given branch
 
 		if all {
-			releases, err := project.releases()
+			releases, err := project.releases(branch)
 			if errHandled(err, w, r) {
 				return
 			}
@@ -169,19 +259,18 @@ func releaseGet(w http.ResponseWriter, r *http.Request) {
 
 		}
 
-		last, err := project.lastRelease()
+		last, err := project.lastRelease(branch)
 		if errHandled(err, w, r) {
 			return
 		}
 
-		if file {
-			fileData, err := project.releaseFile(last.FileKey)
-			if errHandled(err, w, r) {
-				return
-			}
+		if sig {
+			serveSignature(w, r, last.Files[0])
+			return
+		}
 
-			w.Header().Add("Content-disposition", `attachment; filename="`+last.FileName+`"`)
-			http.ServeContent(w, r, last.FileName, time.Time{}, bytes.NewReader(fileData))
+		if file {
+			serveReleaseFile(w, r, project, last.Files[0])
 			return
 		}
 
@@ -196,19 +285,39 @@ func releaseGet(w http.ResponseWriter, r *http.Request) {
 	//ver found
 	// /release/<project-id>/<version> - list release for a given project version ?file returns the file for a given release version
 
-	release, err := project.releaseData(ver)
+	release, err := project.releaseData(branch, ver)
 	if errHandled(err, w, r) {
 		return
 	}
 
-	if file {
-		fileData, err := project.releaseFile(release.FileKey)
+	if fileName != "" {
+		// /release/<project-id>/<version>/<file-name> - returns the named file from a given release version
+		releaseFile, err := release.File(fileName)
+		if err == datastore.ErrNotFound && fileName == checksumFileName {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			http.ServeContent(w, r, checksumFileName, release.When, bytes.NewReader(release.Checksums()))
+			return
+		}
 		if errHandled(err, w, r) {
 			return
 		}
 
-		w.Header().Add("Content-disposition", `attachment; filename="`+release.FileName+`"`)
-		http.ServeContent(w, r, release.FileName, time.Time{}, bytes.NewReader(fileData))
+		if sig {
+			serveSignature(w, r, releaseFile)
+			return
+		}
+
+		serveReleaseFile(w, r, project, releaseFile)
+		return
+	}
+
+	if sig {
+		serveSignature(w, r, release.Files[0])
+		return
+	}
+
+	if file {
+		serveReleaseFile(w, r, project, release.Files[0])
 		return
 	}
 
@@ -218,6 +327,75 @@ func releaseGet(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+const checksumFileName = "SHA256SUMS"
+
+// serveReleaseFile streams the release file to the response, range requests are supported.  The file's checksum
+// is verified before it's sent
+func serveReleaseFile(w http.ResponseWriter, r *http.Request, project *Project, file *datastore.ReleaseFile) {
+	f, err := project.openReleaseFile(file)
+	if errHandled(err, w, r) {
+		return
+	}
+
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Printf("Error closing release file %s: %s", file.FileName, err)
+		}
+	}()
+
+	w.Header().Add("Content-disposition", `attachment; filename="`+file.FileName+`"`)
+	http.ServeContent(w, r, file.FileName, time.Time{}, f)
+}
+
+// serveSignature sends the detached signature of the release file
+func serveSignature(w http.ResponseWriter, r *http.Request, file *datastore.ReleaseFile) {
+	if len(file.Signature) == 0 {
+		errHandled(&Fail{
+			Message:    fmt.Sprintf("Release file %s is not signed", file.FileName),
+			HTTPStatus: http.StatusNotFound,
+		}, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Add("Content-disposition", `attachment; filename="`+file.FileName+`.sig"`)
+	_, err := w.Write(file.Signature)
+	if err != nil {
+		log.Printf("Error writing signature of release file %s: %s", file.FileName, err)
+	}
+}
+
+/*key routes
+/key/ - the server's public key for verifying release signatures
+/key/<project-id> - the public key for verifying a given project's release signatures
+*/
+func keyGet(w http.ResponseWriter, r *http.Request) {
+	prj, _, _ := splitPath(r.URL.Path)
+
+	key := signingKey
+	if prj != "" {
+		// the project isn't looked up, so that the keys can't be used to find which projects exist when roles
+		// hide them
+		key = projectSigningKey(prj)
+	}
+
+	if key == nil {
+		four04(w, r)
+		return
+	}
+
+	data, err := publicKeyPEM(key)
+	if errHandled(err, w, r) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	_, err = w.Write(data)
+	if err != nil {
+		log.Printf("Error writing public key: %s", err)
+	}
+}
+
 type triggerInput struct {
 	Secret string `json:"secret"`
 }
@@ -227,27 +405,81 @@ type triggerInput struct {
 	Triggers a project to start a cycle
 */
 func triggerPost(w http.ResponseWriter, r *http.Request) {
+	project, ok := secretProject(w, r)
+	if !ok {
+		return
+	}
+
+	go func() {
+		project.load(true)
+	}()
+}
+
+/*cancel routes
+/cancel/<project-id>
+	Cancels the currently running cycle of a project
+*/
+func cancelPost(w http.ResponseWriter, r *http.Request) {
+	project, ok := secretProject(w, r)
+	if !ok {
+		return
+	}
+
+	if !project.cancelCycle() {
+		errHandled(&Fail{
+			Message:    "This project doesn't currently have a running cycle",
+			HTTPStatus: http.StatusConflict,
+		}, w, r)
+		return
+	}
+}
+
+// secretProject returns the project in the request path if the request includes the project's trigger secret, or
+// if roles are set, the user is a triggerer or admin of the project.  If the project isn't found or the request
+// isn't allowed, the failure is written to the response and ok is false
+func secretProject(w http.ResponseWriter, r *http.Request) (project *Project, ok bool) {
 	prj, _, _ := splitPath(r.URL.Path)
 
 	if prj == "" {
 		four04(w, r)
-		return
+		return nil, false
 	}
 
-	project, ok := projects.get(prj)
+	project, ok = projects.get(prj)
 	if !ok {
 		four04(w, r)
-		return
+		return nil, false
+	}
+
+	role := requestRole(r, prj)
+	if role < roleViewer {
+		four04(w, r)
+		return nil, false
+	}
+
+	if role >= roleAdmin {
+		return project, true
 	}
 
 	if strings.TrimSpace(project.TriggerSecret) == "" {
 		four04(w, r)
-		return
+		return nil, false
+	}
+
+	if rolesEnabled() {
+		if role < roleTriggerer {
+			errHandled(&Fail{
+				Message:    "You don't have permission to trigger or cancel this project",
+				HTTPStatus: http.StatusForbidden,
+			}, w, r)
+			return nil, false
+		}
+		return project, true
 	}
 
 	input := &triggerInput{}
 	if errHandled(parseInput(r, input), w, r) {
-		return
+		return nil, false
 	}
 
 	if input.Secret != project.TriggerSecret {
@@ -255,10 +487,8 @@ func triggerPost(w http.ResponseWriter, r *http.Request) {
 			Message:    "Invalid trigger secret for this project",
 			HTTPStatus: http.StatusUnauthorized,
 		}, w, r)
-		return
+		return nil, false
 	}
 
-	go func() {
-		project.load(true)
-	}()
+	return project, true
 }
@@ -0,0 +1,172 @@
+// Copyright 2016 Tim Shannon. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// hosted git services can send much larger payloads than the other json input
+const maxHookSize = 25 << 20 //25MB
+
+const (
+	envCommit = "IRONSMITH_COMMIT"
+	envBranch = "IRONSMITH_BRANCH"
+)
+
+// push is the part of a push event's payload that ironsmith uses, the fields are the same in the GitHub, GitLab and
+// Gitea payloads
+type push struct {
+	Ref   string `json:"ref"`
+	After string `json:"after"`
+}
+
+// hookService validates that a webhook request came from the service with the project's secret, and returns whether
+// or not the event is a push
+type hookService func(r *http.Request, body []byte, secret string) (isPush bool, valid bool)
+
+var hookServices = map[string]hookService{
+	"github": githubHook,
+	"gitlab": gitlabHook,
+	"gitea":  giteaHook,
+}
+
+// githubHook validates the HMAC SHA-256 signature of the body in the X-Hub-Signature-256 header
+func githubHook(r *http.Request, body []byte, secret string) (bool, bool) {
+	signature := strings.TrimPrefix(r.Header.Get("X-Hub-Signature-256"), "sha256=")
+	return r.Header.Get("X-GitHub-Event") == "push", validHMAC(body, secret, signature)
+}
+
+// gitlabHook validates the secret token sent as is in the X-Gitlab-Token header
+func gitlabHook(r *http.Request, body []byte, secret string) (bool, bool) {
+	token := r.Header.Get("X-Gitlab-Token")
+	return r.Header.Get("X-Gitlab-Event") == "Push Hook",
+		subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+// giteaHook validates the HMAC SHA-256 signature of the body in the X-Gitea-Signature header
+func giteaHook(r *http.Request, body []byte, secret string) (bool, bool) {
+	return r.Header.Get("X-Gitea-Event") == "push", validHMAC(body, secret, r.Header.Get("X-Gitea-Signature"))
+}
+
+func validHMAC(body []byte, secret, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || len(expected) == 0 {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+/*hook routes
+/hook/<service>/<project-id>
+	Triggers a project to start a cycle from a push event sent by github, gitlab, or gitea
+*/
+func hookPost(w http.ResponseWriter, r *http.Request) {
+	service, prj, _ := splitPath(r.URL.Path)
+
+	validate, ok := hookServices[service]
+	if !ok || prj == "" {
+		four04(w, r)
+		return
+	}
+
+	project, ok := projects.get(prj)
+	if !ok {
+		four04(w, r)
+		return
+	}
+
+	if strings.TrimSpace(project.TriggerSecret) == "" {
+		four04(w, r)
+		return
+	}
+
+	lr := &io.LimitedReader{R: r.Body, N: maxHookSize + 1}
+	body, err := ioutil.ReadAll(lr)
+	if errHandled(err, w, r) {
+		return
+	}
+
+	if lr.N == 0 {
+		errHandled(errInputTooLarge, w, r)
+		return
+	}
+
+	isPush, valid := validate(r, body, project.TriggerSecret)
+	if !valid {
+		errHandled(&Fail{
+			Message:    "Invalid webhook signature for this project",
+			HTTPStatus: http.StatusUnauthorized,
+		}, w, r)
+		return
+	}
+
+	if !isPush {
+		// ping and other events are acknowledged, but don't trigger anything
+		respondJsend(w, &JSend{
+			Status: statusSuccess,
+			Data:   "Only push events trigger a cycle",
+		})
+		return
+	}
+
+	event := &push{}
+	err = json.Unmarshal(body, event)
+	if err != nil {
+		errHandled(&Fail{
+			Message: fmt.Sprintf("Invalid push payload: %s", err),
+		}, w, r)
+		return
+	}
+
+	if !strings.HasPrefix(event.Ref, "refs/heads/") {
+		respondJsend(w, &JSend{
+			Status: statusSuccess,
+			Data:   fmt.Sprintf("%s is not a branch, no cycle triggered", event.Ref),
+		})
+		return
+	}
+
+	branch := strings.TrimPrefix(event.Ref, "refs/heads/")
+
+	if strings.Trim(event.After, "0") == "" {
+		respondJsend(w, &JSend{
+			Status: statusSuccess,
+			Data:   fmt.Sprintf("Branch %s was deleted, no cycle triggered", branch),
+		})
+		return
+	}
+
+	if !project.buildsBranch(branch) {
+		respondJsend(w, &JSend{
+			Status: statusSuccess,
+			Data:   fmt.Sprintf("Branch %s isn't built by this project, no cycle triggered", branch),
+		})
+		return
+	}
+
+	vlog("%s push to branch %s at %s triggered Project: %s\n", service, branch, event.After, project.id())
+
+	go func() {
+		project.load(false, envCommit+"="+event.After, envBranch+"="+branch)
+	}()
+
+	respondJsend(w, &JSend{
+		Status: statusSuccess,
+		Data:   fmt.Sprintf("Cycle triggered for branch %s at %s", branch, event.After),
+	})
+}